@@ -11,6 +11,7 @@ import (
 type Access interface {
 	IsAuthenticated() bool
 	IsAuthorized(string) bool
+	IsAuthorizedFor(team string, action string) bool
 	IsAdmin() bool
 	IsSystem() bool
 	TeamNames() []string
@@ -19,7 +20,8 @@ type Access interface {
 
 type access struct {
 	*jwt.Token
-	action string
+	action      string
+	actionRoles map[string]string
 }
 
 func (a *access) IsAuthenticated() bool {
@@ -27,10 +29,17 @@ func (a *access) IsAuthenticated() bool {
 }
 
 func (a *access) IsAuthorized(team string) bool {
+	return a.IsAuthorizedFor(team, a.action)
+}
+
+// IsAuthorizedFor returns true if the user's role in the team permits the
+// given action, which need not be the action being handled, e.g. to decide
+// how much of a resource to show.
+func (a *access) IsAuthorizedFor(team string, action string) bool {
 	for teamName, teamRoles := range a.TeamRoles() {
 		if teamName == team {
 			for _, teamRole := range teamRoles {
-				if a.HasPermission(teamRole) {
+				if a.hasPermission(teamRole, action) {
 					return true
 				}
 			}
@@ -39,17 +48,24 @@ func (a *access) IsAuthorized(team string) bool {
 	return false
 }
 
+// HasPermission returns true if the role is at least as privileged as the
+// role required for the action.
 func (a *access) HasPermission(role string) bool {
-	switch requiredRoles[a.action] {
-	case "owner":
-		return role == "owner"
-	case "member":
-		return role == "owner" || role == "member"
-	case "viewer":
-		return role == "owner" || role == "member" || role == "viewer"
-	default:
+	return a.hasPermission(role, a.action)
+}
+
+func (a *access) hasPermission(role string, action string) bool {
+	requiredRole, found := a.actionRoles[action]
+	if !found {
+		return false
+	}
+
+	rank, found := roleRanks[role]
+	if !found {
 		return false
 	}
+
+	return rank >= roleRanks[requiredRole]
 }
 
 func (a *access) IsAdmin() bool {
@@ -115,89 +131,105 @@ func (a *access) CSRFToken() string {
 	return ""
 }
 
-var requiredRoles = map[string]string{
-	atc.SaveConfig:                    "member",
-	atc.GetConfig:                     "viewer",
-	atc.GetCC:                         "viewer",
-	atc.GetBuild:                      "viewer",
-	atc.GetBuildPlan:                  "viewer",
-	atc.CreateBuild:                   "member",
-	atc.ListBuilds:                    "viewer",
-	atc.BuildEvents:                   "viewer",
-	atc.BuildResources:                "viewer",
-	atc.AbortBuild:                    "member",
-	atc.GetBuildPreparation:           "viewer",
-	atc.GetJob:                        "viewer",
-	atc.CreateJobBuild:                "member",
-	atc.ListAllJobs:                   "viewer",
-	atc.ListJobs:                      "viewer",
-	atc.ListJobBuilds:                 "viewer",
-	atc.ListJobInputs:                 "viewer",
-	atc.GetJobBuild:                   "viewer",
-	atc.PauseJob:                      "member",
-	atc.UnpauseJob:                    "member",
-	atc.GetVersionsDB:                 "viewer",
-	atc.JobBadge:                      "viewer",
-	atc.MainJobBadge:                  "viewer",
-	atc.ClearTaskCache:                "member",
-	atc.ListAllResources:              "viewer",
-	atc.ListResources:                 "viewer",
-	atc.ListResourceTypes:             "viewer",
-	atc.GetResource:                   "viewer",
-	atc.PauseResource:                 "member",
-	atc.UnpauseResource:               "member",
-	atc.UnpinResource:                 "member",
-	atc.SetPinCommentOnResource:       "member",
-	atc.CheckResource:                 "member",
-	atc.CheckResourceWebHook:          "member",
-	atc.CheckResourceType:             "member",
-	atc.ListResourceVersions:          "viewer",
-	atc.GetResourceVersion:            "viewer",
-	atc.EnableResourceVersion:         "member",
-	atc.DisableResourceVersion:        "member",
-	atc.PinResourceVersion:            "member",
-	atc.ListBuildsWithVersionAsInput:  "viewer",
-	atc.ListBuildsWithVersionAsOutput: "viewer",
-	atc.GetResourceCausality:          "viewer",
-	atc.ListAllPipelines:              "viewer",
-	atc.ListPipelines:                 "viewer",
-	atc.GetPipeline:                   "viewer",
-	atc.DeletePipeline:                "member",
-	atc.OrderPipelines:                "member",
-	atc.PausePipeline:                 "member",
-	atc.UnpausePipeline:               "member",
-	atc.ExposePipeline:                "member",
-	atc.HidePipeline:                  "member",
-	atc.RenamePipeline:                "member",
-	atc.ListPipelineBuilds:            "viewer",
-	atc.CreatePipelineBuild:           "member",
-	atc.PipelineBadge:                 "viewer",
-	atc.RegisterWorker:                "member",
-	atc.LandWorker:                    "member",
-	atc.RetireWorker:                  "member",
-	atc.PruneWorker:                   "member",
-	atc.HeartbeatWorker:               "member",
-	atc.ListWorkers:                   "viewer",
-	atc.DeleteWorker:                  "member",
-	atc.SetLogLevel:                   "member",
-	atc.GetLogLevel:                   "viewer",
-	atc.DownloadCLI:                   "viewer",
-	atc.GetInfo:                       "viewer",
-	atc.GetInfoCreds:                  "viewer",
-	atc.ListContainers:                "viewer",
-	atc.GetContainer:                  "viewer",
-	atc.HijackContainer:               "member",
-	atc.ListDestroyingContainers:      "viewer",
-	atc.ReportWorkerContainers:        "member",
-	atc.ListVolumes:                   "viewer",
-	atc.ListDestroyingVolumes:         "viewer",
-	atc.ReportWorkerVolumes:           "member",
-	atc.ListTeams:                     "viewer",
-	atc.SetTeam:                       "owner",
-	atc.RenameTeam:                    "owner",
-	atc.DestroyTeam:                   "owner",
-	atc.ListTeamBuilds:                "viewer",
-	atc.CreateArtifact:                "member",
-	atc.GetArtifact:                   "member",
-	atc.ListBuildArtifacts:            "viewer",
+var roleRanks = map[string]int{
+	atc.ViewerRole:   1,
+	atc.OperatorRole: 2,
+	atc.MemberRole:   3,
+	atc.OwnerRole:    4,
+}
+
+// DefaultActionRoles is the role required for each API action, unless it's
+// customized with AccessFactory.CustomizeActionRoleMap.
+var DefaultActionRoles = map[string]string{
+	atc.SaveConfig:                    atc.MemberRole,
+	atc.GetConfig:                     atc.ViewerRole,
+	atc.GetCC:                         atc.ViewerRole,
+	atc.GetBuild:                      atc.ViewerRole,
+	atc.GetBuildPlan:                  atc.ViewerRole,
+	atc.CreateBuild:                   atc.MemberRole,
+	atc.ListBuilds:                    atc.ViewerRole,
+	atc.BuildEvents:                   atc.ViewerRole,
+	atc.BuildResources:                atc.ViewerRole,
+	atc.AbortBuild:                    atc.OperatorRole,
+	atc.GetBuildPreparation:           atc.ViewerRole,
+	atc.GetJob:                        atc.ViewerRole,
+	atc.CreateJobBuild:                atc.OperatorRole,
+	atc.ListAllJobs:                   atc.ViewerRole,
+	atc.ListJobs:                      atc.ViewerRole,
+	atc.ListJobBuilds:                 atc.ViewerRole,
+	atc.ListJobInputs:                 atc.ViewerRole,
+	atc.GetJobBuild:                   atc.ViewerRole,
+	atc.PauseJob:                      atc.OperatorRole,
+	atc.UnpauseJob:                    atc.OperatorRole,
+	atc.GetVersionsDB:                 atc.ViewerRole,
+	atc.JobBadge:                      atc.ViewerRole,
+	atc.MainJobBadge:                  atc.ViewerRole,
+	atc.ClearTaskCache:                atc.OperatorRole,
+	atc.ListAllResources:              atc.ViewerRole,
+	atc.ListResources:                 atc.ViewerRole,
+	atc.ListResourceTypes:             atc.ViewerRole,
+	atc.GetResource:                   atc.ViewerRole,
+	atc.PauseResource:                 atc.OperatorRole,
+	atc.UnpauseResource:               atc.OperatorRole,
+	atc.UnpinResource:                 atc.OperatorRole,
+	atc.SetPinCommentOnResource:       atc.OperatorRole,
+	atc.CheckResource:                 atc.OperatorRole,
+	atc.CheckResourceWebHook:          atc.MemberRole,
+	atc.ListResourceWebhookDeliveries: atc.ViewerRole,
+	atc.ListResourceChecks:            atc.ViewerRole,
+	atc.CheckResourceType:             atc.OperatorRole,
+	atc.ListResourceVersions:          atc.ViewerRole,
+	atc.GetResourceVersion:            atc.ViewerRole,
+	atc.EnableResourceVersion:         atc.OperatorRole,
+	atc.DisableResourceVersion:        atc.OperatorRole,
+	atc.PinResourceVersion:            atc.OperatorRole,
+	atc.ListBuildsWithVersionAsInput:  atc.ViewerRole,
+	atc.ListBuildsWithVersionAsOutput: atc.ViewerRole,
+	atc.GetResourceCausality:          atc.ViewerRole,
+	atc.ListAllPipelines:              atc.ViewerRole,
+	atc.ListPipelines:                 atc.ViewerRole,
+	atc.GetPipeline:                   atc.ViewerRole,
+	atc.DeletePipeline:                atc.MemberRole,
+	atc.OrderPipelines:                atc.MemberRole,
+	atc.PausePipeline:                 atc.OperatorRole,
+	atc.UnpausePipeline:               atc.OperatorRole,
+	atc.ExposePipeline:                atc.MemberRole,
+	atc.HidePipeline:                  atc.MemberRole,
+	atc.RenamePipeline:                atc.MemberRole,
+	atc.ListPipelineBuilds:            atc.ViewerRole,
+	atc.CreatePipelineBuild:           atc.MemberRole,
+	atc.PipelineBadge:                 atc.ViewerRole,
+	atc.RegisterWorker:                atc.MemberRole,
+	atc.LandWorker:                    atc.MemberRole,
+	atc.RetireWorker:                  atc.MemberRole,
+	atc.ListBlockingBuilds:            atc.ViewerRole,
+	atc.CordonWorker:                  atc.MemberRole,
+	atc.UncordonWorker:                atc.MemberRole,
+	atc.ScheduleWorkerMaintenance:     atc.MemberRole,
+	atc.PruneWorker:                   atc.MemberRole,
+	atc.HeartbeatWorker:               atc.MemberRole,
+	atc.ListWorkers:                   atc.ViewerRole,
+	atc.DeleteWorker:                  atc.MemberRole,
+	atc.SetLogLevel:                   atc.MemberRole,
+	atc.GetLogLevel:                   atc.ViewerRole,
+	atc.DownloadCLI:                   atc.ViewerRole,
+	atc.GetInfo:                       atc.ViewerRole,
+	atc.GetInfoCreds:                  atc.ViewerRole,
+	atc.ListContainers:                atc.ViewerRole,
+	atc.GetContainer:                  atc.ViewerRole,
+	atc.HijackContainer:               atc.MemberRole,
+	atc.ListDestroyingContainers:      atc.ViewerRole,
+	atc.ReportWorkerContainers:        atc.MemberRole,
+	atc.ListVolumes:                   atc.ViewerRole,
+	atc.ListDestroyingVolumes:         atc.ViewerRole,
+	atc.ReportWorkerVolumes:           atc.MemberRole,
+	atc.ListTeams:                     atc.ViewerRole,
+	atc.SetTeam:                       atc.OwnerRole,
+	atc.RenameTeam:                    atc.OwnerRole,
+	atc.DestroyTeam:                   atc.OwnerRole,
+	atc.SetTeamQuotas:                 atc.OwnerRole,
+	atc.ListTeamBuilds:                atc.ViewerRole,
+	atc.CreateArtifact:                atc.MemberRole,
+	atc.GetArtifact:                   atc.MemberRole,
+	atc.ListBuildArtifacts:            atc.ViewerRole,
 }
@@ -7,6 +7,8 @@ import (
 	"net/http"
 	"strings"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	jwt "github.com/dgrijalva/jwt-go"
 )
 
@@ -14,15 +16,30 @@ import (
 
 type AccessFactory interface {
 	Create(*http.Request, string) Access
+	CustomizeActionRoleMap(lager.Logger, CustomActionRoleMap) error
 }
 
+// CustomActionRoleMap overrides the role required for API actions, keyed by
+// the action's route name, e.g.:
+//
+//	SaveConfig: owner
+//	PauseJob: viewer
+type CustomActionRoleMap map[string]string
+
 type accessFactory struct {
-	publicKey *rsa.PublicKey
+	publicKey   *rsa.PublicKey
+	actionRoles map[string]string
 }
 
 func NewAccessFactory(key *rsa.PublicKey) AccessFactory {
+	actionRoles := map[string]string{}
+	for action, role := range DefaultActionRoles {
+		actionRoles[action] = role
+	}
+
 	return &accessFactory{
-		publicKey: key,
+		publicKey:   key,
+		actionRoles: actionRoles,
 	}
 }
 
@@ -33,7 +50,34 @@ func (a *accessFactory) Create(r *http.Request, action string) Access {
 		token = &jwt.Token{}
 	}
 
-	return &access{token, action}
+	return &access{token, action, a.actionRoles}
+}
+
+// CustomizeActionRoleMap validates every action and role in the map before
+// applying any of it, so that a typo can't leave an action more permissive
+// than intended.
+func (a *accessFactory) CustomizeActionRoleMap(logger lager.Logger, customRoles CustomActionRoleMap) error {
+	for action, role := range customRoles {
+		if _, found := a.actionRoles[action]; !found {
+			return fmt.Errorf("unknown action: %s", action)
+		}
+
+		if !atc.IsValidRole(role) {
+			return fmt.Errorf("unknown role for action %s: %s", action, role)
+		}
+	}
+
+	for action, role := range customRoles {
+		logger.Info("customize-action-role", lager.Data{
+			"action":  action,
+			"role":    role,
+			"default": a.actionRoles[action],
+		})
+
+		a.actionRoles[action] = role
+	}
+
+	return nil
 }
 
 func (a *accessFactory) parseToken(r *http.Request) (*jwt.Token, error) {
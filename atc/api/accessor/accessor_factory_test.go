@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	jwt "github.com/dgrijalva/jwt-go"
 
@@ -85,4 +87,85 @@ var _ = Describe("AccessorFactory", func() {
 			})
 		})
 	})
+
+	Describe("CustomizeActionRoleMap", func() {
+		var customRoles accessor.CustomActionRoleMap
+		var customizeErr error
+
+		BeforeEach(func() {
+			var err error
+			key, err = rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+
+			accessorFactory = accessor.NewAccessFactory(&key.PublicKey)
+
+			req, err = http.NewRequest("GET", "localhost:8080", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			customRoles = accessor.CustomActionRoleMap{
+				atc.SaveConfig: atc.OwnerRole,
+				atc.PauseJob:   atc.ViewerRole,
+			}
+		})
+
+		JustBeforeEach(func() {
+			customizeErr = accessorFactory.CustomizeActionRoleMap(lagertest.NewTestLogger("test"), customRoles)
+		})
+
+		authorized := func(action string, role string) bool {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+				"teams": map[string][]string{"some-team": {role}},
+			})
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Set("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+
+			return accessorFactory.Create(req, action).IsAuthorized("some-team")
+		}
+
+		It("requires the customized roles", func() {
+			Expect(customizeErr).NotTo(HaveOccurred())
+
+			Expect(authorized(atc.SaveConfig, atc.MemberRole)).To(BeFalse())
+			Expect(authorized(atc.SaveConfig, atc.OwnerRole)).To(BeTrue())
+
+			Expect(authorized(atc.PauseJob, atc.ViewerRole)).To(BeTrue())
+		})
+
+		It("keeps the default roles of other actions", func() {
+			Expect(authorized(atc.SetTeam, atc.MemberRole)).To(BeFalse())
+			Expect(authorized(atc.GetPipeline, atc.ViewerRole)).To(BeTrue())
+		})
+
+		It("does not affect other access factories", func() {
+			otherFactory := accessor.NewAccessFactory(&key.PublicKey)
+			Expect(otherFactory.Create(req, atc.SaveConfig)).ToNot(BeNil())
+			Expect(accessor.DefaultActionRoles[atc.SaveConfig]).To(Equal(atc.MemberRole))
+		})
+
+		Context("when an action is unknown", func() {
+			BeforeEach(func() {
+				customRoles["BogusAction"] = atc.ViewerRole
+			})
+
+			It("returns an error", func() {
+				Expect(customizeErr).To(MatchError("unknown action: BogusAction"))
+			})
+
+			It("does not customize any of the actions", func() {
+				Expect(authorized(atc.PauseJob, atc.ViewerRole)).To(BeFalse())
+			})
+		})
+
+		Context("when a role is unknown", func() {
+			BeforeEach(func() {
+				customRoles[atc.PauseJob] = "bogus-role"
+			})
+
+			It("returns an error", func() {
+				Expect(customizeErr).To(MatchError("unknown role for action PauseJob: bogus-role"))
+			})
+		})
+	})
 })
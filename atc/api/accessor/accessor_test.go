@@ -224,6 +224,36 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("Is Authorized for another action", func() {
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access = accessorFactory.Create(req, atc.GetConfig)
+		})
+
+		Context("when request has team name claim set for some-team as member", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"teams": map[string][]string{"some-team": {"member"}}}
+			})
+			It("uses the role required for the other action", func() {
+				Expect(access.IsAuthorizedFor("some-team", atc.SaveConfig)).To(BeTrue())
+			})
+		})
+
+		Context("when request has team name claim set for some-team as viewer", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"teams": map[string][]string{"some-team": {"viewer"}}}
+			})
+			It("returns false even though the handled action is allowed", func() {
+				Expect(access.IsAuthorized("some-team")).To(BeTrue())
+				Expect(access.IsAuthorizedFor("some-team", atc.SaveConfig)).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Get CSRF Token", func() {
 		JustBeforeEach(func() {
 			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
@@ -336,330 +366,449 @@ var _ = Describe("Accessor", func() {
 		},
 		Entry("owner :: table has no entry", "some-role", "owner", false),
 		Entry("member :: table has no entry", "some-role", "member", false),
+		Entry("operator :: table has no entry", "some-role", "operator", false),
 		Entry("viewer :: table has no entry", "some-role", "viewer", false),
 
+		Entry("bogus-role :: "+atc.GetPipeline, atc.GetPipeline, "bogus-role", false),
+
 		Entry("owner :: "+atc.SaveConfig, atc.SaveConfig, "owner", true),
 		Entry("member :: "+atc.SaveConfig, atc.SaveConfig, "member", true),
+		Entry("operator :: "+atc.SaveConfig, atc.SaveConfig, "operator", false),
 		Entry("viewer :: "+atc.SaveConfig, atc.SaveConfig, "viewer", false),
 
 		Entry("owner :: "+atc.GetConfig, atc.GetConfig, "owner", true),
 		Entry("member :: "+atc.GetConfig, atc.GetConfig, "member", true),
+		Entry("operator :: "+atc.GetConfig, atc.GetConfig, "operator", true),
 		Entry("viewer :: "+atc.GetConfig, atc.GetConfig, "viewer", true),
 
 		Entry("owner :: "+atc.GetCC, atc.GetCC, "owner", true),
 		Entry("member :: "+atc.GetCC, atc.GetCC, "member", true),
+		Entry("operator :: "+atc.GetCC, atc.GetCC, "operator", true),
 		Entry("viewer :: "+atc.GetCC, atc.GetCC, "viewer", true),
 
 		Entry("owner :: "+atc.GetBuild, atc.GetBuild, "owner", true),
 		Entry("member :: "+atc.GetBuild, atc.GetBuild, "member", true),
+		Entry("operator :: "+atc.GetBuild, atc.GetBuild, "operator", true),
 		Entry("viewer :: "+atc.GetBuild, atc.GetBuild, "viewer", true),
 
 		Entry("owner :: "+atc.GetBuildPlan, atc.GetBuildPlan, "owner", true),
 		Entry("member :: "+atc.GetBuildPlan, atc.GetBuildPlan, "member", true),
+		Entry("operator :: "+atc.GetBuildPlan, atc.GetBuildPlan, "operator", true),
 		Entry("viewer :: "+atc.GetBuildPlan, atc.GetBuildPlan, "viewer", true),
 
 		Entry("owner :: "+atc.CreateBuild, atc.CreateBuild, "owner", true),
 		Entry("member :: "+atc.CreateBuild, atc.CreateBuild, "member", true),
+		Entry("operator :: "+atc.CreateBuild, atc.CreateBuild, "operator", false),
 		Entry("viewer :: "+atc.CreateBuild, atc.CreateBuild, "viewer", false),
 
 		Entry("owner :: "+atc.ListBuilds, atc.ListBuilds, "owner", true),
 		Entry("member :: "+atc.ListBuilds, atc.ListBuilds, "member", true),
+		Entry("operator :: "+atc.ListBuilds, atc.ListBuilds, "operator", true),
 		Entry("viewer :: "+atc.ListBuilds, atc.ListBuilds, "viewer", true),
 
 		Entry("owner :: "+atc.BuildEvents, atc.BuildEvents, "owner", true),
 		Entry("member :: "+atc.BuildEvents, atc.BuildEvents, "member", true),
+		Entry("operator :: "+atc.BuildEvents, atc.BuildEvents, "operator", true),
 		Entry("viewer :: "+atc.BuildEvents, atc.BuildEvents, "viewer", true),
 
 		Entry("owner :: "+atc.BuildResources, atc.BuildResources, "owner", true),
 		Entry("member :: "+atc.BuildResources, atc.BuildResources, "member", true),
+		Entry("operator :: "+atc.BuildResources, atc.BuildResources, "operator", true),
 		Entry("viewer :: "+atc.BuildResources, atc.BuildResources, "viewer", true),
 
 		Entry("owner :: "+atc.AbortBuild, atc.AbortBuild, "owner", true),
 		Entry("member :: "+atc.AbortBuild, atc.AbortBuild, "member", true),
+		Entry("operator :: "+atc.AbortBuild, atc.AbortBuild, "operator", true),
 		Entry("viewer :: "+atc.AbortBuild, atc.AbortBuild, "viewer", false),
 
 		Entry("owner :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "owner", true),
 		Entry("member :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "member", true),
+		Entry("operator :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "operator", true),
 		Entry("viewer :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "viewer", true),
 
 		Entry("owner :: "+atc.GetJob, atc.GetJob, "owner", true),
 		Entry("member :: "+atc.GetJob, atc.GetJob, "member", true),
+		Entry("operator :: "+atc.GetJob, atc.GetJob, "operator", true),
 		Entry("viewer :: "+atc.GetJob, atc.GetJob, "viewer", true),
 
 		Entry("owner :: "+atc.CreateJobBuild, atc.CreateJobBuild, "owner", true),
 		Entry("member :: "+atc.CreateJobBuild, atc.CreateJobBuild, "member", true),
+		Entry("operator :: "+atc.CreateJobBuild, atc.CreateJobBuild, "operator", true),
 		Entry("viewer :: "+atc.CreateJobBuild, atc.CreateJobBuild, "viewer", false),
 
 		Entry("owner :: "+atc.ListAllJobs, atc.ListAllJobs, "owner", true),
 		Entry("member :: "+atc.ListAllJobs, atc.ListAllJobs, "member", true),
+		Entry("operator :: "+atc.ListAllJobs, atc.ListAllJobs, "operator", true),
 		Entry("viewer :: "+atc.ListAllJobs, atc.ListAllJobs, "viewer", true),
 
 		Entry("owner :: "+atc.ListJobs, atc.ListJobs, "owner", true),
 		Entry("member :: "+atc.ListJobs, atc.ListJobs, "member", true),
+		Entry("operator :: "+atc.ListJobs, atc.ListJobs, "operator", true),
 		Entry("viewer :: "+atc.ListJobs, atc.ListJobs, "viewer", true),
 
 		Entry("owner :: "+atc.ListJobBuilds, atc.ListJobBuilds, "owner", true),
 		Entry("member :: "+atc.ListJobBuilds, atc.ListJobBuilds, "member", true),
+		Entry("operator :: "+atc.ListJobBuilds, atc.ListJobBuilds, "operator", true),
 		Entry("viewer :: "+atc.ListJobBuilds, atc.ListJobBuilds, "viewer", true),
 
 		Entry("owner :: "+atc.ListJobInputs, atc.ListJobInputs, "owner", true),
 		Entry("member :: "+atc.ListJobInputs, atc.ListJobInputs, "member", true),
+		Entry("operator :: "+atc.ListJobInputs, atc.ListJobInputs, "operator", true),
 		Entry("viewer :: "+atc.ListJobInputs, atc.ListJobInputs, "viewer", true),
 
 		Entry("owner :: "+atc.GetJobBuild, atc.GetJobBuild, "owner", true),
 		Entry("member :: "+atc.GetJobBuild, atc.GetJobBuild, "member", true),
+		Entry("operator :: "+atc.GetJobBuild, atc.GetJobBuild, "operator", true),
 		Entry("viewer :: "+atc.GetJobBuild, atc.GetJobBuild, "viewer", true),
 
 		Entry("owner :: "+atc.PauseJob, atc.PauseJob, "owner", true),
 		Entry("member :: "+atc.PauseJob, atc.PauseJob, "member", true),
+		Entry("operator :: "+atc.PauseJob, atc.PauseJob, "operator", true),
 		Entry("viewer :: "+atc.PauseJob, atc.PauseJob, "viewer", false),
 
 		Entry("owner :: "+atc.UnpauseJob, atc.UnpauseJob, "owner", true),
 		Entry("member :: "+atc.UnpauseJob, atc.UnpauseJob, "member", true),
+		Entry("operator :: "+atc.UnpauseJob, atc.UnpauseJob, "operator", true),
 		Entry("viewer :: "+atc.UnpauseJob, atc.UnpauseJob, "viewer", false),
 
 		Entry("owner :: "+atc.GetVersionsDB, atc.GetVersionsDB, "owner", true),
 		Entry("member :: "+atc.GetVersionsDB, atc.GetVersionsDB, "member", true),
+		Entry("operator :: "+atc.GetVersionsDB, atc.GetVersionsDB, "operator", true),
 		Entry("viewer :: "+atc.GetVersionsDB, atc.GetVersionsDB, "viewer", true),
 
 		Entry("owner :: "+atc.JobBadge, atc.JobBadge, "owner", true),
 		Entry("member :: "+atc.JobBadge, atc.JobBadge, "member", true),
+		Entry("operator :: "+atc.JobBadge, atc.JobBadge, "operator", true),
 		Entry("viewer :: "+atc.JobBadge, atc.JobBadge, "viewer", true),
 
 		Entry("owner :: "+atc.MainJobBadge, atc.MainJobBadge, "owner", true),
 		Entry("member :: "+atc.MainJobBadge, atc.MainJobBadge, "member", true),
+		Entry("operator :: "+atc.MainJobBadge, atc.MainJobBadge, "operator", true),
 		Entry("viewer :: "+atc.MainJobBadge, atc.MainJobBadge, "viewer", true),
 
 		Entry("owner :: "+atc.ClearTaskCache, atc.ClearTaskCache, "owner", true),
 		Entry("member :: "+atc.ClearTaskCache, atc.ClearTaskCache, "member", true),
+		Entry("operator :: "+atc.ClearTaskCache, atc.ClearTaskCache, "operator", true),
 		Entry("viewer :: "+atc.ClearTaskCache, atc.ClearTaskCache, "viewer", false),
 
 		Entry("owner :: "+atc.ListAllResources, atc.ListAllResources, "owner", true),
 		Entry("member :: "+atc.ListAllResources, atc.ListAllResources, "member", true),
+		Entry("operator :: "+atc.ListAllResources, atc.ListAllResources, "operator", true),
 		Entry("viewer :: "+atc.ListAllResources, atc.ListAllResources, "viewer", true),
 
 		Entry("owner :: "+atc.ListResources, atc.ListResources, "owner", true),
 		Entry("member :: "+atc.ListResources, atc.ListResources, "member", true),
+		Entry("operator :: "+atc.ListResources, atc.ListResources, "operator", true),
 		Entry("viewer :: "+atc.ListResources, atc.ListResources, "viewer", true),
 
 		Entry("owner :: "+atc.ListResourceTypes, atc.ListResourceTypes, "owner", true),
 		Entry("member :: "+atc.ListResourceTypes, atc.ListResourceTypes, "member", true),
+		Entry("operator :: "+atc.ListResourceTypes, atc.ListResourceTypes, "operator", true),
 		Entry("viewer :: "+atc.ListResourceTypes, atc.ListResourceTypes, "viewer", true),
 
 		Entry("owner :: "+atc.GetResource, atc.GetResource, "owner", true),
 		Entry("member :: "+atc.GetResource, atc.GetResource, "member", true),
+		Entry("operator :: "+atc.GetResource, atc.GetResource, "operator", true),
 		Entry("viewer :: "+atc.GetResource, atc.GetResource, "viewer", true),
 
 		Entry("owner :: "+atc.PauseResource, atc.PauseResource, "owner", true),
 		Entry("member :: "+atc.PauseResource, atc.PauseResource, "member", true),
+		Entry("operator :: "+atc.PauseResource, atc.PauseResource, "operator", true),
 		Entry("viewer :: "+atc.PauseResource, atc.PauseResource, "viewer", false),
 
 		Entry("owner :: "+atc.UnpauseResource, atc.UnpauseResource, "owner", true),
 		Entry("member :: "+atc.UnpauseResource, atc.UnpauseResource, "member", true),
+		Entry("operator :: "+atc.UnpauseResource, atc.UnpauseResource, "operator", true),
 		Entry("viewer :: "+atc.UnpauseResource, atc.UnpauseResource, "viewer", false),
 
 		Entry("owner :: "+atc.CheckResource, atc.CheckResource, "owner", true),
 		Entry("member :: "+atc.CheckResource, atc.CheckResource, "member", true),
+		Entry("operator :: "+atc.CheckResource, atc.CheckResource, "operator", true),
 		Entry("viewer :: "+atc.CheckResource, atc.CheckResource, "viewer", false),
 
 		Entry("owner :: "+atc.CheckResourceWebHook, atc.CheckResourceWebHook, "owner", true),
 		Entry("member :: "+atc.CheckResourceWebHook, atc.CheckResourceWebHook, "member", true),
+		Entry("operator :: "+atc.CheckResourceWebHook, atc.CheckResourceWebHook, "operator", false),
 		Entry("viewer :: "+atc.CheckResourceWebHook, atc.CheckResourceWebHook, "viewer", false),
 
+		Entry("owner :: "+atc.ListResourceWebhookDeliveries, atc.ListResourceWebhookDeliveries, "owner", true),
+		Entry("member :: "+atc.ListResourceWebhookDeliveries, atc.ListResourceWebhookDeliveries, "member", true),
+		Entry("operator :: "+atc.ListResourceWebhookDeliveries, atc.ListResourceWebhookDeliveries, "operator", true),
+		Entry("viewer :: "+atc.ListResourceWebhookDeliveries, atc.ListResourceWebhookDeliveries, "viewer", true),
+
+		Entry("owner :: "+atc.ListResourceChecks, atc.ListResourceChecks, "owner", true),
+		Entry("member :: "+atc.ListResourceChecks, atc.ListResourceChecks, "member", true),
+		Entry("operator :: "+atc.ListResourceChecks, atc.ListResourceChecks, "operator", true),
+		Entry("viewer :: "+atc.ListResourceChecks, atc.ListResourceChecks, "viewer", true),
+
 		Entry("owner :: "+atc.CheckResourceType, atc.CheckResourceType, "owner", true),
 		Entry("member :: "+atc.CheckResourceType, atc.CheckResourceType, "member", true),
+		Entry("operator :: "+atc.CheckResourceType, atc.CheckResourceType, "operator", true),
 		Entry("viewer :: "+atc.CheckResourceType, atc.CheckResourceType, "viewer", false),
 
 		Entry("owner :: "+atc.ListResourceVersions, atc.ListResourceVersions, "owner", true),
 		Entry("member :: "+atc.ListResourceVersions, atc.ListResourceVersions, "member", true),
+		Entry("operator :: "+atc.ListResourceVersions, atc.ListResourceVersions, "operator", true),
 		Entry("viewer :: "+atc.ListResourceVersions, atc.ListResourceVersions, "viewer", true),
 
 		Entry("owner :: "+atc.GetResourceVersion, atc.GetResourceVersion, "owner", true),
 		Entry("member :: "+atc.GetResourceVersion, atc.GetResourceVersion, "member", true),
+		Entry("operator :: "+atc.GetResourceVersion, atc.GetResourceVersion, "operator", true),
 		Entry("viewer :: "+atc.GetResourceVersion, atc.GetResourceVersion, "viewer", true),
 
 		Entry("owner :: "+atc.EnableResourceVersion, atc.EnableResourceVersion, "owner", true),
 		Entry("member :: "+atc.EnableResourceVersion, atc.EnableResourceVersion, "member", true),
+		Entry("operator :: "+atc.EnableResourceVersion, atc.EnableResourceVersion, "operator", true),
 		Entry("viewer :: "+atc.EnableResourceVersion, atc.EnableResourceVersion, "viewer", false),
 
 		Entry("owner :: "+atc.DisableResourceVersion, atc.DisableResourceVersion, "owner", true),
 		Entry("member :: "+atc.DisableResourceVersion, atc.DisableResourceVersion, "member", true),
+		Entry("operator :: "+atc.DisableResourceVersion, atc.DisableResourceVersion, "operator", true),
 		Entry("viewer :: "+atc.DisableResourceVersion, atc.DisableResourceVersion, "viewer", false),
 
 		Entry("owner :: "+atc.ListBuildsWithVersionAsInput, atc.ListBuildsWithVersionAsInput, "owner", true),
 		Entry("member :: "+atc.ListBuildsWithVersionAsInput, atc.ListBuildsWithVersionAsInput, "member", true),
+		Entry("operator :: "+atc.ListBuildsWithVersionAsInput, atc.ListBuildsWithVersionAsInput, "operator", true),
 		Entry("viewer :: "+atc.ListBuildsWithVersionAsInput, atc.ListBuildsWithVersionAsInput, "viewer", true),
 
 		Entry("owner :: "+atc.ListBuildsWithVersionAsOutput, atc.ListBuildsWithVersionAsOutput, "owner", true),
 		Entry("member :: "+atc.ListBuildsWithVersionAsOutput, atc.ListBuildsWithVersionAsOutput, "member", true),
+		Entry("operator :: "+atc.ListBuildsWithVersionAsOutput, atc.ListBuildsWithVersionAsOutput, "operator", true),
 		Entry("viewer :: "+atc.ListBuildsWithVersionAsOutput, atc.ListBuildsWithVersionAsOutput, "viewer", true),
 
 		Entry("owner :: "+atc.GetResourceCausality, atc.GetResourceCausality, "owner", true),
 		Entry("member :: "+atc.GetResourceCausality, atc.GetResourceCausality, "member", true),
+		Entry("operator :: "+atc.GetResourceCausality, atc.GetResourceCausality, "operator", true),
 		Entry("viewer :: "+atc.GetResourceCausality, atc.GetResourceCausality, "viewer", true),
 
 		Entry("owner :: "+atc.ListAllPipelines, atc.ListAllPipelines, "owner", true),
 		Entry("member :: "+atc.ListAllPipelines, atc.ListAllPipelines, "member", true),
+		Entry("operator :: "+atc.ListAllPipelines, atc.ListAllPipelines, "operator", true),
 		Entry("viewer :: "+atc.ListAllPipelines, atc.ListAllPipelines, "viewer", true),
 
 		Entry("owner :: "+atc.ListPipelines, atc.ListPipelines, "owner", true),
 		Entry("member :: "+atc.ListPipelines, atc.ListPipelines, "member", true),
+		Entry("operator :: "+atc.ListPipelines, atc.ListPipelines, "operator", true),
 		Entry("viewer :: "+atc.ListPipelines, atc.ListPipelines, "viewer", true),
 
 		Entry("owner :: "+atc.GetPipeline, atc.GetPipeline, "owner", true),
 		Entry("member :: "+atc.GetPipeline, atc.GetPipeline, "member", true),
+		Entry("operator :: "+atc.GetPipeline, atc.GetPipeline, "operator", true),
 		Entry("viewer :: "+atc.GetPipeline, atc.GetPipeline, "viewer", true),
 
 		Entry("owner :: "+atc.DeletePipeline, atc.DeletePipeline, "owner", true),
 		Entry("member :: "+atc.DeletePipeline, atc.DeletePipeline, "member", true),
+		Entry("operator :: "+atc.DeletePipeline, atc.DeletePipeline, "operator", false),
 		Entry("viewer :: "+atc.DeletePipeline, atc.DeletePipeline, "viewer", false),
 
 		Entry("owner :: "+atc.OrderPipelines, atc.OrderPipelines, "owner", true),
 		Entry("member :: "+atc.OrderPipelines, atc.OrderPipelines, "member", true),
+		Entry("operator :: "+atc.OrderPipelines, atc.OrderPipelines, "operator", false),
 		Entry("viewer :: "+atc.OrderPipelines, atc.OrderPipelines, "viewer", false),
 
 		Entry("owner :: "+atc.PausePipeline, atc.PausePipeline, "owner", true),
 		Entry("member :: "+atc.PausePipeline, atc.PausePipeline, "member", true),
+		Entry("operator :: "+atc.PausePipeline, atc.PausePipeline, "operator", true),
 		Entry("viewer :: "+atc.PausePipeline, atc.PausePipeline, "viewer", false),
 
 		Entry("owner :: "+atc.UnpausePipeline, atc.UnpausePipeline, "owner", true),
 		Entry("member :: "+atc.UnpausePipeline, atc.UnpausePipeline, "member", true),
+		Entry("operator :: "+atc.UnpausePipeline, atc.UnpausePipeline, "operator", true),
 		Entry("viewer :: "+atc.UnpausePipeline, atc.UnpausePipeline, "viewer", false),
 
 		Entry("owner :: "+atc.ExposePipeline, atc.ExposePipeline, "owner", true),
 		Entry("member :: "+atc.ExposePipeline, atc.ExposePipeline, "member", true),
+		Entry("operator :: "+atc.ExposePipeline, atc.ExposePipeline, "operator", false),
 		Entry("viewer :: "+atc.ExposePipeline, atc.ExposePipeline, "viewer", false),
 
 		Entry("owner :: "+atc.HidePipeline, atc.HidePipeline, "owner", true),
 		Entry("member :: "+atc.HidePipeline, atc.HidePipeline, "member", true),
+		Entry("operator :: "+atc.HidePipeline, atc.HidePipeline, "operator", false),
 		Entry("viewer :: "+atc.HidePipeline, atc.HidePipeline, "viewer", false),
 
 		Entry("owner :: "+atc.RenamePipeline, atc.RenamePipeline, "owner", true),
 		Entry("member :: "+atc.RenamePipeline, atc.RenamePipeline, "member", true),
+		Entry("operator :: "+atc.RenamePipeline, atc.RenamePipeline, "operator", false),
 		Entry("viewer :: "+atc.RenamePipeline, atc.RenamePipeline, "viewer", false),
 
 		Entry("owner :: "+atc.ListPipelineBuilds, atc.ListPipelineBuilds, "owner", true),
 		Entry("member :: "+atc.ListPipelineBuilds, atc.ListPipelineBuilds, "member", true),
+		Entry("operator :: "+atc.ListPipelineBuilds, atc.ListPipelineBuilds, "operator", true),
 		Entry("viewer :: "+atc.ListPipelineBuilds, atc.ListPipelineBuilds, "viewer", true),
 
 		Entry("owner :: "+atc.CreatePipelineBuild, atc.CreatePipelineBuild, "owner", true),
 		Entry("member :: "+atc.CreatePipelineBuild, atc.CreatePipelineBuild, "member", true),
+		Entry("operator :: "+atc.CreatePipelineBuild, atc.CreatePipelineBuild, "operator", false),
 		Entry("viewer :: "+atc.CreatePipelineBuild, atc.CreatePipelineBuild, "viewer", false),
 
 		Entry("owner :: "+atc.PipelineBadge, atc.PipelineBadge, "owner", true),
 		Entry("member :: "+atc.PipelineBadge, atc.PipelineBadge, "member", true),
+		Entry("operator :: "+atc.PipelineBadge, atc.PipelineBadge, "operator", true),
 		Entry("viewer :: "+atc.PipelineBadge, atc.PipelineBadge, "viewer", true),
 
 		Entry("owner :: "+atc.RegisterWorker, atc.RegisterWorker, "owner", true),
 		Entry("member :: "+atc.RegisterWorker, atc.RegisterWorker, "member", true),
+		Entry("operator :: "+atc.RegisterWorker, atc.RegisterWorker, "operator", false),
 		Entry("viewer :: "+atc.RegisterWorker, atc.RegisterWorker, "viewer", false),
 
 		Entry("owner :: "+atc.LandWorker, atc.LandWorker, "owner", true),
 		Entry("member :: "+atc.LandWorker, atc.LandWorker, "member", true),
+		Entry("operator :: "+atc.LandWorker, atc.LandWorker, "operator", false),
 		Entry("viewer :: "+atc.LandWorker, atc.LandWorker, "viewer", false),
 
 		Entry("owner :: "+atc.RetireWorker, atc.RetireWorker, "owner", true),
 		Entry("member :: "+atc.RetireWorker, atc.RetireWorker, "member", true),
+		Entry("operator :: "+atc.RetireWorker, atc.RetireWorker, "operator", false),
 		Entry("viewer :: "+atc.RetireWorker, atc.RetireWorker, "viewer", false),
 
+		Entry("owner :: "+atc.ListBlockingBuilds, atc.ListBlockingBuilds, "owner", true),
+		Entry("member :: "+atc.ListBlockingBuilds, atc.ListBlockingBuilds, "member", true),
+		Entry("operator :: "+atc.ListBlockingBuilds, atc.ListBlockingBuilds, "operator", true),
+		Entry("viewer :: "+atc.ListBlockingBuilds, atc.ListBlockingBuilds, "viewer", true),
+
+		Entry("owner :: "+atc.CordonWorker, atc.CordonWorker, "owner", true),
+		Entry("member :: "+atc.CordonWorker, atc.CordonWorker, "member", true),
+		Entry("operator :: "+atc.CordonWorker, atc.CordonWorker, "operator", false),
+		Entry("viewer :: "+atc.CordonWorker, atc.CordonWorker, "viewer", false),
+
+		Entry("owner :: "+atc.UncordonWorker, atc.UncordonWorker, "owner", true),
+		Entry("member :: "+atc.UncordonWorker, atc.UncordonWorker, "member", true),
+		Entry("operator :: "+atc.UncordonWorker, atc.UncordonWorker, "operator", false),
+		Entry("viewer :: "+atc.UncordonWorker, atc.UncordonWorker, "viewer", false),
+
+		Entry("owner :: "+atc.ScheduleWorkerMaintenance, atc.ScheduleWorkerMaintenance, "owner", true),
+		Entry("member :: "+atc.ScheduleWorkerMaintenance, atc.ScheduleWorkerMaintenance, "member", true),
+		Entry("operator :: "+atc.ScheduleWorkerMaintenance, atc.ScheduleWorkerMaintenance, "operator", false),
+		Entry("viewer :: "+atc.ScheduleWorkerMaintenance, atc.ScheduleWorkerMaintenance, "viewer", false),
+
 		Entry("owner :: "+atc.PruneWorker, atc.PruneWorker, "owner", true),
 		Entry("member :: "+atc.PruneWorker, atc.PruneWorker, "member", true),
+		Entry("operator :: "+atc.PruneWorker, atc.PruneWorker, "operator", false),
 		Entry("viewer :: "+atc.PruneWorker, atc.PruneWorker, "viewer", false),
 
 		Entry("owner :: "+atc.HeartbeatWorker, atc.HeartbeatWorker, "owner", true),
 		Entry("member :: "+atc.HeartbeatWorker, atc.HeartbeatWorker, "member", true),
+		Entry("operator :: "+atc.HeartbeatWorker, atc.HeartbeatWorker, "operator", false),
 		Entry("viewer :: "+atc.HeartbeatWorker, atc.HeartbeatWorker, "viewer", false),
 
 		Entry("owner :: "+atc.ListWorkers, atc.ListWorkers, "owner", true),
 		Entry("member :: "+atc.ListWorkers, atc.ListWorkers, "member", true),
+		Entry("operator :: "+atc.ListWorkers, atc.ListWorkers, "operator", true),
 		Entry("viewer :: "+atc.ListWorkers, atc.ListWorkers, "viewer", true),
 
 		Entry("owner :: "+atc.DeleteWorker, atc.DeleteWorker, "owner", true),
 		Entry("member :: "+atc.DeleteWorker, atc.DeleteWorker, "member", true),
+		Entry("operator :: "+atc.DeleteWorker, atc.DeleteWorker, "operator", false),
 		Entry("viewer :: "+atc.DeleteWorker, atc.DeleteWorker, "viewer", false),
 
 		Entry("owner :: "+atc.SetLogLevel, atc.SetLogLevel, "owner", true),
 		Entry("member :: "+atc.SetLogLevel, atc.SetLogLevel, "member", true),
+		Entry("operator :: "+atc.SetLogLevel, atc.SetLogLevel, "operator", false),
 		Entry("viewer :: "+atc.SetLogLevel, atc.SetLogLevel, "viewer", false),
 
 		Entry("owner :: "+atc.GetLogLevel, atc.GetLogLevel, "owner", true),
 		Entry("member :: "+atc.GetLogLevel, atc.GetLogLevel, "member", true),
+		Entry("operator :: "+atc.GetLogLevel, atc.GetLogLevel, "operator", true),
 		Entry("viewer :: "+atc.GetLogLevel, atc.GetLogLevel, "viewer", true),
 
 		Entry("owner :: "+atc.DownloadCLI, atc.DownloadCLI, "owner", true),
 		Entry("member :: "+atc.DownloadCLI, atc.DownloadCLI, "member", true),
+		Entry("operator :: "+atc.DownloadCLI, atc.DownloadCLI, "operator", true),
 		Entry("viewer :: "+atc.DownloadCLI, atc.DownloadCLI, "viewer", true),
 
 		Entry("owner :: "+atc.GetInfo, atc.GetInfo, "owner", true),
 		Entry("member :: "+atc.GetInfo, atc.GetInfo, "member", true),
+		Entry("operator :: "+atc.GetInfo, atc.GetInfo, "operator", true),
 		Entry("viewer :: "+atc.GetInfo, atc.GetInfo, "viewer", true),
 
 		Entry("owner :: "+atc.GetInfoCreds, atc.GetInfoCreds, "owner", true),
 		Entry("member :: "+atc.GetInfoCreds, atc.GetInfoCreds, "member", true),
+		Entry("operator :: "+atc.GetInfoCreds, atc.GetInfoCreds, "operator", true),
 		Entry("viewer :: "+atc.GetInfoCreds, atc.GetInfoCreds, "viewer", true),
 
 		Entry("owner :: "+atc.ListContainers, atc.ListContainers, "owner", true),
 		Entry("member :: "+atc.ListContainers, atc.ListContainers, "member", true),
+		Entry("operator :: "+atc.ListContainers, atc.ListContainers, "operator", true),
 		Entry("viewer :: "+atc.ListContainers, atc.ListContainers, "viewer", true),
 
 		Entry("owner :: "+atc.GetContainer, atc.GetContainer, "owner", true),
 		Entry("member :: "+atc.GetContainer, atc.GetContainer, "member", true),
+		Entry("operator :: "+atc.GetContainer, atc.GetContainer, "operator", true),
 		Entry("viewer :: "+atc.GetContainer, atc.GetContainer, "viewer", true),
 
 		Entry("owner :: "+atc.HijackContainer, atc.HijackContainer, "owner", true),
 		Entry("member :: "+atc.HijackContainer, atc.HijackContainer, "member", true),
+		Entry("operator :: "+atc.HijackContainer, atc.HijackContainer, "operator", false),
 		Entry("viewer :: "+atc.HijackContainer, atc.HijackContainer, "viewer", false),
 
 		Entry("owner :: "+atc.ListDestroyingContainers, atc.ListDestroyingContainers, "owner", true),
 		Entry("member :: "+atc.ListDestroyingContainers, atc.ListDestroyingContainers, "member", true),
+		Entry("operator :: "+atc.ListDestroyingContainers, atc.ListDestroyingContainers, "operator", true),
 		Entry("viewer :: "+atc.ListDestroyingContainers, atc.ListDestroyingContainers, "viewer", true),
 
 		Entry("owner :: "+atc.ReportWorkerContainers, atc.ReportWorkerContainers, "owner", true),
 		Entry("member :: "+atc.ReportWorkerContainers, atc.ReportWorkerContainers, "member", true),
+		Entry("operator :: "+atc.ReportWorkerContainers, atc.ReportWorkerContainers, "operator", false),
 		Entry("viewer :: "+atc.ReportWorkerContainers, atc.ReportWorkerContainers, "viewer", false),
 
 		Entry("owner :: "+atc.ListVolumes, atc.ListVolumes, "owner", true),
 		Entry("member :: "+atc.ListVolumes, atc.ListVolumes, "member", true),
+		Entry("operator :: "+atc.ListVolumes, atc.ListVolumes, "operator", true),
 		Entry("viewer :: "+atc.ListVolumes, atc.ListVolumes, "viewer", true),
 
 		Entry("owner :: "+atc.ListDestroyingVolumes, atc.ListDestroyingVolumes, "owner", true),
 		Entry("member :: "+atc.ListDestroyingVolumes, atc.ListDestroyingVolumes, "member", true),
+		Entry("operator :: "+atc.ListDestroyingVolumes, atc.ListDestroyingVolumes, "operator", true),
 		Entry("viewer :: "+atc.ListDestroyingVolumes, atc.ListDestroyingVolumes, "viewer", true),
 
 		Entry("owner :: "+atc.ReportWorkerVolumes, atc.ReportWorkerVolumes, "owner", true),
 		Entry("member :: "+atc.ReportWorkerVolumes, atc.ReportWorkerVolumes, "member", true),
+		Entry("operator :: "+atc.ReportWorkerVolumes, atc.ReportWorkerVolumes, "operator", false),
 		Entry("viewer :: "+atc.ReportWorkerVolumes, atc.ReportWorkerVolumes, "viewer", false),
 
 		Entry("owner :: "+atc.ListTeams, atc.ListTeams, "owner", true),
 		Entry("member :: "+atc.ListTeams, atc.ListTeams, "member", true),
+		Entry("operator :: "+atc.ListTeams, atc.ListTeams, "operator", true),
 		Entry("viewer :: "+atc.ListTeams, atc.ListTeams, "viewer", true),
 
 		Entry("owner :: "+atc.SetTeam, atc.SetTeam, "owner", true),
 		Entry("member :: "+atc.SetTeam, atc.SetTeam, "member", false),
+		Entry("operator :: "+atc.SetTeam, atc.SetTeam, "operator", false),
 		Entry("viewer :: "+atc.SetTeam, atc.SetTeam, "viewer", false),
 
 		Entry("owner :: "+atc.RenameTeam, atc.RenameTeam, "owner", true),
 		Entry("member :: "+atc.RenameTeam, atc.RenameTeam, "member", false),
+		Entry("operator :: "+atc.RenameTeam, atc.RenameTeam, "operator", false),
 		Entry("viewer :: "+atc.RenameTeam, atc.RenameTeam, "viewer", false),
 
 		Entry("owner :: "+atc.DestroyTeam, atc.DestroyTeam, "owner", true),
 		Entry("member :: "+atc.DestroyTeam, atc.DestroyTeam, "member", false),
+		Entry("operator :: "+atc.DestroyTeam, atc.DestroyTeam, "operator", false),
 		Entry("viewer :: "+atc.DestroyTeam, atc.DestroyTeam, "viewer", false),
 
+		Entry("owner :: "+atc.SetTeamQuotas, atc.SetTeamQuotas, "owner", true),
+		Entry("member :: "+atc.SetTeamQuotas, atc.SetTeamQuotas, "member", false),
+		Entry("operator :: "+atc.SetTeamQuotas, atc.SetTeamQuotas, "operator", false),
+		Entry("viewer :: "+atc.SetTeamQuotas, atc.SetTeamQuotas, "viewer", false),
+
 		Entry("owner :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "owner", true),
 		Entry("member :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "member", true),
+		Entry("operator :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "operator", true),
 		Entry("viewer :: "+atc.ListTeamBuilds, atc.ListTeamBuilds, "viewer", true),
 
 		Entry("owner :: "+atc.CreateArtifact, atc.CreateArtifact, "owner", true),
 		Entry("member :: "+atc.CreateArtifact, atc.CreateArtifact, "member", true),
+		Entry("operator :: "+atc.CreateArtifact, atc.CreateArtifact, "operator", false),
 		Entry("viewer :: "+atc.CreateArtifact, atc.CreateArtifact, "viewer", false),
 
 		Entry("owner :: "+atc.GetArtifact, atc.GetArtifact, "owner", true),
 		Entry("member :: "+atc.GetArtifact, atc.GetArtifact, "member", true),
+		Entry("operator :: "+atc.GetArtifact, atc.GetArtifact, "operator", false),
 		Entry("viewer :: "+atc.GetArtifact, atc.GetArtifact, "viewer", false),
 
 		Entry("owner :: "+atc.ListBuildArtifacts, atc.ListBuildArtifacts, "owner", true),
 		Entry("member :: "+atc.ListBuildArtifacts, atc.ListBuildArtifacts, "member", true),
+		Entry("operator :: "+atc.ListBuildArtifacts, atc.ListBuildArtifacts, "operator", true),
 		Entry("viewer :: "+atc.ListBuildArtifacts, atc.ListBuildArtifacts, "viewer", true),
 	)
 })
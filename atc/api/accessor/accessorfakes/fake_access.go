@@ -49,6 +49,18 @@ type FakeAccess struct {
 	isAuthorizedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsAuthorizedForStub        func(string, string) bool
+	isAuthorizedForMutex       sync.RWMutex
+	isAuthorizedForArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	isAuthorizedForReturns struct {
+		result1 bool
+	}
+	isAuthorizedForReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsSystemStub        func() bool
 	isSystemMutex       sync.RWMutex
 	isSystemArgsForCall []struct {
@@ -289,6 +301,67 @@ func (fake *FakeAccess) IsAuthorizedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeAccess) IsAuthorizedFor(arg1 string, arg2 string) bool {
+	fake.isAuthorizedForMutex.Lock()
+	ret, specificReturn := fake.isAuthorizedForReturnsOnCall[len(fake.isAuthorizedForArgsForCall)]
+	fake.isAuthorizedForArgsForCall = append(fake.isAuthorizedForArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("IsAuthorizedFor", []interface{}{arg1, arg2})
+	fake.isAuthorizedForMutex.Unlock()
+	if fake.IsAuthorizedForStub != nil {
+		return fake.IsAuthorizedForStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isAuthorizedForReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) IsAuthorizedForCallCount() int {
+	fake.isAuthorizedForMutex.RLock()
+	defer fake.isAuthorizedForMutex.RUnlock()
+	return len(fake.isAuthorizedForArgsForCall)
+}
+
+func (fake *FakeAccess) IsAuthorizedForCalls(stub func(string, string) bool) {
+	fake.isAuthorizedForMutex.Lock()
+	defer fake.isAuthorizedForMutex.Unlock()
+	fake.IsAuthorizedForStub = stub
+}
+
+func (fake *FakeAccess) IsAuthorizedForArgsForCall(i int) (string, string) {
+	fake.isAuthorizedForMutex.RLock()
+	defer fake.isAuthorizedForMutex.RUnlock()
+	argsForCall := fake.isAuthorizedForArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAccess) IsAuthorizedForReturns(result1 bool) {
+	fake.isAuthorizedForMutex.Lock()
+	defer fake.isAuthorizedForMutex.Unlock()
+	fake.IsAuthorizedForStub = nil
+	fake.isAuthorizedForReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsAuthorizedForReturnsOnCall(i int, result1 bool) {
+	fake.isAuthorizedForMutex.Lock()
+	defer fake.isAuthorizedForMutex.Unlock()
+	fake.IsAuthorizedForStub = nil
+	if fake.isAuthorizedForReturnsOnCall == nil {
+		fake.isAuthorizedForReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isAuthorizedForReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccess) IsSystem() bool {
 	fake.isSystemMutex.Lock()
 	ret, specificReturn := fake.isSystemReturnsOnCall[len(fake.isSystemArgsForCall)]
@@ -404,6 +477,8 @@ func (fake *FakeAccess) Invocations() map[string][][]interface{} {
 	defer fake.isAuthenticatedMutex.RUnlock()
 	fake.isAuthorizedMutex.RLock()
 	defer fake.isAuthorizedMutex.RUnlock()
+	fake.isAuthorizedForMutex.RLock()
+	defer fake.isAuthorizedForMutex.RUnlock()
 	fake.isSystemMutex.RLock()
 	defer fake.isSystemMutex.RUnlock()
 	fake.teamNamesMutex.RLock()
@@ -21,6 +21,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/gc/gcfakes"
+	"github.com/concourse/concourse/atc/scheduler/inputmapper/inputmapperfakes"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	"github.com/concourse/concourse/atc/wrappa"
 	. "github.com/onsi/ginkgo"
@@ -40,7 +41,7 @@ var (
 	dbPipelineFactory       *dbfakes.FakePipelineFactory
 	dbJobFactory            *dbfakes.FakeJobFactory
 	dbResourceFactory       *dbfakes.FakeResourceFactory
-	dbResourceConfigFactory *dbfakes.FakeResourceConfigFactory
+	dbCheckFactory          *dbfakes.FakeCheckFactory
 	fakePipeline            *dbfakes.FakePipeline
 	fakeAccessor            *accessorfakes.FakeAccessFactory
 	dbWorkerFactory         *dbfakes.FakeWorkerFactory
@@ -49,8 +50,11 @@ var (
 	dbBuildFactory          *dbfakes.FakeBuildFactory
 	dbTeam                  *dbfakes.FakeTeam
 	fakeScannerFactory      *resourceserverfakes.FakeScannerFactory
+	fakeInputMapperFactory  *inputmapperfakes.FakeInputMapperFactory
+	fakeInputMapper         *inputmapperfakes.FakeInputMapper
 	fakeVariablesFactory    *credsfakes.FakeVariablesFactory
 	credsManagers           creds.Managers
+	credentialManagerChain  []string
 	interceptTimeoutFactory *containerserverfakes.FakeInterceptTimeoutFactory
 	interceptTimeout        *containerserverfakes.FakeInterceptTimeout
 	drain                   chan struct{}
@@ -90,7 +94,7 @@ var _ = BeforeEach(func() {
 	dbPipelineFactory = new(dbfakes.FakePipelineFactory)
 	dbJobFactory = new(dbfakes.FakeJobFactory)
 	dbResourceFactory = new(dbfakes.FakeResourceFactory)
-	dbResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
+	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
@@ -115,12 +119,17 @@ var _ = BeforeEach(func() {
 
 	fakeScannerFactory = new(resourceserverfakes.FakeScannerFactory)
 
+	fakeInputMapper = new(inputmapperfakes.FakeInputMapper)
+	fakeInputMapperFactory = new(inputmapperfakes.FakeInputMapperFactory)
+	fakeInputMapperFactory.NewInputMapperReturns(fakeInputMapper)
+
 	fakeVolumeRepository = new(dbfakes.FakeVolumeRepository)
 	fakeContainerRepository = new(dbfakes.FakeContainerRepository)
 	fakeDestroyer = new(gcfakes.FakeDestroyer)
 
 	fakeVariablesFactory = new(credsfakes.FakeVariablesFactory)
 	credsManagers = make(creds.Managers)
+	credentialManagerChain = []string{"vault", "credhub"}
 	var err error
 
 	cliDownloadsDir, err = ioutil.TempDir("", "cli-downloads")
@@ -167,7 +176,7 @@ var _ = BeforeEach(func() {
 		fakeContainerRepository,
 		fakeDestroyer,
 		dbBuildFactory,
-		dbResourceConfigFactory,
+		dbCheckFactory,
 
 		constructedEventHandler.Construct,
 		drain,
@@ -175,6 +184,7 @@ var _ = BeforeEach(func() {
 		fakeWorkerClient,
 
 		fakeScannerFactory,
+		fakeInputMapperFactory,
 
 		sink,
 
@@ -185,6 +195,7 @@ var _ = BeforeEach(func() {
 		"4.5.6",
 		fakeVariablesFactory,
 		credsManagers,
+		credentialManagerChain,
 		interceptTimeoutFactory,
 	)
 
@@ -93,6 +93,23 @@ var _ = Describe("Builds API", func() {
 					fakeaccess.IsAuthorizedReturns(true)
 				})
 
+				Context("when the team has reached its running builds quota", func() {
+					BeforeEach(func() {
+						dbTeam.NameReturns("some-team")
+						dbTeam.CreateStartedBuildReturns(nil, db.ErrRunningBuildsQuotaReached)
+					})
+
+					It("returns 429 Too Many Requests", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusTooManyRequests))
+					})
+
+					It("says why", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("team 'some-team' has reached its running builds quota\n"))
+					})
+				})
+
 				Context("when creating a started build fails", func() {
 					BeforeEach(func() {
 						dbTeam.CreateStartedBuildReturns(nil, errors.New("oh no!"))
@@ -2,6 +2,7 @@ package buildserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
@@ -23,6 +24,13 @@ func (s *Server) CreateBuild(team db.Team) http.Handler {
 		}
 
 		build, err := team.CreateStartedBuild(plan)
+		if err == db.ErrRunningBuildsQuotaReached {
+			hLog.Info("running-builds-quota-reached")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprintf(w, "team '%s' has reached its running builds quota\n", team.Name())
+			return
+		}
+
 		if err != nil {
 			hLog.Error("failed-to-create-one-off-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
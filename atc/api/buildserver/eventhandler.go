@@ -2,6 +2,7 @@ package buildserver
 
 import (
 	"compress/gzip"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,13 +11,33 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/logstore"
 	"github.com/vito/go-sse/sse"
 )
 
 const ProtocolVersionHeader = "X-ATC-Stream-Version"
 const CurrentProtocolVersion = "2.0"
 
+// EventsFunc opens the events of a build, starting from the given event.
+type EventsFunc func(ctx context.Context, from uint) (db.EventSource, error)
+
 func NewEventHandler(logger lager.Logger, build db.Build) http.Handler {
+	return newEventHandler(logger, build, func(_ context.Context, from uint) (db.EventSource, error) {
+		return build.Events(from)
+	})
+}
+
+// NewLogStoreEventHandlerFactory returns an EventHandlerFactory which streams
+// the events of builds which have been archived from the log store.
+func NewLogStoreEventHandlerFactory(store logstore.Store) EventHandlerFactory {
+	return func(logger lager.Logger, build db.Build) http.Handler {
+		return newEventHandler(logger, build, func(ctx context.Context, from uint) (db.EventSource, error) {
+			return logstore.Events(ctx, store, build, from)
+		})
+	}
+}
+
+func newEventHandler(logger lager.Logger, build db.Build, openEvents EventsFunc) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		clientNotifier := w.(http.CloseNotifier)
 
@@ -55,7 +76,7 @@ func NewEventHandler(logger lager.Logger, build db.Build) http.Handler {
 			writer.writeFlusher = gz
 		}
 
-		events, err := build.Events(eventID)
+		events, err := openEvents(r.Context(), eventID)
 		if err != nil {
 			logger.Error("failed-to-get-build-events", err, lager.Data{"build-id": build.ID(), "start": eventID})
 			w.WriteHeader(http.StatusInternalServerError)
@@ -13,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/logstore/logstorefakes"
 	"github.com/vito/go-sse/sse"
 
 	. "github.com/onsi/ginkgo"
@@ -275,4 +276,70 @@ var _ = Describe("Handler", func() {
 			})
 		})
 	})
+
+	Describe("with a log store", func() {
+		var (
+			fakeStore *logstorefakes.FakeStore
+			response  *http.Response
+		)
+
+		BeforeEach(func() {
+			fakeStore = new(logstorefakes.FakeStore)
+
+			server = httptest.NewServer(NewLogStoreEventHandlerFactory(fakeStore)(lagertest.NewTestLogger("test"), build))
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", server.URL, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			client := &http.Client{
+				Transport: &http.Transport{},
+			}
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			_ = response.Body.Close()
+		})
+
+		Context("when the build's events have not been archived", func() {
+			BeforeEach(func() {
+				fakeEventSource := new(dbfakes.FakeEventSource)
+				fakeEventSource.NextReturns(event.Envelope{}, db.ErrEndOfBuildEventStream)
+				build.EventsReturns(fakeEventSource, nil)
+			})
+
+			It("gets the events from the database", func() {
+				Eventually(build.EventsCallCount).Should(Equal(1))
+				Expect(fakeStore.GetCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the build's events have been archived", func() {
+			BeforeEach(func() {
+				build.IDReturns(42)
+				build.EventsArchivedReturns(true)
+			})
+
+			Context("when getting them from the store fails", func() {
+				BeforeEach(func() {
+					fakeStore.GetReturns(nil, errors.New("nope"))
+				})
+
+				It("gets them from the store by build ID", func() {
+					Expect(fakeStore.GetCallCount()).To(Equal(1))
+					_, buildID := fakeStore.GetArgsForCall(0)
+					Expect(buildID).To(Equal(42))
+
+					Expect(build.EventsCallCount()).To(BeZero())
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
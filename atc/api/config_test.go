@@ -284,6 +284,55 @@ var _ = Describe("Config API", func() {
 										Config: pipelineConfig,
 									}))
 								})
+
+								Context("when the pipeline has var sources", func() {
+									BeforeEach(func() {
+										fakePipeline.VarSourcesReturns(atc.VarSourceConfigs{
+											{
+												Name:   "some-source",
+												Type:   "vault",
+												Config: map[string]interface{}{"url": "https://vault.example.com"},
+											},
+										})
+									})
+
+									It("returns them in the config without their config", func() {
+										var actualConfigResponse atc.ConfigResponse
+										err := json.NewDecoder(response.Body).Decode(&actualConfigResponse)
+										Expect(err).NotTo(HaveOccurred())
+
+										Expect(actualConfigResponse.Config.VarSources).To(Equal(atc.VarSourceConfigs{
+											{
+												Name: "some-source",
+												Type: "vault",
+											},
+										}))
+									})
+
+									Context("when the user can set the pipeline", func() {
+										BeforeEach(func() {
+											fakeaccess.IsAuthorizedForReturns(true)
+										})
+
+										It("returns them with their config", func() {
+											var actualConfigResponse atc.ConfigResponse
+											err := json.NewDecoder(response.Body).Decode(&actualConfigResponse)
+											Expect(err).NotTo(HaveOccurred())
+
+											Expect(actualConfigResponse.Config.VarSources).To(Equal(atc.VarSourceConfigs{
+												{
+													Name:   "some-source",
+													Type:   "vault",
+													Config: map[string]interface{}{"url": "https://vault.example.com"},
+												},
+											}))
+
+											team, action := fakeaccess.IsAuthorizedForArgsForCall(0)
+											Expect(team).To(Equal("a-team"))
+											Expect(action).To(Equal(atc.SaveConfig))
+										})
+									})
+								})
 							})
 
 							Context("when finding the resource types fails", func() {
@@ -667,6 +716,48 @@ jobs:
 							})
 						})
 
+						Context("when the payload contains across values with nested maps", func() {
+							BeforeEach(func() {
+								payload := `---
+jobs:
+- name: some-job
+  plan:
+  - task: some-task
+    file: some/task.yml
+    across:
+    - var: platform
+      values:
+      - os: linux
+        arch: amd64
+      max_in_flight: 1`
+
+								request.Header.Set("Content-Type", "application/x-yaml")
+								request.Body = ioutil.NopCloser(bytes.NewBufferString(payload))
+							})
+
+							It("returns 200", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+							})
+
+							It("saves values which can be marshaled to JSON", func() {
+								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
+
+								_, savedConfig, _, _ := dbTeam.SavePipelineArgsForCall(0)
+								Expect(savedConfig.Jobs[0].Plan[0].Across).To(Equal([]atc.AcrossVarConfig{
+									{
+										Var: "platform",
+										Values: []interface{}{
+											map[string]interface{}{"os": "linux", "arch": "amd64"},
+										},
+										MaxInFlight: 1,
+									},
+								}))
+
+								_, err := json.Marshal(savedConfig)
+								Expect(err).NotTo(HaveOccurred())
+							})
+						})
+
 						Context("when it contains credentials to be interpolated", func() {
 							var (
 								payloadAsConfig atc.Config
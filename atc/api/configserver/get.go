@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/tedsuo/rata"
 )
 
@@ -63,11 +64,19 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// var sources are shown in full to those who can set the pipeline, so
+	// that the config can be saved again as it is
+	varSources := pipeline.VarSources()
+	if !accessor.GetAccessor(r).IsAuthorizedFor(teamName, atc.SaveConfig) {
+		varSources = redactVarSources(varSources)
+	}
+
 	config := atc.Config{
 		Groups:        pipeline.Groups(),
 		Resources:     resources.Configs(),
 		ResourceTypes: resourceTypes.Configs(),
 		Jobs:          jobs.Configs(),
+		VarSources:    varSources,
 	}
 
 	w.Header().Set(atc.ConfigVersionHeader, fmt.Sprintf("%d", pipeline.ConfigVersion()))
@@ -81,3 +90,18 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+// redactVarSources leaves out each var source's config, which is likely to
+// contain credentials for the var source and would otherwise be shown to
+// anyone who can view the pipeline.
+func redactVarSources(sources atc.VarSourceConfigs) atc.VarSourceConfigs {
+	var redacted atc.VarSourceConfigs
+	for _, source := range sources {
+		redacted = append(redacted, atc.VarSourceConfig{
+			Name: source.Name,
+			Type: source.Type,
+		})
+	}
+
+	return redacted
+}
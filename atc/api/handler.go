@@ -25,6 +25,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/mainredirect"
+	"github.com/concourse/concourse/atc/scheduler/inputmapper"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/wrappa"
 	"github.com/tedsuo/rata"
@@ -46,7 +47,7 @@ func NewHandler(
 	containerRepository db.ContainerRepository,
 	destroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
-	dbResourceConfigFactory db.ResourceConfigFactory,
+	dbCheckFactory db.CheckFactory,
 
 	eventHandlerFactory buildserver.EventHandlerFactory,
 	drain <-chan struct{},
@@ -54,6 +55,7 @@ func NewHandler(
 	workerClient worker.Client,
 
 	scannerFactory resourceserver.ScannerFactory,
+	inputMapperFactory inputmapper.InputMapperFactory,
 
 	sink *lager.ReconfigurableSink,
 
@@ -64,6 +66,7 @@ func NewHandler(
 	workerVersion string,
 	variablesFactory creds.VariablesFactory,
 	credsManagers creds.Managers,
+	credentialManagerChain []string,
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 ) (http.Handler, error) {
 
@@ -77,18 +80,18 @@ func NewHandler(
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
 
 	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory, drain)
-	jobServer := jobserver.NewServer(logger, externalURL, variablesFactory, dbJobFactory)
-	resourceServer := resourceserver.NewServer(logger, scannerFactory, variablesFactory, dbResourceFactory, dbResourceConfigFactory)
+	jobServer := jobserver.NewServer(logger, externalURL, variablesFactory, dbJobFactory, inputMapperFactory)
+	resourceServer := resourceserver.NewServer(logger, scannerFactory, variablesFactory, dbResourceFactory, dbCheckFactory)
 	versionServer := versionserver.NewServer(logger, externalURL)
 	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL)
 	configServer := configserver.NewServer(logger, dbTeamFactory, variablesFactory)
 	ccServer := ccserver.NewServer(logger, dbTeamFactory, externalURL)
-	workerServer := workerserver.NewServer(logger, dbTeamFactory, dbWorkerFactory)
+	workerServer := workerserver.NewServer(logger, dbTeamFactory, dbWorkerFactory, dbBuildFactory)
 	logLevelServer := loglevelserver.NewServer(logger, sink)
 	cliServer := cliserver.NewServer(logger, absCLIDownloadsDir)
 	containerServer := containerserver.NewServer(logger, workerClient, variablesFactory, interceptTimeoutFactory, containerRepository, destroyer)
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
-	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
+	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL, credentialManagerChain)
 	infoServer := infoserver.NewServer(logger, version, workerVersion, credsManagers)
 	artifactServer := artifactserver.NewServer(logger, workerClient)
 
@@ -150,6 +153,9 @@ func NewHandler(
 		atc.CheckResourceWebHook:    pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceWebHook),
 		atc.CheckResourceType:       pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceType),
 
+		atc.ListResourceWebhookDeliveries: pipelineHandlerFactory.HandlerFor(resourceServer.ListWebhookDeliveries),
+		atc.ListResourceChecks:            pipelineHandlerFactory.HandlerFor(resourceServer.ListChecks),
+
 		atc.ListResourceVersions:          pipelineHandlerFactory.HandlerFor(versionServer.ListResourceVersions),
 		atc.GetResourceVersion:            pipelineHandlerFactory.HandlerFor(versionServer.GetResourceVersion),
 		atc.EnableResourceVersion:         pipelineHandlerFactory.HandlerFor(versionServer.EnableResourceVersion),
@@ -159,13 +165,17 @@ func NewHandler(
 		atc.ListBuildsWithVersionAsOutput: pipelineHandlerFactory.HandlerFor(versionServer.ListBuildsWithVersionAsOutput),
 		atc.GetResourceCausality:          pipelineHandlerFactory.HandlerFor(versionServer.GetCausality),
 
-		atc.ListWorkers:     http.HandlerFunc(workerServer.ListWorkers),
-		atc.RegisterWorker:  http.HandlerFunc(workerServer.RegisterWorker),
-		atc.LandWorker:      http.HandlerFunc(workerServer.LandWorker),
-		atc.RetireWorker:    http.HandlerFunc(workerServer.RetireWorker),
-		atc.PruneWorker:     http.HandlerFunc(workerServer.PruneWorker),
-		atc.HeartbeatWorker: http.HandlerFunc(workerServer.HeartbeatWorker),
-		atc.DeleteWorker:    http.HandlerFunc(workerServer.DeleteWorker),
+		atc.ListWorkers:               http.HandlerFunc(workerServer.ListWorkers),
+		atc.RegisterWorker:            http.HandlerFunc(workerServer.RegisterWorker),
+		atc.LandWorker:                http.HandlerFunc(workerServer.LandWorker),
+		atc.RetireWorker:              http.HandlerFunc(workerServer.RetireWorker),
+		atc.ListBlockingBuilds:        http.HandlerFunc(workerServer.ListBlockingBuilds),
+		atc.CordonWorker:              http.HandlerFunc(workerServer.CordonWorker),
+		atc.UncordonWorker:            http.HandlerFunc(workerServer.UncordonWorker),
+		atc.ScheduleWorkerMaintenance: http.HandlerFunc(workerServer.ScheduleWorkerMaintenance),
+		atc.PruneWorker:               http.HandlerFunc(workerServer.PruneWorker),
+		atc.HeartbeatWorker:           http.HandlerFunc(workerServer.HeartbeatWorker),
+		atc.DeleteWorker:              http.HandlerFunc(workerServer.DeleteWorker),
 
 		atc.SetLogLevel: http.HandlerFunc(logLevelServer.SetMinLevel),
 		atc.GetLogLevel: http.HandlerFunc(logLevelServer.GetMinLevel),
@@ -188,6 +198,7 @@ func NewHandler(
 		atc.SetTeam:        http.HandlerFunc(teamServer.SetTeam),
 		atc.RenameTeam:     http.HandlerFunc(teamServer.RenameTeam),
 		atc.DestroyTeam:    http.HandlerFunc(teamServer.DestroyTeam),
+		atc.SetTeamQuotas:  http.HandlerFunc(teamServer.SetTeamQuotas),
 		atc.ListTeamBuilds: http.HandlerFunc(teamServer.ListTeamBuilds),
 
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -11,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/algorithm"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -1526,6 +1528,193 @@ var _ = Describe("Jobs API", func() {
 						}`))
 						})
 					})
+
+					Context("when input versions are overridden", func() {
+						var fakeResource *dbfakes.FakeResource
+						var versionsDB *algorithm.VersionsDB
+
+						BeforeEach(func() {
+							var err error
+
+							request, err = http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds", bytes.NewBufferString(`{
+								"inputs": {"some-input": {"ref": "abc"}},
+								"ignore_passed": true
+							}`))
+							Expect(err).NotTo(HaveOccurred())
+
+							fakeResource = new(dbfakes.FakeResource)
+							fakePipeline.ResourceReturns(fakeResource, true, nil)
+
+							versionsDB = &algorithm.VersionsDB{ResourceVersions: []algorithm.ResourceVersion{{VersionID: 1, ResourceID: 2}}}
+							fakePipeline.LoadVersionsDBReturns(versionsDB, nil)
+
+							fakeInputMapper.MapInputOverridesReturns(algorithm.InputMapping{}, true, nil)
+						})
+
+						Context("when the versions exist", func() {
+							BeforeEach(func() {
+								fakeResource.ResourceConfigVersionIDReturns(1, true, nil)
+
+								build := new(dbfakes.FakeBuild)
+								build.IDReturns(42)
+								build.NameReturns("1")
+								build.TeamNameReturns("some-team")
+
+								fakeJob.CreateBuildWithInputOverridesReturns(build, nil)
+							})
+
+							It("looks up the versions of the inputs' resources", func() {
+								Expect(fakePipeline.ResourceCallCount()).To(Equal(1))
+								Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("some-input"))
+
+								Expect(fakeResource.ResourceConfigVersionIDCallCount()).To(Equal(1))
+								Expect(fakeResource.ResourceConfigVersionIDArgsForCall(0)).To(Equal(atc.Version{"ref": "abc"}))
+							})
+
+							It("triggers the build with the overrides", func() {
+								Expect(fakeJob.CreateBuildCallCount()).To(BeZero())
+								Expect(fakeJob.CreateBuildWithInputOverridesCallCount()).To(Equal(1))
+								Expect(fakeJob.CreateBuildWithInputOverridesArgsForCall(0)).To(Equal(atc.InputOverrides{
+									Inputs:       map[string]atc.Version{"some-input": {"ref": "abc"}},
+									IgnorePassed: true,
+								}))
+							})
+
+							It("returns 200 OK", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusOK))
+							})
+
+							It("maps the overridden inputs along with the pipeline's versions", func() {
+								Expect(fakeInputMapperFactory.NewInputMapperArgsForCall(0)).To(Equal(fakePipeline))
+
+								Expect(fakeInputMapper.MapInputOverridesCallCount()).To(Equal(1))
+								_, versions, job, _, overrides := fakeInputMapper.MapInputOverridesArgsForCall(0)
+								Expect(versions).To(Equal(versionsDB))
+								Expect(job).To(Equal(fakeJob))
+								Expect(overrides).To(Equal(atc.InputOverrides{
+									Inputs:       map[string]atc.Version{"some-input": {"ref": "abc"}},
+									IgnorePassed: true,
+								}))
+							})
+
+							Context("when the versions do not satisfy the job's passed constraints", func() {
+								BeforeEach(func() {
+									var err error
+
+									request, err = http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds", bytes.NewBufferString(`{
+										"inputs": {"some-input": {"ref": "abc"}}
+									}`))
+									Expect(err).NotTo(HaveOccurred())
+
+									fakeInputMapper.MapInputOverridesReturns(nil, false, nil)
+								})
+
+								It("returns 400 with the problem", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+									body, err := ioutil.ReadAll(response.Body)
+									Expect(err).NotTo(HaveOccurred())
+									Expect(string(body)).To(ContainSubstring("the given versions do not satisfy the job's passed constraints"))
+								})
+
+								It("does not trigger the build", func() {
+									Expect(fakeJob.CreateBuildWithInputOverridesCallCount()).To(BeZero())
+								})
+							})
+
+							Context("when mapping the inputs fails", func() {
+								BeforeEach(func() {
+									fakeInputMapper.MapInputOverridesReturns(nil, false, errors.New("nopers"))
+								})
+
+								It("returns a 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+
+								It("does not trigger the build", func() {
+									Expect(fakeJob.CreateBuildWithInputOverridesCallCount()).To(BeZero())
+								})
+							})
+
+							Context("when loading the pipeline's versions fails", func() {
+								BeforeEach(func() {
+									fakePipeline.LoadVersionsDBReturns(nil, errors.New("nopers"))
+								})
+
+								It("returns a 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+						})
+
+						Context("when a version does not exist", func() {
+							BeforeEach(func() {
+								fakeResource.ResourceConfigVersionIDReturns(0, false, nil)
+							})
+
+							It("returns 400 with the problem", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+								body, err := ioutil.ReadAll(response.Body)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(string(body)).To(ContainSubstring(`version {"ref":"abc"} of input 'some-input' not found`))
+							})
+
+							It("does not trigger the build", func() {
+								Expect(fakeJob.CreateBuildWithInputOverridesCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when looking up a version fails", func() {
+							BeforeEach(func() {
+								fakeResource.ResourceConfigVersionIDReturns(0, false, errors.New("nopers"))
+							})
+
+							It("returns a 500", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+
+						Context("when an overridden input is not an input of the job", func() {
+							BeforeEach(func() {
+								var err error
+
+								request, err = http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds", bytes.NewBufferString(`{
+									"inputs": {"bogus-input": {"ref": "abc"}}
+								}`))
+								Expect(err).NotTo(HaveOccurred())
+							})
+
+							It("returns 400 with the problem", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+								body, err := ioutil.ReadAll(response.Body)
+								Expect(err).NotTo(HaveOccurred())
+								Expect(string(body)).To(ContainSubstring("job 'some-job' has no input named 'bogus-input'"))
+							})
+
+							It("does not trigger the build", func() {
+								Expect(fakeJob.CreateBuildWithInputOverridesCallCount()).To(BeZero())
+							})
+						})
+					})
+
+					Context("when the request body is malformed", func() {
+						BeforeEach(func() {
+							var err error
+
+							request, err = http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds", bytes.NewBufferString(`{`))
+							Expect(err).NotTo(HaveOccurred())
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
+
+						It("does not trigger the build", func() {
+							Expect(fakeJob.CreateBuildCallCount()).To(BeZero())
+						})
+					})
 				})
 			})
 		})
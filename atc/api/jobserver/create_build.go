@@ -2,8 +2,12 @@ package jobserver
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -15,6 +19,14 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 
 		jobName := r.FormValue(":job_name")
 
+		var overrides atc.InputOverrides
+		err := json.NewDecoder(r.Body).Decode(&overrides)
+		if err != nil && err != io.EOF {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
 		job, found, err := pipeline.Job(jobName)
 		if err != nil {
 			logger.Error("failed-to-get-resource-types", err)
@@ -32,7 +44,25 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		build, err := job.CreateBuild()
+		var build db.Build
+		if len(overrides.Inputs) == 0 {
+			build, err = job.CreateBuild()
+		} else {
+			problem, validateErr := s.validateInputOverrides(logger, pipeline, job, overrides)
+			if validateErr != nil {
+				logger.Error("failed-to-validate-input-overrides", validateErr)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if problem != "" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprintln(w, problem)
+				return
+			}
+
+			build, err = job.CreateBuildWithInputOverrides(overrides)
+		}
 		if err != nil {
 			logger.Error("failed-to-create-job-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -46,3 +76,65 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 		}
 	})
 }
+
+// validateInputOverrides checks that each overridden input belongs to the job,
+// that its version has been found by its resource, and that the versions
+// satisfy the inputs' passed constraints unless they are to be ignored,
+// returning a description of the first problem found.
+func (s *Server) validateInputOverrides(logger lager.Logger, pipeline db.Pipeline, job db.Job, overrides atc.InputOverrides) (string, error) {
+	inputs := map[string]atc.JobInput{}
+	for _, input := range job.Config().Inputs() {
+		inputs[input.Name] = input
+	}
+
+	for name, version := range overrides.Inputs {
+		input, found := inputs[name]
+		if !found {
+			return fmt.Sprintf("job '%s' has no input named '%s'", job.Name(), name), nil
+		}
+
+		resource, found, err := pipeline.Resource(input.Resource)
+		if err != nil {
+			return "", err
+		}
+
+		if !found {
+			return fmt.Sprintf("resource '%s' of input '%s' not found", input.Resource, name), nil
+		}
+
+		_, found, err = resource.ResourceConfigVersionID(version)
+		if err != nil {
+			return "", err
+		}
+
+		if !found {
+			versionJSON, _ := json.Marshal(version)
+			return fmt.Sprintf("version %s of input '%s' not found", versionJSON, name), nil
+		}
+	}
+
+	resources, err := pipeline.Resources()
+	if err != nil {
+		return "", err
+	}
+
+	versions, err := pipeline.LoadVersionsDB()
+	if err != nil {
+		return "", err
+	}
+
+	_, ok, err := s.inputMapperFactory.NewInputMapper(pipeline).MapInputOverrides(logger, versions, job, resources, overrides)
+	if err != nil {
+		return "", err
+	}
+
+	if !ok {
+		if overrides.IgnorePassed {
+			return "no versions of the job's other inputs satisfy their passed constraints along with the given versions", nil
+		}
+
+		return "the given versions do not satisfy the job's passed constraints; use --ignore-passed to trigger the build with them anyway", nil
+	}
+
+	return "", nil
+}
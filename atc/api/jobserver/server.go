@@ -5,15 +5,17 @@ import (
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/scheduler/inputmapper"
 )
 
 type Server struct {
 	logger lager.Logger
 
-	externalURL      string
-	rejector         auth.Rejector
-	variablesFactory creds.VariablesFactory
-	jobFactory       db.JobFactory
+	externalURL        string
+	rejector           auth.Rejector
+	variablesFactory   creds.VariablesFactory
+	jobFactory         db.JobFactory
+	inputMapperFactory inputmapper.InputMapperFactory
 }
 
 func NewServer(
@@ -21,12 +23,14 @@ func NewServer(
 	externalURL string,
 	variablesFactory creds.VariablesFactory,
 	jobFactory db.JobFactory,
+	inputMapperFactory inputmapper.InputMapperFactory,
 ) *Server {
 	return &Server{
-		logger:           logger,
-		externalURL:      externalURL,
-		rejector:         auth.UnauthorizedRejector{},
-		variablesFactory: variablesFactory,
-		jobFactory:       jobFactory,
+		logger:             logger,
+		externalURL:        externalURL,
+		rejector:           auth.UnauthorizedRejector{},
+		variablesFactory:   variablesFactory,
+		jobFactory:         jobFactory,
+		inputMapperFactory: inputMapperFactory,
 	}
 }
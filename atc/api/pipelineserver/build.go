@@ -2,6 +2,7 @@ package pipelineserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
@@ -22,6 +23,13 @@ func (s *Server) CreateBuild(pipeline db.Pipeline) http.Handler {
 		}
 
 		build, err := pipeline.CreateStartedBuild(plan)
+		if err == db.ErrRunningBuildsQuotaReached {
+			logger.Info("running-builds-quota-reached")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprintf(w, "team '%s' has reached its running builds quota\n", pipeline.TeamName())
+			return
+		}
+
 		if err != nil {
 			logger.Error("failed-to-create-one-off-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -11,6 +11,7 @@ func PublicBuildInput(input db.BuildInput, pipelineID int) atc.PublicBuildInput
 		Version:         atc.Version(input.Version),
 		PipelineID:      pipelineID,
 		FirstOccurrence: input.FirstOccurrence,
+		Override:        input.Override,
 	}
 }
 
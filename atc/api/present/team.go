@@ -6,9 +6,17 @@ import (
 )
 
 func Team(team db.Team) atc.Team {
-	return atc.Team{
+	presented := atc.Team{
 		ID:   team.ID(),
 		Name: team.Name(),
 		Auth: team.Auth(),
+
+		CredentialManagers: team.CredentialManagers(),
+	}
+
+	if quotas := team.Quotas(); quotas != (atc.TeamQuotas{}) {
+		presented.Quotas = &quotas
 	}
+
+	return presented
 }
@@ -19,6 +19,19 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		version = *workerInfo.Version()
 	}
 
+	var drainDeadline int64
+	if !workerInfo.DrainDeadline().IsZero() {
+		drainDeadline = workerInfo.DrainDeadline().Unix()
+	}
+
+	var maintenanceWindow *atc.MaintenanceWindow
+	if !workerInfo.MaintenanceEnd().IsZero() {
+		maintenanceWindow = &atc.MaintenanceWindow{
+			Start: workerInfo.MaintenanceStart().Unix(),
+			End:   workerInfo.MaintenanceEnd().Unix(),
+		}
+	}
+
 	return atc.Worker{
 		GardenAddr:       gardenAddr,
 		BaggageclaimURL:  baggageclaimURL,
@@ -27,6 +40,7 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		NoProxy:          workerInfo.NoProxy(),
 		ActiveContainers: workerInfo.ActiveContainers(),
 		ActiveVolumes:    workerInfo.ActiveVolumes(),
+		Resources:        workerInfo.Resources(),
 		ResourceTypes:    workerInfo.ResourceTypes(),
 		Platform:         workerInfo.Platform(),
 		Tags:             workerInfo.Tags(),
@@ -36,5 +50,11 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		StartTime:        workerInfo.StartTime(),
 		Version:          version,
 		Ephemeral:        workerInfo.Ephemeral(),
+
+		DrainDeadline:           drainDeadline,
+		AbortAfterDrainDeadline: workerInfo.AbortAfterDrainDeadline(),
+
+		Cordoned:          workerInfo.Cordoned(),
+		MaintenanceWindow: maintenanceWindow,
 	}
 }
@@ -2,6 +2,9 @@ package api_test
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -588,7 +591,7 @@ var _ = Describe("Resources API", func() {
 
 			It("tries to scan with no version specified", func() {
 				Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
-				_, actualResourceName, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
+				_, _, actualResourceName, actualFromVersion, _ := fakeScanner.ScanFromVersionArgsForCall(0)
 				Expect(actualResourceName).To(Equal("resource-name"))
 				Expect(actualFromVersion).To(BeNil())
 			})
@@ -597,6 +600,10 @@ var _ = Describe("Resources API", func() {
 				Expect(response.StatusCode).To(Equal(http.StatusOK))
 			})
 
+			It("does not create a build", func() {
+				Expect(fakePipeline.CreateStartedCheckBuildCallCount()).To(BeZero())
+			})
+
 			Context("when checking with a version specified", func() {
 				BeforeEach(func() {
 					checkRequestBody = atc.CheckRequestBody{
@@ -608,7 +615,7 @@ var _ = Describe("Resources API", func() {
 
 				It("tries to scan with the version specified", func() {
 					Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
-					_, actualResourceName, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
+					_, _, actualResourceName, actualFromVersion, _ := fakeScanner.ScanFromVersionArgsForCall(0)
 					Expect(actualResourceName).To(Equal("resource-name"))
 					Expect(actualFromVersion).To(Equal(checkRequestBody.From))
 				})
@@ -678,6 +685,132 @@ var _ = Describe("Resources API", func() {
 					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
 				})
 			})
+
+			Context("when asked to watch the check", func() {
+				BeforeEach(func() {
+					checkRequestBody.Watch = true
+				})
+
+				It("does not scan", func() {
+					Expect(fakeScanner.ScanFromVersionCallCount()).To(BeZero())
+				})
+
+				Context("when the resource exists", func() {
+					var fakeResource *dbfakes.FakeResource
+
+					BeforeEach(func() {
+						fakeResource = new(dbfakes.FakeResource)
+						fakeResource.IDReturns(7)
+						fakeResource.NameReturns("resource-name")
+						fakeResource.TypeReturns("some-type")
+
+						fakePipeline.ResourceReturns(fakeResource, true, nil)
+					})
+
+					It("looks up the resource", func() {
+						Expect(fakePipeline.ResourceCallCount()).To(Equal(1))
+						Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("resource-name"))
+					})
+
+					Context("when creating the build succeeds", func() {
+						BeforeEach(func() {
+							fakeBuild := new(dbfakes.FakeBuild)
+							fakeBuild.IDReturns(42)
+							fakeBuild.NameReturns("1")
+							fakeBuild.TeamNameReturns("a-team")
+							fakeBuild.PipelineNameReturns("a-pipeline")
+							fakeBuild.StatusReturns("started")
+							fakeBuild.StartTimeReturns(time.Unix(1, 0))
+
+							fakePipeline.CreateStartedCheckBuildReturns(fakeBuild, nil)
+						})
+
+						It("creates a started build which checks the resource", func() {
+							Expect(fakePipeline.CreateStartedCheckBuildCallCount()).To(Equal(1))
+
+							resourceID, plan := fakePipeline.CreateStartedCheckBuildArgsForCall(0)
+							Expect(resourceID).To(Equal(7))
+							Expect(plan.ID).NotTo(BeEmpty())
+							Expect(plan.Check).To(Equal(&atc.CheckPlan{
+								Name: "resource-name",
+								Type: "some-type",
+							}))
+						})
+
+						It("returns 201 Created", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusCreated))
+						})
+
+						It("returns Content-Type 'application/json'", func() {
+							Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+						})
+
+						It("returns the created build", func() {
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(body).To(MatchJSON(`{
+								"id": 42,
+								"name": "1",
+								"team_name": "a-team",
+								"pipeline_name": "a-pipeline",
+								"status": "started",
+								"api_url": "/api/v1/builds/42",
+								"start_time": 1
+							}`))
+						})
+
+						Context("when checking with a version specified", func() {
+							BeforeEach(func() {
+								checkRequestBody.From = atc.Version{
+									"some-version-key": "some-version-value",
+								}
+							})
+
+							It("checks from the version specified", func() {
+								Expect(fakePipeline.CreateStartedCheckBuildCallCount()).To(Equal(1))
+
+								_, plan := fakePipeline.CreateStartedCheckBuildArgsForCall(0)
+								Expect(plan.Check.FromVersion).To(Equal(checkRequestBody.From))
+							})
+						})
+					})
+
+					Context("when creating the build fails", func() {
+						BeforeEach(func() {
+							fakePipeline.CreateStartedCheckBuildReturns(nil, errors.New("welp"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+
+				Context("when the resource does not exist", func() {
+					BeforeEach(func() {
+						fakePipeline.ResourceReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+
+					It("does not create a build", func() {
+						Expect(fakePipeline.CreateStartedCheckBuildCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when looking up the resource fails", func() {
+					BeforeEach(func() {
+						fakePipeline.ResourceReturns(nil, false, errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
 		})
 
 		Context("when not authenticated", func() {
@@ -1224,7 +1357,7 @@ var _ = Describe("Resources API", func() {
 
 				It("tries to scan with the version specified", func() {
 					Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
-					_, actualResourceName, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
+					_, _, actualResourceName, actualFromVersion, _ := fakeScanner.ScanFromVersionArgsForCall(0)
 					Expect(actualResourceName).To(Equal("resource-type-name"))
 					Expect(actualFromVersion).To(Equal(checkRequestBody.From))
 				})
@@ -1255,34 +1388,41 @@ var _ = Describe("Resources API", func() {
 
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", func() {
 		var (
-			fakeScanner               *radarfakes.FakeScanner
-			checkRequestBody          atc.CheckRequestBody
-			response                  *http.Response
-			fakeResource              *dbfakes.FakeResource
-			fakeResourceConfig        *dbfakes.FakeResourceConfig
-			fakeResourceConfigVersion *dbfakes.FakeResourceConfigVersion
-			fakeResourceConfigScope   *dbfakes.FakeResourceConfigScope
+			checkRequestBody atc.CheckRequestBody
+			response         *http.Response
+			fakeResource     *dbfakes.FakeResource
+			webhookPayload   []byte
+			webhookHeader    http.Header
 		)
 
 		BeforeEach(func() {
-			fakeScanner = new(radarfakes.FakeScanner)
-			fakeScannerFactory.NewResourceScannerReturns(fakeScanner)
+			fakePipeline.NameReturns("a-pipeline")
+			fakePipeline.TeamNameReturns("a-team")
+			dbCheckFactory.CreateCheckReturns(true, nil)
+
 			checkRequestBody = atc.CheckRequestBody{}
+			webhookPayload = nil
+			webhookHeader = http.Header{}
 
 			fakeResource = new(dbfakes.FakeResource)
+			fakeResource.IDReturns(42)
 			fakeResource.NameReturns("resource-name")
-			fakeResourceConfig = new(dbfakes.FakeResourceConfig)
-			fakeResourceConfigVersion = new(dbfakes.FakeResourceConfigVersion)
-			fakeResourceConfigScope = new(dbfakes.FakeResourceConfigScope)
 		})
 
 		JustBeforeEach(func() {
-			reqPayload, err := json.Marshal(checkRequestBody)
-			Expect(err).NotTo(HaveOccurred())
+			reqPayload := webhookPayload
+			if reqPayload == nil {
+				var err error
+				reqPayload, err = json.Marshal(checkRequestBody)
+				Expect(err).NotTo(HaveOccurred())
+			}
 
 			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/check/webhook?webhook_token=fake-token", bytes.NewBuffer(reqPayload))
 			Expect(err).NotTo(HaveOccurred())
 			request.Header.Set("Content-Type", "application/json")
+			for name, values := range webhookHeader {
+				request.Header[name] = values
+			}
 
 			response, err = client.Do(request)
 			Expect(err).NotTo(HaveOccurred())
@@ -1297,8 +1437,6 @@ var _ = Describe("Resources API", func() {
 				Expect(err).NotTo(HaveOccurred())
 				fakeResource.WebhookTokenReturns(token)
 				fakePipeline.ResourceReturns(fakeResource, true, nil)
-				fakeResource.ResourceConfigIDReturns(1)
-				fakeResource.ResourceConfigScopeIDReturns(2)
 			})
 
 			It("injects the proper pipelineDB", func() {
@@ -1317,114 +1455,325 @@ var _ = Describe("Resources API", func() {
 					fakePipeline.ResourceReturns(fakeResource, true, nil)
 				})
 
-				It("tries to find the resource config using the resource config id", func() {
-					Eventually(dbResourceConfigFactory.FindResourceConfigByIDCallCount).Should(Equal(1))
-					Expect(dbResourceConfigFactory.FindResourceConfigByIDArgsForCall(0)).To(Equal(1))
+				It("queues a check for the resource", func() {
+					Expect(dbCheckFactory.CreateCheckCallCount()).To(Equal(1))
+					checkable, manuallyTriggered := dbCheckFactory.CreateCheckArgsForCall(0)
+					Expect(checkable).To(Equal(db.Checkable{
+						ResourceID:   42,
+						Name:         "resource-name",
+						TeamName:     "a-team",
+						PipelineName: "a-pipeline",
+					}))
+					Expect(manuallyTriggered).To(BeTrue())
+				})
+
+				It("does not scan the resource itself", func() {
+					Expect(fakeScannerFactory.NewResourceScannerCallCount()).To(BeZero())
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
 				})
 
-				Context("when finding the resource config succeeds", func() {
+				Context("when a check is already queued", func() {
 					BeforeEach(func() {
-						dbResourceConfigFactory.FindResourceConfigByIDReturns(fakeResourceConfig, true, nil)
+						dbCheckFactory.CreateCheckReturns(false, nil)
 					})
 
-					It("tries to find the resource config scope using the resource config scope id", func() {
-						Eventually(fakeResourceConfig.FindResourceConfigScopeByIDCallCount).Should(Equal(1))
-						resourceConfigScopeID, resource := fakeResourceConfig.FindResourceConfigScopeByIDArgsForCall(0)
-						Expect(resourceConfigScopeID).To(Equal(2))
-						Expect(resource).To(Equal(fakeResource))
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
 					})
+				})
 
-					Context("when finding the resource config scope succeeds", func() {
+				Context("when queueing the check fails", func() {
+					BeforeEach(func() {
+						dbCheckFactory.CreateCheckReturns(false, errors.New("disaster"))
+					})
 
-						BeforeEach(func() {
-							fakeResourceConfig.FindResourceConfigScopeByIDReturns(fakeResourceConfigScope, true, nil)
-						})
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
 
-						Context("when the latest version is found", func() {
-							BeforeEach(func() {
-								fakeResourceConfigVersion.IDReturns(4)
-								fakeResourceConfigVersion.VersionReturns(db.Version{"some": "version"})
-								fakeResourceConfigVersion.MetadataReturns([]db.ResourceConfigMetadataField{
-									{
-										Name:  "some",
-										Value: "metadata",
-									},
-								})
-
-								fakeResourceConfigScope.LatestVersionReturns(fakeResourceConfigVersion, true, nil)
-							})
+			Context("when finding the resource fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, errors.New("oops"))
+				})
 
-							It("tries to scan with the latest version", func() {
-								Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
-								_, actualResourceName, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
-								Expect(actualResourceName).To(Equal("resource-name"))
-								Expect(actualFromVersion).To(Equal(atc.Version{"some": "version"}))
-							})
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
 
-							It("returns 200", func() {
-								Expect(response.StatusCode).To(Equal(http.StatusOK))
-							})
-						})
+			Context("when the resource is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, nil)
+				})
 
-						Context("when the latest version is not found", func() {
-							BeforeEach(func() {
-								fakeResourceConfigScope.LatestVersionReturns(nil, false, nil)
-							})
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
 
-							It("tries to scan with no version specified", func() {
-								Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
-								_, actualResourceName, actualFromVersion := fakeScanner.ScanFromVersionArgsForCall(0)
-								Expect(actualResourceName).To(Equal("resource-name"))
-								Expect(actualFromVersion).To(BeNil())
-							})
+		})
 
-							It("returns 200", func() {
-								Expect(response.StatusCode).To(Equal(http.StatusOK))
-							})
-						})
+		Context("when unauthorized", func() {
+			BeforeEach(func() {
+				fakeResource.WebhookTokenReturns("wrong-token")
+				fakePipeline.ResourceReturns(fakeResource, true, nil)
+			})
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
 
-						Context("when failing to get latest version for resource", func() {
-							BeforeEach(func() {
-								fakeResourceConfigScope.LatestVersionReturns(nil, false, errors.New("disaster"))
-							})
+			It("does not record the delivery", func() {
+				Expect(fakeResource.SaveWebhookDeliveryCallCount()).To(BeZero())
+			})
 
-							It("does not scan from version", func() {
-								Consistently(fakeScanner.ScanFromVersionCallCount).Should(Equal(0))
-							})
-						})
+			It("does not queue a check", func() {
+				Expect(dbCheckFactory.CreateCheckCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the resource has a webhook", func() {
+			sign := func(payload []byte, secret string) string {
+				mac := hmac.New(sha256.New, []byte(secret))
+				mac.Write(payload)
+				return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+			}
+
+			BeforeEach(func() {
+				variables = template.StaticVariables{
+					"webhook-secret": "some-secret",
+				}
+
+				fakeResource.WebhookReturns(&atc.WebhookConfig{
+					Provider: atc.WebhookProviderGitHub,
+					Secret:   "((webhook-secret))",
+					Events:   []string{"push"},
+					Branches: []string{"master"},
+				})
+				fakePipeline.ResourceReturns(fakeResource, true, nil)
+
+				webhookPayload = []byte(`{"ref":"refs/heads/master"}`)
+				webhookHeader.Set("X-GitHub-Event", "push")
+			})
+
+			Context("when the signature is valid", func() {
+				BeforeEach(func() {
+					webhookHeader.Set("X-Hub-Signature-256", sign(webhookPayload, "some-secret"))
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("queues a check for the resource", func() {
+					Expect(dbCheckFactory.CreateCheckCallCount()).To(Equal(1))
+				})
+
+				It("records the accepted delivery", func() {
+					Expect(fakeResource.SaveWebhookDeliveryCallCount()).To(Equal(1))
+					Expect(fakeResource.SaveWebhookDeliveryArgsForCall(0)).To(Equal(atc.WebhookDelivery{
+						Provider: atc.WebhookProviderGitHub,
+						Event:    "push",
+						Branch:   "master",
+						Status:   atc.WebhookDeliveryAccepted,
+					}))
+				})
+
+				Context("when the event is filtered out", func() {
+					BeforeEach(func() {
+						webhookHeader.Set("X-GitHub-Event", "issues")
 					})
 
-					Context("when the resource config scope is not found", func() {
-						BeforeEach(func() {
-							fakeResourceConfig.FindResourceConfigScopeByIDReturns(nil, false, nil)
-						})
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
 
-						It("tries to scan", func() {
-							Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
-						})
+					It("does not queue a check", func() {
+						Expect(dbCheckFactory.CreateCheckCallCount()).To(BeZero())
+					})
+
+					It("records the ignored delivery", func() {
+						Expect(fakeResource.SaveWebhookDeliveryCallCount()).To(Equal(1))
+						delivery := fakeResource.SaveWebhookDeliveryArgsForCall(0)
+						Expect(delivery.Status).To(Equal(atc.WebhookDeliveryIgnored))
+						Expect(delivery.Event).To(Equal("issues"))
+						Expect(delivery.Message).To(ContainSubstring("issues"))
 					})
 				})
 
-				Context("when the resource config is not found", func() {
+				Context("when the branch is filtered out", func() {
 					BeforeEach(func() {
-						dbResourceConfigFactory.FindResourceConfigByIDReturns(nil, false, nil)
+						webhookPayload = []byte(`{"ref":"refs/heads/feature"}`)
+						webhookHeader.Set("X-Hub-Signature-256", sign(webhookPayload, "some-secret"))
+					})
+
+					It("does not queue a check", func() {
+						Expect(dbCheckFactory.CreateCheckCallCount()).To(BeZero())
 					})
 
-					It("tries to scan", func() {
-						Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
+					It("records the ignored delivery", func() {
+						Expect(fakeResource.SaveWebhookDeliveryCallCount()).To(Equal(1))
+						delivery := fakeResource.SaveWebhookDeliveryArgsForCall(0)
+						Expect(delivery.Status).To(Equal(atc.WebhookDeliveryIgnored))
+						Expect(delivery.Branch).To(Equal("feature"))
 					})
 				})
 			})
 
-			Context("when finding the resource fails", func() {
+			Context("when the signature is invalid", func() {
 				BeforeEach(func() {
-					fakePipeline.ResourceReturns(nil, false, errors.New("oops"))
+					webhookHeader.Set("X-Hub-Signature-256", sign(webhookPayload, "wrong-secret"))
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+
+				It("does not queue a check", func() {
+					Expect(dbCheckFactory.CreateCheckCallCount()).To(BeZero())
+				})
+
+				It("does not record the delivery", func() {
+					Expect(fakeResource.SaveWebhookDeliveryCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the payload is too large", func() {
+				BeforeEach(func() {
+					webhookPayload = make([]byte, 26<<20)
+					webhookHeader.Set("X-Hub-Signature-256", sign(webhookPayload, "some-secret"))
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+
+				It("does not record the delivery", func() {
+					Expect(fakeResource.SaveWebhookDeliveryCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the secret cannot be evaluated", func() {
+				BeforeEach(func() {
+					variables = template.StaticVariables{}
+					webhookHeader.Set("X-Hub-Signature-256", sign(webhookPayload, "some-secret"))
 				})
 
 				It("returns 500", func() {
 					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 				})
 			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/webhook_deliveries", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/pipelines/a-pipeline/resources/some-resource/webhook_deliveries")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			var fakeResource *dbfakes.FakeResource
+
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+
+				fakeResource = new(dbfakes.FakeResource)
+			})
+
+			Context("when the resource is found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(fakeResource, true, nil)
+				})
+
+				Context("when the deliveries are found", func() {
+					BeforeEach(func() {
+						fakeResource.WebhookDeliveriesReturns([]atc.WebhookDelivery{
+							{
+								ID:         2,
+								ReceivedAt: 1000,
+								Provider:   "github",
+								Event:      "issues",
+								Status:     "ignored",
+								Message:    "event 'issues' is not one of push",
+							},
+							{
+								ID:         1,
+								ReceivedAt: 900,
+								Provider:   "github",
+								Event:      "push",
+								Branch:     "master",
+								Status:     "accepted",
+							},
+						}, nil)
+					})
+
+					It("looks up the resource", func() {
+						Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("some-resource"))
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns Content-Type 'application/json'", func() {
+						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+					})
+
+					It("returns the deliveries", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`[
+							{
+								"id": 2,
+								"received_at": 1000,
+								"provider": "github",
+								"event": "issues",
+								"status": "ignored",
+								"message": "event 'issues' is not one of push"
+							},
+							{
+								"id": 1,
+								"received_at": 900,
+								"provider": "github",
+								"event": "push",
+								"branch": "master",
+								"status": "accepted"
+							}
+						]`))
+					})
+				})
+
+				Context("when looking up the deliveries fails", func() {
+					BeforeEach(func() {
+						fakeResource.WebhookDeliveriesReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
 
 			Context("when the resource is not found", func() {
 				BeforeEach(func() {
@@ -1435,16 +1784,122 @@ var _ = Describe("Resources API", func() {
 					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
 				})
 			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/checks", func() {
+		var response *http.Response
 
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/pipelines/a-pipeline/resources/some-resource/checks")
+			Expect(err).NotTo(HaveOccurred())
 		})
 
-		Context("when unauthorized", func() {
+		Context("when not authorized", func() {
 			BeforeEach(func() {
-				fakeResource.WebhookTokenReturns("wrong-token")
-				fakePipeline.ResourceReturns(fakeResource, true, nil)
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(false)
 			})
-			It("returns 401", func() {
-				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			var fakeResource *dbfakes.FakeResource
+
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+
+				fakeResource = new(dbfakes.FakeResource)
+			})
+
+			Context("when the resource is found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(fakeResource, true, nil)
+				})
+
+				Context("when the check history is found", func() {
+					BeforeEach(func() {
+						fakeResource.CheckHistoryReturns([]atc.ResourceCheck{
+							{
+								ID:         2,
+								StartTime:  1000,
+								EndTime:    1010,
+								WorkerName: "some-worker",
+								Versions:   []atc.Version{},
+								Error:      "resource script '/opt/resource/check []' failed: exit status 1",
+								Stderr:     "some-stderr",
+							},
+							{
+								ID:         1,
+								StartTime:  900,
+								EndTime:    905,
+								WorkerName: "some-other-worker",
+								Versions:   []atc.Version{{"ref": "v1"}},
+							},
+						}, nil)
+					})
+
+					It("looks up the resource", func() {
+						Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("some-resource"))
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns Content-Type 'application/json'", func() {
+						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+					})
+
+					It("returns the checks", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`[
+							{
+								"id": 2,
+								"start_time": 1000,
+								"end_time": 1010,
+								"worker_name": "some-worker",
+								"versions": [],
+								"error": "resource script '/opt/resource/check []' failed: exit status 1",
+								"stderr": "some-stderr"
+							},
+							{
+								"id": 1,
+								"start_time": 900,
+								"end_time": 905,
+								"worker_name": "some-other-worker",
+								"versions": [{"ref": "v1"}]
+							}
+						]`))
+					})
+				})
+
+				Context("when looking up the check history fails", func() {
+					BeforeEach(func() {
+						fakeResource.CheckHistoryReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the resource is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
 			})
 		})
 	})
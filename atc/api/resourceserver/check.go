@@ -3,15 +3,21 @@ package resourceserver
 import (
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/google/jsonapi"
 	"github.com/tedsuo/rata"
 )
 
+// CheckResource checks the resource and responds once the check has finished.
+// If the request asks to watch the check, a build which runs the check is
+// started instead, so that the output of the check script can be followed
+// through the build's events.
 func (s *Server) CheckResource(dbPipeline db.Pipeline) http.Handler {
 	logger := s.logger.Session("check-resource")
 
@@ -26,9 +32,14 @@ func (s *Server) CheckResource(dbPipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		if reqBody.Watch {
+			s.startCheckBuild(logger, dbPipeline, resourceName, reqBody, w)
+			return
+		}
+
 		scanner := s.scannerFactory.NewResourceScanner(dbPipeline)
 
-		err = scanner.ScanFromVersion(logger, resourceName, reqBody.From)
+		err = scanner.ScanFromVersion(r.Context(), logger, resourceName, reqBody.From, resource.IOConfig{})
 		switch scanErr := err.(type) {
 		case resource.ErrResourceScriptFailed:
 			checkResponseBody := atc.CheckResponseBody{
@@ -62,3 +73,39 @@ func (s *Server) CheckResource(dbPipeline db.Pipeline) http.Handler {
 		}
 	})
 }
+
+func (s *Server) startCheckBuild(logger lager.Logger, dbPipeline db.Pipeline, resourceName string, reqBody atc.CheckRequestBody, w http.ResponseWriter) {
+	dbResource, found, err := dbPipeline.Resource(resourceName)
+	if err != nil {
+		logger.Error("failed-to-get-resource", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	plan := atc.NewPlanFactory(time.Now().Unix()).NewPlan(atc.CheckPlan{
+		Name:        dbResource.Name(),
+		Type:        dbResource.Type(),
+		FromVersion: reqBody.From,
+	})
+
+	build, err := dbPipeline.CreateStartedCheckBuild(dbResource.ID(), plan)
+	if err != nil {
+		logger.Error("failed-to-create-check-build", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err = json.NewEncoder(w).Encode(present.Build(build))
+	if err != nil {
+		logger.Error("failed-to-encode-build", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/resource"
 	"github.com/tedsuo/rata"
 )
 
@@ -26,7 +27,7 @@ func (s *Server) CheckResourceType(dbPipeline db.Pipeline) http.Handler {
 
 		scanner := s.scannerFactory.NewResourceTypeScanner(dbPipeline)
 
-		err = scanner.ScanFromVersion(logger, resourceName, reqBody.From)
+		err = scanner.ScanFromVersion(r.Context(), logger, resourceName, reqBody.From, resource.IOConfig{})
 		switch err.(type) {
 		case db.ResourceTypeNotFoundError:
 			w.WriteHeader(http.StatusNotFound)
@@ -2,28 +2,28 @@ package resourceserver
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/webhook"
 	"github.com/tedsuo/rata"
 )
 
+// maxWebhookPayloadSize is the largest webhook payload read, matching the
+// largest payload GitHub will deliver.
+const maxWebhookPayloadSize = 25 << 20
+
 // CheckResourceWebHook defines a handler for process a check resource request via an access token.
 func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 	logger := s.logger.Session("check-resource-webhook")
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resourceName := rata.Param(r, "resource_name")
-		webhookToken := r.URL.Query().Get("webhook_token")
-
-		if webhookToken == "" {
-			logger.Info("no-webhook-token", lager.Data{"error": "missing webhook_token"})
-			w.WriteHeader(http.StatusBadRequest)
-			return
-		}
 
 		pipelineResource, found, err := dbPipeline.Resource(resourceName)
 		if err != nil {
@@ -39,45 +39,135 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 		}
 
 		variables := s.variablesFactory.NewVariables(dbPipeline.TeamName(), dbPipeline.Name())
-		token, err := creds.NewString(variables, pipelineResource.WebhookToken()).Evaluate()
-		if token != webhookToken {
-			logger.Info("invalid-token", lager.Data{"error": fmt.Sprintf("invalid token for webhook %s", webhookToken)})
-			w.WriteHeader(http.StatusUnauthorized)
-			return
-		}
 
-		go func() {
-			var fromVersion atc.Version
-			resourceConfigID := pipelineResource.ResourceConfigID()
-			resourceConfig, found, err := s.resourceConfigFactory.FindResourceConfigByID(resourceConfigID)
-			if err != nil {
-				logger.Error("failed-to-get-resource-config", err, lager.Data{"resource-config-id": resourceConfigID})
+		var delivery atc.WebhookDelivery
+		if pipelineResource.Webhook() != nil {
+			var status int
+			var verified bool
+			delivery, status, verified = s.verifyWebhook(logger, variables, pipelineResource, w, r)
+			if status != http.StatusOK {
+				// deliveries which were not signed with the secret are not
+				// recorded, so that anyone who can reach the endpoint cannot
+				// push the resource's real deliveries out
+				if verified {
+					s.saveWebhookDelivery(logger, pipelineResource, delivery)
+				}
+
+				w.WriteHeader(status)
+				return
+			}
+		} else {
+			webhookToken := r.URL.Query().Get("webhook_token")
+			if webhookToken == "" {
+				logger.Info("no-webhook-token", lager.Data{"error": "missing webhook_token"})
+				w.WriteHeader(http.StatusBadRequest)
 				return
 			}
 
-			if found {
-				resourceConfigScope, found, err := resourceConfig.FindResourceConfigScopeByID(pipelineResource.ResourceConfigScopeID(), pipelineResource)
-				if err != nil {
-					logger.Error("failed-to-get-resource-config-scope", err, lager.Data{"resource-config-scope-id": pipelineResource.ResourceConfigScopeID()})
-					return
-				}
-
-				if found {
-					latestVersion, found, err := resourceConfigScope.LatestVersion()
-					if err != nil {
-						logger.Error("failed-to-get-latest-resource-version", err, lager.Data{"resource-config-id": resourceConfigID})
-						return
-					}
-					if found {
-						fromVersion = atc.Version(latestVersion.Version())
-					}
-				}
+			token, err := creds.NewString(variables, pipelineResource.WebhookToken()).Evaluate()
+			if err != nil || token != webhookToken {
+				logger.Info("invalid-token", lager.Data{"error": fmt.Sprintf("invalid token for webhook %s", webhookToken)})
+				w.WriteHeader(http.StatusUnauthorized)
+				return
 			}
 
-			scanner := s.scannerFactory.NewResourceScanner(dbPipeline)
-			scanner.ScanFromVersion(logger, resourceName, fromVersion)
-		}()
+			delivery = atc.WebhookDelivery{Status: atc.WebhookDeliveryAccepted}
+		}
+
+		s.saveWebhookDelivery(logger, pipelineResource, delivery)
+
+		if delivery.Status == atc.WebhookDeliveryIgnored {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		// the check is queued rather than run here, so that deliveries cannot
+		// start any more checks at once than the check queue allows
+		created, err := s.checkFactory.CreateCheck(db.Checkable{
+			ResourceID:   pipelineResource.ID(),
+			Name:         pipelineResource.Name(),
+			TeamName:     dbPipeline.TeamName(),
+			PipelineName: dbPipeline.Name(),
+		}, true)
+		if err != nil {
+			logger.Error("failed-to-queue-check", err, lager.Data{"resource-name": resourceName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !created {
+			logger.Debug("check-already-queued", lager.Data{"resource-name": resourceName})
+		}
 
 		w.WriteHeader(http.StatusOK)
 	})
 }
+
+// verifyWebhook checks the request against the resource's webhook config,
+// returning the delivery to record and the status to respond with if the
+// request is not to be accepted, along with whether the request was signed
+// with the webhook's secret.
+func (s *Server) verifyWebhook(logger lager.Logger, variables creds.Variables, pipelineResource db.Resource, w http.ResponseWriter, r *http.Request) (atc.WebhookDelivery, int, bool) {
+	config := *pipelineResource.Webhook()
+
+	delivery := atc.WebhookDelivery{
+		Provider: config.Provider,
+		Status:   atc.WebhookDeliveryRejected,
+	}
+
+	provider, err := webhook.NewProvider(config)
+	if err != nil {
+		logger.Error("failed-to-create-webhook-provider", err)
+		delivery.Message = err.Error()
+		return delivery, http.StatusInternalServerError, false
+	}
+
+	secret, err := creds.NewString(variables, config.Secret).Evaluate()
+	if err != nil {
+		logger.Error("failed-to-evaluate-webhook-secret", err)
+		delivery.Message = "failed to evaluate webhook secret"
+		return delivery, http.StatusInternalServerError, false
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		logger.Info("failed-to-read-body", lager.Data{"error": err.Error()})
+		delivery.Message = "failed to read request body"
+		return delivery, http.StatusBadRequest, false
+	}
+
+	err = provider.Verify(r.Header, body, secret)
+	if err != nil {
+		logger.Info("invalid-signature", lager.Data{"error": err.Error()})
+		delivery.Message = err.Error()
+		return delivery, http.StatusUnauthorized, false
+	}
+
+	parsed, err := provider.Parse(r.Header, body)
+	if err != nil {
+		logger.Info("invalid-payload", lager.Data{"error": err.Error()})
+		delivery.Message = err.Error()
+		return delivery, http.StatusBadRequest, true
+	}
+
+	delivery.Event = parsed.Event
+	delivery.Branch = strings.Join(parsed.Branches, ", ")
+
+	triggers, reason := webhook.Triggers(config, parsed)
+	if !triggers {
+		delivery.Status = atc.WebhookDeliveryIgnored
+		delivery.Message = reason
+		return delivery, http.StatusOK, true
+	}
+
+	delivery.Status = atc.WebhookDeliveryAccepted
+
+	return delivery, http.StatusOK, true
+}
+
+func (s *Server) saveWebhookDelivery(logger lager.Logger, pipelineResource db.Resource, delivery atc.WebhookDelivery) {
+	err := pipelineResource.SaveWebhookDelivery(delivery)
+	if err != nil {
+		logger.Error("failed-to-save-webhook-delivery", err)
+	}
+}
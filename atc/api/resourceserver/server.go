@@ -15,11 +15,11 @@ type ScannerFactory interface {
 }
 
 type Server struct {
-	logger                lager.Logger
-	scannerFactory        ScannerFactory
-	variablesFactory      creds.VariablesFactory
-	resourceFactory       db.ResourceFactory
-	resourceConfigFactory db.ResourceConfigFactory
+	logger           lager.Logger
+	scannerFactory   ScannerFactory
+	variablesFactory creds.VariablesFactory
+	resourceFactory  db.ResourceFactory
+	checkFactory     db.CheckFactory
 }
 
 func NewServer(
@@ -27,13 +27,13 @@ func NewServer(
 	scannerFactory ScannerFactory,
 	variablesFactory creds.VariablesFactory,
 	resourceFactory db.ResourceFactory,
-	resourceConfigFactory db.ResourceConfigFactory,
+	checkFactory db.CheckFactory,
 ) *Server {
 	return &Server{
-		logger:                logger,
-		scannerFactory:        scannerFactory,
-		variablesFactory:      variablesFactory,
-		resourceFactory:       resourceFactory,
-		resourceConfigFactory: resourceConfigFactory,
+		logger:           logger,
+		scannerFactory:   scannerFactory,
+		variablesFactory: variablesFactory,
+		resourceFactory:  resourceFactory,
+		checkFactory:     checkFactory,
 	}
 }
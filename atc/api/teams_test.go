@@ -211,6 +211,43 @@ var _ = Describe("Teams API", func() {
 					Expect(updatedProviderAuth).To(Equal(atcTeam.Auth))
 				})
 
+				Context("when the team selects credential managers", func() {
+					BeforeEach(func() {
+						atcTeam.CredentialManagers = []string{"vault", "credhub"}
+					})
+
+					It("updates the team's credential managers", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateCredentialManagersCallCount()).To(Equal(1))
+						Expect(fakeTeam.UpdateCredentialManagersArgsForCall(0)).To(Equal([]string{"vault", "credhub"}))
+					})
+
+					Context("when updating the credential managers fails", func() {
+						BeforeEach(func() {
+							fakeTeam.UpdateCredentialManagersReturns(errors.New("nope"))
+						})
+
+						It("returns 500 Internal Server error", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when a credential manager is not configured", func() {
+						BeforeEach(func() {
+							atcTeam.CredentialManagers = []string{"vault", "bogus"}
+						})
+
+						It("returns 400 Bad Request", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
+
+						It("does not update the team", func() {
+							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(BeZero())
+							Expect(fakeTeam.UpdateCredentialManagersCallCount()).To(BeZero())
+						})
+					})
+				})
+
 				Context("when updating provider auth fails", func() {
 					BeforeEach(func() {
 						fakeTeam.UpdateProviderAuthReturns(errors.New("stop trying to make fetch happen"))
@@ -220,6 +257,39 @@ var _ = Describe("Teams API", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 					})
 				})
+
+				Context("when a role is unknown", func() {
+					BeforeEach(func() {
+						atcTeam.Auth["bogus"] = map[string][]string{
+							"users": []string{"local:username"},
+						}
+					})
+
+					It("returns 400 Bad Request", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("unknown role: bogus\n"))
+					})
+
+					It("does not update provider auth", func() {
+						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when users are given the operator role", func() {
+					BeforeEach(func() {
+						atcTeam.Auth[atc.OperatorRole] = map[string][]string{
+							"groups": []string{"github:org:on-call"},
+						}
+					})
+
+					It("updates provider auth", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateProviderAuthArgsForCall(0)).To(HaveKey(atc.OperatorRole))
+					})
+				})
 			})
 		}
 
@@ -497,6 +567,111 @@ var _ = Describe("Teams API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams/:team_name/quotas", func() {
+		var (
+			response *http.Response
+			body     string
+		)
+
+		BeforeEach(func() {
+			body = `{"max_running_builds":2,"max_containers":10}`
+
+			fakeTeam.IDReturns(2)
+			fakeTeam.NameReturns("a-team")
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest(
+				"PUT",
+				server.URL+"/api/v1/teams/a-team/quotas",
+				bytes.NewBufferString(body),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the requester is an admin", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAdminReturns(true)
+			})
+
+			Context("when the team exists", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					fakeTeam.QuotasReturns(atc.TeamQuotas{MaxRunningBuilds: 2, MaxContainers: 10})
+				})
+
+				It("updates the team's quotas", func() {
+					Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("a-team"))
+					Expect(fakeTeam.UpdateQuotasCallCount()).To(Equal(1))
+					Expect(fakeTeam.UpdateQuotasArgsForCall(0)).To(Equal(atc.TeamQuotas{
+						MaxRunningBuilds: 2,
+						MaxContainers:    10,
+					}))
+				})
+
+				It("returns 200 OK with the team", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{
+						"id": 2,
+						"name": "a-team",
+						"quotas": {"max_running_builds": 2, "max_containers": 10}
+					}`))
+				})
+
+				Context("when a quota is negative", func() {
+					BeforeEach(func() {
+						body = `{"max_running_builds":-1}`
+					})
+
+					It("returns 400 Bad Request", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeTeam.UpdateQuotasCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when updating the quotas fails", func() {
+					BeforeEach(func() {
+						fakeTeam.UpdateQuotasReturns(errors.New("nope"))
+					})
+
+					It("returns 500 Internal Server Error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when the requester is not an admin", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.UpdateQuotasCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/builds", func() {
 		var (
 			response    *http.Response
@@ -9,16 +9,21 @@ type Server struct {
 	logger      lager.Logger
 	teamFactory db.TeamFactory
 	externalURL string
+
+	credentialManagers []string
 }
 
 func NewServer(
 	logger lager.Logger,
 	teamFactory db.TeamFactory,
 	externalURL string,
+	credentialManagers []string,
 ) *Server {
 	return &Server{
 		logger:      logger,
 		teamFactory: teamFactory,
 		externalURL: externalURL,
+
+		credentialManagers: credentialManagers,
 	}
 }
@@ -2,6 +2,7 @@ package teamserver
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
@@ -34,6 +35,22 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	err = atcTeam.Auth.Validate()
+	if err != nil {
+		hLog.Info("invalid-team-auth", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, err)
+		return
+	}
+
+	err = s.validateCredentialManagers(atcTeam.CredentialManagers)
+	if err != nil {
+		hLog.Info("invalid-team-credential-managers", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, err)
+		return
+	}
+
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
 		hLog.Error("failed-to-lookup-team", err, lager.Data{"teamName": teamName})
@@ -50,6 +67,13 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		err = team.UpdateCredentialManagers(atcTeam.CredentialManagers)
+		if err != nil {
+			hLog.Error("failed-to-update-team-credential-managers", err, lager.Data{"teamName": teamName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 	} else if acc.IsAdmin() {
@@ -74,3 +98,18 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+func (s *Server) validateCredentialManagers(names []string) error {
+	configured := map[string]bool{}
+	for _, name := range s.credentialManagers {
+		configured[name] = true
+	}
+
+	for _, name := range names {
+		if !configured[name] {
+			return fmt.Errorf("credential manager '%s' is not configured", name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+)
+
+// SetTeamQuotas allows an admin to limit the builds and containers a team
+// can have at once.
+func (s *Server) SetTeamQuotas(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("set-team-quotas")
+
+	teamName := r.FormValue(":team_name")
+
+	var quotas atc.TeamQuotas
+	err := json.NewDecoder(r.Body).Decode(&quotas)
+	if err != nil {
+		logger.Error("malformed-request", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if quotas.MaxRunningBuilds < 0 || quotas.MaxContainers < 0 {
+		logger.Info("negative-quota", lager.Data{"quotas": quotas})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("team-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = team.UpdateQuotas(quotas)
+	if err != nil {
+		logger.Error("failed-to-update-team-quotas", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(present.Team(team))
+	if err != nil {
+		logger.Error("failed-to-encode-team", err)
+	}
+}
@@ -464,11 +464,12 @@ var _ = Describe("Workers API", func() {
 		var (
 			response   *http.Response
 			workerName string
+			query      string
 			fakeWorker *dbfakes.FakeWorker
 		)
 
 		JustBeforeEach(func() {
-			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/land", nil)
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/land"+query, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			response, err = client.Do(req)
@@ -481,6 +482,7 @@ var _ = Describe("Workers API", func() {
 			fakeWorker.NameReturns(workerName)
 			fakeWorker.TeamNameReturns("some-team")
 			fakeWorker.LandReturns(nil)
+			query = ""
 
 			fakeaccess.IsAuthenticatedReturns(true)
 			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
@@ -501,6 +503,61 @@ var _ = Describe("Workers API", func() {
 				Expect(fakeWorker.LandCallCount()).To(Equal(1))
 			})
 
+			It("does not set a drain deadline", func() {
+				Expect(fakeWorker.SetDrainDeadlineCallCount()).To(BeZero())
+			})
+
+			Context("when a deadline is given", func() {
+				BeforeEach(func() {
+					query = "?deadline=30m&abort_builds=true"
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("lands the worker and sets its drain deadline", func() {
+					Expect(fakeWorker.LandCallCount()).To(Equal(1))
+					Expect(fakeWorker.SetDrainDeadlineCallCount()).To(Equal(1))
+
+					deadline, abortBuilds := fakeWorker.SetDrainDeadlineArgsForCall(0)
+					Expect(deadline).To(BeTemporally("~", time.Now().Add(30*time.Minute), time.Minute))
+					Expect(abortBuilds).To(BeTrue())
+				})
+
+				Context("when setting the drain deadline fails", func() {
+					BeforeEach(func() {
+						fakeWorker.SetDrainDeadlineReturns(errors.New("some-error"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the deadline is malformed", func() {
+				BeforeEach(func() {
+					query = "?deadline=soon"
+				})
+
+				It("returns 400 without landing the worker", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeWorker.LandCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when asked to abort builds without a deadline", func() {
+				BeforeEach(func() {
+					query = "?abort_builds=true"
+				})
+
+				It("returns 400 without landing the worker", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeWorker.LandCallCount()).To(BeZero())
+				})
+			})
+
 			Context("when landing the worker fails", func() {
 				var returnedErr error
 
@@ -661,6 +718,424 @@ var _ = Describe("Workers API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/workers/:worker_name/cordon", func() {
+		var (
+			response   *http.Response
+			workerName string
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/cordon", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			workerName = "some-worker"
+			fakeWorker.NameReturns(workerName)
+			fakeWorker.TeamNameReturns("some-team")
+			fakeaccess.IsAuthenticatedReturns(true)
+
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+			fakeWorker.CordonReturns(nil)
+		})
+
+		Context("when authenticated as system", func() {
+			BeforeEach(func() {
+				fakeaccess.IsSystemReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("sees if the worker exists and attempts to cordon it", func() {
+				Expect(dbWorkerFactory.GetWorkerCallCount()).To(Equal(1))
+				Expect(dbWorkerFactory.GetWorkerArgsForCall(0)).To(Equal(workerName))
+
+				Expect(fakeWorker.CordonCallCount()).To(Equal(1))
+			})
+
+			Context("when the worker is not running", func() {
+				BeforeEach(func() {
+					fakeWorker.StateReturns(db.WorkerStateLanding)
+					fakeWorker.CordonReturns(db.ErrCannotCordonWorker)
+				})
+
+				It("returns 409 with an explanation", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("cannot cordon worker 'some-worker' as it is not running\n"))
+				})
+			})
+
+			Context("when the worker has gone away", func() {
+				BeforeEach(func() {
+					fakeWorker.CordonReturns(db.ErrWorkerNotPresent)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when cordoning the worker fails", func() {
+				BeforeEach(func() {
+					fakeWorker.CordonReturns(errors.New("some-error"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the worker does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when authorized as the worker's owner", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when authorized as some other team", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not attempt to find the worker", func() {
+				Expect(dbWorkerFactory.GetWorkerCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/workers/:worker_name/uncordon", func() {
+		var (
+			response   *http.Response
+			workerName string
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/uncordon", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			workerName = "some-worker"
+			fakeWorker.NameReturns(workerName)
+			fakeWorker.TeamNameReturns("some-team")
+			fakeaccess.IsAuthenticatedReturns(true)
+
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+			fakeWorker.UncordonReturns(nil)
+		})
+
+		Context("when authenticated as system", func() {
+			BeforeEach(func() {
+				fakeaccess.IsSystemReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("sees if the worker exists and attempts to uncordon it", func() {
+				Expect(dbWorkerFactory.GetWorkerCallCount()).To(Equal(1))
+				Expect(dbWorkerFactory.GetWorkerArgsForCall(0)).To(Equal(workerName))
+
+				Expect(fakeWorker.UncordonCallCount()).To(Equal(1))
+			})
+
+			Context("when uncordoning the worker fails", func() {
+				BeforeEach(func() {
+					fakeWorker.UncordonReturns(errors.New("some-error"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the worker does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when authorized as some other team", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/workers/:worker_name/maintenance", func() {
+		var (
+			response   *http.Response
+			workerName string
+			body       []byte
+			fakeWorker *dbfakes.FakeWorker
+			start, end time.Time
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/maintenance", bytes.NewBuffer(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			workerName = "some-worker"
+			fakeWorker.NameReturns(workerName)
+			fakeWorker.TeamNameReturns("some-team")
+			fakeaccess.IsAuthenticatedReturns(true)
+
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+			fakeWorker.ScheduleMaintenanceReturns(nil)
+
+			start = time.Unix(1500000000, 0)
+			end = time.Unix(1500003600, 0)
+
+			var err error
+			body, err = json.Marshal(atc.MaintenanceWindow{
+				Start: start.Unix(),
+				End:   end.Unix(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as system", func() {
+			BeforeEach(func() {
+				fakeaccess.IsSystemReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("schedules the maintenance window on the worker", func() {
+				Expect(dbWorkerFactory.GetWorkerCallCount()).To(Equal(1))
+				Expect(dbWorkerFactory.GetWorkerArgsForCall(0)).To(Equal(workerName))
+
+				Expect(fakeWorker.ScheduleMaintenanceCallCount()).To(Equal(1))
+				actualStart, actualEnd := fakeWorker.ScheduleMaintenanceArgsForCall(0)
+				Expect(actualStart).To(BeTemporally("==", start))
+				Expect(actualEnd).To(BeTemporally("==", end))
+			})
+
+			Context("when the window ends before it starts", func() {
+				BeforeEach(func() {
+					var err error
+					body, err = json.Marshal(atc.MaintenanceWindow{
+						Start: end.Unix(),
+						End:   start.Unix(),
+					})
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns 400 without scheduling maintenance", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeWorker.ScheduleMaintenanceCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the request body is malformed", func() {
+				BeforeEach(func() {
+					body = []byte("not-json")
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when scheduling maintenance fails", func() {
+				BeforeEach(func() {
+					fakeWorker.ScheduleMaintenanceReturns(errors.New("some-error"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the worker does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when authorized as some other team", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/workers/:worker_name/blocking-builds", func() {
+		var (
+			response   *http.Response
+			workerName string
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/workers/"+workerName+"/blocking-builds", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			workerName = "some-worker"
+			fakeWorker.NameReturns(workerName)
+			fakeWorker.TeamNameReturns("some-team")
+
+			fakeaccess.IsAuthenticatedReturns(true)
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+		})
+
+		Context("when the request is authorized as the worker's owner", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+
+				fakeBuild := new(dbfakes.FakeBuild)
+				fakeBuild.IDReturns(42)
+				fakeBuild.NameReturns("1")
+				fakeBuild.JobNameReturns("some-job")
+				fakeBuild.PipelineNameReturns("some-pipeline")
+				fakeBuild.TeamNameReturns("some-team")
+				fakeBuild.StatusReturns(db.BuildStatusStarted)
+
+				dbBuildFactory.GetBuildsBlockingWorkerReturns([]db.Build{fakeBuild}, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns the builds blocking the worker", func() {
+				Expect(dbBuildFactory.GetBuildsBlockingWorkerCallCount()).To(Equal(1))
+				Expect(dbBuildFactory.GetBuildsBlockingWorkerArgsForCall(0)).To(Equal(workerName))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"id": 42,
+						"name": "1",
+						"job_name": "some-job",
+						"pipeline_name": "some-pipeline",
+						"team_name": "some-team",
+						"status": "started",
+						"api_url": "/api/v1/builds/42"
+					}
+				]`))
+			})
+
+			Context("when getting the builds fails", func() {
+				BeforeEach(func() {
+					dbBuildFactory.GetBuildsBlockingWorkerReturns(nil, errors.New("some-error"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the worker does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when the request is authorized as the wrong team", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/workers/:worker_name/prune", func() {
 		var (
 			response   *http.Response
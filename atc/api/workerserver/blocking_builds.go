@@ -0,0 +1,47 @@
+package workerserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+)
+
+// ListBlockingBuilds lists the builds which prevent the worker from landing
+// or retiring.
+func (s *Server) ListBlockingBuilds(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-blocking-builds")
+	workerName := r.FormValue(":worker_name")
+
+	_, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-to-get-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	builds, err := s.dbBuildFactory.GetBuildsBlockingWorker(workerName)
+	if err != nil {
+		logger.Error("failed-to-get-blocking-builds", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	atcBuilds := make([]atc.Build, len(builds))
+	for i, build := range builds {
+		atcBuilds[i] = present.Build(build)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(atcBuilds)
+	if err != nil {
+		logger.Error("failed-to-encode-builds", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,39 @@
+package workerserver
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var errAbortBuildsWithoutDeadline = errors.New("abort_builds requires a deadline")
+
+// drainDeadline parses the optional deadline by which a landing or retiring
+// worker should have drained, given as a duration from now, and whether the
+// builds preventing it from doing so should be aborted once it has passed.
+func drainDeadline(r *http.Request) (time.Time, bool, error) {
+	var abortBuilds bool
+	if r.FormValue("abort_builds") != "" {
+		var err error
+		abortBuilds, err = strconv.ParseBool(r.FormValue("abort_builds"))
+		if err != nil {
+			return time.Time{}, false, err
+		}
+	}
+
+	if r.FormValue("deadline") == "" {
+		if abortBuilds {
+			return time.Time{}, false, errAbortBuildsWithoutDeadline
+		}
+
+		return time.Time{}, false, nil
+	}
+
+	duration, err := time.ParseDuration(r.FormValue("deadline"))
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	return time.Now().Add(duration), abortBuilds, nil
+}
@@ -0,0 +1,60 @@
+package workerserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ScheduleWorkerMaintenance schedules the worker to be cordoned for the
+// duration of the given maintenance window.
+func (s *Server) ScheduleWorkerMaintenance(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("scheduling-worker-maintenance")
+	workerName := r.FormValue(":worker_name")
+
+	var window atc.MaintenanceWindow
+	err := json.NewDecoder(r.Body).Decode(&window)
+	if err != nil {
+		logger.Info("malformed-request", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if window.End <= window.Start {
+		logger.Info("maintenance-ends-before-it-starts", lager.Data{"window": window})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-finding-worker-to-schedule-maintenance", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = worker.ScheduleMaintenance(time.Unix(window.Start, 0), time.Unix(window.End, 0))
+	if err == db.ErrWorkerNotPresent {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		logger.Error("failed-to-schedule-worker-maintenance", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -1,11 +1,22 @@
 package workerserver
 
-import "net/http"
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+)
 
 func (s *Server) RetireWorker(w http.ResponseWriter, r *http.Request) {
 	logger := s.logger.Session("retiring-worker")
 	workerName := r.FormValue(":worker_name")
 
+	deadline, abortBuilds, err := drainDeadline(r)
+	if err != nil {
+		logger.Info("malformed-deadline", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
 
 	if err != nil {
@@ -28,5 +39,14 @@ func (s *Server) RetireWorker(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if !deadline.IsZero() {
+		err = worker.SetDrainDeadline(deadline, abortBuilds)
+		if err != nil {
+			logger.Error("failed-to-set-drain-deadline", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
 	w.WriteHeader(http.StatusOK)
 }
@@ -1,16 +1,19 @@
 package atccmd
 
 import (
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
 	"database/sql"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	_ "net/http/pprof"
 	"net/url"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -25,7 +28,6 @@ import (
 	"github.com/concourse/concourse/atc/api/containerserver"
 	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/creds"
-	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
@@ -33,15 +35,20 @@ import (
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/lidar"
 	"github.com/concourse/concourse/atc/lockrunner"
+	"github.com/concourse/concourse/atc/logstore"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/pipelines"
 	"github.com/concourse/concourse/atc/radar"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
+	"github.com/concourse/concourse/atc/scheduler/inputmapper"
 	"github.com/concourse/concourse/atc/syslog"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/image"
+	"github.com/concourse/concourse/atc/worker/k8s"
 	"github.com/concourse/concourse/atc/wrappa"
 	"github.com/concourse/concourse/skymarshal"
 	"github.com/concourse/concourse/skymarshal/skycmd"
@@ -56,6 +63,11 @@ import (
 	"github.com/tedsuo/ifrit/grouper"
 	"github.com/tedsuo/ifrit/http_server"
 	"github.com/tedsuo/ifrit/sigmon"
+	yaml "gopkg.in/yaml.v2"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
 
 	// dynamically registered metric emitters
 	_ "github.com/concourse/concourse/atc/metric/emitter"
@@ -65,6 +77,7 @@ import (
 	_ "github.com/concourse/concourse/atc/creds/kubernetes"
 	_ "github.com/concourse/concourse/atc/creds/secretsmanager"
 	_ "github.com/concourse/concourse/atc/creds/ssm"
+	_ "github.com/concourse/concourse/atc/creds/static"
 	_ "github.com/concourse/concourse/atc/creds/vault"
 )
 
@@ -101,13 +114,24 @@ type RunCommand struct {
 
 	InterceptIdleTimeout time.Duration `long:"intercept-idle-timeout" default:"0m" description:"Length of time for a intercepted session to be idle before terminating."`
 
+	ConfigRBAC flag.File `long:"config-rbac" description:"YAML file mapping API action names to the role required to perform them, overriding the defaults."`
+
+	DisableRedactSecrets bool `long:"disable-redact-secrets" description:"Disable redacting credentials from build logs."`
+
 	EnableGlobalResources bool `long:"enable-global-resources" description:"Enable equivalent resources across pipelines and teams to share a single version history."`
 
 	GlobalResourceCheckTimeout   time.Duration `long:"global-resource-check-timeout" default:"1h" description:"Time limit on checking for new versions of resources."`
 	ResourceCheckingInterval     time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 	ResourceTypeCheckingInterval time.Duration `long:"resource-type-checking-interval" default:"1m" description:"Interval on which to check for new versions of resource types."`
 
-	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" description:"Method by which a worker is selected during container placement."`
+	LidarScannerInterval time.Duration `long:"lidar-scanner-interval" default:"10s" description:"Interval on which to queue checks for the resources and resource types which are due to be checked."`
+	LidarCheckerInterval time.Duration `long:"lidar-checker-interval" default:"1s" description:"Interval on which to start running queued checks."`
+	MaxChecksInFlight    int           `long:"max-checks-in-flight" default:"32" description:"Maximum number of checks each ATC will run at once."`
+
+	ContainerPlacementStrategy        []string      `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"least-loaded" description:"Method by which a worker is selected during container placement. If specified multiple times, the strategies are applied in order, each narrowing down the workers preferred by the previous ones. Workers refused by limit-active-tasks or least-loaded are refused wherever they appear."`
+	MaxActiveTasksPerWorker           int           `long:"max-active-tasks-per-worker" default:"0" description:"Maximum number of build containers per worker. Only used by the limit-active-tasks placement strategy. 0 means no limit."`
+	MaxWorkerPressure                 float64       `long:"max-worker-pressure" default:"0.9" description:"Fraction of a worker's CPU, memory or disk beyond which it is refused containers by the limit-active-tasks and least-loaded placement strategies."`
+	Runtime                           string        `long:"runtime" default:"garden" choice:"garden" choice:"kubernetes" description:"Runtime used to run containers and volumes on the worker registered by the ATC for the Kubernetes namespace. Workers registered through the TSA or as a static worker always use Garden."`
 	BaggageclaimResponseHeaderTimeout time.Duration `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
 
 	CLIArtifactsDir flag.Dir `long:"cli-artifacts-dir" description:"Directory containing downloadable CLI binaries."`
@@ -122,12 +146,27 @@ type RunCommand struct {
 		ResourceTypes   map[string]string `long:"resource"         description:"A resource type to advertise for the worker. Can be specified multiple times." value-name:"TYPE:IMAGE"`
 	} `group:"Static Worker (optional)" namespace:"worker"`
 
+	KubernetesRuntime struct {
+		InClusterConfig bool              `long:"in-cluster"        description:"Enables the in-cluster client."`
+		ConfigPath      string            `long:"config-path"       description:"Path to Kubernetes config when running ATC outside Kubernetes."`
+		Namespace       string            `long:"namespace"         default:"concourse-workers" description:"Kubernetes namespace in which to run containers and volumes."`
+		WorkerName      string            `long:"worker-name"       default:"kubernetes"        description:"Name of the worker to register for the namespace. Containers and volumes on this worker are run by the Kubernetes runtime."`
+		StorageClass    string            `long:"storage-class"     description:"Storage class of the persistent volume claims backing volumes. Defaults to the cluster's default storage class."`
+		VolumeSize      string            `long:"volume-size"       default:"10Gi"              description:"Storage to request for each volume."`
+		HelperImage     string            `long:"helper-image"      default:"busybox"           description:"Image used to stream data in and out of volumes. Must provide sh, tar, and cp."`
+		PodStartTimeout time.Duration     `long:"pod-start-timeout" default:"5m"                description:"How long to wait for a container's pod to start running."`
+		SweepInterval   time.Duration     `long:"sweep-interval"    default:"30s"               description:"Interval on which to destroy containers and volumes which have been garbage collected."`
+		ResourceTypes   map[string]string `long:"resource"          description:"A resource type to advertise for the worker. Can be specified multiple times." value-name:"TYPE:IMAGE"`
+	} `group:"Kubernetes Runtime" namespace:"kubernetes-runtime"`
+
 	Metrics struct {
 		HostName            string            `long:"metrics-host-name" description:"Host string to attach to emitted metrics."`
 		Attributes          map[string]string `long:"metrics-attribute" description:"A key-value attribute to attach to emitted metrics. Can be specified multiple times." value-name:"NAME:VALUE"`
 		CaptureErrorMetrics bool              `long:"capture-error-metrics" description:"Enable capturing of error log metrics"`
 	} `group:"Metrics & Diagnostics"`
 
+	Tracing tracing.Config `group:"Tracing"`
+
 	Server struct {
 		XFrameOptions string `long:"x-frame-options" description:"The value to set for X-Frame-Options. If omitted, the header is not set."`
 	} `group:"Web Server"`
@@ -159,6 +198,12 @@ type RunCommand struct {
 		CACerts       []string      `long:"syslog-ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the Syslog server SSL cert."`
 	} ` group:"Syslog Drainer Configuration"`
 
+	LogStore struct {
+		S3 logstore.S3Config `group:"S3 Log Store" namespace:"s3"`
+
+		ArchiveInterval time.Duration `long:"archive-interval" default:"1m" description:"Interval on which to archive the events of completed builds to the log store."`
+	} `group:"Build Log Store" namespace:"log-store"`
+
 	Auth struct {
 		AuthFlags     skycmd.AuthFlags
 		MainTeamFlags skycmd.AuthTeamFlags `group:"Authentication (Main Team)" namespace:"main-team"`
@@ -343,6 +388,7 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 	})
 
 	atc.EnableGlobalResources = cmd.EnableGlobalResources
+	db.CredentialsFingerprintKey = cmd.credentialsFingerprintKey()
 
 	radar.GlobalResourceCheckTimeout = cmd.GlobalResourceCheckTimeout
 	//FIXME: These only need to run once for the entire binary. At the moment,
@@ -369,6 +415,10 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
+	if err := cmd.Tracing.Prepare(); err != nil {
+		return nil, err
+	}
+
 	lockConn, err := cmd.constructLockConn(retryingDriverName)
 	if err != nil {
 		return nil, err
@@ -513,9 +563,14 @@ func (cmd *RunCommand) constructAPIMembers(
 		return nil, err
 	}
 
+	workerRuntime, _, err := cmd.workerRuntime(dbWorkerFactory)
+	if err != nil {
+		return nil, err
+	}
+
 	workerProvider := worker.NewDBWorkerProvider(
 		lockFactory,
-		retryhttp.NewExponentialBackOffFactory(5*time.Minute),
+		workerRuntime,
 		image.NewImageFactory(imageResourceFetcherFactory),
 		dbResourceCacheFactory,
 		dbResourceConfigFactory,
@@ -525,13 +580,12 @@ func (cmd *RunCommand) constructAPIMembers(
 		teamFactory,
 		dbWorkerFactory,
 		workerVersion,
-		cmd.BaggageclaimResponseHeaderTimeout,
 	)
 
-	pool := worker.NewPool(workerProvider)
+	pool := worker.NewPool(clock.NewClock(), workerProvider, teamFactory)
 	workerClient := worker.NewClient(pool, workerProvider)
 
-	variablesFactory, err := cmd.variablesFactory(logger)
+	variablesFactory, err := cmd.variablesFactory(logger, teamFactory)
 	if err != nil {
 		return nil, err
 	}
@@ -546,19 +600,32 @@ func (cmd *RunCommand) constructAPIMembers(
 		cmd.ResourceCheckingInterval,
 		cmd.ExternalURL.String(),
 		variablesFactory,
+		cmd.credentialsFingerprintKey(),
 		checkContainerStrategy,
 	)
 
 	drain := make(chan struct{})
 	credsManagers := cmd.CredentialManagers
+
+	credentialManagerChain, err := cmd.credentialManagerChain()
+	if err != nil {
+		return nil, err
+	}
+
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
 	dbJobFactory := db.NewJobFactory(dbConn, lockFactory)
 	dbResourceFactory := db.NewResourceFactory(dbConn, lockFactory)
 	dbContainerRepository := db.NewContainerRepository(dbConn)
 	gcContainerDestroyer := gc.NewDestroyer(logger, dbContainerRepository, dbVolumeRepository)
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
+	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory)
 	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey())
 
+	err = cmd.customizeActionRoles(logger, accessFactory)
+	if err != nil {
+		return nil, err
+	}
+
 	apiHandler, err := cmd.constructAPIHandler(
 		logger,
 		reconfigurableSink,
@@ -571,12 +638,13 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbContainerRepository,
 		gcContainerDestroyer,
 		dbBuildFactory,
-		dbResourceConfigFactory,
+		dbCheckFactory,
 		workerClient,
 		drain,
 		radarScannerFactory,
 		variablesFactory,
 		credsManagers,
+		credentialManagerChain,
 		accessFactory,
 	)
 
@@ -697,9 +765,14 @@ func (cmd *RunCommand) constructBackendMembers(
 		return nil, err
 	}
 
+	workerRuntime, kubernetesRuntime, err := cmd.workerRuntime(dbWorkerFactory)
+	if err != nil {
+		return nil, err
+	}
+
 	workerProvider := worker.NewDBWorkerProvider(
 		lockFactory,
-		retryhttp.NewExponentialBackOffFactory(5*time.Minute),
+		workerRuntime,
 		image.NewImageFactory(imageResourceFetcherFactory),
 		dbResourceCacheFactory,
 		dbResourceConfigFactory,
@@ -709,10 +782,9 @@ func (cmd *RunCommand) constructBackendMembers(
 		teamFactory,
 		dbWorkerFactory,
 		workerVersion,
-		cmd.BaggageclaimResponseHeaderTimeout,
 	)
 
-	pool := worker.NewPool(workerProvider)
+	pool := worker.NewPool(clock.NewClock(), workerProvider, teamFactory)
 	workerClient := worker.NewClient(pool, workerProvider)
 
 	defaultLimits, err := cmd.parseDefaultLimits()
@@ -720,14 +792,30 @@ func (cmd *RunCommand) constructBackendMembers(
 		return nil, err
 	}
 
-	variablesFactory, err := cmd.variablesFactory(logger)
+	variablesFactory, err := cmd.variablesFactory(logger, teamFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	buildContainerStrategy, err := cmd.chooseBuildContainerStrategy()
 	if err != nil {
 		return nil, err
 	}
 
-	buildContainerStrategy := cmd.chooseBuildContainerStrategy()
 	checkContainerStrategy := worker.NewRandomPlacementStrategy()
 
+	radarScannerFactory := radar.NewScannerFactory(
+		pool,
+		resourceFactory,
+		dbResourceConfigFactory,
+		cmd.ResourceTypeCheckingInterval,
+		cmd.ResourceCheckingInterval,
+		cmd.ExternalURL.String(),
+		variablesFactory,
+		cmd.credentialsFingerprintKey(),
+		checkContainerStrategy,
+	)
+
 	engine := cmd.constructEngine(
 		pool,
 		workerClient,
@@ -738,22 +826,21 @@ func (cmd *RunCommand) constructBackendMembers(
 		defaultLimits,
 		buildContainerStrategy,
 		resourceFactory,
+		teamFactory,
+		radarScannerFactory,
 	)
 
 	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory(
-		pool,
-		resourceFactory,
-		dbResourceConfigFactory,
-		cmd.ResourceTypeCheckingInterval,
-		cmd.ResourceCheckingInterval,
 		engine,
-		checkContainerStrategy,
 	)
+
 	dbWorkerLifecycle := db.NewWorkerLifecycle(dbConn)
 	dbResourceCacheLifecycle := db.NewResourceCacheLifecycle(dbConn)
 	dbContainerRepository := db.NewContainerRepository(dbConn)
 	dbArtifactLifecycle := db.NewArtifactLifecycle(dbConn)
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(dbConn)
+	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory)
+	dbCheckLifecycle := db.NewCheckLifecycle(dbConn)
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
 	bus := dbConn.Bus()
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
@@ -773,7 +860,6 @@ func (cmd *RunCommand) constructBackendMembers(
 				logger.Session("pipelines"),
 				dbPipelineFactory,
 				radarSchedulerFactory,
-				variablesFactory,
 			),
 			Interval: 10 * time.Second,
 			Clock:    clock.NewClock(),
@@ -815,6 +901,10 @@ func (cmd *RunCommand) constructBackendMembers(
 				gc.NewResourceConfigCheckSessionCollector(
 					resourceConfigCheckSessionLifecycle,
 				),
+				gc.NewCheckCollector(
+					dbCheckLifecycle,
+					cmd.GlobalResourceCheckTimeout,
+				),
 			),
 			"collector",
 			lockFactory,
@@ -840,6 +930,32 @@ func (cmd *RunCommand) constructBackendMembers(
 		)},
 	}
 
+	if !cmd.Developer.Noop {
+		members = append(members,
+			grouper.Member{Name: "lidar-scanner", Runner: lockrunner.NewRunner(
+				logger.Session("lidar"),
+				lidar.NewScanner(
+					dbCheckFactory,
+					clock.NewClock(),
+					cmd.ResourceCheckingInterval,
+					cmd.ResourceTypeCheckingInterval,
+				),
+				"lidar-scanner",
+				lockFactory,
+				clock.NewClock(),
+				cmd.LidarScannerInterval,
+			)},
+			grouper.Member{Name: "lidar-checker", Runner: lidar.Checker{
+				Logger:         logger.Session("lidar-checker"),
+				CheckFactory:   dbCheckFactory,
+				ScannerFactory: radarScannerFactory,
+				Clock:          clock.NewClock(),
+				Interval:       cmd.LidarCheckerInterval,
+				MaxInFlight:    cmd.MaxChecksInFlight,
+			}},
+		)
+	}
+
 	//Syslog Drainer Configuration
 	if syslogDrainConfigured {
 		members = append(members, grouper.Member{
@@ -860,9 +976,41 @@ func (cmd *RunCommand) constructBackendMembers(
 			)},
 		)
 	}
+	if cmd.LogStore.S3.IsConfigured() {
+		store, err := cmd.LogStore.S3.NewStore()
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, grouper.Member{
+			Name: "log-store-archiver", Runner: lockrunner.NewRunner(
+				logger.Session("log-store-archiver"),
+				logstore.NewArchiver(
+					store,
+					dbBuildFactory,
+					syslogDrainConfigured,
+				),
+				"log-store-archiver",
+				lockFactory,
+				clock.NewClock(),
+				cmd.LogStore.ArchiveInterval,
+			)},
+		)
+	}
 	if cmd.Worker.GardenURL.URL != nil {
 		members = cmd.appendStaticWorker(logger, dbWorkerFactory, members)
 	}
+	if kubernetesRuntime != nil {
+		members = cmd.appendKubernetesWorker(
+			logger,
+			kubernetesRuntime,
+			dbWorkerFactory,
+			dbContainerRepository,
+			dbVolumeRepository,
+			lockFactory,
+			members,
+		)
+	}
 	return members, nil
 }
 
@@ -870,12 +1018,60 @@ func workerVersion() (version.Version, error) {
 	return version.NewVersionFromString(concourse.WorkerVersion)
 }
 
-func (cmd *RunCommand) variablesFactory(logger lager.Logger) (creds.VariablesFactory, error) {
-	var variablesFactory creds.VariablesFactory = noop.NewNoopFactory()
+// credentialManagerChain returns the names of the credential managers to look
+// up credentials in, in order.
+func (cmd *RunCommand) credentialManagerChain() ([]string, error) {
+	configured := map[string]creds.Manager{}
 	for name, manager := range cmd.CredentialManagers {
-		if !manager.IsConfigured() {
-			continue
+		if manager.IsConfigured() {
+			configured[name] = manager
+		}
+	}
+
+	names := cmd.CredentialManagement.Chain
+	if len(names) == 0 {
+		for name := range configured {
+			names = append(names, name)
+		}
+
+		if len(names) > 1 {
+			sort.Strings(names)
+			return nil, fmt.Errorf("multiple credential managers configured (%s): specify the order to look up credentials in with --credential-manager", strings.Join(names, ", "))
+		}
+	}
+
+	for _, name := range names {
+		if _, found := configured[name]; !found {
+			return nil, fmt.Errorf("credential manager '%s' is not configured", name)
 		}
+	}
+
+	return names, nil
+}
+
+// credentialsFingerprintKey returns the key that the credentials a check runs
+// with, or a custom resource type's image is fetched with, are fingerprinted
+// with. It is derived from the session signing key, as
+// that is already kept secret and shared by every web node.
+func (cmd *RunCommand) credentialsFingerprintKey() []byte {
+	signingKey := cmd.Auth.AuthFlags.SigningKey
+	if signingKey == nil || signingKey.PrivateKey == nil {
+		return nil
+	}
+
+	key := sha256.Sum256(x509.MarshalPKCS1PrivateKey(signingKey.PrivateKey))
+	return key[:]
+}
+
+func (cmd *RunCommand) variablesFactory(logger lager.Logger, teamFactory db.TeamFactory) (creds.VariablesFactory, error) {
+	names, err := cmd.credentialManagerChain()
+	if err != nil {
+		return nil, err
+	}
+
+	factories := []creds.NamedVariablesFactory{}
+	for _, name := range names {
+		manager := cmd.CredentialManagers[name]
 
 		credsLogger := logger.Session("credential-manager", lager.Data{
 			"name": name,
@@ -893,14 +1089,88 @@ func (cmd *RunCommand) variablesFactory(logger lager.Logger) (creds.VariablesFac
 			return nil, fmt.Errorf("credential manager '%s' misconfigured: %s", name, err)
 		}
 
-		variablesFactory, err = manager.NewVariablesFactory(credsLogger)
+		variablesFactory, err := manager.NewVariablesFactory(credsLogger)
 		if err != nil {
 			return nil, err
 		}
 
-		break
+		factories = append(factories, creds.NamedVariablesFactory{
+			Name:    name,
+			Factory: creds.NewRetryableVariablesFactory(variablesFactory, cmd.CredentialManagement.RetryConfig),
+		})
+	}
+
+	variablesFactory := creds.NewVarSourcedVariablesFactory(
+		logger.Session("var-sources"),
+		creds.NewChainedVariablesFactory(
+			logger.Session("credential-managers"),
+			factories,
+			teamCredentialManagers{teamFactory},
+		),
+		creds.NewVarSourcePool(
+			creds.ManagerFactories(),
+			cmd.CredentialManagement.VarSourceIdleTimeout,
+			clock.NewClock(),
+		),
+		pipelineVarSources{teamFactory},
+	)
+
+	if cmd.CredentialManagement.CacheConfig.Enabled {
+		variablesFactory = creds.NewCachedVariablesFactory(
+			variablesFactory,
+			cmd.CredentialManagement.CacheConfig,
+			clock.NewClock(),
+			&metric.CredentialCacheHits,
+			&metric.CredentialCacheMisses,
+		)
+	}
+
+	return variablesFactory, nil
+}
+
+// teamCredentialManagers looks up the credential managers chosen by a team.
+type teamCredentialManagers struct {
+	teamFactory db.TeamFactory
+}
+
+func (teams teamCredentialManagers) CredentialManagers(teamName string) ([]string, error) {
+	team, found, err := teams.teamFactory.FindTeam(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, nil
 	}
-	return creds.NewRetryableVariablesFactory(variablesFactory, cmd.CredentialManagement.RetryConfig), nil
+
+	return team.CredentialManagers(), nil
+}
+
+// pipelineVarSources looks up the var sources declared by a pipeline.
+type pipelineVarSources struct {
+	teamFactory db.TeamFactory
+}
+
+func (pipelines pipelineVarSources) VarSources(teamName string, pipelineName string) (atc.VarSourceConfigs, error) {
+	team, found, err := pipelines.teamFactory.FindTeam(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	pipeline, found, err := team.Pipeline(pipelineName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return pipeline.VarSources(), nil
 }
 
 func (cmd *RunCommand) newKey() *encryption.Key {
@@ -1086,6 +1356,15 @@ func (cmd *RunCommand) validate() error {
 		)
 	}
 
+	if cmd.Runtime == "kubernetes" {
+		if cmd.KubernetesRuntime.InClusterConfig == (cmd.KubernetesRuntime.ConfigPath != "") {
+			errs = multierror.Append(
+				errs,
+				errors.New("must specify one of --kubernetes-runtime-in-cluster or --kubernetes-runtime-config-path to use the kubernetes runtime"),
+			)
+		}
+	}
+
 	return errs.ErrorOrNil()
 }
 
@@ -1131,6 +1410,11 @@ func (cmd *RunCommand) constructDBConn(
 		dbConn = db.Log(logger.Session("log-conn"), dbConn)
 	}
 
+	// Instrument with Tracing
+	if cmd.Tracing.IsConfigured() {
+		dbConn = db.Trace(dbConn)
+	}
+
 	// Prepare
 	dbConn.SetMaxOpenConns(maxConn)
 
@@ -1154,18 +1438,15 @@ func (cmd *RunCommand) constructLockConn(driverName string) (*sql.DB, error) {
 	return dbConn, nil
 }
 
-func (cmd *RunCommand) chooseBuildContainerStrategy() worker.ContainerPlacementStrategy {
-	var strategy worker.ContainerPlacementStrategy
-	switch cmd.ContainerPlacementStrategy {
-	case "random":
-		strategy = worker.NewRandomPlacementStrategy()
-	case "fewest-build-containers":
-		strategy = worker.NewFewestBuildContainersPlacementStrategy()
-	default:
-		strategy = worker.NewVolumeLocalityPlacementStrategy()
-	}
+func (cmd *RunCommand) chooseBuildContainerStrategy() (worker.ContainerPlacementStrategy, error) {
+	return worker.NewPlacementStrategy(cmd.ContainerPlacementStrategy, cmd.placementOptions())
+}
 
-	return strategy
+func (cmd *RunCommand) placementOptions() worker.PlacementOptions {
+	return worker.PlacementOptions{
+		MaxActiveTasksPerWorker: cmd.MaxActiveTasksPerWorker,
+		MaxWorkerPressure:       cmd.MaxWorkerPressure,
+	}
 }
 
 func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) error {
@@ -1201,6 +1482,8 @@ func (cmd *RunCommand) constructEngine(
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	resourceFactory resource.ResourceFactory,
+	teamFactory db.TeamFactory,
+	scannerFactory radar.ScannerFactory,
 ) engine.Engine {
 	gardenFactory := exec.NewGardenFactory(
 		workerPool,
@@ -1208,15 +1491,17 @@ func (cmd *RunCommand) constructEngine(
 		resourceFetcher,
 		resourceCacheFactory,
 		resourceConfigFactory,
-		variablesFactory,
 		defaultLimits,
 		strategy,
+		cmd.placementOptions(),
 		resourceFactory,
+		teamFactory,
+		scannerFactory,
 	)
 
 	execV2Engine := engine.NewExecEngine(
 		gardenFactory,
-		engine.NewBuildDelegateFactory(),
+		engine.NewBuildDelegateFactory(variablesFactory, !cmd.DisableRedactSecrets),
 		cmd.ExternalURL.String(),
 	)
 
@@ -1268,12 +1553,13 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbContainerRepository db.ContainerRepository,
 	gcContainerDestroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
-	resourceConfigFactory db.ResourceConfigFactory,
+	dbCheckFactory db.CheckFactory,
 	workerClient worker.Client,
 	drain <-chan struct{},
 	radarScannerFactory radar.ScannerFactory,
 	variablesFactory creds.VariablesFactory,
 	credsManagers creds.Managers,
+	credentialManagerChain []string,
 	accessFactory accessor.AccessFactory,
 ) (http.Handler, error) {
 
@@ -1282,8 +1568,19 @@ func (cmd *RunCommand) constructAPIHandler(
 	checkBuildWriteAccessHandlerFactory := auth.NewCheckBuildWriteAccessHandlerFactory(dbBuildFactory)
 	checkWorkerTeamAccessHandlerFactory := auth.NewCheckWorkerTeamAccessHandlerFactory(dbWorkerFactory)
 
+	var eventHandlerFactory buildserver.EventHandlerFactory = buildserver.NewEventHandler
+	if cmd.LogStore.S3.IsConfigured() {
+		store, err := cmd.LogStore.S3.NewStore()
+		if err != nil {
+			return nil, err
+		}
+
+		eventHandlerFactory = buildserver.NewLogStoreEventHandlerFactory(store)
+	}
+
 	apiWrapper := wrappa.MultiWrappa{
 		wrappa.NewAPIMetricsWrappa(logger),
+		wrappa.NewAPITracingWrappa(),
 		wrappa.NewAPIAuthWrappa(
 			checkPipelineAccessHandlerFactory,
 			checkBuildReadAccessHandlerFactory,
@@ -1308,13 +1605,14 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbContainerRepository,
 		gcContainerDestroyer,
 		dbBuildFactory,
-		resourceConfigFactory,
+		dbCheckFactory,
 
-		buildserver.NewEventHandler,
+		eventHandlerFactory,
 		drain,
 
 		workerClient,
 		radarScannerFactory,
+		inputmapper.NewInputMapperFactory(),
 
 		reconfigurableSink,
 
@@ -1325,6 +1623,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		concourse.WorkerVersion,
 		variablesFactory,
 		credsManagers,
+		credentialManagerChain,
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 	)
 }
@@ -1354,27 +1653,12 @@ func (cmd *RunCommand) constructPipelineSyncer(
 	logger lager.Logger,
 	pipelineFactory db.PipelineFactory,
 	radarSchedulerFactory pipelines.RadarSchedulerFactory,
-	variablesFactory creds.VariablesFactory,
 ) *pipelines.Syncer {
 	return pipelines.NewSyncer(
 		logger,
 		pipelineFactory,
 		func(pipeline db.Pipeline) ifrit.Runner {
-			variables := variablesFactory.NewVariables(pipeline.TeamName(), pipeline.Name())
 			return grouper.NewParallel(os.Interrupt, grouper.Members{
-				{
-					Name: fmt.Sprintf("radar:%d", pipeline.ID()),
-					Runner: radar.NewRunner(
-						logger.Session("radar").WithData(lager.Data{
-							"team":     pipeline.TeamName(),
-							"pipeline": pipeline.Name(),
-						}),
-						cmd.Developer.Noop,
-						radarSchedulerFactory.BuildScanRunnerFactory(pipeline, cmd.ExternalURL.String(), variables),
-						pipeline,
-						1*time.Minute,
-					),
-				},
 				{
 					Name: fmt.Sprintf("scheduler:%d", pipeline.ID()),
 					Runner: &scheduler.Runner{
@@ -1421,6 +1705,144 @@ func (cmd *RunCommand) appendStaticWorker(
 	)
 }
 
+func (cmd *RunCommand) customizeActionRoles(logger lager.Logger, accessFactory accessor.AccessFactory) error {
+	path := cmd.ConfigRBAC.Path()
+	if path == "" {
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var customRoles accessor.CustomActionRoleMap
+	err = yaml.Unmarshal(content, &customRoles)
+	if err != nil {
+		return fmt.Errorf("failed to parse rbac config: %s", err)
+	}
+
+	return accessFactory.CustomizeActionRoleMap(logger.Session("rbac"), customRoles)
+}
+
+// workerRuntime returns the runtime for all workers, along with the
+// Kubernetes runtime if one is configured. Only the worker registered for the
+// Kubernetes namespace uses the Kubernetes runtime; every other worker uses
+// Garden.
+func (cmd *RunCommand) workerRuntime(dbWorkerFactory db.WorkerFactory) (worker.Runtime, *k8s.Runtime, error) {
+	gardenRuntime := worker.NewGardenRuntime(
+		dbWorkerFactory,
+		retryhttp.NewExponentialBackOffFactory(5*time.Minute),
+		cmd.BaggageclaimResponseHeaderTimeout,
+	)
+
+	if cmd.Runtime != "kubernetes" {
+		return gardenRuntime, nil, nil
+	}
+
+	kubernetesRuntime, err := cmd.kubernetesRuntime()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return worker.NewRuntimeByWorker(gardenRuntime, map[string]worker.Runtime{
+		cmd.KubernetesRuntime.WorkerName: kubernetesRuntime,
+	}), kubernetesRuntime, nil
+}
+
+func (cmd *RunCommand) kubernetesRuntime() (*k8s.Runtime, error) {
+	var config *rest.Config
+	var err error
+	if cmd.KubernetesRuntime.InClusterConfig {
+		config, err = rest.InClusterConfig()
+	} else {
+		config, err = clientcmd.BuildConfigFromFlags("", cmd.KubernetesRuntime.ConfigPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	volumeSize, err := k8sresource.ParseQuantity(cmd.KubernetesRuntime.VolumeSize)
+	if err != nil {
+		return nil, fmt.Errorf("invalid volume size: %s", err)
+	}
+
+	return k8s.NewRuntime(
+		clientset,
+		k8s.NewRemoteExecutor(config, clientset),
+		clock.NewClock(),
+		k8s.Config{
+			Namespace:       cmd.KubernetesRuntime.Namespace,
+			StorageClass:    cmd.KubernetesRuntime.StorageClass,
+			VolumeSize:      volumeSize,
+			HelperImage:     cmd.KubernetesRuntime.HelperImage,
+			PodStartTimeout: cmd.KubernetesRuntime.PodStartTimeout,
+		},
+	), nil
+}
+
+func (cmd *RunCommand) appendKubernetesWorker(
+	logger lager.Logger,
+	runtime *k8s.Runtime,
+	workerFactory db.WorkerFactory,
+	containerRepository db.ContainerRepository,
+	volumeRepository db.VolumeRepository,
+	lockFactory lock.LockFactory,
+	members []grouper.Member,
+) []grouper.Member {
+	resourceTypes := []atc.WorkerResourceType{}
+	for t, image := range cmd.KubernetesRuntime.ResourceTypes {
+		resourceTypes = append(resourceTypes, atc.WorkerResourceType{
+			Type:  t,
+			Image: "docker:///" + image,
+		})
+	}
+
+	workerName := cmd.KubernetesRuntime.WorkerName
+
+	// every web node is configured with the same worker, so only one of them
+	// registers and sweeps it at a time
+	return append(members,
+		grouper.Member{Name: "kubernetes-worker", Runner: lockrunner.NewRunner(
+			logger.Session("kubernetes-worker"),
+			worker.NewStaticWorkerHeartbeater(
+				workerFactory,
+				atc.Worker{
+					Name:          workerName,
+					Platform:      "linux",
+					Tags:          []string{},
+					ResourceTypes: resourceTypes,
+					Version:       concourse.WorkerVersion,
+				},
+				30*time.Second,
+			),
+			"kubernetes-worker",
+			lockFactory,
+			clock.NewClock(),
+			10*time.Second,
+		)},
+		grouper.Member{Name: "kubernetes-worker-sweeper", Runner: lockrunner.NewRunner(
+			logger.Session("kubernetes-worker-sweeper"),
+			k8s.NewSweeper(
+				workerName,
+				runtime,
+				gc.NewDestroyer(logger, containerRepository, volumeRepository),
+				containerRepository,
+				volumeRepository,
+			),
+			"kubernetes-worker-sweeper",
+			lockFactory,
+			clock.NewClock(),
+			cmd.KubernetesRuntime.SweepInterval,
+		)},
+	)
+}
+
 func (cmd *RunCommand) isTLSEnabled() bool {
 	return cmd.TLSBindPort != 0
 }
@@ -10,6 +10,18 @@ type PublicBuildInput struct {
 	Version         Version `json:"version"`
 	PipelineID      int     `json:"pipeline_id"`
 	FirstOccurrence bool    `json:"first_occurrence"`
+	Override        bool    `json:"override,omitempty"`
+}
+
+// InputOverrides are the versions chosen for some of a manually triggered
+// build's inputs, keyed by input name, in place of the ones the scheduler
+// would pick.
+type InputOverrides struct {
+	Inputs map[string]Version `json:"inputs,omitempty"`
+
+	// IgnorePassed lets overridden versions be used even if they have not
+	// passed through the jobs listed in the inputs' passed constraints.
+	IgnorePassed bool `json:"ignore_passed,omitempty"`
 }
 
 type PublicBuildOutput struct {
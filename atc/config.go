@@ -18,6 +18,28 @@ type Config struct {
 	Resources     ResourceConfigs `yaml:"resources" json:"resources" mapstructure:"resources"`
 	ResourceTypes ResourceTypes   `yaml:"resource_types" json:"resource_types" mapstructure:"resource_types"`
 	Jobs          JobConfigs      `yaml:"jobs" json:"jobs" mapstructure:"jobs"`
+
+	VarSources VarSourceConfigs `yaml:"var_sources,omitempty" json:"var_sources,omitempty" mapstructure:"var_sources"`
+}
+
+// VarSourceConfig declares a credential manager which the pipeline's steps
+// can look up vars in by name, e.g. ((some-source:some-var)).
+type VarSourceConfig struct {
+	Name   string                 `yaml:"name" json:"name" mapstructure:"name"`
+	Type   string                 `yaml:"type" json:"type" mapstructure:"type"`
+	Config map[string]interface{} `yaml:"config,omitempty" json:"config,omitempty" mapstructure:"config"`
+}
+
+type VarSourceConfigs []VarSourceConfig
+
+func (sources VarSourceConfigs) Lookup(name string) (VarSourceConfig, bool) {
+	for _, source := range sources {
+		if source.Name == name {
+			return source, true
+		}
+	}
+
+	return VarSourceConfig{}, false
 }
 
 type GroupConfig struct {
@@ -39,15 +61,36 @@ func (groups GroupConfigs) Lookup(name string) (GroupConfig, int, bool) {
 }
 
 type ResourceConfig struct {
-	Name         string  `yaml:"name" json:"name" mapstructure:"name"`
-	Public       bool    `yaml:"public,omitempty" json:"public,omitempty" mapstructure:"public"`
-	WebhookToken string  `yaml:"webhook_token,omitempty" json:"webhook_token" mapstructure:"webhook_token"`
-	Type         string  `yaml:"type" json:"type" mapstructure:"type"`
-	Source       Source  `yaml:"source" json:"source" mapstructure:"source"`
-	CheckEvery   string  `yaml:"check_every,omitempty" json:"check_every" mapstructure:"check_every"`
-	CheckTimeout string  `yaml:"check_timeout,omitempty" json:"check_timeout" mapstructure:"check_timeout"`
-	Tags         Tags    `yaml:"tags,omitempty" json:"tags" mapstructure:"tags"`
-	Version      Version `yaml:"version,omitempty" json:"version" mapstructure:"version"`
+	Name         string         `yaml:"name" json:"name" mapstructure:"name"`
+	Public       bool           `yaml:"public,omitempty" json:"public,omitempty" mapstructure:"public"`
+	WebhookToken string         `yaml:"webhook_token,omitempty" json:"webhook_token" mapstructure:"webhook_token"`
+	Webhook      *WebhookConfig `yaml:"webhook,omitempty" json:"webhook,omitempty" mapstructure:"webhook"`
+	Type         string         `yaml:"type" json:"type" mapstructure:"type"`
+	Source       Source         `yaml:"source" json:"source" mapstructure:"source"`
+	CheckEvery   string         `yaml:"check_every,omitempty" json:"check_every" mapstructure:"check_every"`
+	CheckTimeout string         `yaml:"check_timeout,omitempty" json:"check_timeout" mapstructure:"check_timeout"`
+	Tags         Tags           `yaml:"tags,omitempty" json:"tags" mapstructure:"tags"`
+	Version      Version        `yaml:"version,omitempty" json:"version" mapstructure:"version"`
+}
+
+const (
+	WebhookProviderGitHub    = "github"
+	WebhookProviderGitLab    = "gitlab"
+	WebhookProviderBitbucket = "bitbucket"
+	WebhookProviderHMAC      = "hmac"
+)
+
+// WebhookConfig configures how a resource's webhook verifies that deliveries
+// come from the given provider, and which of them trigger a check.
+type WebhookConfig struct {
+	Provider string `yaml:"provider" json:"provider" mapstructure:"provider"`
+	Secret   string `yaml:"secret" json:"secret" mapstructure:"secret"`
+
+	// Header carries the signature for the generic hmac provider.
+	Header string `yaml:"header,omitempty" json:"header,omitempty" mapstructure:"header"`
+
+	Events   []string `yaml:"events,omitempty" json:"events,omitempty" mapstructure:"events"`
+	Branches []string `yaml:"branches,omitempty" json:"branches,omitempty" mapstructure:"branches"`
 }
 
 type ResourceType struct {
@@ -290,6 +333,106 @@ func (c InputsConfig) MarshalJSON() ([]byte, error) {
 	return json.Marshal("")
 }
 
+// An InParallelConfig represents the steps of an in_parallel step. It may be
+// configured either as a plain list of steps or with a limit on how many of
+// them run at once and whether to fail fast.
+type InParallelConfig struct {
+	Steps    PlanSequence `yaml:"steps,omitempty" json:"steps" mapstructure:"steps"`
+	Limit    int          `yaml:"limit,omitempty" json:"limit,omitempty" mapstructure:"limit"`
+	FailFast bool         `yaml:"fail_fast,omitempty" json:"fail_fast,omitempty" mapstructure:"fail_fast"`
+}
+
+func (c *InParallelConfig) UnmarshalJSON(payload []byte) error {
+	var data interface{}
+
+	err := json.Unmarshal(payload, &data)
+	if err != nil {
+		return err
+	}
+
+	switch data.(type) {
+	case []interface{}:
+		return json.Unmarshal(payload, &c.Steps)
+	case map[string]interface{}:
+		// avoid infinitely recursing into this UnmarshalJSON
+		type target InParallelConfig
+
+		var t target
+		err := json.Unmarshal(payload, &t)
+		if err != nil {
+			return err
+		}
+
+		*c = InParallelConfig(t)
+	default:
+		return errors.New("unknown type for in_parallel config")
+	}
+
+	return nil
+}
+
+func (c *InParallelConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var data interface{}
+
+	err := unmarshal(&data)
+	if err != nil {
+		return err
+	}
+
+	switch data.(type) {
+	case []interface{}:
+		return unmarshal(&c.Steps)
+	case map[interface{}]interface{}:
+		// avoid infinitely recursing into this UnmarshalYAML
+		type target InParallelConfig
+
+		var t target
+		err := unmarshal(&t)
+		if err != nil {
+			return err
+		}
+
+		*c = InParallelConfig(t)
+	default:
+		return errors.New("unknown type for in_parallel config")
+	}
+
+	return nil
+}
+
+// An AcrossVarConfig represents one of the vars of an across step modifier,
+// along with the values to run the step with and how many of them may run at
+// once.
+type AcrossVarConfig struct {
+	Var         string        `yaml:"var" json:"var" mapstructure:"var"`
+	Values      []interface{} `yaml:"values,omitempty" json:"values,omitempty" mapstructure:"values"`
+	MaxInFlight int           `yaml:"max_in_flight,omitempty" json:"max_in_flight,omitempty" mapstructure:"max_in_flight"`
+	FailFast    bool          `yaml:"fail_fast,omitempty" json:"fail_fast,omitempty" mapstructure:"fail_fast"`
+}
+
+func (c *AcrossVarConfig) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	// avoid infinitely recursing into this UnmarshalYAML
+	type target AcrossVarConfig
+
+	var t target
+	err := unmarshal(&t)
+	if err != nil {
+		return err
+	}
+
+	// values may be arbitrary YAML, so make sure they can be marshaled to JSON
+	for i, value := range t.Values {
+		t.Values[i], err = sanitize(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	*c = AcrossVarConfig(t)
+
+	return nil
+}
+
 // A PlanConfig is a flattened set of configuration corresponding to
 // a particular Plan, where Source and Version are populated lazily.
 type PlanConfig struct {
@@ -306,6 +449,10 @@ type PlanConfig struct {
 	// corresponds to an Aggregate plan, keyed by the name of each sub-plan
 	Aggregate *PlanSequence `yaml:"aggregate,omitempty" json:"aggregate,omitempty" mapstructure:"aggregate"`
 
+	// a nested chain of steps to run in parallel, optionally limited in
+	// concurrency and aborted on the first failure
+	InParallel *InParallelConfig `yaml:"in_parallel,omitempty" json:"in_parallel,omitempty" mapstructure:"in_parallel"`
+
 	// corresponds to Get and Put resource plans, respectively
 	// name of 'input', e.g. bosh-stemcell
 	Get string `yaml:"get,omitempty" json:"get,omitempty" mapstructure:"get"`
@@ -323,6 +470,19 @@ type PlanConfig struct {
 	// inputs to a put step either a list (e.g. [artifact-1, aritfact-2]) or all (e.g. all)
 	Inputs *InputsConfig `yaml:"inputs,omitempty" json:"inputs,omitempty" mapstructure:"inputs"`
 
+	// name of the pipeline to configure from a file in an artifact, reusing
+	// 'file' and 'vars' for the pipeline config path and static variables
+	SetPipeline string `yaml:"set_pipeline,omitempty" json:"set_pipeline,omitempty" mapstructure:"set_pipeline"`
+	// files containing variables for the pipeline config, e.g. foo/vars.yml
+	VarFiles []string `yaml:"var_files,omitempty" json:"var_files,omitempty" mapstructure:"var_files"`
+
+	// name of a var to set for the rest of the build from a file in an
+	// artifact, reusing 'file' for the path
+	LoadVar string `yaml:"load_var,omitempty" json:"load_var,omitempty" mapstructure:"load_var"`
+	// format of the file to load the var from; one of raw, json or yaml.
+	// detected from the file extension if not specified
+	Format string `yaml:"format,omitempty" json:"format,omitempty" mapstructure:"format"`
+
 	// corresponds to a Task plan
 	// name of 'task', e.g. unit, go1.3, go1.4
 	Task string `yaml:"task,omitempty" json:"task,omitempty" mapstructure:"task"`
@@ -351,6 +511,10 @@ type PlanConfig struct {
 	// used by any step to specify which workers are eligible to run the step
 	Tags Tags `yaml:"tags,omitempty" json:"tags,omitempty" mapstructure:"tags"`
 
+	// used by any step to choose among the eligible workers, overriding the
+	// strategies configured on the ATC; steps nested within inherit it
+	Placement []string `yaml:"placement,omitempty" json:"placement,omitempty" mapstructure:"placement"`
+
 	// used by any step to run something when the build is aborted during execution of the step
 	Abort *PlanConfig `yaml:"on_abort,omitempty" json:"on_abort,omitempty" mapstructure:"on_abort"`
 
@@ -375,6 +539,9 @@ type PlanConfig struct {
 	// repeat the step up to N times, until it works
 	Attempts int `yaml:"attempts,omitempty" json:"attempts,omitempty" mapstructure:"attempts"`
 
+	// run the step once for each combination of the given vars' values
+	Across []AcrossVarConfig `yaml:"across,omitempty" json:"across,omitempty" mapstructure:"across"`
+
 	Version *VersionConfig `yaml:"version,omitempty" json:"version,omitempty" mapstructure:"version"`
 }
 
@@ -395,6 +562,14 @@ func (config PlanConfig) Name() string {
 		return config.Task
 	}
 
+	if config.SetPipeline != "" {
+		return config.SetPipeline
+	}
+
+	if config.LoadVar != "" {
+		return config.LoadVar
+	}
+
 	return ""
 }
 
@@ -46,4 +46,117 @@ var _ = Describe("Config", func() {
 			})
 		})
 	})
+
+	Describe("InParallelConfig", func() {
+		Context("when unmarshaling a list of steps from YAML", func() {
+			It("produces the correct config without error", func() {
+				var inParallelConfig InParallelConfig
+				bs := []byte(`[{get: some-resource}, {task: some-task}]`)
+				err := yaml.Unmarshal(bs, &inParallelConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := InParallelConfig{
+					Steps: PlanSequence{
+						{Get: "some-resource"},
+						{Task: "some-task"},
+					},
+				}
+
+				Expect(inParallelConfig).To(Equal(expected))
+			})
+		})
+
+		Context("when unmarshaling a full config from YAML", func() {
+			It("produces the correct config without error", func() {
+				var inParallelConfig InParallelConfig
+				bs := []byte(`{steps: [{get: some-resource}], limit: 2, fail_fast: true}`)
+				err := yaml.Unmarshal(bs, &inParallelConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := InParallelConfig{
+					Steps: PlanSequence{
+						{Get: "some-resource"},
+					},
+					Limit:    2,
+					FailFast: true,
+				}
+
+				Expect(inParallelConfig).To(Equal(expected))
+			})
+		})
+
+		Context("when unmarshaling a list of steps from JSON", func() {
+			It("produces the correct config without error", func() {
+				var inParallelConfig InParallelConfig
+				bs := []byte(`[{ "get": "some-resource" }, { "task": "some-task" }]`)
+				err := json.Unmarshal(bs, &inParallelConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := InParallelConfig{
+					Steps: PlanSequence{
+						{Get: "some-resource"},
+						{Task: "some-task"},
+					},
+				}
+
+				Expect(inParallelConfig).To(Equal(expected))
+			})
+		})
+
+		Context("when unmarshaling a full config from JSON", func() {
+			It("produces the correct config without error", func() {
+				var inParallelConfig InParallelConfig
+				bs := []byte(`{ "steps": [{ "get": "some-resource" }], "limit": 2, "fail_fast": true }`)
+				err := json.Unmarshal(bs, &inParallelConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := InParallelConfig{
+					Steps: PlanSequence{
+						{Get: "some-resource"},
+					},
+					Limit:    2,
+					FailFast: true,
+				}
+
+				Expect(inParallelConfig).To(Equal(expected))
+			})
+		})
+
+		Context("when unmarshaling something else from JSON", func() {
+			It("returns an error", func() {
+				var inParallelConfig InParallelConfig
+				err := json.Unmarshal([]byte(`"some-step"`), &inParallelConfig)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("AcrossVarConfig", func() {
+		Context("when unmarshaling from YAML", func() {
+			It("produces values which can be marshaled to JSON", func() {
+				var acrossVarConfig AcrossVarConfig
+				bs := []byte(`{var: platform, values: [{os: linux, arch: [amd64]}, windows], max_in_flight: 1, fail_fast: true}`)
+				err := yaml.Unmarshal(bs, &acrossVarConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				expected := AcrossVarConfig{
+					Var: "platform",
+					Values: []interface{}{
+						map[string]interface{}{
+							"os":   "linux",
+							"arch": []interface{}{"amd64"},
+						},
+						"windows",
+					},
+					MaxInFlight: 1,
+					FailFast:    true,
+				}
+
+				Expect(acrossVarConfig).To(Equal(expected))
+
+				_, err = json.Marshal(acrossVarConfig)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+	})
 })
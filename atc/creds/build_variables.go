@@ -0,0 +1,268 @@
+package creds
+
+import (
+	"encoding/base64"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+// RedactedCredential replaces credentials which are redacted from build logs.
+const RedactedCredential = "((redacted))"
+
+// minRedactedCredentialLength is the shortest credential which is redacted.
+// Shorter values, e.g. a port number or a single character, would redact every
+// occurrence of some common text from build logs while hiding very little.
+const minRedactedCredentialLength = 4
+
+// minRedactedLineLength is the shortest line of a multi-line credential which
+// is redacted on its own. Shorter lines, e.g. the braces of a JSON document,
+// are too common in build logs to redact wherever they appear.
+const minRedactedLineLength = 8
+
+// BuildVariables are the variables available to the steps of a single build.
+// Vars which are local to the build, e.g. those set by a load_var step, take
+// precedence over the variables from the team's credential manager.
+//
+// When redaction is enabled, every credential fetched for the build is
+// remembered so that it can be scrubbed from the build's logs.
+type BuildVariables struct {
+	parentVariables Variables
+
+	// the variables of the whole build, which redact the credentials fetched
+	// in any of its scopes; nil for the build's own variables
+	build *BuildVariables
+
+	localVars map[string]interface{}
+	lock      sync.RWMutex
+
+	enableRedaction bool
+	credentials     map[string]bool
+	redactor        *strings.Replacer
+	redacted        []string
+	credentialsLock sync.Mutex
+}
+
+func NewBuildVariables(parentVariables Variables, enableRedaction bool) *BuildVariables {
+	return &BuildVariables{
+		parentVariables: parentVariables,
+		localVars:       map[string]interface{}{},
+
+		enableRedaction: enableRedaction,
+		credentials:     map[string]bool{},
+	}
+}
+
+// NewLocalScope returns the variables for a nested scope of the build, e.g. one
+// combination of an across step. Vars set in the scope are not visible outside
+// of it, while credentials fetched in it are still redacted from the build's
+// logs.
+func (b *BuildVariables) NewLocalScope() *BuildVariables {
+	build := b
+	if b.build != nil {
+		build = b.build
+	}
+
+	return &BuildVariables{
+		parentVariables: b,
+		build:           build,
+		localVars:       map[string]interface{}{},
+		credentials:     map[string]bool{},
+	}
+}
+
+func (b *BuildVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	b.lock.RLock()
+	val, found := b.localVars[varDef.Name]
+	b.lock.RUnlock()
+
+	if found {
+		return val, true, nil
+	}
+
+	val, found, err := b.parentVariables.Get(varDef)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if found && b.enableRedaction {
+		b.track(val)
+	}
+
+	return val, found, nil
+}
+
+func (b *BuildVariables) List() ([]template.VariableDefinition, error) {
+	list, err := b.parentVariables.List()
+	if err != nil {
+		return nil, err
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	for name := range b.localVars {
+		list = append(list, template.VariableDefinition{Name: name})
+	}
+
+	return list, nil
+}
+
+// AddLocalVar sets a var for the rest of the build or scope, overriding any
+// existing var with the same name.
+func (b *BuildVariables) AddLocalVar(name string, val interface{}) {
+	b.lock.Lock()
+	b.localVars[name] = val
+	b.lock.Unlock()
+}
+
+// Redact replaces every credential fetched for the build so far which is at
+// least minRedactedCredentialLength long with RedactedCredential, along with
+// their base64 encodings and each line of any multi-line credentials which is
+// at least minRedactedLineLength long.
+func (b *BuildVariables) Redact(text string) string {
+	if b.build != nil {
+		return b.build.Redact(text)
+	}
+
+	b.credentialsLock.Lock()
+	redactor := b.redactor
+	b.credentialsLock.Unlock()
+
+	if redactor == nil {
+		return text
+	}
+
+	return redactor.Replace(text)
+}
+
+// RedactStream redacts text written as part of a stream, e.g. a step's
+// stdout. Any text at the end which could be the start of a credential is
+// returned as pending rather than redacted, so that it can be prepended to
+// the next text written to the stream in case the credential is split across
+// the two.
+func (b *BuildVariables) RedactStream(text string) (string, string) {
+	if b.build != nil {
+		return b.build.RedactStream(text)
+	}
+
+	b.credentialsLock.Lock()
+	redactor := b.redactor
+	redacted := b.redacted
+	b.credentialsLock.Unlock()
+
+	if redactor == nil {
+		return text, ""
+	}
+
+	text = redactor.Replace(text)
+
+	pending := 0
+	for _, variant := range redacted {
+		if len(variant)-1 <= pending {
+			// the rest are no longer than this one
+			break
+		}
+
+		for length := len(variant) - 1; length > pending; length-- {
+			if strings.HasSuffix(text, variant[:length]) {
+				pending = length
+				break
+			}
+		}
+	}
+
+	return text[:len(text)-pending], text[len(text)-pending:]
+}
+
+func (b *BuildVariables) track(val interface{}) {
+	switch typed := val.(type) {
+	case string:
+		b.trackCredential(typed)
+	case map[string]interface{}:
+		for _, v := range typed {
+			b.track(v)
+		}
+	case map[interface{}]interface{}:
+		for _, v := range typed {
+			b.track(v)
+		}
+	case []interface{}:
+		for _, v := range typed {
+			b.track(v)
+		}
+	}
+}
+
+func (b *BuildVariables) trackCredential(credential string) {
+	if len(strings.TrimSpace(credential)) < minRedactedCredentialLength {
+		return
+	}
+
+	b.credentialsLock.Lock()
+	defer b.credentialsLock.Unlock()
+
+	if b.credentials[credential] {
+		return
+	}
+
+	b.credentials[credential] = true
+
+	b.redacted = redactedVariants(b.credentials)
+	b.redactor = newRedactor(b.redacted)
+}
+
+// redactedVariants returns every string to redact for the given credentials,
+// longest first.
+func redactedVariants(credentials map[string]bool) []string {
+	variants := map[string]bool{}
+	for credential := range credentials {
+		variants[credential] = true
+
+		for _, encoding := range []*base64.Encoding{
+			base64.StdEncoding,
+			base64.RawStdEncoding,
+			base64.URLEncoding,
+			base64.RawURLEncoding,
+		} {
+			variants[encoding.EncodeToString([]byte(credential))] = true
+		}
+
+		if strings.Contains(credential, "\n") {
+			for _, line := range strings.Split(credential, "\n") {
+				line = strings.TrimSpace(line)
+				if len(line) >= minRedactedLineLength {
+					variants[line] = true
+				}
+			}
+		}
+	}
+
+	sorted := make([]string, 0, len(variants))
+	for variant := range variants {
+		sorted = append(sorted, variant)
+	}
+
+	// the replacer tries each string in the order given, so try the longest
+	// first in case a credential contains another
+	sort.Slice(sorted, func(i, j int) bool {
+		if len(sorted[i]) != len(sorted[j]) {
+			return len(sorted[i]) > len(sorted[j])
+		}
+
+		return sorted[i] < sorted[j]
+	})
+
+	return sorted
+}
+
+func newRedactor(sorted []string) *strings.Replacer {
+	oldnew := make([]string, 0, len(sorted)*2)
+	for _, variant := range sorted {
+		oldnew = append(oldnew, variant, RedactedCredential)
+	}
+
+	return strings.NewReplacer(oldnew...)
+}
@@ -0,0 +1,234 @@
+package creds_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildVariables", func() {
+	var (
+		fakeVariables  *credsfakes.FakeVariables
+		buildVariables *creds.BuildVariables
+	)
+
+	BeforeEach(func() {
+		fakeVariables = new(credsfakes.FakeVariables)
+		fakeVariables.GetReturns("parent-value", true, nil)
+		fakeVariables.ListReturns([]template.VariableDefinition{{Name: "parent-var"}}, nil)
+
+		buildVariables = creds.NewBuildVariables(fakeVariables, true)
+	})
+
+	Describe("Get", func() {
+		It("falls back to the parent variables", func() {
+			val, found, err := buildVariables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("parent-value"))
+
+			Expect(fakeVariables.GetArgsForCall(0)).To(Equal(template.VariableDefinition{Name: "some-var"}))
+		})
+
+		Context("when a local var has been added", func() {
+			BeforeEach(func() {
+				buildVariables.AddLocalVar("some-var", "local-value")
+			})
+
+			It("returns the local var without asking the parent", func() {
+				val, found, err := buildVariables.Get(template.VariableDefinition{Name: "some-var"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(val).To(Equal("local-value"))
+
+				Expect(fakeVariables.GetCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("List", func() {
+		BeforeEach(func() {
+			buildVariables.AddLocalVar("local-var", "local-value")
+		})
+
+		It("lists both the parent and the local vars", func() {
+			list, err := buildVariables.List()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list).To(ConsistOf(
+				template.VariableDefinition{Name: "parent-var"},
+				template.VariableDefinition{Name: "local-var"},
+			))
+		})
+
+		Context("when the parent fails to list", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeVariables.ListReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				_, err := buildVariables.List()
+				Expect(err).To(Equal(disaster))
+			})
+		})
+	})
+
+	Describe("Redact", func() {
+		fetch := func(name string) {
+			_, _, err := buildVariables.Get(template.VariableDefinition{Name: name})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("leaves text alone when no credentials have been fetched", func() {
+			Expect(buildVariables.Redact("parent-value")).To(Equal("parent-value"))
+		})
+
+		Context("when a credential has been fetched", func() {
+			BeforeEach(func() {
+				fakeVariables.GetReturns("p4ss?~>", true, nil)
+				fetch("some-var")
+			})
+
+			It("redacts the credential", func() {
+				Expect(buildVariables.Redact("the secret is p4ss?~>!")).To(Equal("the secret is ((redacted))!"))
+			})
+
+			It("redacts its base64 encodings", func() {
+				Expect(buildVariables.Redact("cDRzcz9+Pg== cDRzcz9-Pg== cDRzcz9+Pg")).To(Equal("((redacted)) ((redacted)) ((redacted))"))
+			})
+		})
+
+		Context("when a multi-line credential has been fetched", func() {
+			BeforeEach(func() {
+				fakeVariables.GetReturns("-----BEGIN KEY-----\nabc123def456\n}\n-----END KEY-----\n", true, nil)
+				fetch("some-key")
+			})
+
+			It("redacts each of its lines", func() {
+				Expect(buildVariables.Redact("key:\n  abc123def456\n")).To(Equal("key:\n  ((redacted))\n"))
+			})
+
+			It("does not redact its short lines", func() {
+				Expect(buildVariables.Redact("func() {\n}\n")).To(Equal("func() {\n}\n"))
+			})
+		})
+
+		Context("when a credential with fields has been fetched", func() {
+			BeforeEach(func() {
+				fakeVariables.GetReturns(map[interface{}]interface{}{
+					"username": "some-user",
+					"password": "some-password",
+					"port":     8080,
+				}, true, nil)
+				fetch("some-creds")
+			})
+
+			It("redacts each field", func() {
+				Expect(buildVariables.Redact("some-user:some-password")).To(Equal("((redacted)):((redacted))"))
+			})
+		})
+
+		Context("when a short credential has been fetched", func() {
+			BeforeEach(func() {
+				fakeVariables.GetReturns("a", true, nil)
+				fetch("some-var")
+			})
+
+			It("does not redact it", func() {
+				Expect(buildVariables.Redact("a banana")).To(Equal("a banana"))
+			})
+		})
+
+		Context("when a local var has been fetched", func() {
+			BeforeEach(func() {
+				buildVariables.AddLocalVar("some-var", "local-value")
+				fetch("some-var")
+			})
+
+			It("does not redact it", func() {
+				Expect(buildVariables.Redact("local-value")).To(Equal("local-value"))
+			})
+		})
+
+		Context("when redaction is disabled", func() {
+			BeforeEach(func() {
+				buildVariables = creds.NewBuildVariables(fakeVariables, false)
+				fetch("some-var")
+			})
+
+			It("does not redact anything", func() {
+				Expect(buildVariables.Redact("parent-value")).To(Equal("parent-value"))
+			})
+		})
+	})
+
+	Describe("NewLocalScope", func() {
+		var scope *creds.BuildVariables
+
+		BeforeEach(func() {
+			buildVariables.AddLocalVar("build-var", "build-value")
+
+			scope = buildVariables.NewLocalScope()
+			scope.AddLocalVar("scoped-var", "scoped-value")
+		})
+
+		It("returns vars set in the scope", func() {
+			val, found, err := scope.Get(template.VariableDefinition{Name: "scoped-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("scoped-value"))
+		})
+
+		It("returns vars set for the build", func() {
+			val, found, err := scope.Get(template.VariableDefinition{Name: "build-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("build-value"))
+		})
+
+		It("does not set vars for the build", func() {
+			val, found, err := buildVariables.Get(template.VariableDefinition{Name: "scoped-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("parent-value"))
+		})
+
+		It("redacts credentials fetched in the scope from the whole build", func() {
+			_, _, err := scope.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(buildVariables.Redact("parent-value")).To(Equal("((redacted))"))
+			Expect(scope.Redact("parent-value")).To(Equal("((redacted))"))
+		})
+	})
+
+	Describe("RedactStream", func() {
+		BeforeEach(func() {
+			fakeVariables.GetReturns("some-password", true, nil)
+
+			_, _, err := buildVariables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("redacts the credential", func() {
+			redacted, pending := buildVariables.RedactStream("the password is some-password\n")
+			Expect(redacted).To(Equal("the password is ((redacted))\n"))
+			Expect(pending).To(BeEmpty())
+		})
+
+		It("holds back text which could be the start of the credential", func() {
+			redacted, pending := buildVariables.RedactStream("the password is some-pa")
+			Expect(redacted).To(Equal("the password is "))
+			Expect(pending).To(Equal("some-pa"))
+
+			redacted, pending = buildVariables.RedactStream(pending + "ssword\n")
+			Expect(redacted).To(Equal("((redacted))\n"))
+			Expect(pending).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,168 @@
+package creds
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+type SecretCacheConfig struct {
+	Enabled          bool          `long:"secret-cache-enabled" description:"Cache credentials looked up in the credential managers."`
+	Duration         time.Duration `long:"secret-cache-duration" default:"1m" description:"How long to cache a credential which was found."`
+	NotFoundDuration time.Duration `long:"secret-cache-duration-notfound" default:"10s" description:"How long to cache the absence of a credential which was not found."`
+	MaxSize          int           `long:"secret-cache-max-size" default:"10000" description:"Maximum number of credentials to cache. The least recently used are evicted first."`
+}
+
+// CacheCounter counts the hits or misses of a credential cache.
+type CacheCounter interface {
+	Inc()
+}
+
+type CachedVariablesFactory struct {
+	factory VariablesFactory
+	cache   *secretCache
+}
+
+type CachedVariables struct {
+	variables    Variables
+	cache        *secretCache
+	teamName     string
+	pipelineName string
+}
+
+// NewCachedVariablesFactory returns a VariablesFactory which caches the
+// results of looking up credentials in the given factory. Both found and not
+// found results are cached, but errors are not.
+func NewCachedVariablesFactory(factory VariablesFactory, config SecretCacheConfig, clock clock.Clock, hits CacheCounter, misses CacheCounter) VariablesFactory {
+	return &CachedVariablesFactory{
+		factory: factory,
+		cache: &secretCache{
+			config:  config,
+			clock:   clock,
+			hits:    hits,
+			misses:  misses,
+			entries: map[secretCacheKey]*list.Element{},
+			lru:     list.New(),
+		},
+	}
+}
+
+func (cvf CachedVariablesFactory) NewVariables(teamName string, pipelineName string) Variables {
+	return CachedVariables{
+		variables:    cvf.factory.NewVariables(teamName, pipelineName),
+		cache:        cvf.cache,
+		teamName:     teamName,
+		pipelineName: pipelineName,
+	}
+}
+
+func (cv CachedVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	key := secretCacheKey{
+		teamName:     cv.teamName,
+		pipelineName: cv.pipelineName,
+		name:         varDef.Name,
+	}
+
+	value, found, cached := cv.cache.get(key)
+	if cached {
+		return value, found, nil
+	}
+
+	value, found, err := cv.variables.Get(varDef)
+	if err != nil {
+		return nil, false, err
+	}
+
+	cv.cache.add(key, value, found)
+
+	return value, found, nil
+}
+
+func (cv CachedVariables) List() ([]template.VariableDefinition, error) {
+	return cv.variables.List()
+}
+
+type secretCacheKey struct {
+	teamName     string
+	pipelineName string
+	name         string
+}
+
+type secretCacheEntry struct {
+	key      secretCacheKey
+	value    interface{}
+	found    bool
+	deadline time.Time
+}
+
+// secretCache is a fixed size LRU cache of credentials, each of which expires
+// after a while.
+type secretCache struct {
+	config SecretCacheConfig
+	clock  clock.Clock
+
+	hits   CacheCounter
+	misses CacheCounter
+
+	lock    sync.Mutex
+	entries map[secretCacheKey]*list.Element
+	lru     *list.List
+}
+
+func (cache *secretCache) get(key secretCacheKey) (interface{}, bool, bool) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	elem, ok := cache.entries[key]
+	if ok {
+		entry := elem.Value.(*secretCacheEntry)
+		if cache.clock.Now().Before(entry.deadline) {
+			cache.lru.MoveToFront(elem)
+			cache.hits.Inc()
+			return entry.value, entry.found, true
+		}
+
+		cache.remove(elem)
+	}
+
+	cache.misses.Inc()
+
+	return nil, false, false
+}
+
+func (cache *secretCache) add(key secretCacheKey, value interface{}, found bool) {
+	ttl := cache.config.Duration
+	if !found {
+		ttl = cache.config.NotFoundDuration
+	}
+
+	if ttl <= 0 || cache.config.MaxSize <= 0 {
+		return
+	}
+
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	if elem, ok := cache.entries[key]; ok {
+		cache.remove(elem)
+	}
+
+	for cache.lru.Len() >= cache.config.MaxSize {
+		cache.remove(cache.lru.Back())
+	}
+
+	cache.entries[key] = cache.lru.PushFront(&secretCacheEntry{
+		key:      key,
+		value:    value,
+		found:    found,
+		deadline: cache.clock.Now().Add(ttl),
+	})
+}
+
+func (cache *secretCache) remove(elem *list.Element) {
+	cache.lru.Remove(elem)
+	delete(cache.entries, elem.Value.(*secretCacheEntry).key)
+}
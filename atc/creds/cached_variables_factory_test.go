@@ -0,0 +1,151 @@
+package creds_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/concourse/concourse/atc/metric"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CachedVariablesFactory", func() {
+	var (
+		fakeFactory   *credsfakes.FakeVariablesFactory
+		fakeVariables *credsfakes.FakeVariables
+		fakeClock     *fakeclock.FakeClock
+		hits          *metric.Meter
+		misses        *metric.Meter
+
+		config    creds.SecretCacheConfig
+		factory   creds.VariablesFactory
+		variables creds.Variables
+	)
+
+	BeforeEach(func() {
+		fakeVariables = new(credsfakes.FakeVariables)
+		fakeVariables.GetReturns("some-value", true, nil)
+
+		fakeFactory = new(credsfakes.FakeVariablesFactory)
+		fakeFactory.NewVariablesReturns(fakeVariables)
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+		hits = new(metric.Meter)
+		misses = new(metric.Meter)
+
+		config = creds.SecretCacheConfig{
+			Enabled:          true,
+			Duration:         time.Minute,
+			NotFoundDuration: 10 * time.Second,
+			MaxSize:          2,
+		}
+	})
+
+	JustBeforeEach(func() {
+		factory = creds.NewCachedVariablesFactory(fakeFactory, config, fakeClock, hits, misses)
+		variables = factory.NewVariables("some-team", "some-pipeline")
+	})
+
+	get := func(name string) (interface{}, bool) {
+		value, found, err := variables.Get(template.VariableDefinition{Name: name})
+		Expect(err).ToNot(HaveOccurred())
+		return value, found
+	}
+
+	It("looks up variables for the same team and pipeline", func() {
+		teamName, pipelineName := fakeFactory.NewVariablesArgsForCall(0)
+		Expect(teamName).To(Equal("some-team"))
+		Expect(pipelineName).To(Equal("some-pipeline"))
+	})
+
+	It("caches a credential which was found", func() {
+		value, found := get("some-var")
+		Expect(value).To(Equal("some-value"))
+		Expect(found).To(BeTrue())
+
+		fakeVariables.GetReturns("some-other-value", true, nil)
+
+		value, found = get("some-var")
+		Expect(value).To(Equal("some-value"))
+		Expect(found).To(BeTrue())
+
+		Expect(fakeVariables.GetCallCount()).To(Equal(1))
+		Expect(hits.Delta()).To(Equal(1))
+		Expect(misses.Delta()).To(Equal(1))
+	})
+
+	It("looks the credential up again once it expires", func() {
+		get("some-var")
+
+		fakeClock.Increment(time.Minute)
+		fakeVariables.GetReturns("some-other-value", true, nil)
+
+		value, _ := get("some-var")
+		Expect(value).To(Equal("some-other-value"))
+		Expect(fakeVariables.GetCallCount()).To(Equal(2))
+	})
+
+	It("caches a credential which was not found for the not found duration", func() {
+		fakeVariables.GetReturns(nil, false, nil)
+
+		_, found := get("some-var")
+		Expect(found).To(BeFalse())
+
+		fakeVariables.GetReturns("some-value", true, nil)
+
+		_, found = get("some-var")
+		Expect(found).To(BeFalse())
+		Expect(fakeVariables.GetCallCount()).To(Equal(1))
+
+		fakeClock.Increment(10 * time.Second)
+
+		_, found = get("some-var")
+		Expect(found).To(BeTrue())
+		Expect(fakeVariables.GetCallCount()).To(Equal(2))
+	})
+
+	It("does not cache errors", func() {
+		disaster := errors.New("nope")
+		fakeVariables.GetReturns(nil, false, disaster)
+
+		_, _, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+		Expect(err).To(Equal(disaster))
+
+		fakeVariables.GetReturns("some-value", true, nil)
+
+		value, _ := get("some-var")
+		Expect(value).To(Equal("some-value"))
+	})
+
+	It("evicts the least recently used credential once full", func() {
+		get("var-1")
+		get("var-2")
+		get("var-1")
+		get("var-3")
+		Expect(fakeVariables.GetCallCount()).To(Equal(3))
+
+		get("var-1")
+		get("var-3")
+		Expect(fakeVariables.GetCallCount()).To(Equal(3))
+
+		get("var-2")
+		Expect(fakeVariables.GetCallCount()).To(Equal(4))
+	})
+
+	Context("when another team looks up the same var", func() {
+		It("does not share the cached credential", func() {
+			get("some-var")
+
+			otherVariables := factory.NewVariables("other-team", "some-pipeline")
+
+			_, _, err := otherVariables.Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeVariables.GetCallCount()).To(Equal(2))
+		})
+	})
+})
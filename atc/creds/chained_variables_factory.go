@@ -0,0 +1,208 @@
+package creds
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/hashicorp/go-multierror"
+)
+
+// NamedVariablesFactory is a VariablesFactory for the credential manager of
+// the given name.
+type NamedVariablesFactory struct {
+	Name    string
+	Factory VariablesFactory
+}
+
+//go:generate counterfeiter . TeamCredentialManagers
+
+// TeamCredentialManagers looks up the names of the credential managers which
+// a team has chosen to use. If a team has not chosen any, every credential
+// manager in the chain is used.
+type TeamCredentialManagers interface {
+	CredentialManagers(teamName string) ([]string, error)
+}
+
+type ChainedVariablesFactory struct {
+	logger    lager.Logger
+	factories []NamedVariablesFactory
+	teams     TeamCredentialManagers
+}
+
+type ChainedVariables struct {
+	logger       lager.Logger
+	teamName     string
+	pipelineName string
+	chain        []namedVariables
+
+	// err is returned by every lookup if the team's credential managers could
+	// not be determined.
+	err error
+}
+
+type namedVariables struct {
+	name      string
+	variables Variables
+}
+
+// NewChainedVariablesFactory returns a VariablesFactory which looks up
+// credentials in each of the factories in turn, returning the first one
+// found.
+func NewChainedVariablesFactory(logger lager.Logger, factories []NamedVariablesFactory, teams TeamCredentialManagers) VariablesFactory {
+	return &ChainedVariablesFactory{
+		logger:    logger,
+		factories: factories,
+		teams:     teams,
+	}
+}
+
+// NewVariables determines the team's credential managers up front, so that
+// they are not looked up again for every credential.
+func (cvf ChainedVariablesFactory) NewVariables(teamName string, pipelineName string) Variables {
+	variables := ChainedVariables{
+		logger:       cvf.logger,
+		teamName:     teamName,
+		pipelineName: pipelineName,
+	}
+
+	chain, err := cvf.chain(teamName)
+	if err != nil {
+		variables.err = err
+		return variables
+	}
+
+	for _, link := range chain {
+		variables.chain = append(variables.chain, namedVariables{
+			name:      link.Name,
+			variables: link.Factory.NewVariables(teamName, pipelineName),
+		})
+	}
+
+	return variables
+}
+
+// Get returns the var from the first credential manager which has it. A
+// credential manager which fails is logged and skipped, so that one which is
+// unavailable does not prevent the var being found in another; its error is
+// only returned if no credential manager has the var.
+func (cv ChainedVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	if cv.err != nil {
+		return nil, false, cv.err
+	}
+
+	var errs error
+	for _, link := range cv.chain {
+		result, found, err := link.variables.Get(varDef)
+		if err != nil {
+			cv.logger.Error("failed-to-get-var", err, lager.Data{
+				"var":                varDef.Name,
+				"team":               cv.teamName,
+				"pipeline":           cv.pipelineName,
+				"credential-manager": link.name,
+			})
+
+			errs = multierror.Append(errs, fmt.Errorf("%s: %s", link.name, err))
+			continue
+		}
+
+		if found {
+			cv.logger.Debug("found-var", lager.Data{
+				"var":                varDef.Name,
+				"team":               cv.teamName,
+				"pipeline":           cv.pipelineName,
+				"credential-manager": link.name,
+			})
+
+			return result, true, nil
+		}
+	}
+
+	if errs != nil {
+		return nil, false, errs
+	}
+
+	return nil, false, nil
+}
+
+// List returns the vars of every credential manager. A credential manager
+// which fails is logged and skipped; an error is only returned if every one
+// fails.
+func (cv ChainedVariables) List() ([]template.VariableDefinition, error) {
+	if cv.err != nil {
+		return nil, cv.err
+	}
+
+	var errs error
+	failed := 0
+
+	seen := map[string]bool{}
+	varDefs := []template.VariableDefinition{}
+	for _, link := range cv.chain {
+		defs, err := link.variables.List()
+		if err != nil {
+			cv.logger.Error("failed-to-list-vars", err, lager.Data{
+				"team":               cv.teamName,
+				"pipeline":           cv.pipelineName,
+				"credential-manager": link.name,
+			})
+
+			errs = multierror.Append(errs, fmt.Errorf("%s: %s", link.name, err))
+			failed++
+			continue
+		}
+
+		for _, def := range defs {
+			if seen[def.Name] {
+				continue
+			}
+
+			seen[def.Name] = true
+			varDefs = append(varDefs, def)
+		}
+	}
+
+	if failed > 0 && failed == len(cv.chain) {
+		return nil, errs
+	}
+
+	return varDefs, nil
+}
+
+// chain returns the factories the team has chosen, in the order they were
+// configured.
+func (cvf ChainedVariablesFactory) chain(teamName string) ([]NamedVariablesFactory, error) {
+	if cvf.teams == nil || teamName == "" {
+		return cvf.factories, nil
+	}
+
+	names, err := cvf.teams.CredentialManagers(teamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return cvf.factories, nil
+	}
+
+	chosen := map[string]bool{}
+	for _, name := range names {
+		chosen[name] = false
+	}
+
+	chain := []NamedVariablesFactory{}
+	for _, link := range cvf.factories {
+		if _, ok := chosen[link.Name]; ok {
+			chosen[link.Name] = true
+			chain = append(chain, link)
+		}
+	}
+
+	for _, name := range names {
+		if !chosen[name] {
+			return nil, fmt.Errorf("credential manager '%s' chosen by team '%s' is not configured", name, teamName)
+		}
+	}
+
+	return chain, nil
+}
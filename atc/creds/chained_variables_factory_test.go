@@ -0,0 +1,214 @@
+package creds_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Chained Variables Factory", func() {
+	var (
+		fakeVaultVariables   *credsfakes.FakeVariables
+		fakeCredhubVariables *credsfakes.FakeVariables
+		fakeTeams            *credsfakes.FakeTeamCredentialManagers
+		logger               *lagertest.TestLogger
+
+		factory   creds.VariablesFactory
+		variables creds.Variables
+		varDef    template.VariableDefinition
+	)
+
+	namedFactory := func(name string, variables creds.Variables) creds.NamedVariablesFactory {
+		factory := new(credsfakes.FakeVariablesFactory)
+		factory.NewVariablesReturns(variables)
+		return creds.NamedVariablesFactory{Name: name, Factory: factory}
+	}
+
+	BeforeEach(func() {
+		fakeVaultVariables = new(credsfakes.FakeVariables)
+		fakeCredhubVariables = new(credsfakes.FakeVariables)
+		fakeTeams = new(credsfakes.FakeTeamCredentialManagers)
+		logger = lagertest.NewTestLogger("test")
+
+		factory = creds.NewChainedVariablesFactory(logger, []creds.NamedVariablesFactory{
+			namedFactory("vault", fakeVaultVariables),
+			namedFactory("credhub", fakeCredhubVariables),
+		}, fakeTeams)
+
+		varDef = template.VariableDefinition{Name: "some-var"}
+	})
+
+	JustBeforeEach(func() {
+		variables = factory.NewVariables("some-team", "some-pipeline")
+	})
+
+	Describe("Get", func() {
+		Context("when the first manager has the var", func() {
+			BeforeEach(func() {
+				fakeVaultVariables.GetReturns("from-vault", true, nil)
+				fakeCredhubVariables.GetReturns("from-credhub", true, nil)
+			})
+
+			It("returns its value", func() {
+				value, found, err := variables.Get(varDef)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(value).To(Equal("from-vault"))
+
+				Expect(fakeCredhubVariables.GetCallCount()).To(BeZero())
+			})
+
+			It("logs which manager satisfied the var", func() {
+				_, _, err := variables.Get(varDef)
+				Expect(err).ToNot(HaveOccurred())
+
+				logs := logger.Logs()
+				Expect(logs).To(HaveLen(1))
+				Expect(logs[0].Message).To(Equal("test.found-var"))
+				Expect(logs[0].Data).To(HaveKeyWithValue("var", "some-var"))
+				Expect(logs[0].Data).To(HaveKeyWithValue("credential-manager", "vault"))
+			})
+		})
+
+		Context("when only a later manager has the var", func() {
+			BeforeEach(func() {
+				fakeCredhubVariables.GetReturns("from-credhub", true, nil)
+			})
+
+			It("falls back to it", func() {
+				value, found, err := variables.Get(varDef)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(value).To(Equal("from-credhub"))
+			})
+		})
+
+		Context("when no manager has the var", func() {
+			It("returns not found", func() {
+				_, found, err := variables.Get(varDef)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when a manager fails", func() {
+			BeforeEach(func() {
+				fakeVaultVariables.GetReturns(nil, false, errors.New("nope"))
+				fakeCredhubVariables.GetReturns("from-credhub", true, nil)
+			})
+
+			It("falls back to the next manager", func() {
+				value, found, err := variables.Get(varDef)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(value).To(Equal("from-credhub"))
+			})
+
+			It("logs the failure", func() {
+				_, _, err := variables.Get(varDef)
+				Expect(err).ToNot(HaveOccurred())
+
+				logs := logger.Logs()
+				Expect(logs[0].Message).To(Equal("test.failed-to-get-var"))
+				Expect(logs[0].Data).To(HaveKeyWithValue("credential-manager", "vault"))
+			})
+
+			Context("when no other manager has the var", func() {
+				BeforeEach(func() {
+					fakeCredhubVariables.GetReturns(nil, false, nil)
+				})
+
+				It("returns the error", func() {
+					_, _, err := variables.Get(varDef)
+					Expect(err).To(MatchError(ContainSubstring("vault: nope")))
+				})
+			})
+		})
+
+		Context("when the team has chosen its managers", func() {
+			BeforeEach(func() {
+				fakeTeams.CredentialManagersReturns([]string{"credhub"}, nil)
+				fakeVaultVariables.GetReturns("from-vault", true, nil)
+			})
+
+			It("only looks up the var in them", func() {
+				_, found, err := variables.Get(varDef)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+
+				Expect(fakeTeams.CredentialManagersArgsForCall(0)).To(Equal("some-team"))
+				Expect(fakeVaultVariables.GetCallCount()).To(BeZero())
+				Expect(fakeCredhubVariables.GetCallCount()).To(Equal(1))
+			})
+
+			It("only looks them up once", func() {
+				_, _, err := variables.Get(varDef)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = variables.Get(varDef)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = variables.List()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeTeams.CredentialManagersCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the team has chosen a manager which is not configured", func() {
+			BeforeEach(func() {
+				fakeTeams.CredentialManagersReturns([]string{"bogus"}, nil)
+			})
+
+			It("returns an error", func() {
+				_, _, err := variables.Get(varDef)
+				Expect(err).To(MatchError("credential manager 'bogus' chosen by team 'some-team' is not configured"))
+			})
+		})
+	})
+
+	Describe("List", func() {
+		BeforeEach(func() {
+			fakeVaultVariables.ListReturns([]template.VariableDefinition{{Name: "a"}, {Name: "b"}}, nil)
+			fakeCredhubVariables.ListReturns([]template.VariableDefinition{{Name: "b"}, {Name: "c"}}, nil)
+		})
+
+		It("returns the vars from every manager", func() {
+			Expect(variables.List()).To(Equal([]template.VariableDefinition{
+				{Name: "a"},
+				{Name: "b"},
+				{Name: "c"},
+			}))
+		})
+
+		Context("when a manager fails", func() {
+			BeforeEach(func() {
+				fakeVaultVariables.ListReturns(nil, errors.New("nope"))
+			})
+
+			It("returns the vars from the other managers", func() {
+				Expect(variables.List()).To(Equal([]template.VariableDefinition{
+					{Name: "b"},
+					{Name: "c"},
+				}))
+			})
+
+			Context("when every manager fails", func() {
+				BeforeEach(func() {
+					fakeCredhubVariables.ListReturns(nil, errors.New("also nope"))
+				})
+
+				It("returns the errors", func() {
+					_, err := variables.List()
+					Expect(err).To(MatchError(ContainSubstring("vault: nope")))
+					Expect(err).To(MatchError(ContainSubstring("credhub: also nope")))
+				})
+			})
+		})
+	})
+})
@@ -2,6 +2,9 @@ package creds
 
 import (
 	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	"gopkg.in/yaml.v2"
@@ -13,6 +16,11 @@ func evaluate(variablesResolver Variables, in, out interface{}) error {
 		return err
 	}
 
+	byteParams, err = evaluateSourcedVars(variablesResolver, byteParams)
+	if err != nil {
+		return err
+	}
+
 	tpl := template.NewTemplate(byteParams)
 
 	bytes, err := tpl.Evaluate(variablesResolver, nil, template.EvaluateOpts{
@@ -24,3 +32,180 @@ func evaluate(variablesResolver Variables, in, out interface{}) error {
 
 	return yaml.Unmarshal(bytes, out)
 }
+
+// sourcedVarRegex matches vars looked up in a pipeline's var source, e.g.
+// ((some-source:some/path.some-field)). The template package does not
+// recognize them, so they are interpolated before it runs.
+var sourcedVarRegex = regexp.MustCompile(`\(\(([-\w\pL]+):([-/\w\pL]+)((?:\.[-\w\pL]+)*)\)\)`)
+
+func evaluateSourcedVars(variablesResolver Variables, payload []byte) ([]byte, error) {
+	if !sourcedVarRegex.Match(payload) {
+		return payload, nil
+	}
+
+	var node interface{}
+	err := json.Unmarshal(payload, &node)
+	if err != nil {
+		return nil, err
+	}
+
+	evaluator := &sourcedVarsEvaluator{variables: variablesResolver}
+
+	node, err = evaluator.evaluate(node)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(evaluator.missing) != 0 {
+		return nil, fmt.Errorf("Expected to find variables: %s", strings.Join(evaluator.missing, "\n"))
+	}
+
+	return json.Marshal(node)
+}
+
+type sourcedVarsEvaluator struct {
+	variables Variables
+	missing   []string
+}
+
+func (evaluator *sourcedVarsEvaluator) evaluate(node interface{}) (interface{}, error) {
+	switch typed := node.(type) {
+	case map[string]interface{}:
+		for key, value := range typed {
+			evaluated, err := evaluator.evaluate(value)
+			if err != nil {
+				return nil, err
+			}
+
+			typed[key] = evaluated
+		}
+
+		return typed, nil
+
+	case []interface{}:
+		for i, value := range typed {
+			evaluated, err := evaluator.evaluate(value)
+			if err != nil {
+				return nil, err
+			}
+
+			typed[i] = evaluated
+		}
+
+		return typed, nil
+
+	case string:
+		return evaluator.evaluateString(typed)
+	}
+
+	return node, nil
+}
+
+func (evaluator *sourcedVarsEvaluator) evaluateString(str string) (interface{}, error) {
+	matches := sourcedVarRegex.FindAllStringSubmatchIndex(str, -1)
+	if len(matches) == 0 {
+		return str, nil
+	}
+
+	// a var which is the whole string may be of any type
+	if len(matches) == 1 && matches[0][0] == 0 && matches[0][1] == len(str) {
+		value, _, err := evaluator.lookup(str, matches[0])
+		return value, err
+	}
+
+	var evaluated strings.Builder
+
+	last := 0
+	for _, match := range matches {
+		evaluated.WriteString(str[last:match[0]])
+
+		value, found, err := evaluator.lookup(str, match)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			evaluated.WriteString(str[match[0]:match[1]])
+		} else {
+			switch value.(type) {
+			case string, bool, int, int64, float64, json.Number:
+				fmt.Fprint(&evaluated, value)
+			default:
+				return nil, fmt.Errorf("var '%s' is not a string, number or boolean, so it cannot be interpolated into a string", str[match[2]:match[1]-2])
+			}
+		}
+
+		last = match[1]
+	}
+
+	evaluated.WriteString(str[last:])
+
+	return evaluated.String(), nil
+}
+
+func (evaluator *sourcedVarsEvaluator) lookup(str string, match []int) (interface{}, bool, error) {
+	sourceName := str[match[2]:match[3]]
+	path := str[match[4]:match[5]]
+
+	name := sourceName + ":" + path
+
+	value, found, err := evaluator.variables.Get(template.VariableDefinition{Name: name})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		evaluator.missing = append(evaluator.missing, str[match[2]:match[1]-2])
+		return nil, false, nil
+	}
+
+	if match[6] != match[7] {
+		for _, field := range strings.Split(str[match[6]+1:match[7]], ".") {
+			var fieldFound bool
+			switch fields := value.(type) {
+			case map[string]interface{}:
+				value, fieldFound = fields[field]
+			case map[interface{}]interface{}:
+				value, fieldFound = fields[field]
+			}
+
+			if !fieldFound {
+				return nil, false, fmt.Errorf("var '%s' has no field '%s'", name, field)
+			}
+		}
+	}
+
+	return jsonable(value), true, nil
+}
+
+// jsonable converts the maps returned by credential managers, which may be
+// keyed by interface{}, into maps which can be marshaled as JSON.
+func jsonable(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		converted := map[string]interface{}{}
+		for key, val := range typed {
+			converted[fmt.Sprint(key)] = jsonable(val)
+		}
+
+		return converted
+
+	case map[string]interface{}:
+		converted := map[string]interface{}{}
+		for key, val := range typed {
+			converted[key] = jsonable(val)
+		}
+
+		return converted
+
+	case []interface{}:
+		converted := make([]interface{}, len(typed))
+		for i, val := range typed {
+			converted[i] = jsonable(val)
+		}
+
+		return converted
+	}
+
+	return value
+}
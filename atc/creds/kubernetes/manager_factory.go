@@ -27,3 +27,7 @@ func (factory *kubernetesManagerFactory) AddConfig(group *flags.Group) creds.Man
 
 	return manager
 }
+
+func (factory *kubernetesManagerFactory) NewInstance(map[string]interface{}) (creds.Manager, error) {
+	return nil, creds.UnsupportedVarSourceError{Type: "kubernetes"}
+}
@@ -1,10 +1,17 @@
 package creds
 
 import (
+	"fmt"
+	"reflect"
+	"time"
+
 	"code.cloudfoundry.org/lager"
 	flags "github.com/jessevdk/go-flags"
+	"github.com/mitchellh/mapstructure"
 )
 
+//go:generate counterfeiter . Manager
+
 type Manager interface {
 	IsConfigured() bool
 	Validate() error
@@ -14,14 +21,35 @@ type Manager interface {
 	NewVariablesFactory(lager.Logger) (VariablesFactory, error)
 }
 
+//go:generate counterfeiter . ManagerFactory
+
 type ManagerFactory interface {
 	AddConfig(*flags.Group) Manager
+
+	// NewInstance returns a Manager configured by a pipeline's var source
+	// rather than by flags.
+	NewInstance(config map[string]interface{}) (Manager, error)
 }
 
 type Managers map[string]Manager
 
+// UnsupportedVarSourceError is returned by a ManagerFactory whose credential
+// manager cannot be configured by a pipeline's var source.
+type UnsupportedVarSourceError struct {
+	Type string
+}
+
+func (err UnsupportedVarSourceError) Error() string {
+	return fmt.Sprintf("credential manager '%s' cannot be used as a var source", err.Type)
+}
+
 type CredentialManagementConfig struct {
 	RetryConfig SecretRetryConfig
+	CacheConfig SecretCacheConfig
+
+	Chain []string `long:"credential-manager" description:"Name of a configured credential manager to look up credentials in. Can be specified multiple times to look up credentials in each manager in turn. Required if more than one is configured."`
+
+	VarSourceIdleTimeout time.Duration `long:"var-source-idle-timeout" default:"10m" description:"How long a credential manager configured by a pipeline's var source is kept after it was last used."`
 }
 
 type HealthResponse struct {
@@ -39,3 +67,39 @@ func Register(name string, managerFactory ManagerFactory) {
 func ManagerFactories() map[string]ManagerFactory {
 	return managerFactories
 }
+
+// DecodeVarSourceConfig decodes a var source's config into a Manager using the
+// fields' mapstructure tags. Unknown keys are rejected.
+func DecodeVarSourceConfig(config map[string]interface{}, manager Manager) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           manager,
+		ErrorUnused:      true,
+		WeaklyTypedInput: true,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeDurationHookFunc(),
+			durationFromSecondsHook,
+		),
+	})
+	if err != nil {
+		return err
+	}
+
+	return decoder.Decode(config)
+}
+
+// durationFromSecondsHook allows durations to be given as a number of
+// seconds, as JSON has no notion of a duration.
+func durationFromSecondsHook(from reflect.Type, to reflect.Type, data interface{}) (interface{}, error) {
+	if to != reflect.TypeOf(time.Duration(0)) {
+		return data, nil
+	}
+
+	switch seconds := data.(type) {
+	case float64:
+		return time.Duration(seconds * float64(time.Second)), nil
+	case int:
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	return data, nil
+}
@@ -27,3 +27,17 @@ func (factory *managerFactory) AddConfig(group *flags.Group) creds.Manager {
 	subGroup.Namespace = "aws-secretsmanager"
 	return manager
 }
+
+func (factory *managerFactory) NewInstance(config map[string]interface{}) (creds.Manager, error) {
+	manager := &Manager{
+		PipelineSecretTemplate: DefaultPipelineSecretTemplate,
+		TeamSecretTemplate:     DefaultTeamSecretTemplate,
+	}
+
+	err := creds.DecodeVarSourceConfig(config, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
@@ -1,6 +1,12 @@
 package creds
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/mitchellh/mapstructure"
 )
@@ -46,3 +52,30 @@ func (s Source) Evaluate() (atc.Source, error) {
 
 	return source, nil
 }
+
+// Identity returns the source as configured, before its vars are
+// interpolated. Resource configs are identified by it so that rotating the
+// credentials the source refers to keeps the same version history.
+func (s Source) Identity() atc.Source {
+	return s.rawSource
+}
+
+var varRegex = regexp.MustCompile(`\(\([^()\s]+\)\)`)
+
+// HasVars returns true if the source refers to any vars.
+func HasVars(source atc.Source) bool {
+	payload, _ := json.Marshal(source)
+	return varRegex.Match(payload)
+}
+
+// Fingerprint returns a digest of an interpolated source, which changes
+// whenever a credential the source refers to is rotated. The digest is keyed
+// so that the credentials cannot be guessed from it without the key.
+func Fingerprint(key []byte, source atc.Source) string {
+	payload, _ := json.Marshal(source)
+
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(payload)
+
+	return fmt.Sprintf("%x", mac.Sum(nil))
+}
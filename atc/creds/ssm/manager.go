@@ -21,13 +21,13 @@ const DefaultPipelineSecretTemplate = "/concourse/{{.Team}}/{{.Pipeline}}/{{.Sec
 const DefaultTeamSecretTemplate = "/concourse/{{.Team}}/{{.Secret}}"
 
 type SsmManager struct {
-	AwsAccessKeyID         string `long:"access-key" description:"AWS Access key ID"`
-	AwsSecretAccessKey     string `long:"secret-key" description:"AWS Secret Access Key"`
-	AwsSessionToken        string `long:"session-token" description:"AWS Session Token"`
-	AwsRegion              string `long:"region" description:"AWS region to send requests to"`
-	PipelineSecretTemplate string `long:"pipeline-secret-template" description:"AWS SSM parameter name template used for pipeline specific parameter" default:"/concourse/{{.Team}}/{{.Pipeline}}/{{.Secret}}"`
-	TeamSecretTemplate     string `long:"team-secret-template" description:"AWS SSM parameter name template used for team specific parameter" default:"/concourse/{{.Team}}/{{.Secret}}"`
-	Ssm                    *Ssm
+	AwsAccessKeyID         string `long:"access-key" description:"AWS Access key ID" mapstructure:"access_key"`
+	AwsSecretAccessKey     string `long:"secret-key" description:"AWS Secret Access Key" mapstructure:"secret_key"`
+	AwsSessionToken        string `long:"session-token" description:"AWS Session Token" mapstructure:"session_token"`
+	AwsRegion              string `long:"region" description:"AWS region to send requests to" mapstructure:"region"`
+	PipelineSecretTemplate string `long:"pipeline-secret-template" description:"AWS SSM parameter name template used for pipeline specific parameter" default:"/concourse/{{.Team}}/{{.Pipeline}}/{{.Secret}}" mapstructure:"pipeline_secret_template"`
+	TeamSecretTemplate     string `long:"team-secret-template" description:"AWS SSM parameter name template used for team specific parameter" default:"/concourse/{{.Team}}/{{.Secret}}" mapstructure:"team_secret_template"`
+	Ssm                    *Ssm   `mapstructure:"-"`
 }
 
 type SsmSecret struct {
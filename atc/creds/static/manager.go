@@ -0,0 +1,36 @@
+package static
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+// Manager provides vars declared inline in a pipeline's var source. It is
+// not configurable by flags.
+type Manager struct {
+	Vars map[string]interface{} `mapstructure:"vars"`
+}
+
+func (manager *Manager) IsConfigured() bool {
+	return len(manager.Vars) != 0
+}
+
+func (manager *Manager) Validate() error {
+	return nil
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	return &creds.HealthResponse{
+		Response: map[string]string{
+			"status": "UP",
+		},
+	}, nil
+}
+
+func (manager *Manager) Init(lager.Logger) error {
+	return nil
+}
+
+func (manager *Manager) NewVariablesFactory(lager.Logger) (creds.VariablesFactory, error) {
+	return Static(manager.Vars), nil
+}
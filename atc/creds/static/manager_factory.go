@@ -0,0 +1,31 @@
+package static
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type staticManagerFactory struct{}
+
+func init() {
+	creds.Register("static", NewStaticManagerFactory())
+}
+
+func NewStaticManagerFactory() creds.ManagerFactory {
+	return &staticManagerFactory{}
+}
+
+func (factory *staticManagerFactory) AddConfig(*flags.Group) creds.Manager {
+	return &Manager{}
+}
+
+func (factory *staticManagerFactory) NewInstance(config map[string]interface{}) (creds.Manager, error) {
+	manager := &Manager{}
+
+	err := creds.DecodeVarSourceConfig(config, manager)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager, nil
+}
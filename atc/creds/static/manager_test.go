@@ -0,0 +1,44 @@
+package static_test
+
+import (
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds/static"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manager", func() {
+	Describe("NewInstance", func() {
+		It("provides the configured vars", func() {
+			manager, err := static.NewStaticManagerFactory().NewInstance(map[string]interface{}{
+				"vars": map[string]interface{}{
+					"some-var": "some-value",
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manager.IsConfigured()).To(BeTrue())
+
+			factory, err := manager.NewVariablesFactory(lagertest.NewTestLogger("test"))
+			Expect(err).ToNot(HaveOccurred())
+
+			value, found, err := factory.NewVariables("some-team", "some-pipeline").Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("some-value"))
+
+			_, found, err = factory.NewVariables("some-team", "some-pipeline").Get(template.VariableDefinition{Name: "bogus"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when the config has unknown keys", func() {
+			It("returns an error", func() {
+				_, err := static.NewStaticManagerFactory().NewInstance(map[string]interface{}{
+					"bogus": "value",
+				})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -0,0 +1,27 @@
+package static
+
+import (
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+// Static looks up vars in a fixed set of values.
+type Static map[string]interface{}
+
+func (s Static) NewVariables(string, string) creds.Variables {
+	return s
+}
+
+func (s Static) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	val, found := s[varDef.Name]
+	return val, found, nil
+}
+
+func (s Static) List() ([]template.VariableDefinition, error) {
+	defs := []template.VariableDefinition{}
+	for name := range s {
+		defs = append(defs, template.VariableDefinition{Name: name})
+	}
+
+	return defs, nil
+}
@@ -0,0 +1,124 @@
+package creds
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . VarSourcePool
+
+// VarSourcePool hands out a VariablesFactory for each var source config,
+// initializing its credential manager the first time the config is seen.
+//
+// A factory which has not been used for the idle timeout, e.g. because the
+// config was changed to rotate a credential, is evicted from the pool and
+// closed if it is an io.Closer.
+type VarSourcePool interface {
+	FindOrCreate(lager.Logger, atc.VarSourceConfig) (VariablesFactory, error)
+}
+
+type varSourcePool struct {
+	managerFactories map[string]ManagerFactory
+	idleTimeout      time.Duration
+	clock            clock.Clock
+
+	factoriesL sync.Mutex
+	factories  map[string]*pooledFactory
+}
+
+type pooledFactory struct {
+	factory  VariablesFactory
+	lastUsed time.Time
+}
+
+func NewVarSourcePool(managerFactories map[string]ManagerFactory, idleTimeout time.Duration, clock clock.Clock) VarSourcePool {
+	return &varSourcePool{
+		managerFactories: managerFactories,
+		idleTimeout:      idleTimeout,
+		clock:            clock,
+		factories:        map[string]*pooledFactory{},
+	}
+}
+
+func (pool *varSourcePool) FindOrCreate(logger lager.Logger, source atc.VarSourceConfig) (VariablesFactory, error) {
+	configPayload, err := json.Marshal(source.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	key := source.Type + ":" + string(configPayload)
+
+	pool.factoriesL.Lock()
+	defer pool.factoriesL.Unlock()
+
+	pool.evictIdle(logger)
+
+	pooled, found := pool.factories[key]
+	if found {
+		pooled.lastUsed = pool.clock.Now()
+		return pooled.factory, nil
+	}
+
+	managerFactory, found := pool.managerFactories[source.Type]
+	if !found {
+		return nil, fmt.Errorf("var source '%s' has unknown type '%s'", source.Name, source.Type)
+	}
+
+	manager, err := managerFactory.NewInstance(source.Config)
+	if err != nil {
+		return nil, fmt.Errorf("var source '%s' misconfigured: %s", source.Name, err)
+	}
+
+	err = manager.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("var source '%s' misconfigured: %s", source.Name, err)
+	}
+
+	sourceLogger := logger.Session("var-source", lager.Data{
+		"name": source.Name,
+		"type": source.Type,
+	})
+
+	err = manager.Init(sourceLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	factory, err := manager.NewVariablesFactory(sourceLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	pool.factories[key] = &pooledFactory{
+		factory:  factory,
+		lastUsed: pool.clock.Now(),
+	}
+
+	return factory, nil
+}
+
+// evictIdle removes and closes the factories which have not been used for the
+// idle timeout. It must be called with the lock held.
+func (pool *varSourcePool) evictIdle(logger lager.Logger) {
+	for key, pooled := range pool.factories {
+		if pool.clock.Since(pooled.lastUsed) < pool.idleTimeout {
+			continue
+		}
+
+		delete(pool.factories, key)
+
+		if closer, ok := pooled.factory.(io.Closer); ok {
+			err := closer.Close()
+			if err != nil {
+				logger.Error("failed-to-close-var-source", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package creds_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	_ "github.com/concourse/concourse/atc/creds/static"
+)
+
+var _ = Describe("VarSourcePool", func() {
+	var (
+		pool      creds.VarSourcePool
+		fakeClock *fakeclock.FakeClock
+		logger    *lagertest.TestLogger
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		pool = creds.NewVarSourcePool(creds.ManagerFactories(), time.Minute, fakeClock)
+		logger = lagertest.NewTestLogger("test")
+	})
+
+	staticSource := func(value string) atc.VarSourceConfig {
+		return atc.VarSourceConfig{
+			Name: "some-source",
+			Type: "static",
+			Config: map[string]interface{}{
+				"vars": map[string]interface{}{"some-var": value},
+			},
+		}
+	}
+
+	It("creates a factory for the var source", func() {
+		factory, err := pool.FindOrCreate(logger, staticSource("some-value"))
+		Expect(err).ToNot(HaveOccurred())
+
+		value, found, err := factory.NewVariables("some-team", "some-pipeline").Get(template.VariableDefinition{Name: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("some-value"))
+	})
+
+	It("reuses the factory for the same config", func() {
+		factory, err := pool.FindOrCreate(logger, staticSource("some-value"))
+		Expect(err).ToNot(HaveOccurred())
+
+		sameFactory, err := pool.FindOrCreate(logger, staticSource("some-value"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sameFactory).To(Equal(factory))
+
+		otherFactory, err := pool.FindOrCreate(logger, staticSource("other-value"))
+		Expect(err).ToNot(HaveOccurred())
+
+		value, _, err := otherFactory.NewVariables("some-team", "some-pipeline").Get(template.VariableDefinition{Name: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal("other-value"))
+	})
+
+	Describe("evicting idle factories", func() {
+		var (
+			fakeManagerFactory *credsfakes.FakeManagerFactory
+			fakeFactory        *closingVariablesFactory
+			source             atc.VarSourceConfig
+		)
+
+		BeforeEach(func() {
+			fakeFactory = &closingVariablesFactory{FakeVariablesFactory: new(credsfakes.FakeVariablesFactory)}
+
+			fakeManager := new(credsfakes.FakeManager)
+			fakeManager.NewVariablesFactoryReturns(fakeFactory, nil)
+
+			fakeManagerFactory = new(credsfakes.FakeManagerFactory)
+			fakeManagerFactory.NewInstanceReturns(fakeManager, nil)
+
+			pool = creds.NewVarSourcePool(map[string]creds.ManagerFactory{
+				"closing": fakeManagerFactory,
+			}, time.Minute, fakeClock)
+
+			source = atc.VarSourceConfig{Name: "some-source", Type: "closing"}
+
+			_, err := pool.FindOrCreate(logger, source)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("keeps factories which are still being used", func() {
+			fakeClock.Increment(59 * time.Second)
+
+			_, err := pool.FindOrCreate(logger, source)
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeClock.Increment(59 * time.Second)
+
+			_, err = pool.FindOrCreate(logger, source)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeFactory.closed).To(BeFalse())
+			Expect(fakeManagerFactory.NewInstanceCallCount()).To(Equal(1))
+		})
+
+		It("closes factories which have not been used for the idle timeout", func() {
+			fakeClock.Increment(time.Minute)
+
+			_, err := pool.FindOrCreate(logger, atc.VarSourceConfig{Name: "other-source", Type: "closing", Config: map[string]interface{}{"rotated": true}})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeFactory.closed).To(BeTrue())
+		})
+
+		It("creates the factory again if it is used after being evicted", func() {
+			fakeClock.Increment(time.Minute)
+
+			_, err := pool.FindOrCreate(logger, source)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeFactory.closed).To(BeTrue())
+			Expect(fakeManagerFactory.NewInstanceCallCount()).To(Equal(2))
+		})
+	})
+
+	Context("when the type is unknown", func() {
+		It("returns an error", func() {
+			_, err := pool.FindOrCreate(logger, atc.VarSourceConfig{Name: "some-source", Type: "bogus"})
+			Expect(err).To(MatchError("var source 'some-source' has unknown type 'bogus'"))
+		})
+	})
+})
+
+type closingVariablesFactory struct {
+	*credsfakes.FakeVariablesFactory
+
+	closed bool
+}
+
+func (factory *closingVariablesFactory) Close() error {
+	factory.closed = true
+	return nil
+}
@@ -0,0 +1,130 @@
+package creds
+
+import (
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . PipelineVarSources
+
+// PipelineVarSources looks up the var sources declared by a pipeline.
+type PipelineVarSources interface {
+	VarSources(teamName string, pipelineName string) (atc.VarSourceConfigs, error)
+}
+
+type VarSourcedVariablesFactory struct {
+	logger    lager.Logger
+	factory   VariablesFactory
+	pool      VarSourcePool
+	pipelines PipelineVarSources
+}
+
+type VarSourcedVariables struct {
+	logger       lager.Logger
+	variables    Variables
+	pool         VarSourcePool
+	teamName     string
+	pipelineName string
+	sources      *pipelineSources
+}
+
+// pipelineSources looks up a pipeline's var sources the first time they are
+// needed, and remembers them for any later vars.
+type pipelineSources struct {
+	pipelines    PipelineVarSources
+	teamName     string
+	pipelineName string
+
+	once    sync.Once
+	sources atc.VarSourceConfigs
+	err     error
+}
+
+func (ps *pipelineSources) get() (atc.VarSourceConfigs, error) {
+	ps.once.Do(func() {
+		if ps.pipelineName == "" {
+			return
+		}
+
+		ps.sources, ps.err = ps.pipelines.VarSources(ps.teamName, ps.pipelineName)
+	})
+
+	return ps.sources, ps.err
+}
+
+// NewVarSourcedVariablesFactory returns a VariablesFactory which looks up
+// vars named 'source:name' in the pipeline's var source of the given name,
+// if the pipeline declares one, and any other vars in the given factory.
+func NewVarSourcedVariablesFactory(logger lager.Logger, factory VariablesFactory, pool VarSourcePool, pipelines PipelineVarSources) VariablesFactory {
+	return &VarSourcedVariablesFactory{
+		logger:    logger,
+		factory:   factory,
+		pool:      pool,
+		pipelines: pipelines,
+	}
+}
+
+func (vsf VarSourcedVariablesFactory) NewVariables(teamName string, pipelineName string) Variables {
+	return VarSourcedVariables{
+		logger:       vsf.logger,
+		variables:    vsf.factory.NewVariables(teamName, pipelineName),
+		pool:         vsf.pool,
+		teamName:     teamName,
+		pipelineName: pipelineName,
+		sources: &pipelineSources{
+			pipelines:    vsf.pipelines,
+			teamName:     teamName,
+			pipelineName: pipelineName,
+		},
+	}
+}
+
+func (vs VarSourcedVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	segments := strings.SplitN(varDef.Name, ":", 2)
+	if len(segments) != 2 {
+		return vs.variables.Get(varDef)
+	}
+
+	sourceName, name := segments[0], segments[1]
+
+	sources, err := vs.sources.get()
+	if err != nil {
+		return nil, false, err
+	}
+
+	// vars may contain ':' without naming a var source, e.g. a credential
+	// manager path
+	source, found := sources.Lookup(sourceName)
+	if !found {
+		return vs.variables.Get(varDef)
+	}
+
+	factory, err := vs.pool.FindOrCreate(vs.logger, source)
+	if err != nil {
+		return nil, false, err
+	}
+
+	result, found, err := factory.NewVariables(vs.teamName, vs.pipelineName).Get(template.VariableDefinition{Name: name})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if found {
+		vs.logger.Debug("found-var", lager.Data{
+			"var":        name,
+			"team":       vs.teamName,
+			"pipeline":   vs.pipelineName,
+			"var-source": sourceName,
+		})
+	}
+
+	return result, found, nil
+}
+
+func (vs VarSourcedVariables) List() ([]template.VariableDefinition, error) {
+	return vs.variables.List()
+}
@@ -0,0 +1,126 @@
+package creds_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Var Sourced Variables Factory", func() {
+	var (
+		fakeVariables       *credsfakes.FakeVariables
+		fakeSourceVariables *credsfakes.FakeVariables
+		fakePool            *credsfakes.FakeVarSourcePool
+		fakePipelines       *credsfakes.FakePipelineVarSources
+
+		variables creds.Variables
+	)
+
+	BeforeEach(func() {
+		fakeVariables = new(credsfakes.FakeVariables)
+		factory := new(credsfakes.FakeVariablesFactory)
+		factory.NewVariablesReturns(fakeVariables)
+
+		fakeSourceVariables = new(credsfakes.FakeVariables)
+		sourceFactory := new(credsfakes.FakeVariablesFactory)
+		sourceFactory.NewVariablesReturns(fakeSourceVariables)
+
+		fakePool = new(credsfakes.FakeVarSourcePool)
+		fakePool.FindOrCreateReturns(sourceFactory, nil)
+
+		fakePipelines = new(credsfakes.FakePipelineVarSources)
+		fakePipelines.VarSourcesReturns(atc.VarSourceConfigs{
+			{Name: "some-source", Type: "static"},
+		}, nil)
+
+		variables = creds.NewVarSourcedVariablesFactory(
+			lagertest.NewTestLogger("test"),
+			factory,
+			fakePool,
+			fakePipelines,
+		).NewVariables("some-team", "some-pipeline")
+	})
+
+	Describe("Get", func() {
+		Context("when the var does not name a var source", func() {
+			BeforeEach(func() {
+				fakeVariables.GetReturns("some-value", true, nil)
+			})
+
+			It("looks it up in the credential managers", func() {
+				value, found, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(value).To(Equal("some-value"))
+
+				Expect(fakePool.FindOrCreateCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the var names a var source", func() {
+			BeforeEach(func() {
+				fakeSourceVariables.GetReturns("some-sourced-value", true, nil)
+			})
+
+			It("looks it up in the var source", func() {
+				value, found, err := variables.Get(template.VariableDefinition{Name: "some-source:some-var"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(value).To(Equal("some-sourced-value"))
+
+				team, pipeline := fakePipelines.VarSourcesArgsForCall(0)
+				Expect(team).To(Equal("some-team"))
+				Expect(pipeline).To(Equal("some-pipeline"))
+
+				_, source := fakePool.FindOrCreateArgsForCall(0)
+				Expect(source).To(Equal(atc.VarSourceConfig{Name: "some-source", Type: "static"}))
+
+				Expect(fakeSourceVariables.GetArgsForCall(0)).To(Equal(template.VariableDefinition{Name: "some-var"}))
+				Expect(fakeVariables.GetCallCount()).To(BeZero())
+			})
+
+			It("only looks up the pipeline's var sources once", func() {
+				_, _, err := variables.Get(template.VariableDefinition{Name: "some-source:some-var"})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = variables.Get(template.VariableDefinition{Name: "some-source:some-other-var"})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakePipelines.VarSourcesCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the var names a var source which is not declared by the pipeline", func() {
+			BeforeEach(func() {
+				fakeVariables.GetReturns("some-value", true, nil)
+			})
+
+			It("looks up the whole name in the credential managers", func() {
+				value, found, err := variables.Get(template.VariableDefinition{Name: "bogus:some-var"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(value).To(Equal("some-value"))
+
+				Expect(fakeVariables.GetArgsForCall(0)).To(Equal(template.VariableDefinition{Name: "bogus:some-var"}))
+				Expect(fakePool.FindOrCreateCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the var source cannot be created", func() {
+			BeforeEach(func() {
+				fakePool.FindOrCreateReturns(nil, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				_, _, err := variables.Get(template.VariableDefinition{Name: "some-source:some-var"})
+				Expect(err).To(MatchError("nope"))
+			})
+		})
+	})
+})
@@ -21,6 +21,9 @@ type Cache struct {
 	newItems chan time.Time
 	sr       SecretReader
 	maxLease time.Duration
+
+	stop     chan struct{}
+	stopOnce sync.Once
 }
 
 // TODO: Should a cache have a max size to
@@ -33,6 +36,7 @@ func NewCache(sr SecretReader, maxLease time.Duration) *Cache {
 		newItems: make(chan time.Time, 100),
 		sr:       sr,
 		maxLease: maxLease,
+		stop:     make(chan struct{}),
 	}
 	go c.reaperThread()
 	return c
@@ -72,10 +76,20 @@ func (c *Cache) reaperThread() {
 			}
 			nextWakeup = t
 			sleep.Reset(time.Until(nextWakeup))
+		case <-c.stop:
+			return
 		}
 	}
 }
 
+// Stop ends the reaper thread. Secrets may still be read through the cache,
+// but expired ones are only replaced when next read.
+func (c *Cache) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
+}
+
 // Read a secret from the cache or the underlying client if not
 // present.
 func (c *Cache) Read(path string) (*vaultapi.Secret, error) {
@@ -115,7 +129,10 @@ func (c *Cache) Read(path string) (*vaultapi.Secret, error) {
 	c.Unlock()
 
 	// Tell the reaper thread it has new items to cleanup
-	c.newItems <- cs.deadline
+	select {
+	case c.newItems <- cs.deadline:
+	case <-c.stop:
+	}
 
 	return secret, nil
 }
@@ -25,6 +25,9 @@ type ReAuther struct {
 
 	loggedIn     chan struct{}
 	loggedInOnce *sync.Once
+
+	stop     chan struct{}
+	stopOnce *sync.Once
 }
 
 // NewReAuther with a retry time and a max retry time.
@@ -37,6 +40,9 @@ func NewReAuther(auther Auther, maxTTL, retry, max time.Duration) *ReAuther {
 
 		loggedIn:     make(chan struct{}, 1),
 		loggedInOnce: &sync.Once{},
+
+		stop:     make(chan struct{}),
+		stopOnce: &sync.Once{},
 	}
 
 	go ra.authLoop()
@@ -50,6 +56,14 @@ func (ra *ReAuther) LoggedIn() <-chan struct{} {
 	return ra.loggedIn
 }
 
+// Stop ends the authorization loop. The login is no longer renewed, so it
+// expires at the end of its lease.
+func (ra *ReAuther) Stop() {
+	ra.stopOnce.Do(func() {
+		close(ra.stop)
+	})
+}
+
 // we can't renew a secret that has exceeded it's maxTTL or it's lease
 func (ra *ReAuther) renewable(leaseEnd, tokenEOL time.Time) bool {
 	now := time.Now()
@@ -67,12 +81,26 @@ func (ra *ReAuther) renewable(leaseEnd, tokenEOL time.Time) bool {
 	return true
 }
 
-// sleep until the tokenEOl or half the lease duration
-func (ra *ReAuther) sleep(leaseEnd, tokenEOL time.Time) {
+// sleep until the tokenEOl or half the lease duration, returning false if
+// stopped first
+func (ra *ReAuther) sleep(leaseEnd, tokenEOL time.Time) bool {
 	if ra.maxTTL != 0 && leaseEnd.After(tokenEOL) {
-		time.Sleep(time.Until(tokenEOL))
-	} else {
-		time.Sleep(time.Until(leaseEnd) / 2)
+		return ra.wait(time.Until(tokenEOL))
+	}
+
+	return ra.wait(time.Until(leaseEnd) / 2)
+}
+
+// wait for the duration, returning false if stopped first
+func (ra *ReAuther) wait(duration time.Duration) bool {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ra.stop:
+		return false
 	}
 }
 
@@ -89,7 +117,10 @@ func (ra *ReAuther) authLoop() {
 		for {
 			lease, err := ra.auther.Login()
 			if err != nil {
-				time.Sleep(exp.NextBackOff())
+				if !ra.wait(exp.NextBackOff()) {
+					return
+				}
+
 				continue
 			}
 
@@ -102,7 +133,9 @@ func (ra *ReAuther) authLoop() {
 			now := time.Now()
 			tokenEOL = now.Add(ra.maxTTL)
 			leaseEnd = now.Add(lease)
-			ra.sleep(leaseEnd, tokenEOL)
+			if !ra.sleep(leaseEnd, tokenEOL) {
+				return
+			}
 
 			break
 		}
@@ -114,14 +147,19 @@ func (ra *ReAuther) authLoop() {
 
 			lease, err := ra.auther.Renew()
 			if err != nil {
-				time.Sleep(exp.NextBackOff())
+				if !ra.wait(exp.NextBackOff()) {
+					return
+				}
+
 				continue
 			}
 
 			exp.Reset()
 
 			leaseEnd = time.Now().Add(lease)
-			ra.sleep(leaseEnd, tokenEOL)
+			if !ra.sleep(leaseEnd, tokenEOL) {
+				return
+			}
 		}
 	}
 }
@@ -12,6 +12,9 @@ type vaultFactory struct {
 	prefix   string
 	sharedPath string
 	loggedIn <-chan struct{}
+
+	// stop ends the goroutines renewing the login and reaping the cache
+	stop func()
 }
 
 func NewVaultFactory(sr SecretReader, loggedIn <-chan struct{}, prefix string, sharedPath string) *vaultFactory {
@@ -41,3 +44,13 @@ func (factory *vaultFactory) NewVariables(teamName string, pipelineName string)
 		PipelineName: pipelineName,
 	}
 }
+
+// Close stops renewing the factory's login, e.g. once its var source is no
+// longer used.
+func (factory *vaultFactory) Close() error {
+	if factory.stop != nil {
+		factory.stop()
+	}
+
+	return nil
+}
@@ -24,6 +24,10 @@ type BuildInput struct {
 	ResourceID int
 
 	FirstOccurrence bool
+
+	// Override is true if the version was chosen when the build was
+	// triggered, rather than by the scheduler.
+	Override bool
 }
 
 type BuildOutput struct {
@@ -42,7 +46,7 @@ const (
 	BuildStatusErrored   BuildStatus = "errored"
 )
 
-var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.schema, b.private_plan, b.public_plan, b.create_time, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.drained").
+var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.schema, b.private_plan, b.public_plan, b.create_time, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.drained, b.events_archived").
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
 	JoinClause("LEFT OUTER JOIN pipelines p ON b.pipeline_id = p.id").
@@ -71,6 +75,7 @@ type Build interface {
 	EndTime() time.Time
 	ReapTime() time.Time
 	IsManuallyTriggered() bool
+	InputOverrides() (atc.InputOverrides, error)
 	IsScheduled() bool
 	IsRunning() bool
 
@@ -108,6 +113,9 @@ type Build interface {
 
 	IsDrained() bool
 	SetDrained(bool) error
+
+	EventsArchived() bool
+	MarkEventsArchived() error
 }
 
 type build struct {
@@ -138,6 +146,8 @@ type build struct {
 	conn        Conn
 	lockFactory lock.LockFactory
 	drained     bool
+
+	eventsArchived bool
 }
 
 var ErrBuildDisappeared = errors.New("build disappeared from db")
@@ -172,6 +182,7 @@ func (b *build) ReapTime() time.Time          { return b.reapTime }
 func (b *build) Status() BuildStatus          { return b.status }
 func (b *build) IsScheduled() bool            { return b.scheduled }
 func (b *build) IsDrained() bool              { return b.drained }
+func (b *build) EventsArchived() bool         { return b.eventsArchived }
 
 func (b *build) IsRunning() bool {
 	switch b.status {
@@ -216,6 +227,36 @@ func (b *build) Interceptible() (bool, error) {
 	return interceptible, nil
 }
 
+// InputOverrides returns the versions chosen for the build's inputs when it
+// was manually triggered, if any.
+func (b *build) InputOverrides() (atc.InputOverrides, error) {
+	var overrides atc.InputOverrides
+	var overridesBlob []byte
+
+	err := psql.Select("input_overrides").
+		From("builds").
+		Where(sq.Eq{
+			"id": b.id,
+		}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&overridesBlob)
+	if err != nil {
+		return overrides, err
+	}
+
+	if overridesBlob == nil {
+		return overrides, nil
+	}
+
+	err = json.Unmarshal(overridesBlob, &overrides)
+	if err != nil {
+		return overrides, err
+	}
+
+	return overrides, nil
+}
+
 func (b *build) SetInterceptible(i bool) error {
 	rows, err := psql.Update("builds").
 		Set("interceptible", i).
@@ -421,6 +462,43 @@ func (b *build) SetDrained(drained bool) error {
 	return err
 }
 
+// MarkEventsArchived deletes the build's events once they have been archived
+// elsewhere, e.g. to a logstore.Store.
+func (b *build) MarkEventsArchived() error {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Delete("build_events").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("builds").
+		Set("events_archived", true).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	b.eventsArchived = true
+
+	return nil
+}
+
 func (b *build) Delete() (bool, error) {
 	rows, err := psql.Delete("builds").
 		Where(sq.Eq{
@@ -480,11 +558,26 @@ func (b *build) AbortNotifier() (Notifier, error) {
 	})
 }
 
+// Schedule marks the build as scheduled. If the build's team already has as
+// many other builds scheduled or running as its quota allows,
+// ErrRunningBuildsQuotaReached is returned instead.
 func (b *build) Schedule() (bool, error) {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	err = checkRunningBuildsQuota(tx, b.teamID, b.id)
+	if err != nil {
+		return false, err
+	}
+
 	result, err := psql.Update("builds").
 		Set("scheduled", true).
 		Where(sq.Eq{"id": b.id}).
-		RunWith(b.conn).
+		RunWith(tx).
 		Exec()
 	if err != nil {
 		return false, err
@@ -495,6 +588,11 @@ func (b *build) Schedule() (bool, error) {
 		return false, err
 	}
 
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
 	return rows == 1, nil
 }
 
@@ -972,7 +1070,7 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 			AND i.build_id < builds.id
 		)`
 
-	rows, err := psql.Select("inputs.name", "resources.id", "versions.version", firstOccurrence).
+	rows, err := psql.Select("inputs.name", "resources.id", "versions.version", firstOccurrence, "inputs.override").
 		From("resource_config_versions versions, build_resource_config_version_inputs inputs, builds, resources").
 		Where(sq.Eq{"builds.id": b.id}).
 		Where(sq.NotEq{"versions.check_order": 0}).
@@ -1000,12 +1098,13 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 		var (
 			inputName       string
 			firstOccurrence bool
+			override        bool
 			versionBlob     string
 			version         atc.Version
 			resourceID      int
 		)
 
-		err = rows.Scan(&inputName, &resourceID, &versionBlob, &firstOccurrence)
+		err = rows.Scan(&inputName, &resourceID, &versionBlob, &firstOccurrence, &override)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -1020,6 +1119,7 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 			Version:         version,
 			ResourceID:      resourceID,
 			FirstOccurrence: firstOccurrence,
+			Override:        override,
 		})
 	}
 
@@ -1073,8 +1173,8 @@ func (p *build) saveInputTx(tx Tx, buildID int, input BuildInput) error {
 	}
 
 	_, err = psql.Insert("build_resource_config_version_inputs").
-		Columns("build_id", "resource_id", "version_md5", "name").
-		Values(buildID, input.ResourceID, sq.Expr("md5(?)", versionJSON), input.Name).
+		Columns("build_id", "resource_id", "version_md5", "name", "override").
+		Values(buildID, input.ResourceID, sq.Expr("md5(?)", versionJSON), input.Name, input.Override).
 		Suffix("ON CONFLICT DO NOTHING").
 		RunWith(tx).
 		Exec()
@@ -1099,11 +1199,11 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		schema, privatePlan, jobName, pipelineName, publicPlan sql.NullString
 		createTime, startTime, endTime, reapTime               pq.NullTime
 		nonce                                                  sql.NullString
-		drained                                                bool
+		drained, eventsArchived                                bool
 		status                                                 string
 	)
 
-	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &schema, &privatePlan, &publicPlan, &createTime, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &drained)
+	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &schema, &privatePlan, &publicPlan, &createTime, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &drained, &eventsArchived)
 	if err != nil {
 		return err
 	}
@@ -1119,6 +1219,7 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.endTime = endTime.Time
 	b.reapTime = reapTime.Time
 	b.drained = drained
+	b.eventsArchived = eventsArchived
 
 	var (
 		noncense      *string
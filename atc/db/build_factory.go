@@ -19,6 +19,8 @@ type BuildFactory interface {
 	PublicBuilds(Page) ([]Build, Pagination, error)
 	GetAllStartedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
+	GetArchivableBuilds(afterID int, limit int) ([]Build, error)
+	GetBuildsBlockingWorker(string) ([]Build, error)
 	// TODO: move to BuildLifecycle, new interface (see WorkerLifecycle)
 	MarkNonInterceptibleBuilds() error
 }
@@ -114,6 +116,39 @@ func (f *buildFactory) GetDrainableBuilds() ([]Build, error) {
 	return getBuilds(query, f.conn, f.lockFactory)
 }
 
+// GetArchivableBuilds returns up to limit completed builds whose events have
+// not been archived, in order of ID starting after afterID.
+func (f *buildFactory) GetArchivableBuilds(afterID int, limit int) ([]Build, error) {
+	query := buildsQuery.Where(sq.Eq{
+		"b.completed":       true,
+		"b.events_archived": false,
+		"b.reap_time":       nil,
+	}).
+		Where(sq.Gt{"b.id": afterID}).
+		OrderBy("b.id ASC").
+		Limit(uint64(limit))
+
+	return getBuilds(query, f.conn, f.lockFactory)
+}
+
+// GetBuildsBlockingWorker returns the running builds with containers on the
+// worker which prevent it from landing or retiring, i.e. the builds which are
+// not of an interruptible job.
+func (f *buildFactory) GetBuildsBlockingWorker(workerName string) ([]Build, error) {
+	query := buildsQuery.
+		Where(sq.Eq{
+			"b.status": []string{string(BuildStatusPending), string(BuildStatusStarted)},
+		}).
+		Where(sq.Or{
+			sq.Eq{"j.interruptible": false},
+			sq.Eq{"b.job_id": nil},
+		}).
+		Where(sq.Expr("EXISTS (SELECT 1 FROM containers c WHERE c.build_id = b.id AND c.worker_name = ?)", workerName)).
+		OrderBy("b.id ASC")
+
+	return getBuilds(query, f.conn, f.lockFactory)
+}
+
 func (f *buildFactory) GetAllStartedBuilds() ([]Build, error) {
 	query := buildsQuery.Where(sq.Eq{
 		"b.status": BuildStatusStarted,
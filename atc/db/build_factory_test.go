@@ -354,6 +354,136 @@ var _ = Describe("BuildFactory", func() {
 		})
 	})
 
+	Describe("GetArchivableBuilds", func() {
+		var build2DB, build3DB, build4DB db.Build
+
+		BeforeEach(func() {
+			var err error
+			_, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			build2DB, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			build3DB, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			build4DB, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build2DB.Finish(db.BuildStatusSucceeded)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build3DB.Finish(db.BuildStatusFailed)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build3DB.MarkEventsArchived()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build4DB.Finish(db.BuildStatusErrored)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns all builds that have been completed and not archived", func() {
+			builds, err := buildFactory.GetArchivableBuilds(0, 10)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = build2DB.Reload()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = build4DB.Reload()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(builds).To(ConsistOf(build2DB, build4DB))
+		})
+
+		It("returns at most the limit, in order of ID", func() {
+			builds, err := buildFactory.GetArchivableBuilds(0, 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = build2DB.Reload()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(builds).To(Equal([]db.Build{build2DB}))
+		})
+
+		It("returns the builds after the given ID", func() {
+			builds, err := buildFactory.GetArchivableBuilds(build2DB.ID(), 10)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = build4DB.Reload()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(builds).To(Equal([]db.Build{build4DB}))
+		})
+	})
+
+	Describe("GetBuildsBlockingWorker", func() {
+		var (
+			blockingBuild      db.Build
+			interruptibleBuild db.Build
+		)
+
+		BeforeEach(func() {
+			pipeline, _, err := team.SavePipeline("other-pipeline", atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name:          "interruptible-job",
+						Interruptible: true,
+					},
+				},
+			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			Expect(err).NotTo(HaveOccurred())
+
+			job, found, err := pipeline.Job("interruptible-job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			worker, err := workerFactory.SaveWorker(atc.Worker{
+				Name:       "some-worker",
+				GardenAddr: "some-garden-addr",
+			}, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherWorker, err := workerFactory.SaveWorker(atc.Worker{
+				Name:       "other-worker",
+				GardenAddr: "other-garden-addr",
+			}, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			blockingBuild, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			interruptibleBuild, err = job.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			finishedBuild, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = finishedBuild.Finish(db.BuildStatusSucceeded)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherWorkerBuild, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, build := range []db.Build{blockingBuild, interruptibleBuild, finishedBuild} {
+				_, err = worker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-plan"), team.ID()), db.ContainerMetadata{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, err = otherWorker.CreateContainer(db.NewBuildStepContainerOwner(otherWorkerBuild.ID(), atc.PlanID("some-plan"), team.ID()), db.ContainerMetadata{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the running builds of uninterruptible jobs with containers on the worker", func() {
+			builds, err := buildFactory.GetBuildsBlockingWorker("some-worker")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(builds).To(HaveLen(1))
+			Expect(builds[0].ID()).To(Equal(blockingBuild.ID()))
+		})
+	})
+
 	Describe("GetAllStartedBuilds", func() {
 		var build1DB db.Build
 		var build2DB db.Build
@@ -66,6 +66,46 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("MarkEventsArchived", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveEvent(event.Log{Payload: "some-payload"})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusSucceeded)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.EventsArchived()).To(BeFalse())
+
+			err = build.MarkEventsArchived()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("marks the build's events as archived", func() {
+			Expect(build.EventsArchived()).To(BeTrue())
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.EventsArchived()).To(BeTrue())
+		})
+
+		It("deletes the build's events", func() {
+			events, err := build.Events(0)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer db.Close(events)
+
+			_, err = events.Next()
+			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+		})
+	})
+
 	Describe("Start", func() {
 		var build db.Build
 		var plan atc.Plan
@@ -1162,6 +1202,32 @@ var _ = Describe("Build", func() {
 			Expect(actualBuildInput[1].Name).To(Equal("some-weird-input"))
 			Expect(actualBuildInput[1].Version).To(Equal(atc.Version{"weird": "version"}))
 		})
+
+		It("records which inputs were overridden", func() {
+			resource, found, err := pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			err = build.UseInputs([]db.BuildInput{
+				{
+					Name:       "some-input",
+					ResourceID: resource.ID(),
+					Version:    atc.Version{"some": "version"},
+					Override:   true,
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			actualBuildInputs, _, err := build.Resources()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actualBuildInputs).To(ConsistOf(db.BuildInput{
+				Name:            "some-input",
+				ResourceID:      resource.ID(),
+				Version:         atc.Version{"some": "version"},
+				FirstOccurrence: true,
+				Override:        true,
+			}))
+		})
 	})
 
 	Describe("FinishWithError", func() {
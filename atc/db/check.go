@@ -0,0 +1,166 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
+)
+
+type CheckStatus string
+
+const (
+	CheckStatusPending   CheckStatus = "pending"
+	CheckStatusStarted   CheckStatus = "started"
+	CheckStatusSucceeded CheckStatus = "succeeded"
+	CheckStatusErrored   CheckStatus = "errored"
+)
+
+//go:generate counterfeiter . Check
+
+// A Check is a queued run of a resource's or resource type's check.
+type Check interface {
+	ID() int
+	ResourceID() int
+	ResourceTypeID() int
+	Name() string
+	TeamName() string
+	PipelineID() int
+	PipelineName() string
+	Status() CheckStatus
+	CreateTime() time.Time
+	StartTime() time.Time
+	EndTime() time.Time
+	CheckError() error
+
+	// ManuallyTriggered is true for a check which was queued on demand, e.g.
+	// by a webhook, rather than because the check interval had elapsed.
+	ManuallyTriggered() bool
+
+	Pipeline() (Pipeline, bool, error)
+
+	Finish() error
+	FinishWithError(error) error
+}
+
+var checksQuery = psql.Select("c.id, c.resource_id, c.resource_type_id, COALESCE(r.name, rt.name), t.name, p.id, p.name, c.status, c.create_time, c.start_time, c.end_time, c.check_error, c.manually_triggered").
+	From("checks c").
+	LeftJoin("resources r ON r.id = c.resource_id").
+	LeftJoin("resource_types rt ON rt.id = c.resource_type_id").
+	Join("pipelines p ON p.id = COALESCE(r.pipeline_id, rt.pipeline_id)").
+	Join("teams t ON t.id = p.team_id")
+
+type check struct {
+	id                int
+	resourceID        int
+	resourceTypeID    int
+	name              string
+	teamName          string
+	pipelineID        int
+	pipelineName      string
+	status            CheckStatus
+	createTime        time.Time
+	startTime         time.Time
+	endTime           time.Time
+	checkError        error
+	manuallyTriggered bool
+
+	conn        Conn
+	lockFactory lock.LockFactory
+}
+
+func (c *check) ID() int                 { return c.id }
+func (c *check) ResourceID() int         { return c.resourceID }
+func (c *check) ResourceTypeID() int     { return c.resourceTypeID }
+func (c *check) Name() string            { return c.name }
+func (c *check) TeamName() string        { return c.teamName }
+func (c *check) PipelineID() int         { return c.pipelineID }
+func (c *check) PipelineName() string    { return c.pipelineName }
+func (c *check) Status() CheckStatus     { return c.status }
+func (c *check) CreateTime() time.Time   { return c.createTime }
+func (c *check) StartTime() time.Time    { return c.startTime }
+func (c *check) EndTime() time.Time      { return c.endTime }
+func (c *check) CheckError() error       { return c.checkError }
+func (c *check) ManuallyTriggered() bool { return c.manuallyTriggered }
+
+func (c *check) Pipeline() (Pipeline, bool, error) {
+	row := pipelinesQuery.
+		Where(sq.Eq{"p.id": c.pipelineID}).
+		RunWith(c.conn).
+		QueryRow()
+
+	pipeline := newPipeline(c.conn, c.lockFactory)
+	err := scanPipeline(pipeline, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return pipeline, true, nil
+}
+
+func (c *check) Finish() error {
+	return c.finish(CheckStatusSucceeded, nil)
+}
+
+func (c *check) FinishWithError(checkErr error) error {
+	return c.finish(CheckStatusErrored, checkErr)
+}
+
+func (c *check) finish(status CheckStatus, checkErr error) error {
+	var errString sql.NullString
+	if checkErr != nil {
+		errString = sql.NullString{String: checkErr.Error(), Valid: true}
+	}
+
+	var endTime time.Time
+	err := psql.Update("checks").
+		Set("status", status).
+		Set("end_time", sq.Expr("now()")).
+		Set("check_error", errString).
+		Where(sq.Eq{"id": c.id}).
+		Suffix("RETURNING end_time").
+		RunWith(c.conn).
+		QueryRow().
+		Scan(&endTime)
+	if err != nil {
+		return err
+	}
+
+	c.status = status
+	c.endTime = endTime
+	c.checkError = checkErr
+
+	return nil
+}
+
+func scanCheck(c *check, row scannable) error {
+	var (
+		resourceID, resourceTypeID sql.NullInt64
+		startTime, endTime         pq.NullTime
+		checkErr                   sql.NullString
+	)
+
+	err := row.Scan(&c.id, &resourceID, &resourceTypeID, &c.name, &c.teamName, &c.pipelineID, &c.pipelineName, &c.status, &c.createTime, &startTime, &endTime, &checkErr, &c.manuallyTriggered)
+	if err != nil {
+		return err
+	}
+
+	c.resourceID = int(resourceID.Int64)
+	c.resourceTypeID = int(resourceTypeID.Int64)
+	c.startTime = startTime.Time
+	c.endTime = endTime.Time
+
+	if checkErr.Valid {
+		c.checkError = errors.New(checkErr.String)
+	} else {
+		c.checkError = nil
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
+)
+
+// A Checkable is an active resource or resource type, in an unpaused
+// pipeline, whose versions are found by running checks.
+type Checkable struct {
+	ResourceID     int
+	ResourceTypeID int
+	Name           string
+	TeamName       string
+	PipelineName   string
+	CheckEvery     string
+	LastChecked    time.Time
+
+	// LastCheckEnded is when the last check queued for the checkable ended,
+	// whether or not it got as far as checking.
+	LastCheckEnded time.Time
+}
+
+//go:generate counterfeiter . CheckFactory
+
+type CheckFactory interface {
+	Checkables() ([]Checkable, error)
+	CreateCheck(checkable Checkable, manuallyTriggered bool) (bool, error)
+	StartChecks(limit int) ([]Check, error)
+	PendingChecks() (int, error)
+}
+
+type checkFactory struct {
+	conn        Conn
+	lockFactory lock.LockFactory
+}
+
+func NewCheckFactory(conn Conn, lockFactory lock.LockFactory) CheckFactory {
+	return &checkFactory{
+		conn:        conn,
+		lockFactory: lockFactory,
+	}
+}
+
+func (f *checkFactory) Checkables() ([]Checkable, error) {
+	rows, err := psql.Select("r.id, r.name, r.config, r.nonce, t.name, p.name, rs.last_checked, (SELECT max(c.end_time) FROM checks c WHERE c.resource_id = r.id)").
+		From("resources r").
+		Join("pipelines p ON p.id = r.pipeline_id").
+		Join("teams t ON t.id = p.team_id").
+		LeftJoin("resource_config_scopes rs ON rs.id = r.resource_config_scope_id").
+		Where(sq.Expr("r.active AND NOT p.paused")).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	checkables, err := f.scanCheckables(rows, func(checkable *Checkable, id int, config []byte) error {
+		var resourceConfig atc.ResourceConfig
+		err := json.Unmarshal(config, &resourceConfig)
+		if err != nil {
+			return err
+		}
+
+		checkable.ResourceID = id
+		checkable.CheckEvery = resourceConfig.CheckEvery
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err = psql.Select("rt.id, rt.name, rt.config, rt.nonce, t.name, p.name, (SELECT max(rs.last_checked) FROM resource_config_scopes rs WHERE rs.resource_config_id = rt.resource_config_id), (SELECT max(c.end_time) FROM checks c WHERE c.resource_type_id = rt.id)").
+		From("resource_types rt").
+		Join("pipelines p ON p.id = rt.pipeline_id").
+		Join("teams t ON t.id = p.team_id").
+		Where(sq.Expr("rt.active AND NOT p.paused")).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	typeCheckables, err := f.scanCheckables(rows, func(checkable *Checkable, id int, config []byte) error {
+		var resourceType atc.ResourceType
+		err := json.Unmarshal(config, &resourceType)
+		if err != nil {
+			return err
+		}
+
+		checkable.ResourceTypeID = id
+		checkable.CheckEvery = resourceType.CheckEvery
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(checkables, typeCheckables...), nil
+}
+
+// scanCheckables scans rows of (id, name, config, nonce, team name, pipeline
+// name, last checked, last check ended) into checkables, leaving the ID and config to be set by
+// the given function.
+func (f *checkFactory) scanCheckables(rows *sql.Rows, parse func(*Checkable, int, []byte) error) ([]Checkable, error) {
+	defer Close(rows)
+
+	es := f.conn.EncryptionStrategy()
+
+	checkables := []Checkable{}
+	for rows.Next() {
+		var (
+			checkable      Checkable
+			id             int
+			configBlob     []byte
+			nonce          sql.NullString
+			lastChecked    pq.NullTime
+			lastCheckEnded pq.NullTime
+		)
+
+		err := rows.Scan(&id, &checkable.Name, &configBlob, &nonce, &checkable.TeamName, &checkable.PipelineName, &lastChecked, &lastCheckEnded)
+		if err != nil {
+			return nil, err
+		}
+
+		var noncense *string
+		if nonce.Valid {
+			noncense = &nonce.String
+		}
+
+		decryptedConfig, err := es.Decrypt(string(configBlob), noncense)
+		if err != nil {
+			return nil, err
+		}
+
+		err = parse(&checkable, id, decryptedConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		checkable.LastChecked = lastChecked.Time
+		checkable.LastCheckEnded = lastCheckEnded.Time
+
+		checkables = append(checkables, checkable)
+	}
+
+	return checkables, nil
+}
+
+// CreateCheck queues a check for the checkable, unless one is already
+// pending or running. A manually triggered check runs even if the checkable
+// has been checked within its check interval.
+func (f *checkFactory) CreateCheck(checkable Checkable, manuallyTriggered bool) (bool, error) {
+	var resourceID, resourceTypeID sql.NullInt64
+	if checkable.ResourceID != 0 {
+		resourceID = sql.NullInt64{Int64: int64(checkable.ResourceID), Valid: true}
+	} else {
+		resourceTypeID = sql.NullInt64{Int64: int64(checkable.ResourceTypeID), Valid: true}
+	}
+
+	result, err := psql.Insert("checks").
+		Columns("resource_id", "resource_type_id", "manually_triggered").
+		Values(resourceID, resourceTypeID, manuallyTriggered).
+		Suffix("ON CONFLICT DO NOTHING").
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
+
+// StartChecks marks up to limit of the oldest pending checks as started and
+// returns them. Checks started by one ATC will not be returned to another.
+func (f *checkFactory) StartChecks(limit int) ([]Check, error) {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	rows, err := tx.Query(`
+		UPDATE checks
+		SET status = $1, start_time = now()
+		WHERE id IN (
+			SELECT id
+			FROM checks
+			WHERE status = $2
+			ORDER BY create_time, id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id
+	`, CheckStatusStarted, CheckStatusPending, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			Close(rows)
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	Close(rows)
+
+	checks := []Check{}
+	if len(ids) != 0 {
+		rows, err = checksQuery.
+			Where(sq.Eq{"c.id": ids}).
+			OrderBy("c.create_time", "c.id").
+			RunWith(tx).
+			Query()
+		if err != nil {
+			return nil, err
+		}
+
+		defer Close(rows)
+
+		for rows.Next() {
+			check := &check{conn: f.conn, lockFactory: f.lockFactory}
+
+			err = scanCheck(check, rows)
+			if err != nil {
+				return nil, err
+			}
+
+			checks = append(checks, check)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return checks, nil
+}
+
+func (f *checkFactory) PendingChecks() (int, error) {
+	var count int
+	err := psql.Select("COUNT(*)").
+		From("checks").
+		Where(sq.Eq{"status": CheckStatusPending}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&count)
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
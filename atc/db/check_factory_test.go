@@ -0,0 +1,228 @@
+package db_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckFactory", func() {
+	var checkFactory db.CheckFactory
+
+	BeforeEach(func() {
+		checkFactory = db.NewCheckFactory(dbConn, lockFactory)
+	})
+
+	Describe("Checkables", func() {
+		It("returns the active resources and resource types", func() {
+			checkables, err := checkFactory.Checkables()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkables).To(ConsistOf(
+				db.Checkable{
+					ResourceID:   defaultResource.ID(),
+					Name:         "some-resource",
+					TeamName:     "default-team",
+					PipelineName: "default-pipeline",
+				},
+				db.Checkable{
+					ResourceTypeID: defaultResourceType.ID(),
+					Name:           "some-type",
+					TeamName:       "default-team",
+					PipelineName:   "default-pipeline",
+				},
+			))
+		})
+
+		Context("when a check has ended", func() {
+			var endTime time.Time
+
+			BeforeEach(func() {
+				created, err := checkFactory.CreateCheck(db.Checkable{ResourceID: defaultResource.ID()}, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				checks, err := checkFactory.StartChecks(1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(checks).To(HaveLen(1))
+
+				Expect(checks[0].FinishWithError(errors.New("nope"))).To(Succeed())
+				endTime = checks[0].EndTime()
+			})
+
+			It("returns when it ended", func() {
+				checkables, err := checkFactory.Checkables()
+				Expect(err).NotTo(HaveOccurred())
+
+				for _, checkable := range checkables {
+					if checkable.ResourceID == defaultResource.ID() {
+						Expect(checkable.LastCheckEnded).To(BeTemporally("==", endTime))
+					} else {
+						Expect(checkable.LastCheckEnded).To(BeZero())
+					}
+				}
+			})
+		})
+
+		Context("when the pipeline is paused", func() {
+			BeforeEach(func() {
+				Expect(defaultPipeline.Pause()).To(Succeed())
+			})
+
+			It("returns nothing", func() {
+				checkables, err := checkFactory.Checkables()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(checkables).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("CreateCheck", func() {
+		It("queues a check", func() {
+			created, err := checkFactory.CreateCheck(db.Checkable{ResourceID: defaultResource.ID()}, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			Expect(checkFactory.PendingChecks()).To(Equal(1))
+		})
+
+		Context("when the check is manually triggered", func() {
+			It("queues a check which is manually triggered", func() {
+				created, err := checkFactory.CreateCheck(db.Checkable{ResourceID: defaultResource.ID()}, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				checks, err := checkFactory.StartChecks(1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(checks).To(HaveLen(1))
+				Expect(checks[0].ManuallyTriggered()).To(BeTrue())
+			})
+		})
+
+		Context("when a check is already queued", func() {
+			BeforeEach(func() {
+				_, err := checkFactory.CreateCheck(db.Checkable{ResourceID: defaultResource.ID()}, false)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not queue another", func() {
+				created, err := checkFactory.CreateCheck(db.Checkable{ResourceID: defaultResource.ID()}, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+
+				Expect(checkFactory.PendingChecks()).To(Equal(1))
+			})
+
+			It("queues checks for other resources and resource types", func() {
+				created, err := checkFactory.CreateCheck(db.Checkable{ResourceTypeID: defaultResourceType.ID()}, false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				Expect(checkFactory.PendingChecks()).To(Equal(2))
+			})
+
+			Context("when the queued check has started", func() {
+				BeforeEach(func() {
+					_, err := checkFactory.StartChecks(1)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("does not queue another", func() {
+					created, err := checkFactory.CreateCheck(db.Checkable{ResourceID: defaultResource.ID()}, false)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(created).To(BeFalse())
+				})
+			})
+
+			Context("when the queued check has finished", func() {
+				BeforeEach(func() {
+					checks, err := checkFactory.StartChecks(1)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(checks[0].Finish()).To(Succeed())
+				})
+
+				It("queues another", func() {
+					created, err := checkFactory.CreateCheck(db.Checkable{ResourceID: defaultResource.ID()}, false)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(created).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Describe("StartChecks", func() {
+		BeforeEach(func() {
+			_, err := checkFactory.CreateCheck(db.Checkable{ResourceID: defaultResource.ID()}, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = checkFactory.CreateCheck(db.Checkable{ResourceTypeID: defaultResourceType.ID()}, false)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("starts up to the limit, oldest first", func() {
+			checks, err := checkFactory.StartChecks(1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks).To(HaveLen(1))
+
+			check := checks[0]
+			Expect(check.ResourceID()).To(Equal(defaultResource.ID()))
+			Expect(check.ResourceTypeID()).To(BeZero())
+			Expect(check.Name()).To(Equal("some-resource"))
+			Expect(check.TeamName()).To(Equal("default-team"))
+			Expect(check.PipelineID()).To(Equal(defaultPipeline.ID()))
+			Expect(check.PipelineName()).To(Equal("default-pipeline"))
+			Expect(check.Status()).To(Equal(db.CheckStatusStarted))
+			Expect(check.StartTime()).To(BeTemporally(">=", check.CreateTime()))
+			Expect(check.ManuallyTriggered()).To(BeFalse())
+
+			Expect(checkFactory.PendingChecks()).To(Equal(1))
+		})
+
+		It("does not start checks which have already started", func() {
+			checks, err := checkFactory.StartChecks(2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks).To(HaveLen(2))
+			Expect(checks[1].ResourceTypeID()).To(Equal(defaultResourceType.ID()))
+			Expect(checks[1].Name()).To(Equal("some-type"))
+
+			checks, err = checkFactory.StartChecks(2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks).To(BeEmpty())
+		})
+	})
+
+	Describe("Check", func() {
+		var check db.Check
+
+		BeforeEach(func() {
+			_, err := checkFactory.CreateCheck(db.Checkable{ResourceID: defaultResource.ID()}, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			checks, err := checkFactory.StartChecks(1)
+			Expect(err).NotTo(HaveOccurred())
+			check = checks[0]
+		})
+
+		It("finds its pipeline", func() {
+			pipeline, found, err := check.Pipeline()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(pipeline.ID()).To(Equal(defaultPipeline.ID()))
+		})
+
+		It("can succeed", func() {
+			Expect(check.Finish()).To(Succeed())
+			Expect(check.Status()).To(Equal(db.CheckStatusSucceeded))
+			Expect(check.EndTime()).To(BeTemporally("~", time.Now(), time.Minute))
+			Expect(check.CheckError()).To(BeNil())
+		})
+
+		It("can fail", func() {
+			Expect(check.FinishWithError(errors.New("nope"))).To(Succeed())
+			Expect(check.Status()).To(Equal(db.CheckStatusErrored))
+			Expect(check.CheckError()).To(Equal(errors.New("nope")))
+		})
+	})
+})
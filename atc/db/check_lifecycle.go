@@ -0,0 +1,47 @@
+package db
+
+import (
+	"strconv"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+//go:generate counterfeiter . CheckLifecycle
+
+type CheckLifecycle interface {
+	RemoveExpiredChecks(time.Duration) error
+}
+
+type checkLifecycle struct {
+	conn Conn
+}
+
+func NewCheckLifecycle(conn Conn) CheckLifecycle {
+	return checkLifecycle{
+		conn: conn,
+	}
+}
+
+// RemoveExpiredChecks removes checks which finished longer ago than the given
+// duration, along with checks which were started longer ago than it and so
+// must have been abandoned by the ATC running them.
+func (lifecycle checkLifecycle) RemoveExpiredChecks(expiry time.Duration) error {
+	seconds := strconv.Itoa(int(expiry.Seconds()))
+
+	_, err := psql.Delete("checks").
+		Where(sq.Or{
+			sq.And{
+				sq.Eq{"status": []CheckStatus{CheckStatusSucceeded, CheckStatusErrored}},
+				sq.Expr("now() - end_time > (? || ' SECONDS')::INTERVAL", seconds),
+			},
+			sq.And{
+				sq.Eq{"status": CheckStatusStarted},
+				sq.Expr("now() - start_time > (? || ' SECONDS')::INTERVAL", seconds),
+			},
+		}).
+		RunWith(lifecycle.conn).
+		Exec()
+
+	return err
+}
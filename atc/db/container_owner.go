@@ -124,19 +124,25 @@ func (c buildStepContainerOwner) sqlMap() map[string]interface{} {
 // worker base resource type, with an expiry. When the resource config or
 // worker base resource type disappear, or the expiry is reached, the container
 // can be removed.
+//
+// The credentials fingerprint identifies the credentials the container checks
+// with, so that rotating them results in a new container.
 func NewResourceConfigCheckSessionContainerOwner(
 	resourceConfig ResourceConfig,
+	credentialsFingerprint string,
 	expiries ContainerOwnerExpiries,
 ) ContainerOwner {
 	return resourceConfigCheckSessionContainerOwner{
-		resourceConfig: resourceConfig,
-		expiries:       expiries,
+		resourceConfig:         resourceConfig,
+		credentialsFingerprint: credentialsFingerprint,
+		expiries:               expiries,
 	}
 }
 
 type resourceConfigCheckSessionContainerOwner struct {
-	resourceConfig ResourceConfig
-	expiries       ContainerOwnerExpiries
+	resourceConfig         ResourceConfig
+	credentialsFingerprint string
+	expiries               ContainerOwnerExpiries
 }
 
 type ContainerOwnerExpiries struct {
@@ -150,7 +156,10 @@ func (c resourceConfigCheckSessionContainerOwner) Find(conn Conn) (sq.Eq, bool,
 	rows, err := psql.Select("id").
 		From("resource_config_check_sessions").
 		Where(sq.And{
-			sq.Eq{"resource_config_id": c.resourceConfig.ID()},
+			sq.Eq{
+				"resource_config_id":      c.resourceConfig.ID(),
+				"credentials_fingerprint": c.credentialsFingerprint,
+			},
 			sq.Expr(fmt.Sprintf("expires_at > NOW() + interval '%d seconds'", int(c.expiries.GraceTime.Seconds()))),
 		}).
 		RunWith(conn).
@@ -205,10 +214,11 @@ func (c resourceConfigCheckSessionContainerOwner) Create(tx Tx, workerName strin
 		SetMap(map[string]interface{}{
 			"resource_config_id":           c.resourceConfig.ID(),
 			"worker_base_resource_type_id": wbrtID,
+			"credentials_fingerprint":      c.credentialsFingerprint,
 			"expires_at":                   sq.Expr("(SELECT " + expiryStmt + " FROM workers)"),
 		}).
 		Suffix(`
-			ON CONFLICT (resource_config_id, worker_base_resource_type_id) DO UPDATE SET
+			ON CONFLICT (resource_config_id, worker_base_resource_type_id, credentials_fingerprint) DO UPDATE SET
 				resource_config_id = ?,
 				worker_base_resource_type_id = ?
 			RETURNING id
@@ -55,6 +55,7 @@ var _ = Describe("ContainerOwner", func() {
 		JustBeforeEach(func() {
 			owner = db.NewResourceConfigCheckSessionContainerOwner(
 				resourceConfig,
+				"some-fingerprint",
 				ownerExpiries,
 			)
 		})
@@ -74,6 +75,7 @@ var _ = Describe("ContainerOwner", func() {
 				BeforeEach(func() {
 					existingOwner := db.NewResourceConfigCheckSessionContainerOwner(
 						resourceConfig,
+						"some-fingerprint",
 						ownerExpiries,
 					)
 
@@ -100,6 +102,7 @@ var _ = Describe("ContainerOwner", func() {
 				BeforeEach(func() {
 					existingOwner := db.NewResourceConfigCheckSessionContainerOwner(
 						resourceConfig,
+						"some-fingerprint",
 						ownerExpiries,
 					)
 
@@ -124,6 +127,28 @@ var _ = Describe("ContainerOwner", func() {
 				})
 			})
 
+			Context("when a resource config check session exists for other credentials", func() {
+				BeforeEach(func() {
+					existingOwner := db.NewResourceConfigCheckSessionContainerOwner(
+						resourceConfig,
+						"some-other-fingerprint",
+						ownerExpiries,
+					)
+
+					tx, err := dbConn.Begin()
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = existingOwner.Create(tx, worker.Name())
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(tx.Commit()).To(Succeed())
+				})
+
+				It("doesn't find the resource config check session", func() {
+					Expect(found).To(BeFalse())
+				})
+			})
+
 			Context("when a resource config check session doesn't exist", func() {
 				It("doesn't find a resource config check session", func() {
 					Expect(found).To(BeFalse())
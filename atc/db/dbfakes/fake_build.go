@@ -112,6 +112,16 @@ type FakeBuild struct {
 		result1 db.EventSource
 		result2 error
 	}
+	EventsArchivedStub        func() bool
+	eventsArchivedMutex       sync.RWMutex
+	eventsArchivedArgsForCall []struct {
+	}
+	eventsArchivedReturns struct {
+		result1 bool
+	}
+	eventsArchivedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	FinishStub        func(db.BuildStatus) error
 	finishMutex       sync.RWMutex
 	finishArgsForCall []struct {
@@ -144,6 +154,18 @@ type FakeBuild struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InputOverridesStub        func() (atc.InputOverrides, error)
+	inputOverridesMutex       sync.RWMutex
+	inputOverridesArgsForCall []struct {
+	}
+	inputOverridesReturns struct {
+		result1 atc.InputOverrides
+		result2 error
+	}
+	inputOverridesReturnsOnCall map[int]struct {
+		result1 atc.InputOverrides
+		result2 error
+	}
 	InterceptibleStub        func() (bool, error)
 	interceptibleMutex       sync.RWMutex
 	interceptibleArgsForCall []struct {
@@ -226,6 +248,16 @@ type FakeBuild struct {
 	markAsAbortedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MarkEventsArchivedStub        func() error
+	markEventsArchivedMutex       sync.RWMutex
+	markEventsArchivedArgsForCall []struct {
+	}
+	markEventsArchivedReturns struct {
+		result1 error
+	}
+	markEventsArchivedReturnsOnCall map[int]struct {
+		result1 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -955,6 +987,58 @@ func (fake *FakeBuild) EventsReturnsOnCall(i int, result1 db.EventSource, result
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) EventsArchived() bool {
+	fake.eventsArchivedMutex.Lock()
+	ret, specificReturn := fake.eventsArchivedReturnsOnCall[len(fake.eventsArchivedArgsForCall)]
+	fake.eventsArchivedArgsForCall = append(fake.eventsArchivedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("EventsArchived", []interface{}{})
+	fake.eventsArchivedMutex.Unlock()
+	if fake.EventsArchivedStub != nil {
+		return fake.EventsArchivedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.eventsArchivedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) EventsArchivedCallCount() int {
+	fake.eventsArchivedMutex.RLock()
+	defer fake.eventsArchivedMutex.RUnlock()
+	return len(fake.eventsArchivedArgsForCall)
+}
+
+func (fake *FakeBuild) EventsArchivedCalls(stub func() bool) {
+	fake.eventsArchivedMutex.Lock()
+	defer fake.eventsArchivedMutex.Unlock()
+	fake.EventsArchivedStub = stub
+}
+
+func (fake *FakeBuild) EventsArchivedReturns(result1 bool) {
+	fake.eventsArchivedMutex.Lock()
+	defer fake.eventsArchivedMutex.Unlock()
+	fake.EventsArchivedStub = nil
+	fake.eventsArchivedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) EventsArchivedReturnsOnCall(i int, result1 bool) {
+	fake.eventsArchivedMutex.Lock()
+	defer fake.eventsArchivedMutex.Unlock()
+	fake.EventsArchivedStub = nil
+	if fake.eventsArchivedReturnsOnCall == nil {
+		fake.eventsArchivedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.eventsArchivedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeBuild) Finish(arg1 db.BuildStatus) error {
 	fake.finishMutex.Lock()
 	ret, specificReturn := fake.finishReturnsOnCall[len(fake.finishArgsForCall)]
@@ -1127,6 +1211,61 @@ func (fake *FakeBuild) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) InputOverrides() (atc.InputOverrides, error) {
+	fake.inputOverridesMutex.Lock()
+	ret, specificReturn := fake.inputOverridesReturnsOnCall[len(fake.inputOverridesArgsForCall)]
+	fake.inputOverridesArgsForCall = append(fake.inputOverridesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("InputOverrides", []interface{}{})
+	fake.inputOverridesMutex.Unlock()
+	if fake.InputOverridesStub != nil {
+		return fake.InputOverridesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.inputOverridesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) InputOverridesCallCount() int {
+	fake.inputOverridesMutex.RLock()
+	defer fake.inputOverridesMutex.RUnlock()
+	return len(fake.inputOverridesArgsForCall)
+}
+
+func (fake *FakeBuild) InputOverridesCalls(stub func() (atc.InputOverrides, error)) {
+	fake.inputOverridesMutex.Lock()
+	defer fake.inputOverridesMutex.Unlock()
+	fake.InputOverridesStub = stub
+}
+
+func (fake *FakeBuild) InputOverridesReturns(result1 atc.InputOverrides, result2 error) {
+	fake.inputOverridesMutex.Lock()
+	defer fake.inputOverridesMutex.Unlock()
+	fake.InputOverridesStub = nil
+	fake.inputOverridesReturns = struct {
+		result1 atc.InputOverrides
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) InputOverridesReturnsOnCall(i int, result1 atc.InputOverrides, result2 error) {
+	fake.inputOverridesMutex.Lock()
+	defer fake.inputOverridesMutex.Unlock()
+	fake.InputOverridesStub = nil
+	if fake.inputOverridesReturnsOnCall == nil {
+		fake.inputOverridesReturnsOnCall = make(map[int]struct {
+			result1 atc.InputOverrides
+			result2 error
+		})
+	}
+	fake.inputOverridesReturnsOnCall[i] = struct {
+		result1 atc.InputOverrides
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Interceptible() (bool, error) {
 	fake.interceptibleMutex.Lock()
 	ret, specificReturn := fake.interceptibleReturnsOnCall[len(fake.interceptibleArgsForCall)]
@@ -1546,6 +1685,58 @@ func (fake *FakeBuild) MarkAsAbortedReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) MarkEventsArchived() error {
+	fake.markEventsArchivedMutex.Lock()
+	ret, specificReturn := fake.markEventsArchivedReturnsOnCall[len(fake.markEventsArchivedArgsForCall)]
+	fake.markEventsArchivedArgsForCall = append(fake.markEventsArchivedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MarkEventsArchived", []interface{}{})
+	fake.markEventsArchivedMutex.Unlock()
+	if fake.MarkEventsArchivedStub != nil {
+		return fake.MarkEventsArchivedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.markEventsArchivedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) MarkEventsArchivedCallCount() int {
+	fake.markEventsArchivedMutex.RLock()
+	defer fake.markEventsArchivedMutex.RUnlock()
+	return len(fake.markEventsArchivedArgsForCall)
+}
+
+func (fake *FakeBuild) MarkEventsArchivedCalls(stub func() error) {
+	fake.markEventsArchivedMutex.Lock()
+	defer fake.markEventsArchivedMutex.Unlock()
+	fake.MarkEventsArchivedStub = stub
+}
+
+func (fake *FakeBuild) MarkEventsArchivedReturns(result1 error) {
+	fake.markEventsArchivedMutex.Lock()
+	defer fake.markEventsArchivedMutex.Unlock()
+	fake.MarkEventsArchivedStub = nil
+	fake.markEventsArchivedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) MarkEventsArchivedReturnsOnCall(i int, result1 error) {
+	fake.markEventsArchivedMutex.Lock()
+	defer fake.markEventsArchivedMutex.Unlock()
+	fake.MarkEventsArchivedStub = nil
+	if fake.markEventsArchivedReturnsOnCall == nil {
+		fake.markEventsArchivedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markEventsArchivedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -2857,12 +3048,16 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.endTimeMutex.RUnlock()
 	fake.eventsMutex.RLock()
 	defer fake.eventsMutex.RUnlock()
+	fake.eventsArchivedMutex.RLock()
+	defer fake.eventsArchivedMutex.RUnlock()
 	fake.finishMutex.RLock()
 	defer fake.finishMutex.RUnlock()
 	fake.finishWithErrorMutex.RLock()
 	defer fake.finishWithErrorMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.inputOverridesMutex.RLock()
+	defer fake.inputOverridesMutex.RUnlock()
 	fake.interceptibleMutex.RLock()
 	defer fake.interceptibleMutex.RUnlock()
 	fake.isDrainedMutex.RLock()
@@ -2879,6 +3074,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.jobNameMutex.RUnlock()
 	fake.markAsAbortedMutex.RLock()
 	defer fake.markAsAbortedMutex.RUnlock()
+	fake.markEventsArchivedMutex.RLock()
+	defer fake.markEventsArchivedMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.pipelineMutex.RLock()
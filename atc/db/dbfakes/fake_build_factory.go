@@ -35,6 +35,33 @@ type FakeBuildFactory struct {
 		result1 []db.Build
 		result2 error
 	}
+	GetArchivableBuildsStub        func(int, int) ([]db.Build, error)
+	getArchivableBuildsMutex       sync.RWMutex
+	getArchivableBuildsArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getArchivableBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	getArchivableBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
+	GetBuildsBlockingWorkerStub        func(string) ([]db.Build, error)
+	getBuildsBlockingWorkerMutex       sync.RWMutex
+	getBuildsBlockingWorkerArgsForCall []struct {
+		arg1 string
+	}
+	getBuildsBlockingWorkerReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	getBuildsBlockingWorkerReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	GetDrainableBuildsStub        func() ([]db.Build, error)
 	getDrainableBuildsMutex       sync.RWMutex
 	getDrainableBuildsArgsForCall []struct {
@@ -229,6 +256,133 @@ func (fake *FakeBuildFactory) GetAllStartedBuildsReturnsOnCall(i int, result1 []
 	}{result1, result2}
 }
 
+func (fake *FakeBuildFactory) GetArchivableBuilds(arg1 int, arg2 int) ([]db.Build, error) {
+	fake.getArchivableBuildsMutex.Lock()
+	ret, specificReturn := fake.getArchivableBuildsReturnsOnCall[len(fake.getArchivableBuildsArgsForCall)]
+	fake.getArchivableBuildsArgsForCall = append(fake.getArchivableBuildsArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetArchivableBuilds", []interface{}{arg1, arg2})
+	fake.getArchivableBuildsMutex.Unlock()
+	if fake.GetArchivableBuildsStub != nil {
+		return fake.GetArchivableBuildsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getArchivableBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsCallCount() int {
+	fake.getArchivableBuildsMutex.RLock()
+	defer fake.getArchivableBuildsMutex.RUnlock()
+	return len(fake.getArchivableBuildsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsCalls(stub func(int, int) ([]db.Build, error)) {
+	fake.getArchivableBuildsMutex.Lock()
+	defer fake.getArchivableBuildsMutex.Unlock()
+	fake.GetArchivableBuildsStub = stub
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsArgsForCall(i int) (int, int) {
+	fake.getArchivableBuildsMutex.RLock()
+	defer fake.getArchivableBuildsMutex.RUnlock()
+	argsForCall := fake.getArchivableBuildsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsReturns(result1 []db.Build, result2 error) {
+	fake.getArchivableBuildsMutex.Lock()
+	defer fake.getArchivableBuildsMutex.Unlock()
+	fake.GetArchivableBuildsStub = nil
+	fake.getArchivableBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) GetArchivableBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.getArchivableBuildsMutex.Lock()
+	defer fake.getArchivableBuildsMutex.Unlock()
+	fake.GetArchivableBuildsStub = nil
+	if fake.getArchivableBuildsReturnsOnCall == nil {
+		fake.getArchivableBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.getArchivableBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) GetBuildsBlockingWorker(arg1 string) ([]db.Build, error) {
+	fake.getBuildsBlockingWorkerMutex.Lock()
+	ret, specificReturn := fake.getBuildsBlockingWorkerReturnsOnCall[len(fake.getBuildsBlockingWorkerArgsForCall)]
+	fake.getBuildsBlockingWorkerArgsForCall = append(fake.getBuildsBlockingWorkerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetBuildsBlockingWorker", []interface{}{arg1})
+	fake.getBuildsBlockingWorkerMutex.Unlock()
+	if fake.GetBuildsBlockingWorkerStub != nil {
+		return fake.GetBuildsBlockingWorkerStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getBuildsBlockingWorkerReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) GetBuildsBlockingWorkerCallCount() int {
+	fake.getBuildsBlockingWorkerMutex.RLock()
+	defer fake.getBuildsBlockingWorkerMutex.RUnlock()
+	return len(fake.getBuildsBlockingWorkerArgsForCall)
+}
+
+func (fake *FakeBuildFactory) GetBuildsBlockingWorkerCalls(stub func(string) ([]db.Build, error)) {
+	fake.getBuildsBlockingWorkerMutex.Lock()
+	defer fake.getBuildsBlockingWorkerMutex.Unlock()
+	fake.GetBuildsBlockingWorkerStub = stub
+}
+
+func (fake *FakeBuildFactory) GetBuildsBlockingWorkerArgsForCall(i int) string {
+	fake.getBuildsBlockingWorkerMutex.RLock()
+	defer fake.getBuildsBlockingWorkerMutex.RUnlock()
+	argsForCall := fake.getBuildsBlockingWorkerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildFactory) GetBuildsBlockingWorkerReturns(result1 []db.Build, result2 error) {
+	fake.getBuildsBlockingWorkerMutex.Lock()
+	defer fake.getBuildsBlockingWorkerMutex.Unlock()
+	fake.GetBuildsBlockingWorkerStub = nil
+	fake.getBuildsBlockingWorkerReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) GetBuildsBlockingWorkerReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.getBuildsBlockingWorkerMutex.Lock()
+	defer fake.getBuildsBlockingWorkerMutex.Unlock()
+	fake.GetBuildsBlockingWorkerStub = nil
+	if fake.getBuildsBlockingWorkerReturnsOnCall == nil {
+		fake.getBuildsBlockingWorkerReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.getBuildsBlockingWorkerReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) GetDrainableBuilds() ([]db.Build, error) {
 	fake.getDrainableBuildsMutex.Lock()
 	ret, specificReturn := fake.getDrainableBuildsReturnsOnCall[len(fake.getDrainableBuildsArgsForCall)]
@@ -553,6 +707,10 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.buildMutex.RUnlock()
 	fake.getAllStartedBuildsMutex.RLock()
 	defer fake.getAllStartedBuildsMutex.RUnlock()
+	fake.getArchivableBuildsMutex.RLock()
+	defer fake.getArchivableBuildsMutex.RUnlock()
+	fake.getBuildsBlockingWorkerMutex.RLock()
+	defer fake.getBuildsBlockingWorkerMutex.RUnlock()
 	fake.getDrainableBuildsMutex.RLock()
 	defer fake.getDrainableBuildsMutex.RUnlock()
 	fake.markNonInterceptibleBuildsMutex.RLock()
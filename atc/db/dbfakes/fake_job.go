@@ -91,6 +91,19 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	CreateBuildWithInputOverridesStub        func(atc.InputOverrides) (db.Build, error)
+	createBuildWithInputOverridesMutex       sync.RWMutex
+	createBuildWithInputOverridesArgsForCall []struct {
+		arg1 atc.InputOverrides
+	}
+	createBuildWithInputOverridesReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	createBuildWithInputOverridesReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
 	DeleteNextInputMappingStub        func() error
 	deleteNextInputMappingMutex       sync.RWMutex
 	deleteNextInputMappingArgsForCall []struct {
@@ -135,6 +148,19 @@ type FakeJob struct {
 	firstLoggedBuildIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	GetBuildInputsForMappingStub        func(algorithm.InputMapping) ([]db.BuildInput, error)
+	getBuildInputsForMappingMutex       sync.RWMutex
+	getBuildInputsForMappingArgsForCall []struct {
+		arg1 algorithm.InputMapping
+	}
+	getBuildInputsForMappingReturns struct {
+		result1 []db.BuildInput
+		result2 error
+	}
+	getBuildInputsForMappingReturnsOnCall map[int]struct {
+		result1 []db.BuildInput
+		result2 error
+	}
 	GetIndependentBuildInputsStub        func() ([]db.BuildInput, error)
 	getIndependentBuildInputsMutex       sync.RWMutex
 	getIndependentBuildInputsArgsForCall []struct {
@@ -730,6 +756,69 @@ func (fake *FakeJob) CreateBuildReturnsOnCall(i int, result1 db.Build, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeJob) CreateBuildWithInputOverrides(arg1 atc.InputOverrides) (db.Build, error) {
+	fake.createBuildWithInputOverridesMutex.Lock()
+	ret, specificReturn := fake.createBuildWithInputOverridesReturnsOnCall[len(fake.createBuildWithInputOverridesArgsForCall)]
+	fake.createBuildWithInputOverridesArgsForCall = append(fake.createBuildWithInputOverridesArgsForCall, struct {
+		arg1 atc.InputOverrides
+	}{arg1})
+	fake.recordInvocation("CreateBuildWithInputOverrides", []interface{}{arg1})
+	fake.createBuildWithInputOverridesMutex.Unlock()
+	if fake.CreateBuildWithInputOverridesStub != nil {
+		return fake.CreateBuildWithInputOverridesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createBuildWithInputOverridesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) CreateBuildWithInputOverridesCallCount() int {
+	fake.createBuildWithInputOverridesMutex.RLock()
+	defer fake.createBuildWithInputOverridesMutex.RUnlock()
+	return len(fake.createBuildWithInputOverridesArgsForCall)
+}
+
+func (fake *FakeJob) CreateBuildWithInputOverridesCalls(stub func(atc.InputOverrides) (db.Build, error)) {
+	fake.createBuildWithInputOverridesMutex.Lock()
+	defer fake.createBuildWithInputOverridesMutex.Unlock()
+	fake.CreateBuildWithInputOverridesStub = stub
+}
+
+func (fake *FakeJob) CreateBuildWithInputOverridesArgsForCall(i int) atc.InputOverrides {
+	fake.createBuildWithInputOverridesMutex.RLock()
+	defer fake.createBuildWithInputOverridesMutex.RUnlock()
+	argsForCall := fake.createBuildWithInputOverridesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) CreateBuildWithInputOverridesReturns(result1 db.Build, result2 error) {
+	fake.createBuildWithInputOverridesMutex.Lock()
+	defer fake.createBuildWithInputOverridesMutex.Unlock()
+	fake.CreateBuildWithInputOverridesStub = nil
+	fake.createBuildWithInputOverridesReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) CreateBuildWithInputOverridesReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.createBuildWithInputOverridesMutex.Lock()
+	defer fake.createBuildWithInputOverridesMutex.Unlock()
+	fake.CreateBuildWithInputOverridesStub = nil
+	if fake.createBuildWithInputOverridesReturnsOnCall == nil {
+		fake.createBuildWithInputOverridesReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.createBuildWithInputOverridesReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) DeleteNextInputMapping() error {
 	fake.deleteNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.deleteNextInputMappingReturnsOnCall[len(fake.deleteNextInputMappingArgsForCall)]
@@ -944,6 +1033,69 @@ func (fake *FakeJob) FirstLoggedBuildIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) GetBuildInputsForMapping(arg1 algorithm.InputMapping) ([]db.BuildInput, error) {
+	fake.getBuildInputsForMappingMutex.Lock()
+	ret, specificReturn := fake.getBuildInputsForMappingReturnsOnCall[len(fake.getBuildInputsForMappingArgsForCall)]
+	fake.getBuildInputsForMappingArgsForCall = append(fake.getBuildInputsForMappingArgsForCall, struct {
+		arg1 algorithm.InputMapping
+	}{arg1})
+	fake.recordInvocation("GetBuildInputsForMapping", []interface{}{arg1})
+	fake.getBuildInputsForMappingMutex.Unlock()
+	if fake.GetBuildInputsForMappingStub != nil {
+		return fake.GetBuildInputsForMappingStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getBuildInputsForMappingReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) GetBuildInputsForMappingCallCount() int {
+	fake.getBuildInputsForMappingMutex.RLock()
+	defer fake.getBuildInputsForMappingMutex.RUnlock()
+	return len(fake.getBuildInputsForMappingArgsForCall)
+}
+
+func (fake *FakeJob) GetBuildInputsForMappingCalls(stub func(algorithm.InputMapping) ([]db.BuildInput, error)) {
+	fake.getBuildInputsForMappingMutex.Lock()
+	defer fake.getBuildInputsForMappingMutex.Unlock()
+	fake.GetBuildInputsForMappingStub = stub
+}
+
+func (fake *FakeJob) GetBuildInputsForMappingArgsForCall(i int) algorithm.InputMapping {
+	fake.getBuildInputsForMappingMutex.RLock()
+	defer fake.getBuildInputsForMappingMutex.RUnlock()
+	argsForCall := fake.getBuildInputsForMappingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) GetBuildInputsForMappingReturns(result1 []db.BuildInput, result2 error) {
+	fake.getBuildInputsForMappingMutex.Lock()
+	defer fake.getBuildInputsForMappingMutex.Unlock()
+	fake.GetBuildInputsForMappingStub = nil
+	fake.getBuildInputsForMappingReturns = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) GetBuildInputsForMappingReturnsOnCall(i int, result1 []db.BuildInput, result2 error) {
+	fake.getBuildInputsForMappingMutex.Lock()
+	defer fake.getBuildInputsForMappingMutex.Unlock()
+	fake.GetBuildInputsForMappingStub = nil
+	if fake.getBuildInputsForMappingReturnsOnCall == nil {
+		fake.getBuildInputsForMappingReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildInput
+			result2 error
+		})
+	}
+	fake.getBuildInputsForMappingReturnsOnCall[i] = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) GetIndependentBuildInputs() ([]db.BuildInput, error) {
 	fake.getIndependentBuildInputsMutex.Lock()
 	ret, specificReturn := fake.getIndependentBuildInputsReturnsOnCall[len(fake.getIndependentBuildInputsArgsForCall)]
@@ -2081,6 +2233,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.configMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.createBuildWithInputOverridesMutex.RLock()
+	defer fake.createBuildWithInputOverridesMutex.RUnlock()
 	fake.deleteNextInputMappingMutex.RLock()
 	defer fake.deleteNextInputMappingMutex.RUnlock()
 	fake.ensurePendingBuildExistsMutex.RLock()
@@ -2089,6 +2243,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.finishedAndNextBuildMutex.RUnlock()
 	fake.firstLoggedBuildIDMutex.RLock()
 	defer fake.firstLoggedBuildIDMutex.RUnlock()
+	fake.getBuildInputsForMappingMutex.RLock()
+	defer fake.getBuildInputsForMappingMutex.RUnlock()
 	fake.getIndependentBuildInputsMutex.RLock()
 	defer fake.getIndependentBuildInputsMutex.RUnlock()
 	fake.getNextBuildInputsMutex.RLock()
@@ -119,6 +119,20 @@ type FakePipeline struct {
 		result1 db.Build
 		result2 error
 	}
+	CreateStartedCheckBuildStub        func(int, atc.Plan) (db.Build, error)
+	createStartedCheckBuildMutex       sync.RWMutex
+	createStartedCheckBuildArgsForCall []struct {
+		arg1 int
+		arg2 atc.Plan
+	}
+	createStartedCheckBuildReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	createStartedCheckBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
 	DashboardStub        func() (db.Dashboard, error)
 	dashboardMutex       sync.RWMutex
 	dashboardArgsForCall []struct {
@@ -433,6 +447,16 @@ type FakePipeline struct {
 	unpauseReturnsOnCall map[int]struct {
 		result1 error
 	}
+	VarSourcesStub        func() atc.VarSourceConfigs
+	varSourcesMutex       sync.RWMutex
+	varSourcesArgsForCall []struct {
+	}
+	varSourcesReturns struct {
+		result1 atc.VarSourceConfigs
+	}
+	varSourcesReturnsOnCall map[int]struct {
+		result1 atc.VarSourceConfigs
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -924,6 +948,70 @@ func (fake *FakePipeline) CreateStartedBuildReturnsOnCall(i int, result1 db.Buil
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) CreateStartedCheckBuild(arg1 int, arg2 atc.Plan) (db.Build, error) {
+	fake.createStartedCheckBuildMutex.Lock()
+	ret, specificReturn := fake.createStartedCheckBuildReturnsOnCall[len(fake.createStartedCheckBuildArgsForCall)]
+	fake.createStartedCheckBuildArgsForCall = append(fake.createStartedCheckBuildArgsForCall, struct {
+		arg1 int
+		arg2 atc.Plan
+	}{arg1, arg2})
+	fake.recordInvocation("CreateStartedCheckBuild", []interface{}{arg1, arg2})
+	fake.createStartedCheckBuildMutex.Unlock()
+	if fake.CreateStartedCheckBuildStub != nil {
+		return fake.CreateStartedCheckBuildStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createStartedCheckBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) CreateStartedCheckBuildCallCount() int {
+	fake.createStartedCheckBuildMutex.RLock()
+	defer fake.createStartedCheckBuildMutex.RUnlock()
+	return len(fake.createStartedCheckBuildArgsForCall)
+}
+
+func (fake *FakePipeline) CreateStartedCheckBuildCalls(stub func(int, atc.Plan) (db.Build, error)) {
+	fake.createStartedCheckBuildMutex.Lock()
+	defer fake.createStartedCheckBuildMutex.Unlock()
+	fake.CreateStartedCheckBuildStub = stub
+}
+
+func (fake *FakePipeline) CreateStartedCheckBuildArgsForCall(i int) (int, atc.Plan) {
+	fake.createStartedCheckBuildMutex.RLock()
+	defer fake.createStartedCheckBuildMutex.RUnlock()
+	argsForCall := fake.createStartedCheckBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakePipeline) CreateStartedCheckBuildReturns(result1 db.Build, result2 error) {
+	fake.createStartedCheckBuildMutex.Lock()
+	defer fake.createStartedCheckBuildMutex.Unlock()
+	fake.CreateStartedCheckBuildStub = nil
+	fake.createStartedCheckBuildReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) CreateStartedCheckBuildReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.createStartedCheckBuildMutex.Lock()
+	defer fake.createStartedCheckBuildMutex.Unlock()
+	fake.CreateStartedCheckBuildStub = nil
+	if fake.createStartedCheckBuildReturnsOnCall == nil {
+		fake.createStartedCheckBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.createStartedCheckBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) Dashboard() (db.Dashboard, error) {
 	fake.dashboardMutex.Lock()
 	ret, specificReturn := fake.dashboardReturnsOnCall[len(fake.dashboardArgsForCall)]
@@ -2450,6 +2538,58 @@ func (fake *FakePipeline) UnpauseReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakePipeline) VarSources() atc.VarSourceConfigs {
+	fake.varSourcesMutex.Lock()
+	ret, specificReturn := fake.varSourcesReturnsOnCall[len(fake.varSourcesArgsForCall)]
+	fake.varSourcesArgsForCall = append(fake.varSourcesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("VarSources", []interface{}{})
+	fake.varSourcesMutex.Unlock()
+	if fake.VarSourcesStub != nil {
+		return fake.VarSourcesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.varSourcesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) VarSourcesCallCount() int {
+	fake.varSourcesMutex.RLock()
+	defer fake.varSourcesMutex.RUnlock()
+	return len(fake.varSourcesArgsForCall)
+}
+
+func (fake *FakePipeline) VarSourcesCalls(stub func() atc.VarSourceConfigs) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = stub
+}
+
+func (fake *FakePipeline) VarSourcesReturns(result1 atc.VarSourceConfigs) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = nil
+	fake.varSourcesReturns = struct {
+		result1 atc.VarSourceConfigs
+	}{result1}
+}
+
+func (fake *FakePipeline) VarSourcesReturnsOnCall(i int, result1 atc.VarSourceConfigs) {
+	fake.varSourcesMutex.Lock()
+	defer fake.varSourcesMutex.Unlock()
+	fake.VarSourcesStub = nil
+	if fake.varSourcesReturnsOnCall == nil {
+		fake.varSourcesReturnsOnCall = make(map[int]struct {
+			result1 atc.VarSourceConfigs
+		})
+	}
+	fake.varSourcesReturnsOnCall[i] = struct {
+		result1 atc.VarSourceConfigs
+	}{result1}
+}
+
 func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -2469,6 +2609,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
+	fake.createStartedCheckBuildMutex.RLock()
+	defer fake.createStartedCheckBuildMutex.RUnlock()
 	fake.dashboardMutex.RLock()
 	defer fake.dashboardMutex.RUnlock()
 	fake.deleteBuildEventsByBuildIDsMutex.RLock()
@@ -2523,6 +2665,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.teamNameMutex.RUnlock()
 	fake.unpauseMutex.RLock()
 	defer fake.unpauseMutex.RUnlock()
+	fake.varSourcesMutex.RLock()
+	defer fake.varSourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -42,6 +42,18 @@ type FakeResource struct {
 	checkEveryReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CheckHistoryStub        func() ([]atc.ResourceCheck, error)
+	checkHistoryMutex       sync.RWMutex
+	checkHistoryArgsForCall []struct {
+	}
+	checkHistoryReturns struct {
+		result1 []atc.ResourceCheck
+		result2 error
+	}
+	checkHistoryReturnsOnCall map[int]struct {
+		result1 []atc.ResourceCheck
+		result2 error
+	}
 	CheckSetupErrorStub        func() error
 	checkSetupErrorMutex       sync.RWMutex
 	checkSetupErrorArgsForCall []struct {
@@ -258,6 +270,17 @@ type FakeResource struct {
 		result1 bool
 		result2 error
 	}
+	SaveWebhookDeliveryStub        func(atc.WebhookDelivery) error
+	saveWebhookDeliveryMutex       sync.RWMutex
+	saveWebhookDeliveryArgsForCall []struct {
+		arg1 atc.WebhookDelivery
+	}
+	saveWebhookDeliveryReturns struct {
+		result1 error
+	}
+	saveWebhookDeliveryReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetCheckSetupErrorStub        func(error) error
 	setCheckSetupErrorMutex       sync.RWMutex
 	setCheckSetupErrorArgsForCall []struct {
@@ -362,6 +385,28 @@ type FakeResource struct {
 		result3 bool
 		result4 error
 	}
+	WebhookStub        func() *atc.WebhookConfig
+	webhookMutex       sync.RWMutex
+	webhookArgsForCall []struct {
+	}
+	webhookReturns struct {
+		result1 *atc.WebhookConfig
+	}
+	webhookReturnsOnCall map[int]struct {
+		result1 *atc.WebhookConfig
+	}
+	WebhookDeliveriesStub        func() ([]atc.WebhookDelivery, error)
+	webhookDeliveriesMutex       sync.RWMutex
+	webhookDeliveriesArgsForCall []struct {
+	}
+	webhookDeliveriesReturns struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}
+	webhookDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}
 	WebhookTokenStub        func() string
 	webhookTokenMutex       sync.RWMutex
 	webhookTokenArgsForCall []struct {
@@ -532,6 +577,61 @@ func (fake *FakeResource) CheckEveryReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) CheckHistory() ([]atc.ResourceCheck, error) {
+	fake.checkHistoryMutex.Lock()
+	ret, specificReturn := fake.checkHistoryReturnsOnCall[len(fake.checkHistoryArgsForCall)]
+	fake.checkHistoryArgsForCall = append(fake.checkHistoryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CheckHistory", []interface{}{})
+	fake.checkHistoryMutex.Unlock()
+	if fake.CheckHistoryStub != nil {
+		return fake.CheckHistoryStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checkHistoryReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) CheckHistoryCallCount() int {
+	fake.checkHistoryMutex.RLock()
+	defer fake.checkHistoryMutex.RUnlock()
+	return len(fake.checkHistoryArgsForCall)
+}
+
+func (fake *FakeResource) CheckHistoryCalls(stub func() ([]atc.ResourceCheck, error)) {
+	fake.checkHistoryMutex.Lock()
+	defer fake.checkHistoryMutex.Unlock()
+	fake.CheckHistoryStub = stub
+}
+
+func (fake *FakeResource) CheckHistoryReturns(result1 []atc.ResourceCheck, result2 error) {
+	fake.checkHistoryMutex.Lock()
+	defer fake.checkHistoryMutex.Unlock()
+	fake.CheckHistoryStub = nil
+	fake.checkHistoryReturns = struct {
+		result1 []atc.ResourceCheck
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) CheckHistoryReturnsOnCall(i int, result1 []atc.ResourceCheck, result2 error) {
+	fake.checkHistoryMutex.Lock()
+	defer fake.checkHistoryMutex.Unlock()
+	fake.CheckHistoryStub = nil
+	if fake.checkHistoryReturnsOnCall == nil {
+		fake.checkHistoryReturnsOnCall = make(map[int]struct {
+			result1 []atc.ResourceCheck
+			result2 error
+		})
+	}
+	fake.checkHistoryReturnsOnCall[i] = struct {
+		result1 []atc.ResourceCheck
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) CheckSetupError() error {
 	fake.checkSetupErrorMutex.Lock()
 	ret, specificReturn := fake.checkSetupErrorReturnsOnCall[len(fake.checkSetupErrorArgsForCall)]
@@ -1627,6 +1727,66 @@ func (fake *FakeResource) SaveUncheckedVersionReturnsOnCall(i int, result1 bool,
 	}{result1, result2}
 }
 
+func (fake *FakeResource) SaveWebhookDelivery(arg1 atc.WebhookDelivery) error {
+	fake.saveWebhookDeliveryMutex.Lock()
+	ret, specificReturn := fake.saveWebhookDeliveryReturnsOnCall[len(fake.saveWebhookDeliveryArgsForCall)]
+	fake.saveWebhookDeliveryArgsForCall = append(fake.saveWebhookDeliveryArgsForCall, struct {
+		arg1 atc.WebhookDelivery
+	}{arg1})
+	fake.recordInvocation("SaveWebhookDelivery", []interface{}{arg1})
+	fake.saveWebhookDeliveryMutex.Unlock()
+	if fake.SaveWebhookDeliveryStub != nil {
+		return fake.SaveWebhookDeliveryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveWebhookDeliveryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) SaveWebhookDeliveryCallCount() int {
+	fake.saveWebhookDeliveryMutex.RLock()
+	defer fake.saveWebhookDeliveryMutex.RUnlock()
+	return len(fake.saveWebhookDeliveryArgsForCall)
+}
+
+func (fake *FakeResource) SaveWebhookDeliveryCalls(stub func(atc.WebhookDelivery) error) {
+	fake.saveWebhookDeliveryMutex.Lock()
+	defer fake.saveWebhookDeliveryMutex.Unlock()
+	fake.SaveWebhookDeliveryStub = stub
+}
+
+func (fake *FakeResource) SaveWebhookDeliveryArgsForCall(i int) atc.WebhookDelivery {
+	fake.saveWebhookDeliveryMutex.RLock()
+	defer fake.saveWebhookDeliveryMutex.RUnlock()
+	argsForCall := fake.saveWebhookDeliveryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResource) SaveWebhookDeliveryReturns(result1 error) {
+	fake.saveWebhookDeliveryMutex.Lock()
+	defer fake.saveWebhookDeliveryMutex.Unlock()
+	fake.SaveWebhookDeliveryStub = nil
+	fake.saveWebhookDeliveryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResource) SaveWebhookDeliveryReturnsOnCall(i int, result1 error) {
+	fake.saveWebhookDeliveryMutex.Lock()
+	defer fake.saveWebhookDeliveryMutex.Unlock()
+	fake.SaveWebhookDeliveryStub = nil
+	if fake.saveWebhookDeliveryReturnsOnCall == nil {
+		fake.saveWebhookDeliveryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveWebhookDeliveryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResource) SetCheckSetupError(arg1 error) error {
 	fake.setCheckSetupErrorMutex.Lock()
 	ret, specificReturn := fake.setCheckSetupErrorReturnsOnCall[len(fake.setCheckSetupErrorArgsForCall)]
@@ -2141,6 +2301,113 @@ func (fake *FakeResource) VersionsReturnsOnCall(i int, result1 []atc.ResourceVer
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeResource) Webhook() *atc.WebhookConfig {
+	fake.webhookMutex.Lock()
+	ret, specificReturn := fake.webhookReturnsOnCall[len(fake.webhookArgsForCall)]
+	fake.webhookArgsForCall = append(fake.webhookArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Webhook", []interface{}{})
+	fake.webhookMutex.Unlock()
+	if fake.WebhookStub != nil {
+		return fake.WebhookStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.webhookReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) WebhookCallCount() int {
+	fake.webhookMutex.RLock()
+	defer fake.webhookMutex.RUnlock()
+	return len(fake.webhookArgsForCall)
+}
+
+func (fake *FakeResource) WebhookCalls(stub func() *atc.WebhookConfig) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = stub
+}
+
+func (fake *FakeResource) WebhookReturns(result1 *atc.WebhookConfig) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = nil
+	fake.webhookReturns = struct {
+		result1 *atc.WebhookConfig
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookReturnsOnCall(i int, result1 *atc.WebhookConfig) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = nil
+	if fake.webhookReturnsOnCall == nil {
+		fake.webhookReturnsOnCall = make(map[int]struct {
+			result1 *atc.WebhookConfig
+		})
+	}
+	fake.webhookReturnsOnCall[i] = struct {
+		result1 *atc.WebhookConfig
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookDeliveries() ([]atc.WebhookDelivery, error) {
+	fake.webhookDeliveriesMutex.Lock()
+	ret, specificReturn := fake.webhookDeliveriesReturnsOnCall[len(fake.webhookDeliveriesArgsForCall)]
+	fake.webhookDeliveriesArgsForCall = append(fake.webhookDeliveriesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WebhookDeliveries", []interface{}{})
+	fake.webhookDeliveriesMutex.Unlock()
+	if fake.WebhookDeliveriesStub != nil {
+		return fake.WebhookDeliveriesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.webhookDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) WebhookDeliveriesCallCount() int {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	return len(fake.webhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeResource) WebhookDeliveriesCalls(stub func() ([]atc.WebhookDelivery, error)) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = stub
+}
+
+func (fake *FakeResource) WebhookDeliveriesReturns(result1 []atc.WebhookDelivery, result2 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	fake.webhookDeliveriesReturns = struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) WebhookDeliveriesReturnsOnCall(i int, result1 []atc.WebhookDelivery, result2 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	if fake.webhookDeliveriesReturnsOnCall == nil {
+		fake.webhookDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.WebhookDelivery
+			result2 error
+		})
+	}
+	fake.webhookDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) WebhookToken() string {
 	fake.webhookTokenMutex.Lock()
 	ret, specificReturn := fake.webhookTokenReturnsOnCall[len(fake.webhookTokenArgsForCall)]
@@ -2202,6 +2469,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.checkErrorMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
+	fake.checkHistoryMutex.RLock()
+	defer fake.checkHistoryMutex.RUnlock()
 	fake.checkSetupErrorMutex.RLock()
 	defer fake.checkSetupErrorMutex.RUnlock()
 	fake.checkTimeoutMutex.RLock()
@@ -2242,6 +2511,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.resourceConfigVersionIDMutex.RUnlock()
 	fake.saveUncheckedVersionMutex.RLock()
 	defer fake.saveUncheckedVersionMutex.RUnlock()
+	fake.saveWebhookDeliveryMutex.RLock()
+	defer fake.saveWebhookDeliveryMutex.RUnlock()
 	fake.setCheckSetupErrorMutex.RLock()
 	defer fake.setCheckSetupErrorMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
@@ -2260,6 +2531,10 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.unpinVersionMutex.RUnlock()
 	fake.versionsMutex.RLock()
 	defer fake.versionsMutex.RUnlock()
+	fake.webhookMutex.RLock()
+	defer fake.webhookMutex.RUnlock()
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
 	fake.webhookTokenMutex.RLock()
 	defer fake.webhookTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -77,6 +77,17 @@ type FakeResourceConfigScope struct {
 		result2 bool
 		result3 error
 	}
+	RecordCheckStub        func(atc.ResourceCheck) error
+	recordCheckMutex       sync.RWMutex
+	recordCheckArgsForCall []struct {
+		arg1 atc.ResourceCheck
+	}
+	recordCheckReturns struct {
+		result1 error
+	}
+	recordCheckReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ResourceStub        func() db.Resource
 	resourceMutex       sync.RWMutex
 	resourceArgsForCall []struct {
@@ -444,6 +455,66 @@ func (fake *FakeResourceConfigScope) LatestVersionReturnsOnCall(i int, result1 d
 	}{result1, result2, result3}
 }
 
+func (fake *FakeResourceConfigScope) RecordCheck(arg1 atc.ResourceCheck) error {
+	fake.recordCheckMutex.Lock()
+	ret, specificReturn := fake.recordCheckReturnsOnCall[len(fake.recordCheckArgsForCall)]
+	fake.recordCheckArgsForCall = append(fake.recordCheckArgsForCall, struct {
+		arg1 atc.ResourceCheck
+	}{arg1})
+	fake.recordInvocation("RecordCheck", []interface{}{arg1})
+	fake.recordCheckMutex.Unlock()
+	if fake.RecordCheckStub != nil {
+		return fake.RecordCheckStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordCheckReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceConfigScope) RecordCheckCallCount() int {
+	fake.recordCheckMutex.RLock()
+	defer fake.recordCheckMutex.RUnlock()
+	return len(fake.recordCheckArgsForCall)
+}
+
+func (fake *FakeResourceConfigScope) RecordCheckCalls(stub func(atc.ResourceCheck) error) {
+	fake.recordCheckMutex.Lock()
+	defer fake.recordCheckMutex.Unlock()
+	fake.RecordCheckStub = stub
+}
+
+func (fake *FakeResourceConfigScope) RecordCheckArgsForCall(i int) atc.ResourceCheck {
+	fake.recordCheckMutex.RLock()
+	defer fake.recordCheckMutex.RUnlock()
+	argsForCall := fake.recordCheckArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResourceConfigScope) RecordCheckReturns(result1 error) {
+	fake.recordCheckMutex.Lock()
+	defer fake.recordCheckMutex.Unlock()
+	fake.RecordCheckStub = nil
+	fake.recordCheckReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResourceConfigScope) RecordCheckReturnsOnCall(i int, result1 error) {
+	fake.recordCheckMutex.Lock()
+	defer fake.recordCheckMutex.Unlock()
+	fake.RecordCheckStub = nil
+	if fake.recordCheckReturnsOnCall == nil {
+		fake.recordCheckReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordCheckReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResourceConfigScope) Resource() db.Resource {
 	fake.resourceMutex.Lock()
 	ret, specificReturn := fake.resourceReturnsOnCall[len(fake.resourceArgsForCall)]
@@ -805,6 +876,8 @@ func (fake *FakeResourceConfigScope) Invocations() map[string][][]interface{} {
 	defer fake.iDMutex.RUnlock()
 	fake.latestVersionMutex.RLock()
 	defer fake.latestVersionMutex.RUnlock()
+	fake.recordCheckMutex.RLock()
+	defer fake.recordCheckMutex.RUnlock()
 	fake.resourceMutex.RLock()
 	defer fake.resourceMutex.RUnlock()
 	fake.resourceConfigMutex.RLock()
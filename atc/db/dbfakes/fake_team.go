@@ -75,6 +75,18 @@ type FakeTeam struct {
 		result1 []db.Container
 		result2 error
 	}
+	ContainersQuotaReachedStub        func() (bool, error)
+	containersQuotaReachedMutex       sync.RWMutex
+	containersQuotaReachedArgsForCall []struct {
+	}
+	containersQuotaReachedReturns struct {
+		result1 bool
+		result2 error
+	}
+	containersQuotaReachedReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	CreateOneOffBuildStub        func() (db.Build, error)
 	createOneOffBuildMutex       sync.RWMutex
 	createOneOffBuildArgsForCall []struct {
@@ -100,6 +112,16 @@ type FakeTeam struct {
 		result1 db.Build
 		result2 error
 	}
+	CredentialManagersStub        func() []string
+	credentialManagersMutex       sync.RWMutex
+	credentialManagersArgsForCall []struct {
+	}
+	credentialManagersReturns struct {
+		result1 []string
+	}
+	credentialManagersReturnsOnCall map[int]struct {
+		result1 []string
+	}
 	DeleteStub        func() error
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -328,6 +350,16 @@ type FakeTeam struct {
 		result1 []db.Pipeline
 		result2 error
 	}
+	QuotasStub        func() atc.TeamQuotas
+	quotasMutex       sync.RWMutex
+	quotasArgsForCall []struct {
+	}
+	quotasReturns struct {
+		result1 atc.TeamQuotas
+	}
+	quotasReturnsOnCall map[int]struct {
+		result1 atc.TeamQuotas
+	}
 	RenameStub        func(string) error
 	renameMutex       sync.RWMutex
 	renameArgsForCall []struct {
@@ -371,6 +403,17 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	UpdateCredentialManagersStub        func([]string) error
+	updateCredentialManagersMutex       sync.RWMutex
+	updateCredentialManagersArgsForCall []struct {
+		arg1 []string
+	}
+	updateCredentialManagersReturns struct {
+		result1 error
+	}
+	updateCredentialManagersReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -382,6 +425,17 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateQuotasStub        func(atc.TeamQuotas) error
+	updateQuotasMutex       sync.RWMutex
+	updateQuotasArgsForCall []struct {
+		arg1 atc.TeamQuotas
+	}
+	updateQuotasReturns struct {
+		result1 error
+	}
+	updateQuotasReturnsOnCall map[int]struct {
+		result1 error
+	}
 	VisiblePipelinesStub        func() ([]db.Pipeline, error)
 	visiblePipelinesMutex       sync.RWMutex
 	visiblePipelinesArgsForCall []struct {
@@ -709,6 +763,61 @@ func (fake *FakeTeam) ContainersReturnsOnCall(i int, result1 []db.Container, res
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) ContainersQuotaReached() (bool, error) {
+	fake.containersQuotaReachedMutex.Lock()
+	ret, specificReturn := fake.containersQuotaReachedReturnsOnCall[len(fake.containersQuotaReachedArgsForCall)]
+	fake.containersQuotaReachedArgsForCall = append(fake.containersQuotaReachedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ContainersQuotaReached", []interface{}{})
+	fake.containersQuotaReachedMutex.Unlock()
+	if fake.ContainersQuotaReachedStub != nil {
+		return fake.ContainersQuotaReachedStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.containersQuotaReachedReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ContainersQuotaReachedCallCount() int {
+	fake.containersQuotaReachedMutex.RLock()
+	defer fake.containersQuotaReachedMutex.RUnlock()
+	return len(fake.containersQuotaReachedArgsForCall)
+}
+
+func (fake *FakeTeam) ContainersQuotaReachedCalls(stub func() (bool, error)) {
+	fake.containersQuotaReachedMutex.Lock()
+	defer fake.containersQuotaReachedMutex.Unlock()
+	fake.ContainersQuotaReachedStub = stub
+}
+
+func (fake *FakeTeam) ContainersQuotaReachedReturns(result1 bool, result2 error) {
+	fake.containersQuotaReachedMutex.Lock()
+	defer fake.containersQuotaReachedMutex.Unlock()
+	fake.ContainersQuotaReachedStub = nil
+	fake.containersQuotaReachedReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ContainersQuotaReachedReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.containersQuotaReachedMutex.Lock()
+	defer fake.containersQuotaReachedMutex.Unlock()
+	fake.ContainersQuotaReachedStub = nil
+	if fake.containersQuotaReachedReturnsOnCall == nil {
+		fake.containersQuotaReachedReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.containersQuotaReachedReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CreateOneOffBuild() (db.Build, error) {
 	fake.createOneOffBuildMutex.Lock()
 	ret, specificReturn := fake.createOneOffBuildReturnsOnCall[len(fake.createOneOffBuildArgsForCall)]
@@ -827,6 +936,58 @@ func (fake *FakeTeam) CreateStartedBuildReturnsOnCall(i int, result1 db.Build, r
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CredentialManagers() []string {
+	fake.credentialManagersMutex.Lock()
+	ret, specificReturn := fake.credentialManagersReturnsOnCall[len(fake.credentialManagersArgsForCall)]
+	fake.credentialManagersArgsForCall = append(fake.credentialManagersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CredentialManagers", []interface{}{})
+	fake.credentialManagersMutex.Unlock()
+	if fake.CredentialManagersStub != nil {
+		return fake.CredentialManagersStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.credentialManagersReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) CredentialManagersCallCount() int {
+	fake.credentialManagersMutex.RLock()
+	defer fake.credentialManagersMutex.RUnlock()
+	return len(fake.credentialManagersArgsForCall)
+}
+
+func (fake *FakeTeam) CredentialManagersCalls(stub func() []string) {
+	fake.credentialManagersMutex.Lock()
+	defer fake.credentialManagersMutex.Unlock()
+	fake.CredentialManagersStub = stub
+}
+
+func (fake *FakeTeam) CredentialManagersReturns(result1 []string) {
+	fake.credentialManagersMutex.Lock()
+	defer fake.credentialManagersMutex.Unlock()
+	fake.CredentialManagersStub = nil
+	fake.credentialManagersReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeTeam) CredentialManagersReturnsOnCall(i int, result1 []string) {
+	fake.credentialManagersMutex.Lock()
+	defer fake.credentialManagersMutex.Unlock()
+	fake.CredentialManagersStub = nil
+	if fake.credentialManagersReturnsOnCall == nil {
+		fake.credentialManagersReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.credentialManagersReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeTeam) Delete() error {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1879,6 +2040,58 @@ func (fake *FakeTeam) PublicPipelinesReturnsOnCall(i int, result1 []db.Pipeline,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) Quotas() atc.TeamQuotas {
+	fake.quotasMutex.Lock()
+	ret, specificReturn := fake.quotasReturnsOnCall[len(fake.quotasArgsForCall)]
+	fake.quotasArgsForCall = append(fake.quotasArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Quotas", []interface{}{})
+	fake.quotasMutex.Unlock()
+	if fake.QuotasStub != nil {
+		return fake.QuotasStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.quotasReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) QuotasCallCount() int {
+	fake.quotasMutex.RLock()
+	defer fake.quotasMutex.RUnlock()
+	return len(fake.quotasArgsForCall)
+}
+
+func (fake *FakeTeam) QuotasCalls(stub func() atc.TeamQuotas) {
+	fake.quotasMutex.Lock()
+	defer fake.quotasMutex.Unlock()
+	fake.QuotasStub = stub
+}
+
+func (fake *FakeTeam) QuotasReturns(result1 atc.TeamQuotas) {
+	fake.quotasMutex.Lock()
+	defer fake.quotasMutex.Unlock()
+	fake.QuotasStub = nil
+	fake.quotasReturns = struct {
+		result1 atc.TeamQuotas
+	}{result1}
+}
+
+func (fake *FakeTeam) QuotasReturnsOnCall(i int, result1 atc.TeamQuotas) {
+	fake.quotasMutex.Lock()
+	defer fake.quotasMutex.Unlock()
+	fake.QuotasStub = nil
+	if fake.quotasReturnsOnCall == nil {
+		fake.quotasReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamQuotas
+		})
+	}
+	fake.quotasReturnsOnCall[i] = struct {
+		result1 atc.TeamQuotas
+	}{result1}
+}
+
 func (fake *FakeTeam) Rename(arg1 string) error {
 	fake.renameMutex.Lock()
 	ret, specificReturn := fake.renameReturnsOnCall[len(fake.renameArgsForCall)]
@@ -2072,6 +2285,71 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) UpdateCredentialManagers(arg1 []string) error {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.updateCredentialManagersMutex.Lock()
+	ret, specificReturn := fake.updateCredentialManagersReturnsOnCall[len(fake.updateCredentialManagersArgsForCall)]
+	fake.updateCredentialManagersArgsForCall = append(fake.updateCredentialManagersArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	fake.recordInvocation("UpdateCredentialManagers", []interface{}{arg1Copy})
+	fake.updateCredentialManagersMutex.Unlock()
+	if fake.UpdateCredentialManagersStub != nil {
+		return fake.UpdateCredentialManagersStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateCredentialManagersReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateCredentialManagersCallCount() int {
+	fake.updateCredentialManagersMutex.RLock()
+	defer fake.updateCredentialManagersMutex.RUnlock()
+	return len(fake.updateCredentialManagersArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateCredentialManagersCalls(stub func([]string) error) {
+	fake.updateCredentialManagersMutex.Lock()
+	defer fake.updateCredentialManagersMutex.Unlock()
+	fake.UpdateCredentialManagersStub = stub
+}
+
+func (fake *FakeTeam) UpdateCredentialManagersArgsForCall(i int) []string {
+	fake.updateCredentialManagersMutex.RLock()
+	defer fake.updateCredentialManagersMutex.RUnlock()
+	argsForCall := fake.updateCredentialManagersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateCredentialManagersReturns(result1 error) {
+	fake.updateCredentialManagersMutex.Lock()
+	defer fake.updateCredentialManagersMutex.Unlock()
+	fake.UpdateCredentialManagersStub = nil
+	fake.updateCredentialManagersReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateCredentialManagersReturnsOnCall(i int, result1 error) {
+	fake.updateCredentialManagersMutex.Lock()
+	defer fake.updateCredentialManagersMutex.Unlock()
+	fake.UpdateCredentialManagersStub = nil
+	if fake.updateCredentialManagersReturnsOnCall == nil {
+		fake.updateCredentialManagersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateCredentialManagersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2132,6 +2410,66 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) UpdateQuotas(arg1 atc.TeamQuotas) error {
+	fake.updateQuotasMutex.Lock()
+	ret, specificReturn := fake.updateQuotasReturnsOnCall[len(fake.updateQuotasArgsForCall)]
+	fake.updateQuotasArgsForCall = append(fake.updateQuotasArgsForCall, struct {
+		arg1 atc.TeamQuotas
+	}{arg1})
+	fake.recordInvocation("UpdateQuotas", []interface{}{arg1})
+	fake.updateQuotasMutex.Unlock()
+	if fake.UpdateQuotasStub != nil {
+		return fake.UpdateQuotasStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.updateQuotasReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateQuotasCallCount() int {
+	fake.updateQuotasMutex.RLock()
+	defer fake.updateQuotasMutex.RUnlock()
+	return len(fake.updateQuotasArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateQuotasCalls(stub func(atc.TeamQuotas) error) {
+	fake.updateQuotasMutex.Lock()
+	defer fake.updateQuotasMutex.Unlock()
+	fake.UpdateQuotasStub = stub
+}
+
+func (fake *FakeTeam) UpdateQuotasArgsForCall(i int) atc.TeamQuotas {
+	fake.updateQuotasMutex.RLock()
+	defer fake.updateQuotasMutex.RUnlock()
+	argsForCall := fake.updateQuotasArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateQuotasReturns(result1 error) {
+	fake.updateQuotasMutex.Lock()
+	defer fake.updateQuotasMutex.Unlock()
+	fake.UpdateQuotasStub = nil
+	fake.updateQuotasReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateQuotasReturnsOnCall(i int, result1 error) {
+	fake.updateQuotasMutex.Lock()
+	defer fake.updateQuotasMutex.Unlock()
+	fake.UpdateQuotasStub = nil
+	if fake.updateQuotasReturnsOnCall == nil {
+		fake.updateQuotasReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateQuotasReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) VisiblePipelines() ([]db.Pipeline, error) {
 	fake.visiblePipelinesMutex.Lock()
 	ret, specificReturn := fake.visiblePipelinesReturnsOnCall[len(fake.visiblePipelinesArgsForCall)]
@@ -2255,10 +2593,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.buildsWithTimeMutex.RUnlock()
 	fake.containersMutex.RLock()
 	defer fake.containersMutex.RUnlock()
+	fake.containersQuotaReachedMutex.RLock()
+	defer fake.containersQuotaReachedMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
+	fake.credentialManagersMutex.RLock()
+	defer fake.credentialManagersMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.findCheckContainersMutex.RLock()
@@ -2293,14 +2635,20 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.privateAndPublicBuildsMutex.RUnlock()
 	fake.publicPipelinesMutex.RLock()
 	defer fake.publicPipelinesMutex.RUnlock()
+	fake.quotasMutex.RLock()
+	defer fake.quotasMutex.RUnlock()
 	fake.renameMutex.RLock()
 	defer fake.renameMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.updateCredentialManagersMutex.RLock()
+	defer fake.updateCredentialManagersMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.updateQuotasMutex.RLock()
+	defer fake.updateQuotasMutex.RUnlock()
 	fake.visiblePipelinesMutex.RLock()
 	defer fake.visiblePipelinesMutex.RUnlock()
 	fake.workersMutex.RLock()
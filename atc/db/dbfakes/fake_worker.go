@@ -10,6 +10,16 @@ import (
 )
 
 type FakeWorker struct {
+	AbortAfterDrainDeadlineStub        func() bool
+	abortAfterDrainDeadlineMutex       sync.RWMutex
+	abortAfterDrainDeadlineArgsForCall []struct {
+	}
+	abortAfterDrainDeadlineReturns struct {
+		result1 bool
+	}
+	abortAfterDrainDeadlineReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	ActiveContainersStub        func() int
 	activeContainersMutex       sync.RWMutex
 	activeContainersArgsForCall []struct {
@@ -50,6 +60,26 @@ type FakeWorker struct {
 	certsPathReturnsOnCall map[int]struct {
 		result1 *string
 	}
+	CordonStub        func() error
+	cordonMutex       sync.RWMutex
+	cordonArgsForCall []struct {
+	}
+	cordonReturns struct {
+		result1 error
+	}
+	cordonReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CordonedStub        func() bool
+	cordonedMutex       sync.RWMutex
+	cordonedArgsForCall []struct {
+	}
+	cordonedReturns struct {
+		result1 bool
+	}
+	cordonedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	CreateContainerStub        func(db.ContainerOwner, db.ContainerMetadata) (db.CreatingContainer, error)
 	createContainerMutex       sync.RWMutex
 	createContainerArgsForCall []struct {
@@ -74,6 +104,16 @@ type FakeWorker struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DrainDeadlineStub        func() time.Time
+	drainDeadlineMutex       sync.RWMutex
+	drainDeadlineArgsForCall []struct {
+	}
+	drainDeadlineReturns struct {
+		result1 time.Time
+	}
+	drainDeadlineReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	EphemeralStub        func() bool
 	ephemeralMutex       sync.RWMutex
 	ephemeralArgsForCall []struct {
@@ -149,6 +189,26 @@ type FakeWorker struct {
 	landReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MaintenanceEndStub        func() time.Time
+	maintenanceEndMutex       sync.RWMutex
+	maintenanceEndArgsForCall []struct {
+	}
+	maintenanceEndReturns struct {
+		result1 time.Time
+	}
+	maintenanceEndReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
+	MaintenanceStartStub        func() time.Time
+	maintenanceStartMutex       sync.RWMutex
+	maintenanceStartArgsForCall []struct {
+	}
+	maintenanceStartReturns struct {
+		result1 time.Time
+	}
+	maintenanceStartReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -225,6 +285,16 @@ type FakeWorker struct {
 	resourceTypesReturnsOnCall map[int]struct {
 		result1 []atc.WorkerResourceType
 	}
+	ResourcesStub        func() *atc.WorkerResources
+	resourcesMutex       sync.RWMutex
+	resourcesArgsForCall []struct {
+	}
+	resourcesReturns struct {
+		result1 *atc.WorkerResources
+	}
+	resourcesReturnsOnCall map[int]struct {
+		result1 *atc.WorkerResources
+	}
 	RetireStub        func() error
 	retireMutex       sync.RWMutex
 	retireArgsForCall []struct {
@@ -235,6 +305,30 @@ type FakeWorker struct {
 	retireReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ScheduleMaintenanceStub        func(time.Time, time.Time) error
+	scheduleMaintenanceMutex       sync.RWMutex
+	scheduleMaintenanceArgsForCall []struct {
+		arg1 time.Time
+		arg2 time.Time
+	}
+	scheduleMaintenanceReturns struct {
+		result1 error
+	}
+	scheduleMaintenanceReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetDrainDeadlineStub        func(time.Time, bool) error
+	setDrainDeadlineMutex       sync.RWMutex
+	setDrainDeadlineArgsForCall []struct {
+		arg1 time.Time
+		arg2 bool
+	}
+	setDrainDeadlineReturns struct {
+		result1 error
+	}
+	setDrainDeadlineReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartTimeStub        func() int64
 	startTimeMutex       sync.RWMutex
 	startTimeArgsForCall []struct {
@@ -285,6 +379,16 @@ type FakeWorker struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UncordonStub        func() error
+	uncordonMutex       sync.RWMutex
+	uncordonArgsForCall []struct {
+	}
+	uncordonReturns struct {
+		result1 error
+	}
+	uncordonReturnsOnCall map[int]struct {
+		result1 error
+	}
 	VersionStub        func() *string
 	versionMutex       sync.RWMutex
 	versionArgsForCall []struct {
@@ -299,6 +403,58 @@ type FakeWorker struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeWorker) AbortAfterDrainDeadline() bool {
+	fake.abortAfterDrainDeadlineMutex.Lock()
+	ret, specificReturn := fake.abortAfterDrainDeadlineReturnsOnCall[len(fake.abortAfterDrainDeadlineArgsForCall)]
+	fake.abortAfterDrainDeadlineArgsForCall = append(fake.abortAfterDrainDeadlineArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AbortAfterDrainDeadline", []interface{}{})
+	fake.abortAfterDrainDeadlineMutex.Unlock()
+	if fake.AbortAfterDrainDeadlineStub != nil {
+		return fake.AbortAfterDrainDeadlineStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.abortAfterDrainDeadlineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) AbortAfterDrainDeadlineCallCount() int {
+	fake.abortAfterDrainDeadlineMutex.RLock()
+	defer fake.abortAfterDrainDeadlineMutex.RUnlock()
+	return len(fake.abortAfterDrainDeadlineArgsForCall)
+}
+
+func (fake *FakeWorker) AbortAfterDrainDeadlineCalls(stub func() bool) {
+	fake.abortAfterDrainDeadlineMutex.Lock()
+	defer fake.abortAfterDrainDeadlineMutex.Unlock()
+	fake.AbortAfterDrainDeadlineStub = stub
+}
+
+func (fake *FakeWorker) AbortAfterDrainDeadlineReturns(result1 bool) {
+	fake.abortAfterDrainDeadlineMutex.Lock()
+	defer fake.abortAfterDrainDeadlineMutex.Unlock()
+	fake.AbortAfterDrainDeadlineStub = nil
+	fake.abortAfterDrainDeadlineReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorker) AbortAfterDrainDeadlineReturnsOnCall(i int, result1 bool) {
+	fake.abortAfterDrainDeadlineMutex.Lock()
+	defer fake.abortAfterDrainDeadlineMutex.Unlock()
+	fake.AbortAfterDrainDeadlineStub = nil
+	if fake.abortAfterDrainDeadlineReturnsOnCall == nil {
+		fake.abortAfterDrainDeadlineReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.abortAfterDrainDeadlineReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeWorker) ActiveContainers() int {
 	fake.activeContainersMutex.Lock()
 	ret, specificReturn := fake.activeContainersReturnsOnCall[len(fake.activeContainersArgsForCall)]
@@ -507,6 +663,110 @@ func (fake *FakeWorker) CertsPathReturnsOnCall(i int, result1 *string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Cordon() error {
+	fake.cordonMutex.Lock()
+	ret, specificReturn := fake.cordonReturnsOnCall[len(fake.cordonArgsForCall)]
+	fake.cordonArgsForCall = append(fake.cordonArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Cordon", []interface{}{})
+	fake.cordonMutex.Unlock()
+	if fake.CordonStub != nil {
+		return fake.CordonStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cordonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) CordonCallCount() int {
+	fake.cordonMutex.RLock()
+	defer fake.cordonMutex.RUnlock()
+	return len(fake.cordonArgsForCall)
+}
+
+func (fake *FakeWorker) CordonCalls(stub func() error) {
+	fake.cordonMutex.Lock()
+	defer fake.cordonMutex.Unlock()
+	fake.CordonStub = stub
+}
+
+func (fake *FakeWorker) CordonReturns(result1 error) {
+	fake.cordonMutex.Lock()
+	defer fake.cordonMutex.Unlock()
+	fake.CordonStub = nil
+	fake.cordonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) CordonReturnsOnCall(i int, result1 error) {
+	fake.cordonMutex.Lock()
+	defer fake.cordonMutex.Unlock()
+	fake.CordonStub = nil
+	if fake.cordonReturnsOnCall == nil {
+		fake.cordonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) Cordoned() bool {
+	fake.cordonedMutex.Lock()
+	ret, specificReturn := fake.cordonedReturnsOnCall[len(fake.cordonedArgsForCall)]
+	fake.cordonedArgsForCall = append(fake.cordonedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Cordoned", []interface{}{})
+	fake.cordonedMutex.Unlock()
+	if fake.CordonedStub != nil {
+		return fake.CordonedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cordonedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) CordonedCallCount() int {
+	fake.cordonedMutex.RLock()
+	defer fake.cordonedMutex.RUnlock()
+	return len(fake.cordonedArgsForCall)
+}
+
+func (fake *FakeWorker) CordonedCalls(stub func() bool) {
+	fake.cordonedMutex.Lock()
+	defer fake.cordonedMutex.Unlock()
+	fake.CordonedStub = stub
+}
+
+func (fake *FakeWorker) CordonedReturns(result1 bool) {
+	fake.cordonedMutex.Lock()
+	defer fake.cordonedMutex.Unlock()
+	fake.CordonedStub = nil
+	fake.cordonedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorker) CordonedReturnsOnCall(i int, result1 bool) {
+	fake.cordonedMutex.Lock()
+	defer fake.cordonedMutex.Unlock()
+	fake.CordonedStub = nil
+	if fake.cordonedReturnsOnCall == nil {
+		fake.cordonedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.cordonedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeWorker) CreateContainer(arg1 db.ContainerOwner, arg2 db.ContainerMetadata) (db.CreatingContainer, error) {
 	fake.createContainerMutex.Lock()
 	ret, specificReturn := fake.createContainerReturnsOnCall[len(fake.createContainerArgsForCall)]
@@ -623,6 +883,58 @@ func (fake *FakeWorker) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) DrainDeadline() time.Time {
+	fake.drainDeadlineMutex.Lock()
+	ret, specificReturn := fake.drainDeadlineReturnsOnCall[len(fake.drainDeadlineArgsForCall)]
+	fake.drainDeadlineArgsForCall = append(fake.drainDeadlineArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DrainDeadline", []interface{}{})
+	fake.drainDeadlineMutex.Unlock()
+	if fake.DrainDeadlineStub != nil {
+		return fake.DrainDeadlineStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.drainDeadlineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) DrainDeadlineCallCount() int {
+	fake.drainDeadlineMutex.RLock()
+	defer fake.drainDeadlineMutex.RUnlock()
+	return len(fake.drainDeadlineArgsForCall)
+}
+
+func (fake *FakeWorker) DrainDeadlineCalls(stub func() time.Time) {
+	fake.drainDeadlineMutex.Lock()
+	defer fake.drainDeadlineMutex.Unlock()
+	fake.DrainDeadlineStub = stub
+}
+
+func (fake *FakeWorker) DrainDeadlineReturns(result1 time.Time) {
+	fake.drainDeadlineMutex.Lock()
+	defer fake.drainDeadlineMutex.Unlock()
+	fake.DrainDeadlineStub = nil
+	fake.drainDeadlineReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeWorker) DrainDeadlineReturnsOnCall(i int, result1 time.Time) {
+	fake.drainDeadlineMutex.Lock()
+	defer fake.drainDeadlineMutex.Unlock()
+	fake.DrainDeadlineStub = nil
+	if fake.drainDeadlineReturnsOnCall == nil {
+		fake.drainDeadlineReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.drainDeadlineReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeWorker) Ephemeral() bool {
 	fake.ephemeralMutex.Lock()
 	ret, specificReturn := fake.ephemeralReturnsOnCall[len(fake.ephemeralArgsForCall)]
@@ -1001,6 +1313,110 @@ func (fake *FakeWorker) LandReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) MaintenanceEnd() time.Time {
+	fake.maintenanceEndMutex.Lock()
+	ret, specificReturn := fake.maintenanceEndReturnsOnCall[len(fake.maintenanceEndArgsForCall)]
+	fake.maintenanceEndArgsForCall = append(fake.maintenanceEndArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MaintenanceEnd", []interface{}{})
+	fake.maintenanceEndMutex.Unlock()
+	if fake.MaintenanceEndStub != nil {
+		return fake.MaintenanceEndStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.maintenanceEndReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) MaintenanceEndCallCount() int {
+	fake.maintenanceEndMutex.RLock()
+	defer fake.maintenanceEndMutex.RUnlock()
+	return len(fake.maintenanceEndArgsForCall)
+}
+
+func (fake *FakeWorker) MaintenanceEndCalls(stub func() time.Time) {
+	fake.maintenanceEndMutex.Lock()
+	defer fake.maintenanceEndMutex.Unlock()
+	fake.MaintenanceEndStub = stub
+}
+
+func (fake *FakeWorker) MaintenanceEndReturns(result1 time.Time) {
+	fake.maintenanceEndMutex.Lock()
+	defer fake.maintenanceEndMutex.Unlock()
+	fake.MaintenanceEndStub = nil
+	fake.maintenanceEndReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeWorker) MaintenanceEndReturnsOnCall(i int, result1 time.Time) {
+	fake.maintenanceEndMutex.Lock()
+	defer fake.maintenanceEndMutex.Unlock()
+	fake.MaintenanceEndStub = nil
+	if fake.maintenanceEndReturnsOnCall == nil {
+		fake.maintenanceEndReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.maintenanceEndReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeWorker) MaintenanceStart() time.Time {
+	fake.maintenanceStartMutex.Lock()
+	ret, specificReturn := fake.maintenanceStartReturnsOnCall[len(fake.maintenanceStartArgsForCall)]
+	fake.maintenanceStartArgsForCall = append(fake.maintenanceStartArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MaintenanceStart", []interface{}{})
+	fake.maintenanceStartMutex.Unlock()
+	if fake.MaintenanceStartStub != nil {
+		return fake.MaintenanceStartStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.maintenanceStartReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) MaintenanceStartCallCount() int {
+	fake.maintenanceStartMutex.RLock()
+	defer fake.maintenanceStartMutex.RUnlock()
+	return len(fake.maintenanceStartArgsForCall)
+}
+
+func (fake *FakeWorker) MaintenanceStartCalls(stub func() time.Time) {
+	fake.maintenanceStartMutex.Lock()
+	defer fake.maintenanceStartMutex.Unlock()
+	fake.MaintenanceStartStub = stub
+}
+
+func (fake *FakeWorker) MaintenanceStartReturns(result1 time.Time) {
+	fake.maintenanceStartMutex.Lock()
+	defer fake.maintenanceStartMutex.Unlock()
+	fake.MaintenanceStartStub = nil
+	fake.maintenanceStartReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeWorker) MaintenanceStartReturnsOnCall(i int, result1 time.Time) {
+	fake.maintenanceStartMutex.Lock()
+	defer fake.maintenanceStartMutex.Unlock()
+	fake.MaintenanceStartStub = nil
+	if fake.maintenanceStartReturnsOnCall == nil {
+		fake.maintenanceStartReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.maintenanceStartReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeWorker) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1374,6 +1790,58 @@ func (fake *FakeWorker) ResourceTypesReturnsOnCall(i int, result1 []atc.WorkerRe
 	}{result1}
 }
 
+func (fake *FakeWorker) Resources() *atc.WorkerResources {
+	fake.resourcesMutex.Lock()
+	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
+	fake.resourcesArgsForCall = append(fake.resourcesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Resources", []interface{}{})
+	fake.resourcesMutex.Unlock()
+	if fake.ResourcesStub != nil {
+		return fake.ResourcesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourcesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ResourcesCallCount() int {
+	fake.resourcesMutex.RLock()
+	defer fake.resourcesMutex.RUnlock()
+	return len(fake.resourcesArgsForCall)
+}
+
+func (fake *FakeWorker) ResourcesCalls(stub func() *atc.WorkerResources) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = stub
+}
+
+func (fake *FakeWorker) ResourcesReturns(result1 *atc.WorkerResources) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = nil
+	fake.resourcesReturns = struct {
+		result1 *atc.WorkerResources
+	}{result1}
+}
+
+func (fake *FakeWorker) ResourcesReturnsOnCall(i int, result1 *atc.WorkerResources) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = nil
+	if fake.resourcesReturnsOnCall == nil {
+		fake.resourcesReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerResources
+		})
+	}
+	fake.resourcesReturnsOnCall[i] = struct {
+		result1 *atc.WorkerResources
+	}{result1}
+}
+
 func (fake *FakeWorker) Retire() error {
 	fake.retireMutex.Lock()
 	ret, specificReturn := fake.retireReturnsOnCall[len(fake.retireArgsForCall)]
@@ -1426,6 +1894,128 @@ func (fake *FakeWorker) RetireReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) ScheduleMaintenance(arg1 time.Time, arg2 time.Time) error {
+	fake.scheduleMaintenanceMutex.Lock()
+	ret, specificReturn := fake.scheduleMaintenanceReturnsOnCall[len(fake.scheduleMaintenanceArgsForCall)]
+	fake.scheduleMaintenanceArgsForCall = append(fake.scheduleMaintenanceArgsForCall, struct {
+		arg1 time.Time
+		arg2 time.Time
+	}{arg1, arg2})
+	fake.recordInvocation("ScheduleMaintenance", []interface{}{arg1, arg2})
+	fake.scheduleMaintenanceMutex.Unlock()
+	if fake.ScheduleMaintenanceStub != nil {
+		return fake.ScheduleMaintenanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.scheduleMaintenanceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ScheduleMaintenanceCallCount() int {
+	fake.scheduleMaintenanceMutex.RLock()
+	defer fake.scheduleMaintenanceMutex.RUnlock()
+	return len(fake.scheduleMaintenanceArgsForCall)
+}
+
+func (fake *FakeWorker) ScheduleMaintenanceCalls(stub func(time.Time, time.Time) error) {
+	fake.scheduleMaintenanceMutex.Lock()
+	defer fake.scheduleMaintenanceMutex.Unlock()
+	fake.ScheduleMaintenanceStub = stub
+}
+
+func (fake *FakeWorker) ScheduleMaintenanceArgsForCall(i int) (time.Time, time.Time) {
+	fake.scheduleMaintenanceMutex.RLock()
+	defer fake.scheduleMaintenanceMutex.RUnlock()
+	argsForCall := fake.scheduleMaintenanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorker) ScheduleMaintenanceReturns(result1 error) {
+	fake.scheduleMaintenanceMutex.Lock()
+	defer fake.scheduleMaintenanceMutex.Unlock()
+	fake.ScheduleMaintenanceStub = nil
+	fake.scheduleMaintenanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) ScheduleMaintenanceReturnsOnCall(i int, result1 error) {
+	fake.scheduleMaintenanceMutex.Lock()
+	defer fake.scheduleMaintenanceMutex.Unlock()
+	fake.ScheduleMaintenanceStub = nil
+	if fake.scheduleMaintenanceReturnsOnCall == nil {
+		fake.scheduleMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.scheduleMaintenanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) SetDrainDeadline(arg1 time.Time, arg2 bool) error {
+	fake.setDrainDeadlineMutex.Lock()
+	ret, specificReturn := fake.setDrainDeadlineReturnsOnCall[len(fake.setDrainDeadlineArgsForCall)]
+	fake.setDrainDeadlineArgsForCall = append(fake.setDrainDeadlineArgsForCall, struct {
+		arg1 time.Time
+		arg2 bool
+	}{arg1, arg2})
+	fake.recordInvocation("SetDrainDeadline", []interface{}{arg1, arg2})
+	fake.setDrainDeadlineMutex.Unlock()
+	if fake.SetDrainDeadlineStub != nil {
+		return fake.SetDrainDeadlineStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setDrainDeadlineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) SetDrainDeadlineCallCount() int {
+	fake.setDrainDeadlineMutex.RLock()
+	defer fake.setDrainDeadlineMutex.RUnlock()
+	return len(fake.setDrainDeadlineArgsForCall)
+}
+
+func (fake *FakeWorker) SetDrainDeadlineCalls(stub func(time.Time, bool) error) {
+	fake.setDrainDeadlineMutex.Lock()
+	defer fake.setDrainDeadlineMutex.Unlock()
+	fake.SetDrainDeadlineStub = stub
+}
+
+func (fake *FakeWorker) SetDrainDeadlineArgsForCall(i int) (time.Time, bool) {
+	fake.setDrainDeadlineMutex.RLock()
+	defer fake.setDrainDeadlineMutex.RUnlock()
+	argsForCall := fake.setDrainDeadlineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorker) SetDrainDeadlineReturns(result1 error) {
+	fake.setDrainDeadlineMutex.Lock()
+	defer fake.setDrainDeadlineMutex.Unlock()
+	fake.SetDrainDeadlineStub = nil
+	fake.setDrainDeadlineReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) SetDrainDeadlineReturnsOnCall(i int, result1 error) {
+	fake.setDrainDeadlineMutex.Lock()
+	defer fake.setDrainDeadlineMutex.Unlock()
+	fake.SetDrainDeadlineStub = nil
+	if fake.setDrainDeadlineReturnsOnCall == nil {
+		fake.setDrainDeadlineReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setDrainDeadlineReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) StartTime() int64 {
 	fake.startTimeMutex.Lock()
 	ret, specificReturn := fake.startTimeReturnsOnCall[len(fake.startTimeArgsForCall)]
@@ -1686,6 +2276,58 @@ func (fake *FakeWorker) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Uncordon() error {
+	fake.uncordonMutex.Lock()
+	ret, specificReturn := fake.uncordonReturnsOnCall[len(fake.uncordonArgsForCall)]
+	fake.uncordonArgsForCall = append(fake.uncordonArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Uncordon", []interface{}{})
+	fake.uncordonMutex.Unlock()
+	if fake.UncordonStub != nil {
+		return fake.UncordonStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.uncordonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) UncordonCallCount() int {
+	fake.uncordonMutex.RLock()
+	defer fake.uncordonMutex.RUnlock()
+	return len(fake.uncordonArgsForCall)
+}
+
+func (fake *FakeWorker) UncordonCalls(stub func() error) {
+	fake.uncordonMutex.Lock()
+	defer fake.uncordonMutex.Unlock()
+	fake.UncordonStub = stub
+}
+
+func (fake *FakeWorker) UncordonReturns(result1 error) {
+	fake.uncordonMutex.Lock()
+	defer fake.uncordonMutex.Unlock()
+	fake.UncordonStub = nil
+	fake.uncordonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) UncordonReturnsOnCall(i int, result1 error) {
+	fake.uncordonMutex.Lock()
+	defer fake.uncordonMutex.Unlock()
+	fake.UncordonStub = nil
+	if fake.uncordonReturnsOnCall == nil {
+		fake.uncordonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) Version() *string {
 	fake.versionMutex.Lock()
 	ret, specificReturn := fake.versionReturnsOnCall[len(fake.versionArgsForCall)]
@@ -1741,6 +2383,8 @@ func (fake *FakeWorker) VersionReturnsOnCall(i int, result1 *string) {
 func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.abortAfterDrainDeadlineMutex.RLock()
+	defer fake.abortAfterDrainDeadlineMutex.RUnlock()
 	fake.activeContainersMutex.RLock()
 	defer fake.activeContainersMutex.RUnlock()
 	fake.activeVolumesMutex.RLock()
@@ -1749,10 +2393,16 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.baggageclaimURLMutex.RUnlock()
 	fake.certsPathMutex.RLock()
 	defer fake.certsPathMutex.RUnlock()
+	fake.cordonMutex.RLock()
+	defer fake.cordonMutex.RUnlock()
+	fake.cordonedMutex.RLock()
+	defer fake.cordonedMutex.RUnlock()
 	fake.createContainerMutex.RLock()
 	defer fake.createContainerMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.drainDeadlineMutex.RLock()
+	defer fake.drainDeadlineMutex.RUnlock()
 	fake.ephemeralMutex.RLock()
 	defer fake.ephemeralMutex.RUnlock()
 	fake.expiresAtMutex.RLock()
@@ -1767,6 +2417,10 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.hTTPSProxyURLMutex.RUnlock()
 	fake.landMutex.RLock()
 	defer fake.landMutex.RUnlock()
+	fake.maintenanceEndMutex.RLock()
+	defer fake.maintenanceEndMutex.RUnlock()
+	fake.maintenanceStartMutex.RLock()
+	defer fake.maintenanceStartMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.noProxyMutex.RLock()
@@ -1781,8 +2435,14 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.resourceCertsMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
 	defer fake.resourceTypesMutex.RUnlock()
+	fake.resourcesMutex.RLock()
+	defer fake.resourcesMutex.RUnlock()
 	fake.retireMutex.RLock()
 	defer fake.retireMutex.RUnlock()
+	fake.scheduleMaintenanceMutex.RLock()
+	defer fake.scheduleMaintenanceMutex.RUnlock()
+	fake.setDrainDeadlineMutex.RLock()
+	defer fake.setDrainDeadlineMutex.RUnlock()
 	fake.startTimeMutex.RLock()
 	defer fake.startTimeMutex.RUnlock()
 	fake.stateMutex.RLock()
@@ -1793,6 +2453,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.uncordonMutex.RLock()
+	defer fake.uncordonMutex.RUnlock()
 	fake.versionMutex.RLock()
 	defer fake.versionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
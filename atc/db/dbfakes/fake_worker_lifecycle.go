@@ -8,6 +8,30 @@ import (
 )
 
 type FakeWorkerLifecycle struct {
+	AbortBuildsPastDrainDeadlineStub        func() ([]int, error)
+	abortBuildsPastDrainDeadlineMutex       sync.RWMutex
+	abortBuildsPastDrainDeadlineArgsForCall []struct {
+	}
+	abortBuildsPastDrainDeadlineReturns struct {
+		result1 []int
+		result2 error
+	}
+	abortBuildsPastDrainDeadlineReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
+	CordonWorkersInMaintenanceStub        func() ([]string, error)
+	cordonWorkersInMaintenanceMutex       sync.RWMutex
+	cordonWorkersInMaintenanceArgsForCall []struct {
+	}
+	cordonWorkersInMaintenanceReturns struct {
+		result1 []string
+		result2 error
+	}
+	cordonWorkersInMaintenanceReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	DeleteFinishedRetiringWorkersStub        func() ([]string, error)
 	deleteFinishedRetiringWorkersMutex       sync.RWMutex
 	deleteFinishedRetiringWorkersArgsForCall []struct {
@@ -68,10 +92,132 @@ type FakeWorkerLifecycle struct {
 		result1 []string
 		result2 error
 	}
+	UncordonWorkersAfterMaintenanceStub        func() ([]string, error)
+	uncordonWorkersAfterMaintenanceMutex       sync.RWMutex
+	uncordonWorkersAfterMaintenanceArgsForCall []struct {
+	}
+	uncordonWorkersAfterMaintenanceReturns struct {
+		result1 []string
+		result2 error
+	}
+	uncordonWorkersAfterMaintenanceReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeWorkerLifecycle) AbortBuildsPastDrainDeadline() ([]int, error) {
+	fake.abortBuildsPastDrainDeadlineMutex.Lock()
+	ret, specificReturn := fake.abortBuildsPastDrainDeadlineReturnsOnCall[len(fake.abortBuildsPastDrainDeadlineArgsForCall)]
+	fake.abortBuildsPastDrainDeadlineArgsForCall = append(fake.abortBuildsPastDrainDeadlineArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AbortBuildsPastDrainDeadline", []interface{}{})
+	fake.abortBuildsPastDrainDeadlineMutex.Unlock()
+	if fake.AbortBuildsPastDrainDeadlineStub != nil {
+		return fake.AbortBuildsPastDrainDeadlineStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.abortBuildsPastDrainDeadlineReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerLifecycle) AbortBuildsPastDrainDeadlineCallCount() int {
+	fake.abortBuildsPastDrainDeadlineMutex.RLock()
+	defer fake.abortBuildsPastDrainDeadlineMutex.RUnlock()
+	return len(fake.abortBuildsPastDrainDeadlineArgsForCall)
+}
+
+func (fake *FakeWorkerLifecycle) AbortBuildsPastDrainDeadlineCalls(stub func() ([]int, error)) {
+	fake.abortBuildsPastDrainDeadlineMutex.Lock()
+	defer fake.abortBuildsPastDrainDeadlineMutex.Unlock()
+	fake.AbortBuildsPastDrainDeadlineStub = stub
+}
+
+func (fake *FakeWorkerLifecycle) AbortBuildsPastDrainDeadlineReturns(result1 []int, result2 error) {
+	fake.abortBuildsPastDrainDeadlineMutex.Lock()
+	defer fake.abortBuildsPastDrainDeadlineMutex.Unlock()
+	fake.AbortBuildsPastDrainDeadlineStub = nil
+	fake.abortBuildsPastDrainDeadlineReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerLifecycle) AbortBuildsPastDrainDeadlineReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.abortBuildsPastDrainDeadlineMutex.Lock()
+	defer fake.abortBuildsPastDrainDeadlineMutex.Unlock()
+	fake.AbortBuildsPastDrainDeadlineStub = nil
+	if fake.abortBuildsPastDrainDeadlineReturnsOnCall == nil {
+		fake.abortBuildsPastDrainDeadlineReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.abortBuildsPastDrainDeadlineReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerLifecycle) CordonWorkersInMaintenance() ([]string, error) {
+	fake.cordonWorkersInMaintenanceMutex.Lock()
+	ret, specificReturn := fake.cordonWorkersInMaintenanceReturnsOnCall[len(fake.cordonWorkersInMaintenanceArgsForCall)]
+	fake.cordonWorkersInMaintenanceArgsForCall = append(fake.cordonWorkersInMaintenanceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CordonWorkersInMaintenance", []interface{}{})
+	fake.cordonWorkersInMaintenanceMutex.Unlock()
+	if fake.CordonWorkersInMaintenanceStub != nil {
+		return fake.CordonWorkersInMaintenanceStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.cordonWorkersInMaintenanceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerLifecycle) CordonWorkersInMaintenanceCallCount() int {
+	fake.cordonWorkersInMaintenanceMutex.RLock()
+	defer fake.cordonWorkersInMaintenanceMutex.RUnlock()
+	return len(fake.cordonWorkersInMaintenanceArgsForCall)
+}
+
+func (fake *FakeWorkerLifecycle) CordonWorkersInMaintenanceCalls(stub func() ([]string, error)) {
+	fake.cordonWorkersInMaintenanceMutex.Lock()
+	defer fake.cordonWorkersInMaintenanceMutex.Unlock()
+	fake.CordonWorkersInMaintenanceStub = stub
+}
+
+func (fake *FakeWorkerLifecycle) CordonWorkersInMaintenanceReturns(result1 []string, result2 error) {
+	fake.cordonWorkersInMaintenanceMutex.Lock()
+	defer fake.cordonWorkersInMaintenanceMutex.Unlock()
+	fake.CordonWorkersInMaintenanceStub = nil
+	fake.cordonWorkersInMaintenanceReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerLifecycle) CordonWorkersInMaintenanceReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.cordonWorkersInMaintenanceMutex.Lock()
+	defer fake.cordonWorkersInMaintenanceMutex.Unlock()
+	fake.CordonWorkersInMaintenanceStub = nil
+	if fake.cordonWorkersInMaintenanceReturnsOnCall == nil {
+		fake.cordonWorkersInMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.cordonWorkersInMaintenanceReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorkerLifecycle) DeleteFinishedRetiringWorkers() ([]string, error) {
 	fake.deleteFinishedRetiringWorkersMutex.Lock()
 	ret, specificReturn := fake.deleteFinishedRetiringWorkersReturnsOnCall[len(fake.deleteFinishedRetiringWorkersArgsForCall)]
@@ -347,9 +493,68 @@ func (fake *FakeWorkerLifecycle) StallUnresponsiveWorkersReturnsOnCall(i int, re
 	}{result1, result2}
 }
 
+func (fake *FakeWorkerLifecycle) UncordonWorkersAfterMaintenance() ([]string, error) {
+	fake.uncordonWorkersAfterMaintenanceMutex.Lock()
+	ret, specificReturn := fake.uncordonWorkersAfterMaintenanceReturnsOnCall[len(fake.uncordonWorkersAfterMaintenanceArgsForCall)]
+	fake.uncordonWorkersAfterMaintenanceArgsForCall = append(fake.uncordonWorkersAfterMaintenanceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UncordonWorkersAfterMaintenance", []interface{}{})
+	fake.uncordonWorkersAfterMaintenanceMutex.Unlock()
+	if fake.UncordonWorkersAfterMaintenanceStub != nil {
+		return fake.UncordonWorkersAfterMaintenanceStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.uncordonWorkersAfterMaintenanceReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerLifecycle) UncordonWorkersAfterMaintenanceCallCount() int {
+	fake.uncordonWorkersAfterMaintenanceMutex.RLock()
+	defer fake.uncordonWorkersAfterMaintenanceMutex.RUnlock()
+	return len(fake.uncordonWorkersAfterMaintenanceArgsForCall)
+}
+
+func (fake *FakeWorkerLifecycle) UncordonWorkersAfterMaintenanceCalls(stub func() ([]string, error)) {
+	fake.uncordonWorkersAfterMaintenanceMutex.Lock()
+	defer fake.uncordonWorkersAfterMaintenanceMutex.Unlock()
+	fake.UncordonWorkersAfterMaintenanceStub = stub
+}
+
+func (fake *FakeWorkerLifecycle) UncordonWorkersAfterMaintenanceReturns(result1 []string, result2 error) {
+	fake.uncordonWorkersAfterMaintenanceMutex.Lock()
+	defer fake.uncordonWorkersAfterMaintenanceMutex.Unlock()
+	fake.UncordonWorkersAfterMaintenanceStub = nil
+	fake.uncordonWorkersAfterMaintenanceReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerLifecycle) UncordonWorkersAfterMaintenanceReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.uncordonWorkersAfterMaintenanceMutex.Lock()
+	defer fake.uncordonWorkersAfterMaintenanceMutex.Unlock()
+	fake.UncordonWorkersAfterMaintenanceStub = nil
+	if fake.uncordonWorkersAfterMaintenanceReturnsOnCall == nil {
+		fake.uncordonWorkersAfterMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.uncordonWorkersAfterMaintenanceReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorkerLifecycle) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.abortBuildsPastDrainDeadlineMutex.RLock()
+	defer fake.abortBuildsPastDrainDeadlineMutex.RUnlock()
+	fake.cordonWorkersInMaintenanceMutex.RLock()
+	defer fake.cordonWorkersInMaintenanceMutex.RUnlock()
 	fake.deleteFinishedRetiringWorkersMutex.RLock()
 	defer fake.deleteFinishedRetiringWorkersMutex.RUnlock()
 	fake.deleteUnresponsiveEphemeralWorkersMutex.RLock()
@@ -360,6 +565,8 @@ func (fake *FakeWorkerLifecycle) Invocations() map[string][][]interface{} {
 	defer fake.landFinishedLandingWorkersMutex.RUnlock()
 	fake.stallUnresponsiveWorkersMutex.RLock()
 	defer fake.stallUnresponsiveWorkersMutex.RUnlock()
+	fake.uncordonWorkersAfterMaintenanceMutex.RLock()
+	defer fake.uncordonWorkersAfterMaintenanceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -32,6 +32,7 @@ type Job interface {
 	Unpause() error
 
 	CreateBuild() (Build, error)
+	CreateBuildWithInputOverrides(atc.InputOverrides) (Build, error)
 	Builds(page Page) ([]Build, Pagination, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 	Build(name string) (Build, bool, error)
@@ -42,6 +43,7 @@ type Job interface {
 
 	GetIndependentBuildInputs() ([]BuildInput, error)
 	GetNextBuildInputs() ([]BuildInput, bool, error)
+	GetBuildInputsForMapping(inputMapping algorithm.InputMapping) ([]BuildInput, error)
 	SaveNextInputMapping(inputMapping algorithm.InputMapping) error
 	SaveIndependentInputMapping(inputMapping algorithm.InputMapping) error
 	DeleteNextInputMapping() error
@@ -491,6 +493,21 @@ func (j *job) GetPendingBuilds() ([]Build, error) {
 }
 
 func (j *job) CreateBuild() (Build, error) {
+	return j.createManuallyTriggeredBuild(sql.NullString{})
+}
+
+// CreateBuildWithInputOverrides creates a manually triggered build which will
+// use the given versions for some of its inputs.
+func (j *job) CreateBuildWithInputOverrides(overrides atc.InputOverrides) (Build, error) {
+	overridesJSON, err := json.Marshal(overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	return j.createManuallyTriggeredBuild(sql.NullString{String: string(overridesJSON), Valid: true})
+}
+
+func (j *job) createManuallyTriggeredBuild(overridesJSON sql.NullString) (Build, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -511,6 +528,7 @@ func (j *job) CreateBuild() (Build, error) {
 		"team_id":            j.teamID,
 		"status":             BuildStatusPending,
 		"manually_triggered": true,
+		"input_overrides":    overridesJSON,
 	})
 	if err != nil {
 		return nil, err
@@ -662,6 +680,64 @@ func (j *job) getBuildInputs(table string) ([]BuildInput, error) {
 	return buildInputs, nil
 }
 
+// GetBuildInputsForMapping looks up the versions chosen by an input mapping
+// which has not been saved as the job's next inputs.
+func (j *job) GetBuildInputsForMapping(inputMapping algorithm.InputMapping) ([]BuildInput, error) {
+	versionIDs := []int{}
+	for _, inputVersion := range inputMapping {
+		versionIDs = append(versionIDs, inputVersion.VersionID)
+	}
+
+	rows, err := psql.Select("id, version").
+		From("resource_config_versions").
+		Where(sq.Eq{"id": versionIDs}).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	versions := map[int]atc.Version{}
+	for rows.Next() {
+		var (
+			id          int
+			versionBlob string
+			version     atc.Version
+		)
+
+		err := rows.Scan(&id, &versionBlob)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(versionBlob), &version)
+		if err != nil {
+			return nil, err
+		}
+
+		versions[id] = version
+	}
+
+	buildInputs := []BuildInput{}
+	for inputName, inputVersion := range inputMapping {
+		version, found := versions[inputVersion.VersionID]
+		if !found {
+			return nil, fmt.Errorf("version of input '%s' no longer exists", inputName)
+		}
+
+		buildInputs = append(buildInputs, BuildInput{
+			Name:            inputName,
+			ResourceID:      inputVersion.ResourceID,
+			Version:         version,
+			FirstOccurrence: inputVersion.FirstOccurrence,
+		})
+	}
+
+	return buildInputs, nil
+}
+
 func (j *job) getNewBuildName(tx Tx) (string, error) {
 	var buildName string
 	err := psql.Update("jobs").
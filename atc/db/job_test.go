@@ -1067,6 +1067,118 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("GetBuildInputsForMapping", func() {
+		var (
+			versions []atc.ResourceVersion
+			resource db.Resource
+		)
+
+		BeforeEach(func() {
+			setupTx, err := dbConn.Begin()
+			Expect(err).ToNot(HaveOccurred())
+
+			brt := db.BaseResourceType{
+				Name: "some-type",
+			}
+
+			_, err = brt.FindOrCreate(setupTx, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(setupTx.Commit()).To(Succeed())
+
+			var found bool
+			resource, found, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			resourceConfigScope, err := resource.SetResourceConfig(logger, atc.Source{}, creds.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = resourceConfigScope.SaveVersions([]atc.Version{
+				{"version": "v1"},
+				{"version": "v2"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			versions, _, found, err = resource.Versions(db.Page{Limit: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("returns the versions of the mapping without saving it", func() {
+			actualBuildInputs, err := job.GetBuildInputsForMapping(algorithm.InputMapping{
+				"some-input-1": algorithm.InputVersion{
+					VersionID:       versions[1].ID,
+					ResourceID:      resource.ID(),
+					FirstOccurrence: false,
+				},
+				"some-input-2": algorithm.InputVersion{
+					VersionID:       versions[0].ID,
+					ResourceID:      resource.ID(),
+					FirstOccurrence: true,
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(actualBuildInputs).To(ConsistOf(
+				db.BuildInput{
+					Name:            "some-input-1",
+					ResourceID:      resource.ID(),
+					Version:         atc.Version{"version": "v1"},
+					FirstOccurrence: false,
+				},
+				db.BuildInput{
+					Name:            "some-input-2",
+					ResourceID:      resource.ID(),
+					Version:         atc.Version{"version": "v2"},
+					FirstOccurrence: true,
+				},
+			))
+
+			_, found, err := job.GetNextBuildInputs()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when a version in the mapping does not exist", func() {
+			It("returns an error", func() {
+				_, err := job.GetBuildInputsForMapping(algorithm.InputMapping{
+					"some-input": algorithm.InputVersion{
+						VersionID:  versions[0].ID + 100,
+						ResourceID: resource.ID(),
+					},
+				})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("CreateBuildWithInputOverrides", func() {
+		It("creates a manually triggered build with the overrides", func() {
+			overrides := atc.InputOverrides{
+				Inputs:       map[string]atc.Version{"some-input": {"version": "v1"}},
+				IgnorePassed: true,
+			}
+
+			build, err := job.CreateBuildWithInputOverrides(overrides)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.IsManuallyTriggered()).To(BeTrue())
+			Expect(build.Status()).To(Equal(db.BuildStatusPending))
+
+			actualOverrides, err := build.InputOverrides()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualOverrides).To(Equal(overrides))
+		})
+
+		It("does not record overrides for builds created without them", func() {
+			build, err := job.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			actualOverrides, err := build.InputOverrides()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualOverrides).To(Equal(atc.InputOverrides{}))
+		})
+	})
+
 	Describe("a build is created for a job", func() {
 		var (
 			build1DB      db.Build
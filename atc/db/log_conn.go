@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"strings"
 
@@ -36,6 +37,21 @@ func (c *logConn) Exec(query string, args ...interface{}) (sql.Result, error) {
 	return c.Conn.Exec(query, args...)
 }
 
+func (c *logConn) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	c.logger.Debug("query", lager.Data{"query": c.strip(query)})
+	return c.Conn.QueryContext(ctx, query, args...)
+}
+
+func (c *logConn) QueryRowContext(ctx context.Context, query string, args ...interface{}) squirrel.RowScanner {
+	c.logger.Debug("query-row", lager.Data{"query": c.strip(query)})
+	return c.Conn.QueryRowContext(ctx, query, args...)
+}
+
+func (c *logConn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	c.logger.Debug("exec", lager.Data{"query": c.strip(query)})
+	return c.Conn.ExecContext(ctx, query, args...)
+}
+
 func (c *logConn) strip(query string) string {
 	return strings.Join(strings.Fields(query), " ")
 }
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE teams
+    DROP COLUMN max_running_builds,
+    DROP COLUMN max_containers;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE teams
+    ADD COLUMN max_running_builds integer,
+    ADD COLUMN max_containers integer;
+COMMIT;
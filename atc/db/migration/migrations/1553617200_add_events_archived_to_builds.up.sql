@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN events_archived boolean NOT NULL DEFAULT false;
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+  DELETE FROM resource_config_check_sessions
+  WHERE id NOT IN (
+    SELECT DISTINCT ON (resource_config_id, worker_base_resource_type_id) id
+    FROM resource_config_check_sessions
+    ORDER BY resource_config_id, worker_base_resource_type_id, expires_at DESC
+  );
+
+  DROP INDEX resource_config_check_sessions_uniq;
+
+  CREATE UNIQUE INDEX resource_config_check_sessions_uniq
+  ON resource_config_check_sessions (resource_config_id, worker_base_resource_type_id);
+
+  ALTER TABLE resource_config_check_sessions
+    DROP COLUMN credentials_fingerprint;
+COMMIT;
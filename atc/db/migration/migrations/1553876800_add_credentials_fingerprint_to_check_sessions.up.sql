@@ -0,0 +1,9 @@
+BEGIN;
+  ALTER TABLE resource_config_check_sessions
+    ADD COLUMN credentials_fingerprint text NOT NULL DEFAULT '';
+
+  DROP INDEX resource_config_check_sessions_uniq;
+
+  CREATE UNIQUE INDEX resource_config_check_sessions_uniq
+  ON resource_config_check_sessions (resource_config_id, worker_base_resource_type_id, credentials_fingerprint);
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+  CREATE TABLE resource_webhook_deliveries (
+    id serial PRIMARY KEY,
+    resource_id integer NOT NULL REFERENCES resources (id) ON DELETE CASCADE,
+    received_at timestamp with time zone NOT NULL DEFAULT now(),
+    provider text,
+    event text,
+    branch text,
+    status text NOT NULL,
+    message text
+  );
+
+  CREATE INDEX resource_webhook_deliveries_resource_id_idx ON resource_webhook_deliveries (resource_id, id);
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+  CREATE TABLE checks (
+    id serial PRIMARY KEY,
+    resource_id integer REFERENCES resources (id) ON DELETE CASCADE,
+    resource_type_id integer REFERENCES resource_types (id) ON DELETE CASCADE,
+    status text NOT NULL DEFAULT 'pending',
+    create_time timestamp with time zone NOT NULL DEFAULT now(),
+    start_time timestamp with time zone,
+    end_time timestamp with time zone,
+    check_error text,
+    CHECK ((resource_id IS NULL) != (resource_type_id IS NULL))
+  );
+
+  CREATE UNIQUE INDEX checks_resource_id_running_uniq
+    ON checks (resource_id)
+    WHERE status IN ('pending', 'started');
+
+  CREATE UNIQUE INDEX checks_resource_type_id_running_uniq
+    ON checks (resource_type_id)
+    WHERE status IN ('pending', 'started');
+
+  CREATE INDEX checks_status_create_time_idx ON checks (status, create_time);
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+  CREATE TABLE check_history (
+    id serial PRIMARY KEY,
+    resource_config_scope_id integer NOT NULL REFERENCES resource_config_scopes (id) ON DELETE CASCADE,
+    start_time timestamp with time zone NOT NULL,
+    end_time timestamp with time zone NOT NULL,
+    worker_name text,
+    versions jsonb NOT NULL DEFAULT '[]',
+    check_error text,
+    stderr text
+  );
+
+  CREATE INDEX check_history_resource_config_scope_id_idx ON check_history (resource_config_scope_id, id);
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE build_resource_config_version_inputs DROP COLUMN override;
+
+  ALTER TABLE builds DROP COLUMN input_overrides;
+COMMIT;
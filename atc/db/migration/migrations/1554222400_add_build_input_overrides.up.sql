@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE builds ADD COLUMN input_overrides jsonb;
+
+  ALTER TABLE build_resource_config_version_inputs ADD COLUMN override boolean NOT NULL DEFAULT false;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE workers
+    DROP COLUMN drain_deadline,
+    DROP COLUMN abort_after_drain_deadline;
+COMMIT;
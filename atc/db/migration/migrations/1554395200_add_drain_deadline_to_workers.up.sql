@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN drain_deadline timestamp with time zone,
+    ADD COLUMN abort_after_drain_deadline boolean NOT NULL DEFAULT false;
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  UPDATE workers SET state = 'running' WHERE state = 'cordoned';
+
+  ALTER TABLE workers
+    DROP COLUMN maintenance_start,
+    DROP COLUMN maintenance_end;
+COMMIT;
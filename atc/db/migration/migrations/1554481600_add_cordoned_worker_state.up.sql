@@ -0,0 +1,6 @@
+-- NO_TRANSACTION
+ALTER TYPE worker_state ADD VALUE IF NOT EXISTS 'cordoned';
+
+ALTER TABLE workers
+  ADD COLUMN maintenance_start timestamp with time zone,
+  ADD COLUMN maintenance_end timestamp with time zone;
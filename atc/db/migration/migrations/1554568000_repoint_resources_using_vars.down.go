@@ -0,0 +1,10 @@
+package migrations
+
+// Resources using vars are left on the resource configs for their source as
+// configured. Older versions identify resource configs by their interpolated
+// source, which cannot be worked out here without the credentials, and move
+// each resource on to the resource config for it on its next check. Until then
+// the resource keeps showing the version history it has now.
+func (self *migrations) Down_1554568000() error {
+	return nil
+}
@@ -0,0 +1,255 @@
+package migrations
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+var sourceVarRegex = regexp.MustCompile(`\(\([^()\s]+\)\)`)
+
+type resourceUsingVars struct {
+	id               int
+	pipelineID       int
+	resourceType     string
+	source           map[string]interface{}
+	resourceConfigID int
+	scopeID          sql.NullInt64
+}
+
+// Resource configs used to be identified by their interpolated source, and
+// are now identified by their source as configured. Resources whose source
+// refers to vars are moved on to the resource config they would now find,
+// along with their version history, so that it is not lost on upgrade.
+//
+// Resources of a custom resource type whose source refers to vars are left
+// alone, as their parent resource cache changes too; they will check again
+// from scratch.
+func (self *migrations) Up_1554568000() error {
+	tx, err := self.DB.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	resources, err := self.resourcesUsingVars(tx)
+	if err != nil {
+		return err
+	}
+
+	for _, resource := range resources {
+		typeUsesVars, err := self.resourceTypeUsesVars(tx, resource.pipelineID, resource.resourceType, map[string]bool{})
+		if err != nil {
+			return err
+		}
+
+		if typeUsesVars {
+			continue
+		}
+
+		err = repointResource(tx, resource)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (self *migrations) resourcesUsingVars(tx *sql.Tx) ([]resourceUsingVars, error) {
+	rows, err := tx.Query(`
+		SELECT id, pipeline_id, config, nonce, resource_config_id, resource_config_scope_id
+		FROM resources
+		WHERE active AND resource_config_id IS NOT NULL
+	`)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	resources := []resourceUsingVars{}
+	for rows.Next() {
+		var (
+			resource resourceUsingVars
+			config   string
+			nonce    sql.NullString
+		)
+
+		err = rows.Scan(&resource.id, &resource.pipelineID, &config, &nonce, &resource.resourceConfigID, &resource.scopeID)
+		if err != nil {
+			return nil, err
+		}
+
+		var resourceConfig struct {
+			Type   string                 `json:"type"`
+			Source map[string]interface{} `json:"source"`
+		}
+
+		err = self.decryptJSON(config, nonce, &resourceConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		if !sourceUsesVars(resourceConfig.Source) {
+			continue
+		}
+
+		resource.resourceType = resourceConfig.Type
+		resource.source = resourceConfig.Source
+
+		resources = append(resources, resource)
+	}
+
+	return resources, rows.Err()
+}
+
+func (self *migrations) resourceTypeUsesVars(tx *sql.Tx, pipelineID int, typeName string, seen map[string]bool) (bool, error) {
+	if seen[typeName] {
+		return false, nil
+	}
+
+	seen[typeName] = true
+
+	var (
+		config string
+		nonce  sql.NullString
+	)
+
+	err := tx.QueryRow(`
+		SELECT config, nonce
+		FROM resource_types
+		WHERE pipeline_id = $1 AND name = $2 AND active
+	`, pipelineID, typeName).Scan(&config, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	var resourceType struct {
+		Type   string                 `json:"type"`
+		Source map[string]interface{} `json:"source"`
+	}
+
+	err = self.decryptJSON(config, nonce, &resourceType)
+	if err != nil {
+		return false, err
+	}
+
+	if sourceUsesVars(resourceType.Source) {
+		return true, nil
+	}
+
+	return self.resourceTypeUsesVars(tx, pipelineID, resourceType.Type, seen)
+}
+
+func (self *migrations) decryptJSON(payload string, nonce sql.NullString, dest interface{}) error {
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decrypted, err := self.Strategy.Decrypt(payload, noncense)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(decrypted, dest)
+}
+
+func repointResource(tx *sql.Tx, resource resourceUsingVars) error {
+	payload, err := json.Marshal(resource.source)
+	if err != nil {
+		return err
+	}
+
+	sourceHash := fmt.Sprintf("%x", sha256.Sum256(payload))
+
+	var resourceConfigID int
+	err = tx.QueryRow(`
+		INSERT INTO resource_configs (base_resource_type_id, resource_cache_id, source_hash)
+		SELECT base_resource_type_id, resource_cache_id, $2
+		FROM resource_configs
+		WHERE id = $1
+		ON CONFLICT DO NOTHING
+		RETURNING id
+	`, resource.resourceConfigID, sourceHash).Scan(&resourceConfigID)
+	if err == sql.ErrNoRows {
+		err = tx.QueryRow(`
+			SELECT n.id
+			FROM resource_configs n, resource_configs o
+			WHERE o.id = $1
+			AND n.source_hash = $2
+			AND n.base_resource_type_id IS NOT DISTINCT FROM o.base_resource_type_id
+			AND n.resource_cache_id IS NOT DISTINCT FROM o.resource_cache_id
+		`, resource.resourceConfigID, sourceHash).Scan(&resourceConfigID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if resourceConfigID == resource.resourceConfigID {
+		return nil
+	}
+
+	// the resource's scope is unique to it now that its source refers to vars
+	var scopeID int
+	err = tx.QueryRow(`
+		INSERT INTO resource_config_scopes (resource_id, resource_config_id)
+		VALUES ($1, $2)
+		ON CONFLICT (resource_id, resource_config_id) WHERE resource_id IS NOT NULL DO NOTHING
+		RETURNING id
+	`, resource.id, resourceConfigID).Scan(&scopeID)
+	if err == sql.ErrNoRows {
+		// the resource already has a scope for the resource config, e.g. from
+		// before a downgrade
+		_, err = tx.Exec(`
+			UPDATE resources
+			SET resource_config_id = $2,
+				resource_config_scope_id = (
+					SELECT id
+					FROM resource_config_scopes
+					WHERE resource_id = $1 AND resource_config_id = $2
+				)
+			WHERE id = $1
+		`, resource.id, resourceConfigID)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if resource.scopeID.Valid {
+		_, err = tx.Exec(`
+			INSERT INTO resource_config_versions (resource_config_scope_id, version, version_md5, metadata, check_order)
+			SELECT $2, version, version_md5, metadata, check_order
+			FROM resource_config_versions
+			WHERE resource_config_scope_id = $1
+		`, resource.scopeID.Int64, scopeID)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`
+		UPDATE resources
+		SET resource_config_id = $2, resource_config_scope_id = $3
+		WHERE id = $1
+	`, resource.id, resourceConfigID, scopeID)
+	return err
+}
+
+func sourceUsesVars(source map[string]interface{}) bool {
+	payload, _ := json.Marshal(source)
+	return sourceVarRegex.Match(payload)
+}
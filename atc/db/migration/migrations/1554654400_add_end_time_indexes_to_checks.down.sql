@@ -0,0 +1,5 @@
+BEGIN;
+  DROP INDEX checks_resource_id_end_time_idx;
+
+  DROP INDEX checks_resource_type_id_end_time_idx;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  CREATE INDEX checks_resource_id_end_time_idx ON checks (resource_id, end_time);
+
+  CREATE INDEX checks_resource_type_id_end_time_idx ON checks (resource_type_id, end_time);
+COMMIT;
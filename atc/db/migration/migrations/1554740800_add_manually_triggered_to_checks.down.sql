@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE checks
+    DROP COLUMN manually_triggered;
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE checks
+    ADD COLUMN manually_triggered boolean NOT NULL DEFAULT false;
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  DROP INDEX builds_resource_id_idx;
+
+  ALTER TABLE builds
+    DROP COLUMN resource_id;
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN resource_id integer REFERENCES resources (id) ON DELETE CASCADE;
+
+  CREATE INDEX builds_resource_id_idx ON builds (resource_id);
+COMMIT;
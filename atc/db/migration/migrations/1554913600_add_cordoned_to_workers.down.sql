@@ -0,0 +1,6 @@
+BEGIN;
+  UPDATE workers SET state = 'cordoned' WHERE cordoned AND state = 'running';
+
+  ALTER TABLE workers
+    DROP COLUMN cordoned;
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN cordoned boolean NOT NULL DEFAULT false;
+
+  UPDATE workers SET cordoned = true, state = 'running' WHERE state = 'cordoned';
+COMMIT;
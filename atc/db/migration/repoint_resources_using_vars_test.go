@@ -0,0 +1,156 @@
+package migration_test
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Repoint resources using vars", func() {
+	const preMigrationVersion = 1554481600
+	const postMigrationVersion = 1554568000
+
+	// the keys are out of order, and the source has nested fields and numbers,
+	// so that the hash is only the same as the ATC's if both encode the source
+	// the same way
+	const resourceConfig = `{"type":"some-type","source":{"uri":"((uri))","depth":1,"branch":"master","private_key":{"value":"((key))","format":"pem"}}}`
+
+	var (
+		db *sql.DB
+	)
+
+	BeforeEach(func() {
+		db = postgresRunner.OpenDBAtVersion(preMigrationVersion)
+
+		setup(db)
+
+		_, err := db.Exec(`INSERT INTO base_resource_types(name) VALUES('some-type')`)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = db.Exec(`
+			INSERT INTO resource_configs(base_resource_type_id, source_hash) VALUES
+			(1, 'interpolated-hash'),
+			(1, 'plain-hash')
+		`)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = db.Exec(`
+			INSERT INTO resource_config_scopes(resource_config_id) VALUES
+			(1),
+			(2)
+		`)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = db.Exec(`
+			INSERT INTO resource_config_versions(resource_config_scope_id, version, version_md5, check_order) VALUES
+			(1, '{"ref":"v1"}', md5('{"ref":"v1"}'), 1),
+			(1, '{"ref":"v2"}', md5('{"ref":"v2"}'), 2),
+			(2, '{"ref":"v1"}', md5('{"ref":"v1"}'), 1)
+		`)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = db.Exec(`
+			INSERT INTO resources(name, pipeline_id, config, active, resource_config_id, resource_config_scope_id) VALUES
+			('some-resource', 1, $1, true, 1, 1),
+			('some-other-resource', 1, '{"type":"some-type","source":{"uri":"some-uri"}}', true, 2, 2)
+		`, resourceConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		db.Close()
+
+		db = postgresRunner.OpenDBAtVersion(postMigrationVersion)
+	})
+
+	AfterEach(func() {
+		_ = db.Close()
+	})
+
+	Context("Up", func() {
+		It("moves resources using vars on to a resource config for their uninterpolated source", func() {
+			var sourceHash string
+			err := db.QueryRow(`
+				SELECT rc.source_hash
+				FROM resources r, resource_configs rc
+				WHERE r.id = 1 AND rc.id = r.resource_config_id
+			`).Scan(&sourceHash)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sourceHash).To(Equal(fmt.Sprintf("%x", sha256.Sum256([]byte(`{"branch":"master","depth":1,"private_key":{"format":"pem","value":"((key))"},"uri":"((uri))"}`)))))
+		})
+
+		It("gives the resource config the same source hash as the ATC does", func() {
+			var sourceHash string
+			err := db.QueryRow(`
+				SELECT rc.source_hash
+				FROM resources r, resource_configs rc
+				WHERE r.id = 1 AND rc.id = r.resource_config_id
+			`).Scan(&sourceHash)
+			Expect(err).NotTo(HaveOccurred())
+
+			var config atc.ResourceConfig
+			err = json.Unmarshal([]byte(resourceConfig), &config)
+			Expect(err).NotTo(HaveOccurred())
+
+			// resource configs are found by the hash of the JSON encoding of
+			// their source's identity
+			payload, err := json.Marshal(creds.NewSource(template.StaticVariables{}, config.Source).Identity())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sourceHash).To(Equal(fmt.Sprintf("%x", sha256.Sum256(payload))))
+		})
+
+		It("keeps their version history in a scope of their own", func() {
+			var resourceID int
+			var versions int
+			err := db.QueryRow(`
+				SELECT s.resource_id, (SELECT COUNT(*) FROM resource_config_versions v WHERE v.resource_config_scope_id = s.id)
+				FROM resources r, resource_config_scopes s
+				WHERE r.id = 1 AND s.id = r.resource_config_scope_id
+			`).Scan(&resourceID, &versions)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resourceID).To(Equal(1))
+			Expect(versions).To(Equal(2))
+		})
+
+		It("leaves other resources alone", func() {
+			var resourceConfigID, scopeID int
+			err := db.QueryRow(`
+				SELECT resource_config_id, resource_config_scope_id
+				FROM resources
+				WHERE id = 2
+			`).Scan(&resourceConfigID, &scopeID)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resourceConfigID).To(Equal(2))
+			Expect(scopeID).To(Equal(2))
+		})
+	})
+
+	Context("Down", func() {
+		BeforeEach(func() {
+			db.Close()
+
+			db = postgresRunner.OpenDBAtVersion(preMigrationVersion)
+		})
+
+		It("leaves resources using vars with their version history", func() {
+			var versions int
+			err := db.QueryRow(`
+				SELECT COUNT(*)
+				FROM resources r, resource_config_versions v
+				WHERE r.id = 1 AND v.resource_config_scope_id = r.resource_config_scope_id
+			`).Scan(&versions)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(versions).To(Equal(2))
+		})
+	})
+})
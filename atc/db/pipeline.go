@@ -37,6 +37,7 @@ type Pipeline interface {
 	TeamID() int
 	TeamName() string
 	Groups() atc.GroupConfigs
+	VarSources() atc.VarSourceConfigs
 	ConfigVersion() ConfigVersion
 	Public() bool
 	Paused() bool
@@ -53,6 +54,7 @@ type Pipeline interface {
 
 	CreateOneOffBuild() (Build, error)
 	CreateStartedBuild(plan atc.Plan) (Build, error)
+	CreateStartedCheckBuild(resourceID int, plan atc.Plan) (Build, error)
 
 	GetAllPendingBuilds() (map[string][]Build, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
@@ -89,6 +91,7 @@ type pipeline struct {
 	teamID        int
 	teamName      string
 	groups        atc.GroupConfigs
+	varSources    atc.VarSourceConfigs
 	configVersion ConfigVersion
 	paused        bool
 	public        bool
@@ -100,7 +103,7 @@ type pipeline struct {
 	lockFactory lock.LockFactory
 }
 
-//ConfigVersion is a sequence identifier used for compare-and-swap
+// ConfigVersion is a sequence identifier used for compare-and-swap
 type ConfigVersion int
 
 type PipelinePausedState string
@@ -113,7 +116,9 @@ var pipelinesQuery = psql.Select(`
 		p.team_id,
 		t.name,
 		p.paused,
-		p.public
+		p.public,
+		p.var_sources,
+		p.nonce
 	`).
 	From("pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id")
@@ -147,14 +152,15 @@ func newPipeline(conn Conn, lockFactory lock.LockFactory) *pipeline {
 	}
 }
 
-func (p *pipeline) ID() int                      { return p.id }
-func (p *pipeline) Name() string                 { return p.name }
-func (p *pipeline) TeamID() int                  { return p.teamID }
-func (p *pipeline) TeamName() string             { return p.teamName }
-func (p *pipeline) Groups() atc.GroupConfigs     { return p.groups }
-func (p *pipeline) ConfigVersion() ConfigVersion { return p.configVersion }
-func (p *pipeline) Public() bool                 { return p.public }
-func (p *pipeline) Paused() bool                 { return p.paused }
+func (p *pipeline) ID() int                          { return p.id }
+func (p *pipeline) Name() string                     { return p.name }
+func (p *pipeline) TeamID() int                      { return p.teamID }
+func (p *pipeline) TeamName() string                 { return p.teamName }
+func (p *pipeline) Groups() atc.GroupConfigs         { return p.groups }
+func (p *pipeline) VarSources() atc.VarSourceConfigs { return p.varSources }
+func (p *pipeline) ConfigVersion() ConfigVersion     { return p.configVersion }
+func (p *pipeline) Public() bool                     { return p.public }
+func (p *pipeline) Paused() bool                     { return p.paused }
 
 // IMPORTANT: This method is broken with the new resource config versions changes
 func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
@@ -443,12 +449,12 @@ func (p *pipeline) Resource(name string) (Resource, bool, error) {
 
 func (p *pipeline) Builds(page Page) ([]Build, Pagination, error) {
 	return getBuildsWithPagination(
-		buildsQuery.Where(sq.Eq{"b.pipeline_id": p.id}), minMaxIdQuery, page, p.conn, p.lockFactory)
+		buildsQuery.Where(sq.Eq{"b.pipeline_id": p.id, "b.resource_id": nil}), minMaxIdQuery, page, p.conn, p.lockFactory)
 }
 
 func (p *pipeline) BuildsWithTime(page Page) ([]Build, Pagination, error) {
 	return getBuildsWithDates(
-		buildsQuery.Where(sq.Eq{"b.pipeline_id": p.id}), minMaxIdQuery, page, p.conn, p.lockFactory)
+		buildsQuery.Where(sq.Eq{"b.pipeline_id": p.id, "b.resource_id": nil}), minMaxIdQuery, page, p.conn, p.lockFactory)
 }
 
 func (p *pipeline) Resources() (Resources, error) {
@@ -972,6 +978,17 @@ func (p *pipeline) CreateOneOffBuild() (Build, error) {
 }
 
 func (p *pipeline) CreateStartedBuild(plan atc.Plan) (Build, error) {
+	return p.createStartedBuild(plan, 0)
+}
+
+// CreateStartedCheckBuild creates a started build which checks the given
+// resource. Check builds are not counted against the team's running builds
+// quota and are not listed with the pipeline's builds.
+func (p *pipeline) CreateStartedCheckBuild(resourceID int, plan atc.Plan) (Build, error) {
+	return p.createStartedBuild(plan, resourceID)
+}
+
+func (p *pipeline) createStartedBuild(plan atc.Plan, resourceID int) (Build, error) {
 	tx, err := p.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -979,6 +996,13 @@ func (p *pipeline) CreateStartedBuild(plan atc.Plan) (Build, error) {
 
 	defer Rollback(tx)
 
+	if resourceID == 0 {
+		err = checkRunningBuildsQuota(tx, p.teamID, 0)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	metadata, err := json.Marshal(plan)
 	if err != nil {
 		return nil, err
@@ -989,8 +1013,7 @@ func (p *pipeline) CreateStartedBuild(plan atc.Plan) (Build, error) {
 		return nil, err
 	}
 
-	build := &build{conn: p.conn, lockFactory: p.lockFactory}
-	err = createBuild(tx, build, map[string]interface{}{
+	columns := map[string]interface{}{
 		"name":         sq.Expr("nextval('one_off_name')"),
 		"pipeline_id":  p.id,
 		"team_id":      p.teamID,
@@ -1000,7 +1023,14 @@ func (p *pipeline) CreateStartedBuild(plan atc.Plan) (Build, error) {
 		"private_plan": encryptedPlan,
 		"public_plan":  plan.Public(),
 		"nonce":        nonce,
-	})
+	}
+
+	if resourceID != 0 {
+		columns["resource_id"] = resourceID
+	}
+
+	build := &build{conn: p.conn, lockFactory: p.lockFactory}
+	err = createBuild(tx, build, columns)
 	if err != nil {
 		return nil, err
 	}
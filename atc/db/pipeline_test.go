@@ -2025,6 +2025,60 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("CreateStartedCheckBuild", func() {
+		var (
+			resource   db.Resource
+			checkBuild db.Build
+		)
+
+		BeforeEach(func() {
+			var found bool
+			var err error
+			resource, found, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(team.UpdateQuotas(atc.TeamQuotas{MaxRunningBuilds: 1})).To(Succeed())
+
+			checkBuild, err = pipeline.CreateStartedCheckBuild(resource.ID(), atc.Plan{
+				ID: atc.PlanID("56"),
+				Check: &atc.CheckPlan{
+					Name: "some-resource",
+					Type: "some-type",
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates a started build", func() {
+			Expect(checkBuild.ID()).ToNot(BeZero())
+			Expect(checkBuild.PipelineName()).To(Equal("fake-pipeline"))
+			Expect(checkBuild.Status()).To(Equal(db.BuildStatusStarted))
+		})
+
+		It("is not listed with the pipeline's builds", func() {
+			builds, _, err := pipeline.Builds(db.Page{Limit: 10})
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, build := range builds {
+				Expect(build.ID()).ToNot(Equal(checkBuild.ID()))
+			}
+		})
+
+		It("is not counted against the team's running builds quota", func() {
+			_, err := pipeline.CreateStartedBuild(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("is started even when the team has reached its quota", func() {
+			_, err := pipeline.CreateStartedBuild(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = pipeline.CreateStartedCheckBuild(resource.ID(), atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
 	Describe("Resources", func() {
 		var resourceTypes db.ResourceTypes
 
@@ -35,6 +35,7 @@ type Resource interface {
 	CheckSetupError() error
 	CheckError() error
 	WebhookToken() string
+	Webhook() *atc.WebhookConfig
 	ConfigPinnedVersion() atc.Version
 	APIPinnedVersion() atc.Version
 	PinComment() string
@@ -57,6 +58,11 @@ type Resource interface {
 	SetResourceConfig(lager.Logger, atc.Source, creds.VersionedResourceTypes) (ResourceConfigScope, error)
 	SetCheckSetupError(error) error
 
+	SaveWebhookDelivery(atc.WebhookDelivery) error
+	WebhookDeliveries() ([]atc.WebhookDelivery, error)
+
+	CheckHistory() ([]atc.ResourceCheck, error)
+
 	Reload() (bool, error)
 }
 
@@ -85,6 +91,7 @@ type resource struct {
 	checkSetupError       error
 	checkError            error
 	webhookToken          string
+	webhook               *atc.WebhookConfig
 	configPinnedVersion   atc.Version
 	apiPinnedVersion      atc.Version
 	pinComment            string
@@ -150,6 +157,7 @@ func (r *resource) Tags() atc.Tags                   { return r.tags }
 func (r *resource) CheckSetupError() error           { return r.checkSetupError }
 func (r *resource) CheckError() error                { return r.checkError }
 func (r *resource) WebhookToken() string             { return r.webhookToken }
+func (r *resource) Webhook() *atc.WebhookConfig      { return r.webhook }
 func (r *resource) ConfigPinnedVersion() atc.Version { return r.configPinnedVersion }
 func (r *resource) APIPinnedVersion() atc.Version    { return r.apiPinnedVersion }
 func (r *resource) PinComment() string               { return r.pinComment }
@@ -326,6 +334,133 @@ func (r *resource) SetPinComment(comment string) error {
 	return err
 }
 
+// webhookDeliveriesToKeep is the number of recent webhook deliveries kept for
+// each resource.
+const webhookDeliveriesToKeep = 50
+
+func (r *resource) SaveWebhookDelivery(delivery atc.WebhookDelivery) error {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Insert("resource_webhook_deliveries").
+		Columns("resource_id", "provider", "event", "branch", "status", "message").
+		Values(r.id, delivery.Provider, delivery.Event, delivery.Branch, delivery.Status, delivery.Message).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		DELETE FROM resource_webhook_deliveries
+		WHERE resource_id = $1
+		AND id NOT IN (
+			SELECT id
+			FROM resource_webhook_deliveries
+			WHERE resource_id = $1
+			ORDER BY id DESC
+			LIMIT $2
+		)
+	`, r.id, webhookDeliveriesToKeep)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *resource) WebhookDeliveries() ([]atc.WebhookDelivery, error) {
+	rows, err := psql.Select("id", "received_at", "provider", "event", "branch", "status", "message").
+		From("resource_webhook_deliveries").
+		Where(sq.Eq{"resource_id": r.id}).
+		OrderBy("id DESC").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	deliveries := []atc.WebhookDelivery{}
+	for rows.Next() {
+		var (
+			delivery                         atc.WebhookDelivery
+			receivedAt                       time.Time
+			provider, event, branch, message sql.NullString
+		)
+
+		err = rows.Scan(&delivery.ID, &receivedAt, &provider, &event, &branch, &delivery.Status, &message)
+		if err != nil {
+			return nil, err
+		}
+
+		delivery.ReceivedAt = receivedAt.Unix()
+		delivery.Provider = provider.String
+		delivery.Event = event.String
+		delivery.Branch = branch.String
+		delivery.Message = message.String
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// CheckHistory returns the most recent checks of the resource's current
+// config scope, newest first.
+func (r *resource) CheckHistory() ([]atc.ResourceCheck, error) {
+	checks := []atc.ResourceCheck{}
+	if r.resourceConfigScopeID == 0 {
+		return checks, nil
+	}
+
+	rows, err := psql.Select("id", "start_time", "end_time", "worker_name", "versions", "check_error", "stderr").
+		From("check_history").
+		Where(sq.Eq{"resource_config_scope_id": r.resourceConfigScopeID}).
+		OrderBy("id DESC").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var (
+			check                        atc.ResourceCheck
+			startTime, endTime           time.Time
+			versionsJSON                 []byte
+			workerName, checkErr, stderr sql.NullString
+		)
+
+		err = rows.Scan(&check.ID, &startTime, &endTime, &workerName, &versionsJSON, &checkErr, &stderr)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(versionsJSON, &check.Versions)
+		if err != nil {
+			return nil, err
+		}
+
+		check.StartTime = startTime.Unix()
+		check.EndTime = endTime.Unix()
+		check.WorkerName = workerName.String
+		check.Error = checkErr.String
+		check.Stderr = stderr.String
+
+		checks = append(checks, check)
+	}
+
+	return checks, nil
+}
+
 func (r *resource) CurrentPinnedVersion() atc.Version {
 	if r.configPinnedVersion != nil {
 		return r.configPinnedVersion
@@ -629,6 +764,7 @@ func scanResource(r *resource, row scannable) error {
 	r.checkTimeout = config.CheckTimeout
 	r.tags = config.Tags
 	r.webhookToken = config.WebhookToken
+	r.webhook = config.Webhook
 	r.configPinnedVersion = config.Version
 
 	if apiPinnedVersion.Valid {
@@ -178,12 +178,16 @@ var _ = Describe("ResourceCacheFactory", func() {
 				return fmt.Sprintf("%x", sha256.Sum256([]byte(s)))
 			}
 
+			fingerprint := creds.Fingerprint(db.CredentialsFingerprintKey, atc.Source{
+				"some-type-type": "some-secret-sauce",
+			})
+
 			Expect(resourceCaches).To(ConsistOf(
 				resourceCache{
 					Version:          `{"some-type-type": "version"}`,
 					ParamsHash:       toHash(`{}`),
 					BaseResourceName: "some-base-type",
-					SourceHash:       toHash(`{"some-type-type":"some-secret-sauce"}`),
+					SourceHash:       toHash(`{"credentials_fingerprint":"` + fingerprint + `","source":{"some-type-type":"((source-param))"}}`),
 				},
 				resourceCache{
 					Version:    `{"some-type": "version"}`,
@@ -198,6 +202,38 @@ var _ = Describe("ResourceCacheFactory", func() {
 			))
 		})
 
+		It("does not share the caches of a custom type between different credentials", func() {
+			findOrCreate := func(secret string) db.UsedResourceCache {
+				usedResourceCache, err := resourceCacheFactory.FindOrCreateResourceCache(
+					logger,
+					db.ForBuild(build.ID()),
+					"some-type-type",
+					atc.Version{"some": "version"},
+					atc.Source{
+						"some": "source",
+					},
+					atc.Params{"some": "params"},
+					creds.NewVersionedResourceTypes(
+						template.StaticVariables{"source-param": secret},
+						atc.VersionedResourceTypes{
+							resourceType2,
+						},
+					),
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				return usedResourceCache
+			}
+
+			someCache := findOrCreate("some-secret-sauce")
+			otherCache := findOrCreate("some-other-secret-sauce")
+
+			Expect(otherCache.ID()).ToNot(Equal(someCache.ID()))
+			Expect(otherCache.ResourceConfig().CreatedByResourceCache().ID()).ToNot(Equal(someCache.ResourceConfig().CreatedByResourceCache().ID()))
+
+			Expect(findOrCreate("some-secret-sauce").ID()).To(Equal(someCache.ID()))
+		})
+
 		It("returns an error if base resource type does not exist", func() {
 			_, err := resourceCacheFactory.FindOrCreateResourceCache(
 				logger,
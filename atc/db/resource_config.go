@@ -32,6 +32,10 @@ type ResourceConfigDescriptor struct {
 
 	// The resource's source configuration.
 	Source atc.Source
+
+	// The keyed fingerprint of the interpolated source, for a source which
+	// refers to vars but is identified as configured.
+	CredentialsFingerprint string
 }
 
 //go:generate counterfeiter . ResourceConfig
@@ -161,7 +165,7 @@ func (r *ResourceConfigDescriptor) findOrCreate(logger lager.Logger, tx Tx, lock
 	}
 
 	if !found {
-		hash := mapHash(r.Source)
+		hash := r.sourceHash()
 
 		var err error
 		err = psql.Insert("resource_configs").
@@ -257,7 +261,7 @@ func (r *ResourceConfigDescriptor) findWithParentID(tx Tx, parentColumnName stri
 		From("resource_configs").
 		Where(sq.Eq{
 			parentColumnName: parentID,
-			"source_hash":    mapHash(r.Source),
+			"source_hash":    r.sourceHash(),
 		}).
 		Where(whereClause).
 		Suffix("FOR SHARE").
@@ -275,6 +279,43 @@ func (r *ResourceConfigDescriptor) findWithParentID(tx Tx, parentColumnName stri
 	return id, true, nil
 }
 
+// sourceHash identifies the resource config's source. A source which is
+// identified as configured but refers to vars is identified along with the
+// fingerprint of the credentials it was interpolated with.
+func (r *ResourceConfigDescriptor) sourceHash() string {
+	if r.CredentialsFingerprint == "" {
+		return mapHash(r.Source)
+	}
+
+	return mapHash(map[string]interface{}{
+		"source":                  r.Source,
+		"credentials_fingerprint": r.CredentialsFingerprint,
+	})
+}
+
+// usesVars returns true if the resource's source, or the source of any of the
+// custom resource types it is provided by, refers to vars.
+func usesVars(resource Resource, resourceTypes creds.VersionedResourceTypes) bool {
+	if creds.HasVars(resource.Source()) {
+		return true
+	}
+
+	typeName := resource.Type()
+	for {
+		customType, found := resourceTypes.Lookup(typeName)
+		if !found {
+			return false
+		}
+
+		if creds.HasVars(customType.Source.Identity()) {
+			return true
+		}
+
+		typeName = customType.Type
+		resourceTypes = resourceTypes.Without(customType.Name)
+	}
+}
+
 func findOrCreateResourceConfigScope(tx Tx, conn Conn, lockFactory lock.LockFactory, resourceConfig ResourceConfig, resource Resource, resourceTypes creds.VersionedResourceTypes) (ResourceConfigScope, error) {
 	var unique bool
 	var uniqueResource Resource
@@ -289,6 +330,12 @@ func findOrCreateResourceConfigScope(tx Tx, conn Conn, lockFactory lock.LockFact
 			} else {
 				unique = resourceConfig.CreatedByBaseResourceType().UniqueVersionHistory
 			}
+
+			// resource configs are identified by their uninterpolated source,
+			// which may refer to different credentials for each resource
+			if usesVars(resource, resourceTypes) {
+				unique = true
+			}
 		}
 
 		if unique {
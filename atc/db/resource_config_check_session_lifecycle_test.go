@@ -33,7 +33,7 @@ var _ = Describe("ResourceConfigCheckSessionLifecycle", func() {
 				resourceConfigScope, err := defaultResource.SetResourceConfig(logger, defaultResource.Source(), creds.VersionedResourceTypes{})
 				Expect(err).ToNot(HaveOccurred())
 
-				owner := db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), expiry)
+				owner := db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), "", expiry)
 
 				var query sq.Eq
 				var found bool
@@ -130,7 +130,7 @@ var _ = Describe("ResourceConfigCheckSessionLifecycle", func() {
 				)
 				Expect(err).ToNot(HaveOccurred())
 
-				owner := db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), expiry)
+				owner := db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), "", expiry)
 
 				var query sq.Eq
 				var found bool
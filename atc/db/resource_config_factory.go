@@ -21,6 +21,11 @@ func (e ErrCustomResourceTypeVersionNotFound) Error() string {
 	return fmt.Sprintf("custom resource type '%s' version not found", e.Name)
 }
 
+// CredentialsFingerprintKey is the key that the credentials of custom resource
+// types whose source refers to vars are fingerprinted with; see
+// creds.Fingerprint.
+var CredentialsFingerprintKey []byte
+
 //go:generate counterfeiter . ResourceConfigFactory
 
 type ResourceConfigFactory interface {
@@ -117,20 +122,27 @@ func constructResourceConfigDescriptor(
 
 	customType, found := resourceTypes.Lookup(resourceTypeName)
 	if found {
-		source, err := customType.Source.Evaluate()
-		if err != nil {
-			return ResourceConfigDescriptor{}, err
-		}
-
 		customTypeResourceConfig, err := constructResourceConfigDescriptor(
 			customType.Type,
-			source,
+			customType.Source.Identity(),
 			resourceTypes.Without(customType.Name),
 		)
 		if err != nil {
 			return ResourceConfigDescriptor{}, err
 		}
 
+		// the image fetched for a custom type depends on the credentials its
+		// source refers to, so it must not be shared by anyone whose vars
+		// resolve to different ones
+		if creds.HasVars(customType.Source.Identity()) {
+			source, err := customType.Source.Evaluate()
+			if err != nil {
+				return ResourceConfigDescriptor{}, err
+			}
+
+			customTypeResourceConfig.CredentialsFingerprint = creds.Fingerprint(CredentialsFingerprintKey, source)
+		}
+
 		resourceConfigDescriptor.CreatedByResourceCache = &ResourceCacheDescriptor{
 			ResourceConfigDescriptor: customTypeResourceConfig,
 			Version:                  customType.Version,
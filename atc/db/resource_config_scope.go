@@ -31,6 +31,7 @@ type ResourceConfigScope interface {
 	LatestVersion() (ResourceConfigVersion, bool, error)
 
 	SetCheckError(error) error
+	RecordCheck(atc.ResourceCheck) error
 
 	AcquireResourceCheckingLock(
 		logger lager.Logger,
@@ -179,6 +180,57 @@ func (r *resourceConfigScope) SetCheckError(cause error) error {
 	return err
 }
 
+// checkHistoryToKeep is the number of recent checks kept for each resource
+// config scope.
+const checkHistoryToKeep = 50
+
+// RecordCheck adds the check to the scope's history, discarding all but the
+// most recent checks.
+func (r *resourceConfigScope) RecordCheck(check atc.ResourceCheck) error {
+	versions := check.Versions
+	if versions == nil {
+		versions = []atc.Version{}
+	}
+
+	versionsJSON, err := json.Marshal(versions)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Insert("check_history").
+		Columns("resource_config_scope_id", "start_time", "end_time", "worker_name", "versions", "check_error", "stderr").
+		Values(r.id, time.Unix(check.StartTime, 0), time.Unix(check.EndTime, 0), check.WorkerName, versionsJSON, check.Error, check.Stderr).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		DELETE FROM check_history
+		WHERE resource_config_scope_id = $1
+		AND id NOT IN (
+			SELECT id
+			FROM check_history
+			WHERE resource_config_scope_id = $1
+			ORDER BY id DESC
+			LIMIT $2
+		)
+	`, r.id, checkHistoryToKeep)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (r *resourceConfigScope) AcquireResourceCheckingLock(
 	logger lager.Logger,
 	interval time.Duration,
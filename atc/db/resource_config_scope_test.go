@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cloudfoundry/bosh-cli/director/template"
@@ -112,6 +113,77 @@ var _ = Describe("Resource Config Scope", func() {
 		})
 	})
 
+	Describe("RecordCheck", func() {
+		var checkHistory func() []atc.ResourceCheck
+
+		BeforeEach(func() {
+			checkHistory = func() []atc.ResourceCheck {
+				pipeline, found, err := defaultTeam.Pipeline("scope-pipeline")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				resource, found, err := pipeline.Resource("some-resource")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				checks, err := resource.CheckHistory()
+				Expect(err).NotTo(HaveOccurred())
+
+				return checks
+			}
+		})
+
+		It("records the check in the resource's check history", func() {
+			startTime := time.Now().Add(-time.Minute).Unix()
+			endTime := time.Now().Unix()
+
+			err := resourceScope.RecordCheck(atc.ResourceCheck{
+				StartTime:  startTime,
+				EndTime:    endTime,
+				WorkerName: "some-worker",
+				Versions:   []atc.Version{{"ref": "v1"}, {"ref": "v2"}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = resourceScope.RecordCheck(atc.ResourceCheck{
+				StartTime:  startTime,
+				EndTime:    endTime,
+				WorkerName: "some-worker",
+				Error:      "resource script '/opt/resource/check []' failed: exit status 1",
+				Stderr:     "some-stderr",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			checks := checkHistory()
+			Expect(checks).To(HaveLen(2))
+
+			Expect(checks[0].StartTime).To(Equal(startTime))
+			Expect(checks[0].EndTime).To(Equal(endTime))
+			Expect(checks[0].WorkerName).To(Equal("some-worker"))
+			Expect(checks[0].Versions).To(BeEmpty())
+			Expect(checks[0].Error).To(Equal("resource script '/opt/resource/check []' failed: exit status 1"))
+			Expect(checks[0].Stderr).To(Equal("some-stderr"))
+
+			Expect(checks[1].Versions).To(Equal([]atc.Version{{"ref": "v1"}, {"ref": "v2"}}))
+			Expect(checks[1].Error).To(BeEmpty())
+			Expect(checks[1].Stderr).To(BeEmpty())
+		})
+
+		It("keeps only the most recent checks", func() {
+			for i := 0; i < 55; i++ {
+				err := resourceScope.RecordCheck(atc.ResourceCheck{
+					WorkerName: fmt.Sprintf("worker-%d", i),
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			checks := checkHistory()
+			Expect(checks).To(HaveLen(50))
+			Expect(checks[0].WorkerName).To(Equal("worker-54"))
+			Expect(checks[49].WorkerName).To(Equal("worker-5"))
+		})
+	})
+
 	Describe("LatestVersion", func() {
 		Context("when the resource config exists", func() {
 			var latestCV db.ResourceConfigVersion
@@ -2,6 +2,7 @@ package db_test
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/cloudfoundry/bosh-cli/director/template"
@@ -37,6 +38,11 @@ var _ = Describe("Resource", func() {
 						Public: true,
 						Type:   "git",
 						Source: atc.Source{"some": "other-repository"},
+						Webhook: &atc.WebhookConfig{
+							Provider: "github",
+							Secret:   "((webhook-secret))",
+							Events:   []string{"push"},
+						},
 					},
 					{
 						Name:   "some-secret-resource",
@@ -86,6 +92,11 @@ var _ = Describe("Resource", func() {
 				case "some-other-resource":
 					Expect(r.Type()).To(Equal("git"))
 					Expect(r.Source()).To(Equal(atc.Source{"some": "other-repository"}))
+					Expect(r.Webhook()).To(Equal(&atc.WebhookConfig{
+						Provider: "github",
+						Secret:   "((webhook-secret))",
+						Events:   []string{"push"},
+					}))
 				case "some-secret-resource":
 					Expect(r.Type()).To(Equal("git"))
 					Expect(r.Source()).To(Equal(atc.Source{"some": "((secret-repository))"}))
@@ -224,6 +235,16 @@ var _ = Describe("Resource", func() {
 						Type:   "some-type",
 						Source: atc.Source{"some": "repository"},
 					},
+					{
+						Name:   "some-secret-resource",
+						Type:   "some-type",
+						Source: atc.Source{"some": "((repository))"},
+					},
+					{
+						Name:   "some-other-secret-resource",
+						Type:   "some-type",
+						Source: atc.Source{"some": "((repository))"},
+					},
 					{
 						Name:   "pipeline-resource",
 						Type:   "some-resourceType",
@@ -322,6 +343,46 @@ var _ = Describe("Resource", func() {
 				})
 			})
 
+			Context("when the resources' source refers to vars", func() {
+				var (
+					resourceScope1 db.ResourceConfigScope
+					resourceScope2 db.ResourceConfigScope
+				)
+
+				BeforeEach(func() {
+					setupTx, err := dbConn.Begin()
+					Expect(err).ToNot(HaveOccurred())
+
+					brt := db.BaseResourceType{
+						Name: "some-type",
+					}
+
+					_, err = brt.FindOrCreate(setupTx, false)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(setupTx.Commit()).To(Succeed())
+
+					resource1, found, err := pipeline.Resource("some-secret-resource")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					resourceScope1, err = resource1.SetResourceConfig(logger, atc.Source{"some": "((repository))"}, creds.VersionedResourceTypes{})
+					Expect(err).NotTo(HaveOccurred())
+
+					resource2, found, err := pipeline.Resource("some-other-secret-resource")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					resourceScope2, err = resource2.SetResourceConfig(logger, atc.Source{"some": "((repository))"}, creds.VersionedResourceTypes{})
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("shares the resource config but gives each resource its own version history", func() {
+					Expect(resourceScope1.ResourceConfig().ID()).To(Equal(resourceScope2.ResourceConfig().ID()))
+					Expect(resourceScope1.ID()).ToNot(Equal(resourceScope2.ID()))
+					Expect(resourceScope1.Resource()).ToNot(BeNil())
+				})
+			})
+
 			Context("when the resource uses a base resource type that has unique version history", func() {
 				var (
 					resourceScope1 db.ResourceConfigScope
@@ -624,6 +685,85 @@ var _ = Describe("Resource", func() {
 		})
 	})
 
+	Describe("WebhookDeliveries", func() {
+		var resource db.Resource
+
+		BeforeEach(func() {
+			var err error
+			resource, _, err = pipeline.Resource("some-other-resource")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns no deliveries at first", func() {
+			deliveries, err := resource.WebhookDeliveries()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(BeEmpty())
+		})
+
+		Context("when deliveries are saved", func() {
+			BeforeEach(func() {
+				err := resource.SaveWebhookDelivery(atc.WebhookDelivery{
+					Provider: "github",
+					Event:    "push",
+					Branch:   "master",
+					Status:   atc.WebhookDeliveryAccepted,
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = resource.SaveWebhookDelivery(atc.WebhookDelivery{
+					Provider: "github",
+					Status:   atc.WebhookDeliveryRejected,
+					Message:  "invalid signature",
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the most recent first", func() {
+				deliveries, err := resource.WebhookDeliveries()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(2))
+
+				Expect(deliveries[0].Status).To(Equal(atc.WebhookDeliveryRejected))
+				Expect(deliveries[0].Message).To(Equal("invalid signature"))
+				Expect(deliveries[0].ReceivedAt).ToNot(BeZero())
+
+				Expect(deliveries[1].Status).To(Equal(atc.WebhookDeliveryAccepted))
+				Expect(deliveries[1].Provider).To(Equal("github"))
+				Expect(deliveries[1].Event).To(Equal("push"))
+				Expect(deliveries[1].Branch).To(Equal("master"))
+			})
+
+			It("does not return deliveries to other resources", func() {
+				otherResource, _, err := pipeline.Resource("some-resource")
+				Expect(err).ToNot(HaveOccurred())
+
+				deliveries, err := otherResource.WebhookDeliveries()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(BeEmpty())
+			})
+		})
+
+		Context("when more deliveries are saved than are kept", func() {
+			BeforeEach(func() {
+				for i := 0; i < 55; i++ {
+					err := resource.SaveWebhookDelivery(atc.WebhookDelivery{
+						Status:  atc.WebhookDeliveryAccepted,
+						Message: fmt.Sprintf("delivery-%d", i),
+					})
+					Expect(err).ToNot(HaveOccurred())
+				}
+			})
+
+			It("keeps only the most recent", func() {
+				deliveries, err := resource.WebhookDeliveries()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(50))
+				Expect(deliveries[0].Message).To(Equal("delivery-54"))
+				Expect(deliveries[49].Message).To(Equal("delivery-5"))
+			})
+		})
+	})
+
 	Describe("ResourceConfigVersion", func() {
 		var (
 			resource                   db.Resource
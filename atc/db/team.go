@@ -17,6 +17,8 @@ import (
 )
 
 var ErrConfigComparisonFailed = errors.New("comparison with existing config failed during save")
+var ErrRunningBuildsQuotaReached = errors.New("team has reached its running builds quota")
+var ErrContainersQuotaReached = errors.New("team has reached its containers quota")
 
 //go:generate counterfeiter . Team
 
@@ -66,6 +68,13 @@ type Team interface {
 	FindWorkerForVolume(handle string) (Worker, bool, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+
+	Quotas() atc.TeamQuotas
+	UpdateQuotas(quotas atc.TeamQuotas) error
+	ContainersQuotaReached() (bool, error)
+
+	CredentialManagers() []string
+	UpdateCredentialManagers(names []string) error
 }
 
 type team struct {
@@ -76,7 +85,9 @@ type team struct {
 	name  string
 	admin bool
 
-	auth atc.TeamAuth
+	auth               atc.TeamAuth
+	quotas             atc.TeamQuotas
+	credentialManagers []string
 }
 
 func (t *team) ID() int      { return t.id }
@@ -351,6 +362,16 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
+	varSourcesPayload, err := json.Marshal(config.VarSources)
+	if err != nil {
+		return nil, false, err
+	}
+
+	encryptedVarSources, nonce, err := t.conn.EncryptionStrategy().Encrypt(varSourcesPayload)
+	if err != nil {
+		return nil, false, err
+	}
+
 	jobGroups := make(map[string][]string)
 	for _, group := range config.Groups {
 		for _, job := range group.Jobs {
@@ -386,12 +407,14 @@ func (t *team) SavePipeline(
 
 		err = psql.Insert("pipelines").
 			SetMap(map[string]interface{}{
-				"name":     pipelineName,
-				"groups":   groupsPayload,
-				"version":  sq.Expr("nextval('config_version_seq')"),
-				"ordering": sq.Expr("currval('pipelines_id_seq')"),
-				"paused":   pausedState.Bool(),
-				"team_id":  t.id,
+				"name":        pipelineName,
+				"groups":      groupsPayload,
+				"var_sources": encryptedVarSources,
+				"nonce":       nonce,
+				"version":     sq.Expr("nextval('config_version_seq')"),
+				"ordering":    sq.Expr("currval('pipelines_id_seq')"),
+				"paused":      pausedState.Bool(),
+				"team_id":     t.id,
 			}).
 			Suffix("RETURNING id").
 			RunWith(tx).
@@ -404,6 +427,8 @@ func (t *team) SavePipeline(
 	} else {
 		update := psql.Update("pipelines").
 			Set("groups", groupsPayload).
+			Set("var_sources", encryptedVarSources).
+			Set("nonce", nonce).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
 			Where(sq.Eq{
 				"name":    pipelineName,
@@ -692,6 +717,11 @@ func (t *team) CreateStartedBuild(plan atc.Plan) (Build, error) {
 
 	defer Rollback(tx)
 
+	err = checkRunningBuildsQuota(tx, t.id, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	metadata, err := json.Marshal(plan)
 	if err != nil {
 		return nil, err
@@ -795,6 +825,162 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+func (t *team) Quotas() atc.TeamQuotas { return t.quotas }
+
+func (t *team) UpdateQuotas(quotas atc.TeamQuotas) error {
+	_, err := psql.Update("teams").
+		Set("max_running_builds", quotaValue(quotas.MaxRunningBuilds)).
+		Set("max_containers", quotaValue(quotas.MaxContainers)).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.quotas = quotas
+
+	return nil
+}
+
+// ContainersQuotaReached returns true if the team owns as many containers as
+// its quota allows. Containers which are being destroyed are not counted.
+func (t *team) ContainersQuotaReached() (bool, error) {
+	return containersQuotaReached(t.conn, t.id)
+}
+
+func containersQuotaReached(runner sq.BaseRunner, teamID int) (bool, error) {
+	return quotaReached(runner, teamID, "max_containers", sq.Select("COUNT(*)").
+		From("containers c").
+		Where(sq.Expr("c.team_id = t.id")).
+		Where(sq.NotEq{"c.state": string(atc.ContainerStateDestroying)}))
+}
+
+// checkContainersQuota locks the team's quotas and returns
+// ErrContainersQuotaReached if the team may not create another container.
+func checkContainersQuota(tx Tx, teamID int) error {
+	err := lockTeamQuotas(tx, teamID)
+	if err != nil {
+		return err
+	}
+
+	reached, err := containersQuotaReached(tx, teamID)
+	if err != nil {
+		return err
+	}
+
+	if reached {
+		return ErrContainersQuotaReached
+	}
+
+	return nil
+}
+
+// lockTeamQuotas locks the team's row until the transaction ends. Usage which
+// is counted against the team's quotas and then added to within the
+// transaction cannot then be raced by another transaction doing the same.
+func lockTeamQuotas(tx Tx, teamID int) error {
+	_, err := psql.Select("id").
+		From("teams").
+		Where(sq.Eq{"id": teamID}).
+		Suffix("FOR NO KEY UPDATE").
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+// checkRunningBuildsQuota locks the team's quotas and returns
+// ErrRunningBuildsQuotaReached if the team may not run another build. The
+// given build, if it is already running, is not counted against the quota.
+func checkRunningBuildsQuota(tx Tx, teamID int, buildID int) error {
+	err := lockTeamQuotas(tx, teamID)
+	if err != nil {
+		return err
+	}
+
+	reached, err := runningBuildsQuotaReached(tx, teamID, buildID)
+	if err != nil {
+		return err
+	}
+
+	if reached {
+		return ErrRunningBuildsQuotaReached
+	}
+
+	return nil
+}
+
+// runningBuildsQuotaReached returns true if the team has as many builds,
+// other than the given one, scheduled or running as its quota allows. Builds
+// which check a resource are not counted.
+func runningBuildsQuotaReached(tx Tx, teamID int, buildID int) (bool, error) {
+	return quotaReached(tx, teamID, "max_running_builds", sq.Select("COUNT(*)").
+		From("builds b").
+		Where(sq.Expr("b.team_id = t.id")).
+		Where(sq.NotEq{"b.id": buildID}).
+		Where(sq.Eq{"b.resource_id": nil}).
+		Where(sq.Eq{"b.completed": false}).
+		Where(sq.Or{
+			sq.Eq{"b.scheduled": true},
+			sq.Eq{"b.status": string(BuildStatusStarted)},
+		}))
+}
+
+// quotaReached compares the team's quota in the given column against its
+// usage. The usage query must use '?' placeholders, as it is embedded into a
+// query which numbers them.
+func quotaReached(runner sq.BaseRunner, teamID int, column string, usage sq.SelectBuilder) (bool, error) {
+	usageSQL, usageArgs, err := usage.ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var reached bool
+	err = psql.Select("COALESCE(t."+column+" <= ("+usageSQL+"), false)", usageArgs...).
+		From("teams t").
+		Where(sq.Eq{"t.id": teamID}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&reached)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return reached, nil
+}
+
+// CredentialManagers returns the names of the credential managers which the
+// team's credentials are looked up in. If empty, every configured credential
+// manager is used.
+func (t *team) CredentialManagers() []string { return t.credentialManagers }
+
+func (t *team) UpdateCredentialManagers(names []string) error {
+	_, err := psql.Update("teams").
+		Set("credential_managers", pq.Array(names)).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.credentialManagers = names
+
+	return nil
+}
+
+func quotaValue(quota int) interface{} {
+	if quota <= 0 {
+		return nil
+	}
+
+	return quota
+}
+
 func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, variablesFactory creds.VariablesFactory) ([]Container, map[int]time.Time, error) {
 	pipeline, found, err := t.Pipeline(pipelineName)
 	if err != nil {
@@ -821,16 +1007,11 @@ func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, res
 
 	versionedResourceTypes := pipelineResourceTypes.Deserialize()
 
-	source, err := creds.NewSource(variables, resource.Source()).Evaluate()
-	if err != nil {
-		return nil, nil, err
-	}
-
 	resourceConfigFactory := NewResourceConfigFactory(t.conn, t.lockFactory)
 	resourceConfig, err := resourceConfigFactory.FindOrCreateResourceConfig(
 		logger,
 		resource.Type(),
-		source,
+		resource.Source(),
 		creds.NewVersionedResourceTypes(variables, versionedResourceTypes),
 	)
 	if err != nil {
@@ -1074,12 +1255,29 @@ func (t *team) findContainer(whereClause sq.Sqlizer) (CreatingContainer, Created
 }
 
 func scanPipeline(p *pipeline, scan scannable) error {
-	var groups sql.NullString
-	err := scan.Scan(&p.id, &p.name, &groups, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public)
+	var groups, varSources, nonce sql.NullString
+	err := scan.Scan(&p.id, &p.name, &groups, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &varSources, &nonce)
 	if err != nil {
 		return err
 	}
 
+	if varSources.Valid {
+		var noncense *string
+		if nonce.Valid {
+			noncense = &nonce.String
+		}
+
+		decryptedVarSources, err := p.conn.EncryptionStrategy().Decrypt(varSources.String, noncense)
+		if err != nil {
+			return err
+		}
+
+		err = json.Unmarshal(decryptedVarSources, &p.varSources)
+		if err != nil {
+			return err
+		}
+	}
+
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
 		err = json.Unmarshal([]byte(groups.String), &pipelineGroups)
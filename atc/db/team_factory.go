@@ -9,6 +9,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
 )
 
 //go:generate counterfeiter . TeamFactory
@@ -51,9 +52,9 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 	}
 
 	row := psql.Insert("teams").
-		Columns("name, auth, admin").
-		Values(t.Name, auth, admin).
-		Suffix("RETURNING id, name, admin, auth").
+		Columns("name, auth, admin, credential_managers").
+		Values(t.Name, auth, admin, pq.Array(t.CredentialManagers)).
+		Suffix("RETURNING id, name, admin, auth, max_running_builds, max_containers, credential_managers").
 		RunWith(tx).
 		QueryRow()
 
@@ -89,7 +90,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 		lockFactory: factory.lockFactory,
 	}
 
-	row := psql.Select("id, name, admin, auth").
+	row := psql.Select("id, name, admin, auth, max_running_builds, max_containers, credential_managers").
 		From("teams").
 		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
 		RunWith(factory.conn).
@@ -108,7 +109,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 }
 
 func (factory *teamFactory) GetTeams() ([]Team, error) {
-	rows, err := psql.Select("id, name, admin, auth").
+	rows, err := psql.Select("id, name, admin, auth, max_running_builds, max_containers, credential_managers").
 		From("teams").
 		OrderBy("id ASC").
 		RunWith(factory.conn).
@@ -166,15 +167,26 @@ func (factory *teamFactory) CreateDefaultTeamIfNotExists() (Team, error) {
 }
 
 func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
-	var providerAuth sql.NullString
+	var (
+		providerAuth                    sql.NullString
+		maxRunningBuilds, maxContainers sql.NullInt64
+	)
 
 	err := rows.Scan(
 		&t.id,
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&maxRunningBuilds,
+		&maxContainers,
+		pq.Array(&t.credentialManagers),
 	)
 
+	t.quotas = atc.TeamQuotas{
+		MaxRunningBuilds: int(maxRunningBuilds.Int64),
+		MaxContainers:    int(maxContainers.Int64),
+	}
+
 	if providerAuth.Valid {
 		err = json.Unmarshal([]byte(providerAuth.String), &t.auth)
 		if err != nil {
@@ -23,7 +23,7 @@ var _ = Describe("Team Factory", func() {
 
 	Describe("CreateTeam", func() {
 		var team db.Team
-		BeforeEach(func() {
+		JustBeforeEach(func() {
 			var err error
 			team, err = teamFactory.CreateTeam(atcTeam)
 			Expect(err).ToNot(HaveOccurred())
@@ -38,6 +38,17 @@ var _ = Describe("Team Factory", func() {
 			Expect(found).To(BeTrue())
 			Expect(t.ID()).To(Equal(team.ID()))
 		})
+
+		Context("when the team selects credential managers", func() {
+			BeforeEach(func() {
+				atcTeam.Name = "credential-managers-team"
+				atcTeam.CredentialManagers = []string{"vault", "credhub"}
+			})
+
+			It("saves them", func() {
+				Expect(team.CredentialManagers()).To(Equal([]string{"vault", "credhub"}))
+			})
+		})
 	})
 
 	Describe("FindTeam", func() {
@@ -444,7 +444,7 @@ var _ = Describe("Team", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				resourceContainer, err = defaultWorker.CreateContainer(
-					db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), expiries),
+					db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), "", expiries),
 					db.ContainerMetadata{},
 				)
 				Expect(err).ToNot(HaveOccurred())
@@ -491,7 +491,7 @@ var _ = Describe("Team", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				resourceContainer, err = worker.CreateContainer(
-					db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), expiries),
+					db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), "", expiries),
 					db.ContainerMetadata{
 						Type: "check",
 					},
@@ -570,7 +570,7 @@ var _ = Describe("Team", func() {
 					Expect(err).ToNot(HaveOccurred())
 
 					resource2Container, err = worker.CreateContainer(
-						db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), expiries),
+						db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), "", expiries),
 						db.ContainerMetadata{
 							Type: "check",
 						},
@@ -603,7 +603,7 @@ var _ = Describe("Team", func() {
 					Expect(err).ToNot(HaveOccurred())
 
 					globalResourceContainer, err = defaultWorker.CreateContainer(
-						db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), expiries),
+						db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), "", expiries),
 						db.ContainerMetadata{
 							Type: "check",
 						},
@@ -635,7 +635,7 @@ var _ = Describe("Team", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				resourceContainer, err = defaultWorker.CreateContainer(
-					db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), expiries),
+					db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), "", expiries),
 					db.ContainerMetadata{
 						Type: "check",
 					},
@@ -847,6 +847,174 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("Quotas", func() {
+		It("has no quotas by default", func() {
+			Expect(team.Quotas()).To(Equal(atc.TeamQuotas{}))
+		})
+
+		Describe("UpdateQuotas", func() {
+			It("saves the quotas on the team", func() {
+				quotas := atc.TeamQuotas{MaxRunningBuilds: 2, MaxContainers: 10}
+				Expect(team.UpdateQuotas(quotas)).To(Succeed())
+				Expect(team.Quotas()).To(Equal(quotas))
+
+				foundTeam, found, err := teamFactory.FindTeam(team.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(foundTeam.Quotas()).To(Equal(quotas))
+			})
+		})
+
+		Describe("UpdateCredentialManagers", func() {
+			It("saves the credential managers on the team", func() {
+				Expect(team.CredentialManagers()).To(BeEmpty())
+
+				names := []string{"vault", "credhub"}
+				Expect(team.UpdateCredentialManagers(names)).To(Succeed())
+				Expect(team.CredentialManagers()).To(Equal(names))
+
+				foundTeam, found, err := teamFactory.FindTeam(team.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(foundTeam.CredentialManagers()).To(Equal(names))
+			})
+		})
+
+		Describe("running builds quota", func() {
+			var pendingBuild db.Build
+
+			BeforeEach(func() {
+				_, err := team.CreateStartedBuild(atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+
+				pendingBuild, err = team.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = otherTeam.CreateStartedBuild(atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when the team has no quota", func() {
+				It("starts and schedules builds", func() {
+					_, err := team.CreateStartedBuild(atc.Plan{})
+					Expect(err).ToNot(HaveOccurred())
+
+					scheduled, err := pendingBuild.Schedule()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+				})
+			})
+
+			Context("when the team has fewer running builds than its quota", func() {
+				BeforeEach(func() {
+					Expect(team.UpdateQuotas(atc.TeamQuotas{MaxRunningBuilds: 2})).To(Succeed())
+				})
+
+				It("schedules the build", func() {
+					scheduled, err := pendingBuild.Schedule()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+				})
+
+				It("counts the build once it is scheduled", func() {
+					_, err := pendingBuild.Schedule()
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = team.CreateStartedBuild(atc.Plan{})
+					Expect(err).To(Equal(db.ErrRunningBuildsQuotaReached))
+				})
+
+				It("does not count the build against itself when it is scheduled again", func() {
+					_, err := pendingBuild.Schedule()
+					Expect(err).ToNot(HaveOccurred())
+
+					scheduled, err := pendingBuild.Schedule()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+				})
+			})
+
+			Context("when the team has as many running builds as its quota", func() {
+				BeforeEach(func() {
+					Expect(team.UpdateQuotas(atc.TeamQuotas{MaxRunningBuilds: 1})).To(Succeed())
+				})
+
+				It("does not schedule the build", func() {
+					scheduled, err := pendingBuild.Schedule()
+					Expect(err).To(Equal(db.ErrRunningBuildsQuotaReached))
+					Expect(scheduled).To(BeFalse())
+
+					reloaded, err := pendingBuild.Reload()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reloaded).To(BeTrue())
+					Expect(pendingBuild.IsScheduled()).To(BeFalse())
+				})
+
+				It("does not start one-off builds", func() {
+					_, err := team.CreateStartedBuild(atc.Plan{})
+					Expect(err).To(Equal(db.ErrRunningBuildsQuotaReached))
+				})
+
+				It("does not affect other teams", func() {
+					_, err := otherTeam.CreateStartedBuild(atc.Plan{})
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+		})
+
+		Describe("ContainersQuotaReached", func() {
+			BeforeEach(func() {
+				build, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = defaultWorker.CreateContainer(
+					db.NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID()),
+					db.ContainerMetadata{Type: "task", StepName: "some-task"},
+				)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when the team has no quota", func() {
+				It("returns false", func() {
+					reached, err := defaultTeam.ContainersQuotaReached()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reached).To(BeFalse())
+				})
+			})
+
+			Context("when the team has as many containers as its quota", func() {
+				BeforeEach(func() {
+					Expect(defaultTeam.UpdateQuotas(atc.TeamQuotas{MaxContainers: 1})).To(Succeed())
+				})
+
+				It("returns true", func() {
+					reached, err := defaultTeam.ContainersQuotaReached()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reached).To(BeTrue())
+				})
+
+				It("refuses to create another container for the team", func() {
+					build, err := defaultTeam.CreateOneOffBuild()
+					Expect(err).ToNot(HaveOccurred())
+
+					_, err = defaultWorker.CreateContainer(
+						db.NewBuildStepContainerOwner(build.ID(), "some-other-plan", defaultTeam.ID()),
+						db.ContainerMetadata{Type: "task", StepName: "some-other-task"},
+					)
+					Expect(err).To(Equal(db.ErrContainersQuotaReached))
+				})
+
+				It("does not affect other teams", func() {
+					Expect(team.UpdateQuotas(atc.TeamQuotas{MaxContainers: 1})).To(Succeed())
+
+					reached, err := team.ContainersQuotaReached()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(reached).To(BeFalse())
+				})
+			})
+		})
+	})
+
 	Describe("Pipelines", func() {
 		var (
 			pipelines []db.Pipeline
@@ -1728,6 +1896,28 @@ var _ = Describe("Team", func() {
 			Expect(pipeline.Paused()).To(BeTrue())
 		})
 
+		It("saves the var sources", func() {
+			config.VarSources = atc.VarSourceConfigs{
+				{
+					Name: "some-source",
+					Type: "static",
+					Config: map[string]interface{}{
+						"vars": map[string]interface{}{"some-var": "some-value"},
+					},
+				},
+			}
+
+			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(savedPipeline.VarSources()).To(Equal(config.VarSources))
+
+			config.VarSources = nil
+
+			updatedPipeline, _, err := team.SavePipeline(pipelineName, config, savedPipeline.ConfigVersion(), db.PipelineNoChange)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedPipeline.VarSources()).To(BeEmpty())
+		})
+
 		It("creates all of the resources from the pipeline in the database", func() {
 			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, db.PipelineNoChange)
 			Expect(err).ToNot(HaveOccurred())
@@ -2471,7 +2661,7 @@ var _ = Describe("Team", func() {
 						Expect(err).ToNot(HaveOccurred())
 
 						resourceContainer, err = defaultWorker.CreateContainer(
-							db.NewResourceConfigCheckSessionContainerOwner(resourceConfig, expiries),
+							db.NewResourceConfigCheckSessionContainerOwner(resourceConfig, "", expiries),
 							db.ContainerMetadata{},
 						)
 						Expect(err).ToNot(HaveOccurred())
@@ -2522,7 +2712,7 @@ var _ = Describe("Team", func() {
 							Expect(err).ToNot(HaveOccurred())
 
 							otherResourceContainer, _, err = defaultWorker.FindContainerOnWorker(
-								db.NewResourceConfigCheckSessionContainerOwner(resourceConfig, expiries),
+								db.NewResourceConfigCheckSessionContainerOwner(resourceConfig, "", expiries),
 							)
 							Expect(err).ToNot(HaveOccurred())
 						})
@@ -2587,7 +2777,7 @@ var _ = Describe("Team", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				resourceContainer, err = defaultWorker.CreateContainer(
-					db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), expiries),
+					db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), "", expiries),
 					db.ContainerMetadata{},
 				)
 				Expect(err).ToNot(HaveOccurred())
@@ -0,0 +1,59 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Trace records a span for each query made with a context, as a child of the
+// span in the context. Queries made without one, or outside of a traced
+// operation, are not traced, as each would otherwise be a trace of its own.
+func Trace(conn Conn) Conn {
+	return &traceConn{
+		Conn: conn,
+	}
+}
+
+type traceConn struct {
+	Conn
+}
+
+func (c *traceConn) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := c.startSpan(ctx, "query", query)
+
+	rows, err := c.Conn.QueryContext(ctx, query, args...)
+	tracing.End(span, err)
+
+	return rows, err
+}
+
+func (c *traceConn) QueryRowContext(ctx context.Context, query string, args ...interface{}) squirrel.RowScanner {
+	ctx, span := c.startSpan(ctx, "query-row", query)
+	defer tracing.End(span, nil)
+
+	return c.Conn.QueryRowContext(ctx, query, args...)
+}
+
+func (c *traceConn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := c.startSpan(ctx, "exec", query)
+
+	result, err := c.Conn.ExecContext(ctx, query, args...)
+	tracing.End(span, err)
+
+	return result, err
+}
+
+func (c *traceConn) startSpan(ctx context.Context, component string, query string) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx, trace.SpanFromContext(ctx)
+	}
+
+	return tracing.StartSpan(ctx, "db."+component, tracing.Attrs{
+		"query": strings.Join(strings.Fields(query), " "),
+	})
+}
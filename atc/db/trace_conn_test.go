@@ -0,0 +1,76 @@
+package db_test
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/tracing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ = Describe("Trace", func() {
+	var (
+		recorder  *tracetest.SpanRecorder
+		fakeConn  *dbfakes.FakeConn
+		traceConn db.Conn
+	)
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		tracing.ConfigureTraceProvider(sdktrace.NewTracerProvider(
+			sdktrace.WithSpanProcessor(recorder),
+		))
+
+		fakeConn = new(dbfakes.FakeConn)
+		traceConn = db.Trace(fakeConn)
+	})
+
+	AfterEach(func() {
+		tracing.ConfigureTraceProvider(trace.NewNoopTracerProvider())
+	})
+
+	Context("when the query is made within a traced operation", func() {
+		It("records the query as a child of the operation's span", func() {
+			ctx, parent := tracing.StartSpan(context.Background(), "parent", nil)
+
+			_, err := traceConn.ExecContext(ctx, "UPDATE  builds\n SET scheduled = true")
+			Expect(err).ToNot(HaveOccurred())
+
+			tracing.End(parent, nil)
+
+			Expect(fakeConn.ExecContextCallCount()).To(Equal(1))
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(2))
+			Expect(spans[0].Name()).To(Equal("db.exec"))
+			Expect(spans[0].Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+			Expect(spans[0].Attributes()).To(ConsistOf(attribute.String("query", "UPDATE builds SET scheduled = true")))
+		})
+	})
+
+	Context("when the query is made outside of a traced operation", func() {
+		It("does not record it", func() {
+			_, err := traceConn.QueryContext(context.Background(), "SELECT 1")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConn.QueryContextCallCount()).To(Equal(1))
+			Expect(recorder.Ended()).To(BeEmpty())
+		})
+	})
+
+	Context("when the query is made without a context", func() {
+		It("does not record it", func() {
+			_, err := traceConn.Exec("SELECT 1")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConn.ExecCallCount()).To(Equal(1))
+			Expect(recorder.Ended()).To(BeEmpty())
+		})
+	})
+})
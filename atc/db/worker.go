@@ -16,6 +16,7 @@ import (
 var (
 	ErrWorkerNotPresent         = errors.New("worker not present in db")
 	ErrCannotPruneRunningWorker = errors.New("worker not stalled for pruning")
+	ErrCannotCordonWorker       = errors.New("worker not running for cordoning")
 )
 
 type ContainerOwnerDisappearedError struct {
@@ -51,6 +52,7 @@ type Worker interface {
 	NoProxy() string
 	ActiveContainers() int
 	ActiveVolumes() int
+	Resources() *atc.WorkerResources
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
@@ -59,11 +61,20 @@ type Worker interface {
 	StartTime() int64
 	ExpiresAt() time.Time
 	Ephemeral() bool
+	DrainDeadline() time.Time
+	AbortAfterDrainDeadline() bool
+	Cordoned() bool
+	MaintenanceStart() time.Time
+	MaintenanceEnd() time.Time
 
 	Reload() (bool, error)
 
 	Land() error
 	Retire() error
+	SetDrainDeadline(deadline time.Time, abortBuilds bool) error
+	Cordon() error
+	Uncordon() error
+	ScheduleMaintenance(start time.Time, end time.Time) error
 	Prune() error
 	Delete() error
 
@@ -84,6 +95,7 @@ type worker struct {
 	noProxy          string
 	activeContainers int
 	activeVolumes    int
+	resources        *atc.WorkerResources
 	resourceTypes    []atc.WorkerResourceType
 	platform         string
 	tags             []string
@@ -93,6 +105,13 @@ type worker struct {
 	expiresAt        time.Time
 	certsPath        *string
 	ephemeral        bool
+
+	drainDeadline           time.Time
+	abortAfterDrainDeadline bool
+
+	cordoned         bool
+	maintenanceStart time.Time
+	maintenanceEnd   time.Time
 }
 
 func (worker *worker) Name() string             { return worker.name }
@@ -107,6 +126,7 @@ func (worker *worker) HTTPSProxyURL() string                   { return worker.h
 func (worker *worker) NoProxy() string                         { return worker.noProxy }
 func (worker *worker) ActiveContainers() int                   { return worker.activeContainers }
 func (worker *worker) ActiveVolumes() int                      { return worker.activeVolumes }
+func (worker *worker) Resources() *atc.WorkerResources         { return worker.resources }
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
 func (worker *worker) Tags() []string                          { return worker.tags }
@@ -118,6 +138,13 @@ func (worker *worker) Ephemeral() bool                         { return worker.e
 func (worker *worker) StartTime() int64     { return worker.startTime }
 func (worker *worker) ExpiresAt() time.Time { return worker.expiresAt }
 
+func (worker *worker) DrainDeadline() time.Time      { return worker.drainDeadline }
+func (worker *worker) AbortAfterDrainDeadline() bool { return worker.abortAfterDrainDeadline }
+
+func (worker *worker) Cordoned() bool              { return worker.cordoned }
+func (worker *worker) MaintenanceStart() time.Time { return worker.maintenanceStart }
+func (worker *worker) MaintenanceEnd() time.Time   { return worker.maintenanceEnd }
+
 func (worker *worker) Reload() (bool, error) {
 	row := workersQuery.Where(sq.Eq{"w.name": worker.name}).
 		RunWith(worker.conn).
@@ -189,6 +216,127 @@ func (worker *worker) Retire() error {
 	return nil
 }
 
+// SetDrainDeadline sets the time by which the worker should have landed or
+// retired. If abortBuilds is true, the builds preventing it from doing so are
+// aborted once the deadline has passed.
+func (worker *worker) SetDrainDeadline(deadline time.Time, abortBuilds bool) error {
+	result, err := psql.Update("workers").
+		SetMap(map[string]interface{}{
+			"drain_deadline":             deadline,
+			"abort_after_drain_deadline": abortBuilds,
+		}).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	worker.drainDeadline = deadline
+	worker.abortAfterDrainDeadline = abortBuilds
+
+	return nil
+}
+
+// Cordon stops new containers from being placed on the running worker, while
+// it keeps running its existing containers and heartbeating. The worker stays
+// cordoned if it stalls and comes back.
+func (worker *worker) Cordon() error {
+	var cordoned bool
+	err := psql.Update("workers").
+		Set("cordoned", sq.Expr("(cordoned OR state = 'running'::worker_state)")).
+		Where(sq.Eq{"name": worker.name}).
+		Suffix("RETURNING cordoned").
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&cordoned)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrWorkerNotPresent
+		}
+		return err
+	}
+
+	if !cordoned {
+		return ErrCannotCordonWorker
+	}
+
+	worker.cordoned = true
+
+	return nil
+}
+
+// Uncordon puts the cordoned worker back into rotation, ending any
+// maintenance it is scheduled for.
+func (worker *worker) Uncordon() error {
+	result, err := psql.Update("workers").
+		SetMap(map[string]interface{}{
+			"cordoned":          false,
+			"maintenance_start": nil,
+			"maintenance_end":   nil,
+		}).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	worker.cordoned = false
+	worker.maintenanceStart = time.Time{}
+	worker.maintenanceEnd = time.Time{}
+
+	return nil
+}
+
+// ScheduleMaintenance schedules the worker to be cordoned from start until
+// end.
+func (worker *worker) ScheduleMaintenance(start time.Time, end time.Time) error {
+	result, err := psql.Update("workers").
+		SetMap(map[string]interface{}{
+			"maintenance_start": start,
+			"maintenance_end":   end,
+		}).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	worker.maintenanceStart = start
+	worker.maintenanceEnd = end
+
+	return nil
+}
+
 func (worker *worker) Prune() error {
 	rows, err := sq.Delete("workers").
 		Where(sq.Eq{
@@ -303,6 +451,15 @@ func (worker *worker) CreateContainer(owner ContainerOwner, meta ContainerMetada
 		insMap[k] = v
 	}
 
+	// containers which are not owned by a team, e.g. for checks, are not
+	// counted against any quota
+	if teamID, ok := insMap["team_id"].(int); ok && teamID != 0 {
+		err = checkContainersQuota(tx, teamID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = psql.Insert("containers").
 		SetMap(insMap).
 		Suffix("RETURNING id, " + strings.Join(containerMetadataColumns, ", ")).
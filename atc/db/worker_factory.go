@@ -9,6 +9,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
 )
 
 //go:generate counterfeiter . WorkerFactory
@@ -46,6 +47,7 @@ var workersQuery = psql.Select(`
 		w.no_proxy,
 		w.active_containers,
 		w.active_volumes,
+		w.resources,
 		w.resource_types,
 		w.platform,
 		w.tags,
@@ -53,7 +55,12 @@ var workersQuery = psql.Select(`
 		w.team_id,
 		w.start_time,
 		w.expires,
-		w.ephemeral
+		w.ephemeral,
+		w.drain_deadline,
+		w.abort_after_drain_deadline,
+		w.cordoned,
+		w.maintenance_start,
+		w.maintenance_end
 	`).
 	From("workers w").
 	LeftJoin("teams t ON w.team_id = t.id")
@@ -131,6 +138,7 @@ func scanWorker(worker *worker, row scannable) error {
 		httpProxyURL  sql.NullString
 		httpsProxyURL sql.NullString
 		noProxy       sql.NullString
+		resources     []byte
 		resourceTypes []byte
 		platform      sql.NullString
 		tags          []byte
@@ -139,6 +147,10 @@ func scanWorker(worker *worker, row scannable) error {
 		startTime     sql.NullInt64
 		expiresAt     *time.Time
 		ephemeral     sql.NullBool
+		drainDeadline pq.NullTime
+
+		maintenanceStart pq.NullTime
+		maintenanceEnd   pq.NullTime
 	)
 
 	err := row.Scan(
@@ -153,6 +165,7 @@ func scanWorker(worker *worker, row scannable) error {
 		&noProxy,
 		&worker.activeContainers,
 		&worker.activeVolumes,
+		&resources,
 		&resourceTypes,
 		&platform,
 		&tags,
@@ -161,6 +174,11 @@ func scanWorker(worker *worker, row scannable) error {
 		&startTime,
 		&expiresAt,
 		&ephemeral,
+		&drainDeadline,
+		&worker.abortAfterDrainDeadline,
+		&worker.cordoned,
+		&maintenanceStart,
+		&maintenanceEnd,
 	)
 	if err != nil {
 		return err
@@ -220,6 +238,18 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.ephemeral = ephemeral.Bool
 	}
 
+	worker.drainDeadline = drainDeadline.Time
+	worker.maintenanceStart = maintenanceStart.Time
+	worker.maintenanceEnd = maintenanceEnd.Time
+
+	worker.resources = nil
+	if resources != nil {
+		err = json.Unmarshal(resources, &worker.resources)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = json.Unmarshal(resourceTypes, &worker.resourceTypes)
 	if err != nil {
 		return err
@@ -235,6 +265,11 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 	// So we format time.Now() without any timezone information and then
 	// parse that using the same layout to strip the timezone information
 
+	resources, err := marshalWorkerResources(atcWorker.Resources)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := f.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -261,6 +296,7 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		Set("expires", sq.Expr(expires)).
 		Set("active_containers", atcWorker.ActiveContainers).
 		Set("active_volumes", atcWorker.ActiveVolumes).
+		Set("resources", resources).
 		Set("state", sq.Expr("("+cSQL+")")).
 		Where(sq.Eq{"name": atcWorker.Name}).
 		RunWith(tx).
@@ -380,6 +416,11 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		return nil, err
 	}
 
+	resources, err := marshalWorkerResources(atcWorker.Resources)
+	if err != nil {
+		return nil, err
+	}
+
 	expires := "NULL"
 	if ttl != 0 {
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
@@ -401,6 +442,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		atcWorker.GardenAddr,
 		atcWorker.ActiveContainers,
 		atcWorker.ActiveVolumes,
+		resources,
 		resourceTypes,
 		tags,
 		atcWorker.Platform,
@@ -432,6 +474,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"addr",
 			"active_containers",
 			"active_volumes",
+			"resources",
 			"resource_types",
 			"tags",
 			"platform",
@@ -454,6 +497,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				addr = ?,
 				active_containers = ?,
 				active_volumes = ?,
+				resources = ?,
 				resource_types = ?,
 				tags = ?,
 				platform = ?,
@@ -467,7 +511,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				start_time = ?,
 				state = ?,
 				team_id = ?,
-				ephemeral = ?
+				ephemeral = ?,
+				drain_deadline = NULL,
+				abort_after_drain_deadline = false
 			WHERE `+matchTeamUpsert,
 			conflictValues...,
 		).
@@ -503,6 +549,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		noProxy:          atcWorker.NoProxy,
 		activeContainers: atcWorker.ActiveContainers,
 		activeVolumes:    atcWorker.ActiveVolumes,
+		resources:        atcWorker.Resources,
 		resourceTypes:    atcWorker.ResourceTypes,
 		platform:         atcWorker.Platform,
 		tags:             atcWorker.Tags,
@@ -577,3 +624,16 @@ insert_coin:
 
 	return true
 }
+
+func marshalWorkerResources(resources *atc.WorkerResources) (sql.NullString, error) {
+	if resources == nil {
+		return sql.NullString{}, nil
+	}
+
+	payload, err := json.Marshal(resources)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return sql.NullString{String: string(payload), Valid: true}, nil
+}
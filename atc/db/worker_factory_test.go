@@ -436,6 +436,34 @@ var _ = Describe("WorkerFactory", func() {
 				Expect(*foundWorker.BaggageclaimURL()).To(Equal("some-bc-url"))
 			})
 
+			It("updates the worker's resources", func() {
+				atcWorker.Resources = &atc.WorkerResources{
+					CPUs:              4,
+					CPUUsage:          1.5,
+					MemoryInBytes:     1024,
+					MemoryUsedInBytes: 512,
+					DiskInBytes:       2048,
+					DiskUsedInBytes:   256,
+				}
+
+				foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(foundWorker.Resources()).To(Equal(atcWorker.Resources))
+
+				reloadedWorker, found, err := workerFactory.GetWorker(atcWorker.Name)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(reloadedWorker.Resources()).To(Equal(atcWorker.Resources))
+			})
+
+			Context("when the worker does not report its resources", func() {
+				It("has no resources", func() {
+					foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(foundWorker.Resources()).To(BeNil())
+				})
+			})
+
 			Context("when the current state is landing", func() {
 				BeforeEach(func() {
 					atcWorker.State = string(db.WorkerStateLanding)
@@ -628,7 +656,7 @@ var _ = Describe("WorkerFactory", func() {
 					rcs, err := otherResource.SetResourceConfig(logger, atc.Source{"some": "source"}, creds.VersionedResourceTypes{})
 					Expect(err).NotTo(HaveOccurred())
 
-					owner = db.NewResourceConfigCheckSessionContainerOwner(rcs.ResourceConfig(), ownerExpiries)
+					owner = db.NewResourceConfigCheckSessionContainerOwner(rcs.ResourceConfig(), "", ownerExpiries)
 
 					_, err = defaultWorker.CreateContainer(owner, containerMetadata)
 					Expect(err).ToNot(HaveOccurred())
@@ -11,8 +11,11 @@ import (
 type WorkerLifecycle interface {
 	DeleteUnresponsiveEphemeralWorkers() ([]string, error)
 	StallUnresponsiveWorkers() ([]string, error)
+	CordonWorkersInMaintenance() ([]string, error)
+	UncordonWorkersAfterMaintenance() ([]string, error)
 	LandFinishedLandingWorkers() ([]string, error)
 	DeleteFinishedRetiringWorkers() ([]string, error)
+	AbortBuildsPastDrainDeadline() ([]int, error)
 	GetWorkerStateByName() (map[string]WorkerState, error)
 }
 
@@ -45,6 +48,9 @@ func (lifecycle *workerLifecycle) DeleteUnresponsiveEphemeralWorkers() ([]string
 	return workersAffected(rows)
 }
 
+// StallUnresponsiveWorkers stalls running workers which have missed their
+// heartbeat, whether or not they are cordoned. Stalled workers which were
+// cordoned stay cordoned when they heartbeat again.
 func (lifecycle *workerLifecycle) StallUnresponsiveWorkers() ([]string, error) {
 	query, args, err := psql.Update("workers").
 		SetMap(map[string]interface{}{
@@ -67,6 +73,52 @@ func (lifecycle *workerLifecycle) StallUnresponsiveWorkers() ([]string, error) {
 	return workersAffected(rows)
 }
 
+func (lifecycle *workerLifecycle) CordonWorkersInMaintenance() ([]string, error) {
+	query, args, err := psql.Update("workers").
+		Set("cordoned", true).
+		Where(sq.Eq{
+			"state":    []string{string(WorkerStateRunning), string(WorkerStateStalled)},
+			"cordoned": false,
+		}).
+		Where(sq.Expr("maintenance_start <= NOW()")).
+		Where(sq.Expr("maintenance_end > NOW()")).
+		Suffix("RETURNING name").
+		ToSql()
+	if err != nil {
+		return []string{}, err
+	}
+
+	rows, err := lifecycle.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return workersAffected(rows)
+}
+
+func (lifecycle *workerLifecycle) UncordonWorkersAfterMaintenance() ([]string, error) {
+	query, args, err := psql.Update("workers").
+		SetMap(map[string]interface{}{
+			"cordoned":          false,
+			"maintenance_start": nil,
+			"maintenance_end":   nil,
+		}).
+		Where(sq.Eq{"cordoned": true}).
+		Where(sq.Expr("maintenance_end <= NOW()")).
+		Suffix("RETURNING name").
+		ToSql()
+	if err != nil {
+		return []string{}, err
+	}
+
+	rows, err := lifecycle.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return workersAffected(rows)
+}
+
 func (lifecycle *workerLifecycle) DeleteFinishedRetiringWorkers() ([]string, error) {
 	// Squirrel does not have default support for subqueries in where clauses.
 	// We hacked together a way to do it
@@ -181,6 +233,90 @@ func (lifecycle *workerLifecycle) LandFinishedLandingWorkers() ([]string, error)
 	return workersAffected(rows)
 }
 
+// AbortBuildsPastDrainDeadline aborts the builds preventing landing or
+// retiring workers from doing so once their drain deadline has passed, if the
+// workers were asked to abort them. It returns the IDs of the aborted builds.
+func (lifecycle *workerLifecycle) AbortBuildsPastDrainDeadline() ([]int, error) {
+	subQ, subQArgs, err := sq.Select("b.id").
+		Distinct().
+		From("builds b").
+		Join("containers c ON b.id = c.build_id").
+		Join("workers w ON w.name = c.worker_name").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		Where(sq.Or{
+			sq.Eq{
+				"b.status": string(BuildStatusStarted),
+			},
+			sq.Eq{
+				"b.status": string(BuildStatusPending),
+			},
+		}).
+		Where(sq.Or{
+			sq.Eq{
+				"j.interruptible": false,
+			},
+			sq.Eq{
+				"b.job_id": nil,
+			},
+		}).
+		Where(sq.Or{
+			sq.Eq{
+				"w.state": string(WorkerStateLanding),
+			},
+			sq.Eq{
+				"w.state": string(WorkerStateRetiring),
+			},
+		}).
+		Where(sq.Eq{
+			"w.abort_after_drain_deadline": true,
+		}).
+		Where(sq.Expr("w.drain_deadline < NOW()")).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := sq.Update("builds").
+		Set("status", string(BuildStatusAborted)).
+		Where("id IN ("+subQ+")", subQArgs...).
+		PlaceholderFormat(sq.Dollar).
+		Suffix("RETURNING id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := lifecycle.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var buildIDs []int
+	for rows.Next() {
+		var id int
+
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		buildIDs = append(buildIDs, id)
+	}
+
+	for _, id := range buildIDs {
+		err = lifecycle.conn.Bus().Notify(buildAbortChannel(id))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return buildIDs, nil
+}
+
 func (lifecycle *workerLifecycle) GetWorkerStateByName() (map[string]WorkerState, error) {
 	rows, err := psql.Select(`
 		name,
@@ -101,6 +101,146 @@ var _ = Describe("Worker Lifecycle", func() {
 				Expect(stalledWorkers[0]).To(Equal("some-name"))
 			})
 		})
+
+		Context("when the worker is cordoned and has not heartbeated recently", func() {
+			BeforeEach(func() {
+				dbWorker, err := workerFactory.SaveWorker(atcWorker, -1*time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+
+				err = dbWorker.Cordon()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("marks the worker as `stalled` and leaves it cordoned", func() {
+				stalledWorkers, err := workerLifecycle.StallUnresponsiveWorkers()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stalledWorkers).To(ConsistOf(atcWorker.Name))
+
+				dbWorker, found, err := workerFactory.GetWorker(atcWorker.Name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(dbWorker.State()).To(Equal(db.WorkerStateStalled))
+				Expect(dbWorker.Cordoned()).To(BeTrue())
+			})
+
+			Context("when the worker heartbeats again", func() {
+				It("is running and still cordoned", func() {
+					_, err := workerLifecycle.StallUnresponsiveWorkers()
+					Expect(err).ToNot(HaveOccurred())
+
+					dbWorker, err := workerFactory.HeartbeatWorker(atcWorker, 5*time.Minute)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbWorker.State()).To(Equal(db.WorkerStateRunning))
+					Expect(dbWorker.Cordoned()).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Describe("CordonWorkersInMaintenance", func() {
+		var dbWorker db.Worker
+
+		BeforeEach(func() {
+			var err error
+			dbWorker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the worker's maintenance has started", func() {
+			BeforeEach(func() {
+				err := dbWorker.ScheduleMaintenance(time.Now().Add(-time.Minute), time.Now().Add(time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("cordons the worker", func() {
+				cordonedWorkers, err := workerLifecycle.CordonWorkersInMaintenance()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cordonedWorkers).To(ConsistOf(atcWorker.Name))
+
+				_, err = dbWorker.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbWorker.Cordoned()).To(BeTrue())
+			})
+
+			Context("when the worker is landing", func() {
+				BeforeEach(func() {
+					err := dbWorker.Land()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("leaves the worker alone", func() {
+					cordonedWorkers, err := workerLifecycle.CordonWorkersInMaintenance()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cordonedWorkers).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when the worker's maintenance has not started", func() {
+			BeforeEach(func() {
+				err := dbWorker.ScheduleMaintenance(time.Now().Add(time.Hour), time.Now().Add(2*time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("leaves the worker alone", func() {
+				cordonedWorkers, err := workerLifecycle.CordonWorkersInMaintenance()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cordonedWorkers).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("UncordonWorkersAfterMaintenance", func() {
+		var dbWorker db.Worker
+
+		BeforeEach(func() {
+			var err error
+			dbWorker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = dbWorker.Cordon()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the worker's maintenance has ended", func() {
+			BeforeEach(func() {
+				err := dbWorker.ScheduleMaintenance(time.Now().Add(-time.Hour), time.Now().Add(-time.Minute))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("uncordons the worker and clears its maintenance", func() {
+				uncordonedWorkers, err := workerLifecycle.UncordonWorkersAfterMaintenance()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(uncordonedWorkers).To(ConsistOf(atcWorker.Name))
+
+				_, err = dbWorker.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbWorker.Cordoned()).To(BeFalse())
+				Expect(dbWorker.MaintenanceStart()).To(BeZero())
+				Expect(dbWorker.MaintenanceEnd()).To(BeZero())
+			})
+		})
+
+		Context("when the worker's maintenance has not ended", func() {
+			BeforeEach(func() {
+				err := dbWorker.ScheduleMaintenance(time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("leaves the worker alone", func() {
+				uncordonedWorkers, err := workerLifecycle.UncordonWorkersAfterMaintenance()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(uncordonedWorkers).To(BeEmpty())
+			})
+		})
+
+		Context("when the worker was cordoned without maintenance", func() {
+			It("leaves the worker alone", func() {
+				uncordonedWorkers, err := workerLifecycle.UncordonWorkersAfterMaintenance()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(uncordonedWorkers).To(BeEmpty())
+			})
+		})
 	})
 
 	Describe("DeleteFinishedRetiringWorkers", func() {
@@ -553,6 +693,110 @@ var _ = Describe("Worker Lifecycle", func() {
 		})
 	})
 
+	Describe("AbortBuildsPastDrainDeadline", func() {
+		var (
+			dbWorker db.Worker
+			dbBuild  db.Build
+		)
+
+		BeforeEach(func() {
+			atcWorker.State = string(db.WorkerStateLanding)
+
+			var err error
+			dbWorker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+
+			dbBuild, err = defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dbBuild.Start("exec.v2", atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dbWorker.CreateContainer(db.NewBuildStepContainerOwner(dbBuild.ID(), atc.PlanID(4), defaultTeam.ID()), db.ContainerMetadata{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the worker has no drain deadline", func() {
+			It("does not abort the build", func() {
+				abortedBuilds, err := workerLifecycle.AbortBuildsPastDrainDeadline()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(abortedBuilds).To(BeEmpty())
+
+				_, err = dbBuild.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dbBuild.Status()).To(Equal(db.BuildStatusStarted))
+			})
+		})
+
+		Context("when the drain deadline has not passed", func() {
+			BeforeEach(func() {
+				err := dbWorker.SetDrainDeadline(time.Now().Add(time.Hour), true)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not abort the build", func() {
+				abortedBuilds, err := workerLifecycle.AbortBuildsPastDrainDeadline()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(abortedBuilds).To(BeEmpty())
+			})
+		})
+
+		Context("when the drain deadline has passed", func() {
+			var abortBuilds bool
+
+			JustBeforeEach(func() {
+				err := dbWorker.SetDrainDeadline(time.Now().Add(-time.Minute), abortBuilds)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			Context("when the worker was asked to abort its builds", func() {
+				BeforeEach(func() {
+					abortBuilds = true
+				})
+
+				It("aborts the build so that the worker can land", func() {
+					abortedBuilds, err := workerLifecycle.AbortBuildsPastDrainDeadline()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(abortedBuilds).To(ConsistOf(dbBuild.ID()))
+
+					_, err = dbBuild.Reload()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(dbBuild.Status()).To(Equal(db.BuildStatusAborted))
+
+					landedWorkers, err := workerLifecycle.LandFinishedLandingWorkers()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(landedWorkers).To(ConsistOf(atcWorker.Name))
+				})
+
+				Context("when the worker is running again", func() {
+					JustBeforeEach(func() {
+						atcWorker.State = string(db.WorkerStateRunning)
+						_, err := workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+						Expect(err).ToNot(HaveOccurred())
+					})
+
+					It("does not abort the build", func() {
+						abortedBuilds, err := workerLifecycle.AbortBuildsPastDrainDeadline()
+						Expect(err).ToNot(HaveOccurred())
+						Expect(abortedBuilds).To(BeEmpty())
+					})
+				})
+			})
+
+			Context("when the worker was not asked to abort its builds", func() {
+				BeforeEach(func() {
+					abortBuilds = false
+				})
+
+				It("does not abort the build", func() {
+					abortedBuilds, err := workerLifecycle.AbortBuildsPastDrainDeadline()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(abortedBuilds).To(BeEmpty())
+				})
+			})
+		})
+	})
+
 	Describe("GetWorkersState", func() {
 
 		JustBeforeEach(func() {
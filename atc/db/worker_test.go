@@ -132,6 +132,211 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("Cordon", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the worker is running", func() {
+			It("cordons the worker, leaving it running", func() {
+				err := worker.Cordon()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.Cordoned()).To(BeTrue())
+				Expect(worker.State()).To(Equal(WorkerStateRunning))
+			})
+
+			Context("when the worker heartbeats", func() {
+				BeforeEach(func() {
+					err := worker.Cordon()
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("keeps the worker cordoned", func() {
+					worker, err := workerFactory.HeartbeatWorker(atcWorker, 5*time.Minute)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(worker.Cordoned()).To(BeTrue())
+				})
+			})
+
+			Context("when the worker registers again", func() {
+				BeforeEach(func() {
+					err := worker.Cordon()
+					Expect(err).NotTo(HaveOccurred())
+
+					_, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("keeps the worker cordoned", func() {
+					_, err := worker.Reload()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(worker.Cordoned()).To(BeTrue())
+				})
+			})
+		})
+
+		Context("when the worker is landing", func() {
+			BeforeEach(func() {
+				err := worker.Land()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				err := worker.Cordon()
+				Expect(err).To(Equal(ErrCannotCordonWorker))
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.State()).To(Equal(WorkerStateLanding))
+				Expect(worker.Cordoned()).To(BeFalse())
+			})
+		})
+
+		Context("when the worker is not present", func() {
+			BeforeEach(func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				err := worker.Cordon()
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
+	Describe("Uncordon", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = worker.Cordon()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = worker.ScheduleMaintenance(time.Now().Add(-time.Minute), time.Now().Add(time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("uncordons the worker and ends its maintenance", func() {
+			err := worker.Uncordon()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.Cordoned()).To(BeFalse())
+			Expect(worker.State()).To(Equal(WorkerStateRunning))
+			Expect(worker.MaintenanceStart()).To(BeZero())
+			Expect(worker.MaintenanceEnd()).To(BeZero())
+		})
+
+		Context("when the worker is not present", func() {
+			BeforeEach(func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				err := worker.Uncordon()
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
+	Describe("ScheduleMaintenance", func() {
+		var start, end time.Time
+
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			start = time.Now().Add(time.Hour)
+			end = start.Add(time.Hour)
+		})
+
+		It("sets the maintenance window of the worker", func() {
+			err := worker.ScheduleMaintenance(start, end)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.MaintenanceStart()).To(BeTemporally("~", start, time.Second))
+			Expect(worker.MaintenanceEnd()).To(BeTemporally("~", end, time.Second))
+			Expect(worker.State()).To(Equal(WorkerStateRunning))
+		})
+
+		Context("when the worker is not present", func() {
+			BeforeEach(func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				err := worker.ScheduleMaintenance(start, end)
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
+	Describe("SetDrainDeadline", func() {
+		var deadline time.Time
+
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			deadline = time.Now().Add(30 * time.Minute)
+		})
+
+		Context("when the worker is present", func() {
+			It("sets the drain deadline of the worker", func() {
+				err := worker.SetDrainDeadline(deadline, true)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.DrainDeadline()).To(BeTemporally("~", deadline, time.Second))
+				Expect(worker.AbortAfterDrainDeadline()).To(BeTrue())
+			})
+
+			Context("when the worker registers again", func() {
+				BeforeEach(func() {
+					err := worker.SetDrainDeadline(deadline, true)
+					Expect(err).NotTo(HaveOccurred())
+
+					worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("clears the drain deadline", func() {
+					_, err := worker.Reload()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(worker.DrainDeadline()).To(BeZero())
+					Expect(worker.AbortAfterDrainDeadline()).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when the worker is not present", func() {
+			BeforeEach(func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				err := worker.SetDrainDeadline(deadline, true)
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
 	Describe("Delete", func() {
 		BeforeEach(func() {
 			var err error
@@ -245,7 +450,7 @@ var _ = Describe("Worker", func() {
 			)
 			Expect(err).ToNot(HaveOccurred())
 
-			containerOwner = NewResourceConfigCheckSessionContainerOwner(resourceConfig, expiries)
+			containerOwner = NewResourceConfigCheckSessionContainerOwner(resourceConfig, "", expiries)
 		})
 
 		JustBeforeEach(func() {
@@ -0,0 +1,73 @@
+package atc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+var (
+	ErrFailedToConstructDecoder = errors.New("decoder could not be constructed")
+	ErrCouldNotDecode           = errors.New("data could not be decoded into config structure")
+)
+
+type ExtraKeysError struct {
+	extraKeys []string
+}
+
+func (eke ExtraKeysError) Error() string {
+	msg := &bytes.Buffer{}
+
+	fmt.Fprintln(msg, "unknown/extra keys:")
+	for _, unusedKey := range eke.extraKeys {
+		fmt.Fprintf(msg, "  - %s\n", unusedKey)
+	}
+
+	return msg.String()
+}
+
+// DecodeConfig decodes a pipeline config which has been unmarshaled into a
+// generic structure (e.g. from YAML or JSON), rejecting any unknown keys
+// nested within it.
+func DecodeConfig(configStructure interface{}) (Config, error) {
+	var config Config
+	var md mapstructure.Metadata
+	msConfig := &mapstructure.DecoderConfig{
+		Metadata:         &md,
+		Result:           &config,
+		WeaklyTypedInput: true,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			SanitizeDecodeHook,
+			VersionConfigDecodeHook,
+			InputsConfigDecodeHook,
+			InParallelConfigDecodeHook,
+			AcrossValuesDecodeHook,
+			ContainerLimitsDecodeHook,
+		),
+	}
+
+	decoder, err := mapstructure.NewDecoder(msConfig)
+	if err != nil {
+		return Config{}, ErrFailedToConstructDecoder
+	}
+
+	if err := decoder.Decode(configStructure); err != nil {
+		return Config{}, ErrCouldNotDecode
+	}
+
+	nestedUnused := []string{}
+	for _, unused := range md.Unused {
+		if strings.Contains(unused, ".") {
+			nestedUnused = append(nestedUnused, unused)
+		}
+	}
+
+	if len(nestedUnused) != 0 {
+		return Config{}, ExtraKeysError{extraKeys: nestedUnused}
+	}
+
+	return config, nil
+}
@@ -203,6 +203,45 @@ var InputsConfigDecodeHook = func(
 	return data, nil
 }
 
+var InParallelConfigDecodeHook = func(
+	srcType reflect.Type,
+	dstType reflect.Type,
+	data interface{},
+) (interface{}, error) {
+	if dstType != reflect.TypeOf(InParallelConfig{}) {
+		return data, nil
+	}
+
+	if srcType.Kind() == reflect.Slice {
+		return map[string]interface{}{
+			"steps": data,
+		}, nil
+	}
+
+	return data, nil
+}
+
+// AcrossValuesDecodeHook sanitizes the arbitrary values of an across var, as
+// they are decoded into a []interface{} which SanitizeDecodeHook does not
+// descend into.
+var AcrossValuesDecodeHook = func(
+	srcType reflect.Type,
+	dstType reflect.Type,
+	data interface{},
+) (interface{}, error) {
+	if dstType != reflect.TypeOf([]interface{}{}) {
+		return data, nil
+	}
+
+	return sanitize(data)
+}
+
+// SanitizeYAML converts a value unmarshaled from YAML into one which can be
+// marshaled to JSON, i.e. with a map[string]interface{} for every map.
+func SanitizeYAML(value interface{}) (interface{}, error) {
+	return sanitize(value)
+}
+
 func sanitize(root interface{}) (interface{}, error) {
 	switch rootVal := root.(type) {
 	case map[interface{}]interface{}:
@@ -21,6 +21,47 @@ func (build *execBuild) buildAggregateStep(logger lager.Logger, plan atc.Plan) e
 	return agg
 }
 
+func (build *execBuild) buildParallelStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("parallel", lager.Data{
+		"limit":     plan.InParallel.Limit,
+		"fail-fast": plan.InParallel.FailFast,
+	})
+
+	var steps []exec.Step
+
+	for _, innerPlan := range plan.InParallel.Steps {
+		innerPlan.Attempts = plan.Attempts
+		step := build.buildStep(logger, innerPlan)
+		steps = append(steps, step)
+	}
+
+	return exec.InParallel(steps, plan.InParallel.Limit, plan.InParallel.FailFast)
+}
+
+func (build *execBuild) buildAcrossStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("across")
+
+	var steps []exec.Step
+
+	for _, scopedPlan := range plan.Across.Steps {
+		vars := map[string]interface{}{}
+		for i, acrossVar := range plan.Across.Vars {
+			vars[acrossVar.Var] = scopedPlan.Values[i]
+		}
+
+		// each combination's steps see its values as vars local to them
+		scopedBuild := *build
+		scopedBuild.delegate = build.delegate.LocalScope(vars)
+
+		innerPlan := scopedPlan.Step
+		innerPlan.Attempts = plan.Attempts
+		step := scopedBuild.buildStep(logger, innerPlan)
+		steps = append(steps, step)
+	}
+
+	return exec.Across(plan.Across.Vars, steps)
+}
+
 func (build *execBuild) buildDoStep(logger lager.Logger, plan atc.Plan) exec.Step {
 	logger = logger.Session("do")
 
@@ -100,6 +141,44 @@ func (build *execBuild) buildTaskStep(logger lager.Logger, plan atc.Plan) exec.S
 	)
 }
 
+func (build *execBuild) buildSetPipelineStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("set-pipeline", lager.Data{
+		"name": plan.SetPipeline.Name,
+	})
+
+	return build.factory.SetPipeline(
+		logger,
+		plan,
+		build.dbBuild,
+		build.delegate.SetPipelineDelegate(plan.ID),
+	)
+}
+
+func (build *execBuild) buildLoadVarStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("load-var", lager.Data{
+		"name": plan.LoadVar.Name,
+	})
+
+	return build.factory.LoadVar(
+		logger,
+		plan,
+		build.delegate.LoadVarDelegate(plan.ID),
+	)
+}
+
+func (build *execBuild) buildCheckStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("check", lager.Data{
+		"name": plan.Check.Name,
+	})
+
+	return build.factory.Check(
+		logger,
+		plan,
+		build.dbBuild,
+		build.delegate.CheckDelegate(plan.ID),
+	)
+}
+
 func (build *execBuild) buildGetStep(logger lager.Logger, plan atc.Plan) exec.Step {
 	logger = logger.Session("get", lager.Data{
 		"name": plan.Get.Name,
@@ -2,61 +2,95 @@ package engine
 
 import (
 	"io"
+	"sync"
 	"unicode/utf8"
 
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 )
 
 type BuildStepDelegate struct {
-	build  db.Build
-	planID atc.PlanID
-	clock  clock.Clock
+	build     db.Build
+	planID    atc.PlanID
+	variables *creds.BuildVariables
+	clock     clock.Clock
+
+	stdout *dbEventWriter
+	stderr *dbEventWriter
 }
 
 func NewBuildStepDelegate(
 	build db.Build,
 	planID atc.PlanID,
+	variables *creds.BuildVariables,
 	clock clock.Clock,
 ) *BuildStepDelegate {
 	return &BuildStepDelegate{
-		build:  build,
-		planID: planID,
-		clock:  clock,
+		build:     build,
+		planID:    planID,
+		variables: variables,
+		clock:     clock,
+
+		stdout: newDBEventWriter(
+			build,
+			variables,
+			event.Origin{
+				Source: event.OriginSourceStdout,
+				ID:     event.OriginID(planID),
+			},
+			clock,
+		),
+		stderr: newDBEventWriter(
+			build,
+			variables,
+			event.Origin{
+				Source: event.OriginSourceStderr,
+				ID:     event.OriginID(planID),
+			},
+			clock,
+		),
 	}
 }
 
+func (delegate *BuildStepDelegate) Variables() *creds.BuildVariables {
+	return delegate.variables
+}
+
 func (delegate *BuildStepDelegate) ImageVersionDetermined(resourceCache db.UsedResourceCache) error {
 	return delegate.build.SaveImageResourceVersion(resourceCache)
 }
 
 func (delegate *BuildStepDelegate) Stdout() io.Writer {
-	return newDBEventWriter(
-		delegate.build,
-		event.Origin{
-			Source: event.OriginSourceStdout,
-			ID:     event.OriginID(delegate.planID),
-		},
-		delegate.clock,
-	)
+	return delegate.stdout
 }
 
 func (delegate *BuildStepDelegate) Stderr() io.Writer {
-	return newDBEventWriter(
-		delegate.build,
-		event.Origin{
-			Source: event.OriginSourceStderr,
-			ID:     event.OriginID(delegate.planID),
-		},
-		delegate.clock,
-	)
+	return delegate.stderr
+}
+
+// Flush saves any output which the step's stdout and stderr are still holding
+// back. It is called once the step has finished writing, before its finish
+// event is saved.
+func (delegate *BuildStepDelegate) Flush(logger lager.Logger) {
+	err := delegate.stdout.flush()
+	if err != nil {
+		logger.Error("failed-to-flush-stdout", err)
+	}
+
+	err = delegate.stderr.flush()
+	if err != nil {
+		logger.Error("failed-to-flush-stderr", err)
+	}
 }
 
 func (delegate *BuildStepDelegate) Errored(logger lager.Logger, message string) {
+	delegate.Flush(logger)
+
 	err := delegate.build.SaveEvent(event.Error{
 		Message: message,
 		Origin: event.Origin{
@@ -68,25 +102,37 @@ func (delegate *BuildStepDelegate) Errored(logger lager.Logger, message string)
 	}
 }
 
-func newDBEventWriter(build db.Build, origin event.Origin, clock clock.Clock) io.Writer {
+func newDBEventWriter(build db.Build, variables *creds.BuildVariables, origin event.Origin, clock clock.Clock) *dbEventWriter {
 	return &dbEventWriter{
-		build:  build,
-		origin: origin,
-		clock:  clock,
+		build:     build,
+		variables: variables,
+		origin:    origin,
+		clock:     clock,
 	}
 }
 
 type dbEventWriter struct {
 	build db.Build
 
+	// credentials interpolated by the build are redacted from each write. Text
+	// at the end of a write which could be the start of a credential is held
+	// back until the next write, so that a credential written in more than one
+	// chunk is still caught.
+	variables *creds.BuildVariables
+
 	origin event.Origin
 
 	dangling []byte
+	pending  string
+	lock     sync.Mutex
 
 	clock clock.Clock
 }
 
 func (writer *dbEventWriter) Write(data []byte) (int, error) {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
 	text := append(writer.dangling, data...)
 
 	checkEncoding, _ := utf8.DecodeLastRune(text)
@@ -97,14 +143,47 @@ func (writer *dbEventWriter) Write(data []byte) (int, error) {
 
 	writer.dangling = nil
 
-	err := writer.build.SaveEvent(event.Log{
-		Time:    writer.clock.Now().Unix(),
-		Payload: string(text),
-		Origin:  writer.origin,
-	})
+	payload := string(text)
+	if writer.variables != nil {
+		payload, writer.pending = writer.variables.RedactStream(writer.pending + payload)
+		if payload == "" {
+			return len(data), nil
+		}
+	}
+
+	err := writer.save(payload)
 	if err != nil {
 		return 0, err
 	}
 
 	return len(data), nil
 }
+
+// flush saves whatever is left of the output, i.e. any text held back in case
+// it was the start of a credential and any incomplete UTF-8 sequence.
+func (writer *dbEventWriter) flush() error {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	payload := writer.pending + string(writer.dangling)
+	writer.pending = ""
+	writer.dangling = nil
+
+	if payload == "" {
+		return nil
+	}
+
+	if writer.variables != nil {
+		payload = writer.variables.Redact(payload)
+	}
+
+	return writer.save(payload)
+}
+
+func (writer *dbEventWriter) save(payload string) error {
+	return writer.build.SaveEvent(event.Log{
+		Time:    writer.clock.Now().Unix(),
+		Payload: payload,
+		Origin:  writer.origin,
+	})
+}
@@ -6,7 +6,10 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
 
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/event"
@@ -25,7 +28,7 @@ var _ = Describe("BuildStepDelegate", func() {
 	BeforeEach(func() {
 		fakeBuild = new(dbfakes.FakeBuild)
 		fakeClock = fakeclock.NewFakeClock(time.Unix(123456789, 0))
-		delegate = engine.NewBuildStepDelegate(fakeBuild, "some-plan-id", fakeClock)
+		delegate = engine.NewBuildStepDelegate(fakeBuild, "some-plan-id", nil, fakeClock)
 	})
 
 	Describe("ImageVersionDetermined", func() {
@@ -96,6 +99,106 @@ var _ = Describe("BuildStepDelegate", func() {
 					Expect(writeErr).To(Equal(disaster))
 				})
 			})
+
+			Context("when the build has interpolated a credential", func() {
+				BeforeEach(func() {
+					variables := creds.NewBuildVariables(template.StaticVariables{
+						"some-secret": "hello",
+					}, true)
+
+					_, _, err := variables.Get(template.VariableDefinition{Name: "some-secret"})
+					Expect(err).ToNot(HaveOccurred())
+
+					delegate = engine.NewBuildStepDelegate(fakeBuild, "some-plan-id", variables, fakeClock)
+					writer = delegate.Stdout()
+				})
+
+				It("redacts the credential from the log event", func() {
+					Expect(writtenBytes).To(Equal(len("hello")))
+					Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+					Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
+						Time:    123456789,
+						Payload: "((redacted))",
+						Origin: event.Origin{
+							Source: event.OriginSourceStdout,
+							ID:     "some-plan-id",
+						},
+					}))
+				})
+
+				Context("when the credential is split across writes", func() {
+					BeforeEach(func() {
+						variables := creds.NewBuildVariables(template.StaticVariables{
+							"some-secret": "hello world",
+						}, true)
+
+						_, _, err := variables.Get(template.VariableDefinition{Name: "some-secret"})
+						Expect(err).ToNot(HaveOccurred())
+
+						delegate = engine.NewBuildStepDelegate(fakeBuild, "some-plan-id", variables, fakeClock)
+						writer = delegate.Stdout()
+					})
+
+					It("holds back the start of the credential until the next write", func() {
+						Expect(writtenBytes).To(Equal(len("hello")))
+						Expect(fakeBuild.SaveEventCallCount()).To(BeZero())
+
+						_, err := writer.Write([]byte(" world\n"))
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+						Expect(fakeBuild.SaveEventArgsForCall(0).(event.Log).Payload).To(Equal("((redacted))\n"))
+					})
+
+					It("keeps holding it back when stdout is asked for again", func() {
+						_, err := delegate.Stdout().Write([]byte(" world\n"))
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+						Expect(fakeBuild.SaveEventArgsForCall(0).(event.Log).Payload).To(Equal("((redacted))\n"))
+					})
+
+					Context("when the step finishes without writing the rest", func() {
+						JustBeforeEach(func() {
+							delegate.Flush(lagertest.NewTestLogger("test"))
+						})
+
+						It("saves the held back text", func() {
+							Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+							Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.Log{
+								Time:    123456789,
+								Payload: "hello",
+								Origin: event.Origin{
+									Source: event.OriginSourceStdout,
+									ID:     "some-plan-id",
+								},
+							}))
+						})
+
+						It("does not save it again", func() {
+							delegate.Flush(lagertest.NewTestLogger("test"))
+							Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("when the step errors", func() {
+						JustBeforeEach(func() {
+							delegate.Errored(lagertest.NewTestLogger("test"), "nope")
+						})
+
+						It("saves the held back text before the error", func() {
+							Expect(fakeBuild.SaveEventCallCount()).To(Equal(2))
+							Expect(fakeBuild.SaveEventArgsForCall(0).(event.Log).Payload).To(Equal("hello"))
+							Expect(fakeBuild.SaveEventArgsForCall(1)).To(Equal(event.Error{
+								Message: "nope",
+								Origin: event.Origin{
+									ID: "some-plan-id",
+								},
+							}))
+						})
+					})
+				})
+			})
 		})
 	})
 
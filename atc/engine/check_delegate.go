@@ -0,0 +1,48 @@
+package engine
+
+import (
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+)
+
+type checkDelegate struct {
+	*BuildStepDelegate
+
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func NewCheckDelegate(build db.Build, planID atc.PlanID, variables *creds.BuildVariables, clock clock.Clock) exec.CheckDelegate {
+	return &checkDelegate{
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, variables, clock),
+
+		build: build,
+		eventOrigin: event.Origin{
+			ID: event.OriginID(planID),
+		},
+		clock: clock,
+	}
+}
+
+func (d *checkDelegate) Finished(logger lager.Logger, succeeded bool) {
+	d.Flush(logger)
+
+	err := d.build.SaveEvent(event.FinishCheck{
+		Origin:    d.eventOrigin,
+		Time:      d.clock.Now().Unix(),
+		Succeeded: succeeded,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-finish-check-event", err)
+		return
+	}
+
+	logger.Info("finished", lager.Data{"succeeded": succeeded})
+}
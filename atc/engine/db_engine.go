@@ -55,6 +55,13 @@ func (engine *dbEngine) CreateBuild(logger lager.Logger, build db.Build, plan at
 
 	if !started {
 		createdBuild.Abort(logger.Session("aborted-immediately"))
+	} else {
+		metric.BuildSchedulingLatency{
+			TeamName:     build.TeamName(),
+			PipelineName: build.PipelineName(),
+			JobName:      build.JobName(),
+			Duration:     time.Since(build.CreateTime()),
+		}.Emit(logger)
 	}
 
 	return &dbBuild{
@@ -22,6 +22,17 @@ type FakeBuildDelegate struct {
 	buildStepDelegateReturnsOnCall map[int]struct {
 		result1 exec.BuildStepDelegate
 	}
+	CheckDelegateStub        func(atc.PlanID) exec.CheckDelegate
+	checkDelegateMutex       sync.RWMutex
+	checkDelegateArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	checkDelegateReturns struct {
+		result1 exec.CheckDelegate
+	}
+	checkDelegateReturnsOnCall map[int]struct {
+		result1 exec.CheckDelegate
+	}
 	FinishStub        func(lager.Logger, error, bool)
 	finishMutex       sync.RWMutex
 	finishArgsForCall []struct {
@@ -40,6 +51,28 @@ type FakeBuildDelegate struct {
 	getDelegateReturnsOnCall map[int]struct {
 		result1 exec.GetDelegate
 	}
+	LoadVarDelegateStub        func(atc.PlanID) exec.LoadVarDelegate
+	loadVarDelegateMutex       sync.RWMutex
+	loadVarDelegateArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	loadVarDelegateReturns struct {
+		result1 exec.LoadVarDelegate
+	}
+	loadVarDelegateReturnsOnCall map[int]struct {
+		result1 exec.LoadVarDelegate
+	}
+	LocalScopeStub        func(map[string]interface{}) engine.BuildDelegate
+	localScopeMutex       sync.RWMutex
+	localScopeArgsForCall []struct {
+		arg1 map[string]interface{}
+	}
+	localScopeReturns struct {
+		result1 engine.BuildDelegate
+	}
+	localScopeReturnsOnCall map[int]struct {
+		result1 engine.BuildDelegate
+	}
 	PutDelegateStub        func(atc.PlanID) exec.PutDelegate
 	putDelegateMutex       sync.RWMutex
 	putDelegateArgsForCall []struct {
@@ -51,6 +84,17 @@ type FakeBuildDelegate struct {
 	putDelegateReturnsOnCall map[int]struct {
 		result1 exec.PutDelegate
 	}
+	SetPipelineDelegateStub        func(atc.PlanID) exec.SetPipelineDelegate
+	setPipelineDelegateMutex       sync.RWMutex
+	setPipelineDelegateArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	setPipelineDelegateReturns struct {
+		result1 exec.SetPipelineDelegate
+	}
+	setPipelineDelegateReturnsOnCall map[int]struct {
+		result1 exec.SetPipelineDelegate
+	}
 	TaskDelegateStub        func(atc.PlanID) exec.TaskDelegate
 	taskDelegateMutex       sync.RWMutex
 	taskDelegateArgsForCall []struct {
@@ -126,6 +170,66 @@ func (fake *FakeBuildDelegate) BuildStepDelegateReturnsOnCall(i int, result1 exe
 	}{result1}
 }
 
+func (fake *FakeBuildDelegate) CheckDelegate(arg1 atc.PlanID) exec.CheckDelegate {
+	fake.checkDelegateMutex.Lock()
+	ret, specificReturn := fake.checkDelegateReturnsOnCall[len(fake.checkDelegateArgsForCall)]
+	fake.checkDelegateArgsForCall = append(fake.checkDelegateArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("CheckDelegate", []interface{}{arg1})
+	fake.checkDelegateMutex.Unlock()
+	if fake.CheckDelegateStub != nil {
+		return fake.CheckDelegateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.checkDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) CheckDelegateCallCount() int {
+	fake.checkDelegateMutex.RLock()
+	defer fake.checkDelegateMutex.RUnlock()
+	return len(fake.checkDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) CheckDelegateCalls(stub func(atc.PlanID) exec.CheckDelegate) {
+	fake.checkDelegateMutex.Lock()
+	defer fake.checkDelegateMutex.Unlock()
+	fake.CheckDelegateStub = stub
+}
+
+func (fake *FakeBuildDelegate) CheckDelegateArgsForCall(i int) atc.PlanID {
+	fake.checkDelegateMutex.RLock()
+	defer fake.checkDelegateMutex.RUnlock()
+	argsForCall := fake.checkDelegateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildDelegate) CheckDelegateReturns(result1 exec.CheckDelegate) {
+	fake.checkDelegateMutex.Lock()
+	defer fake.checkDelegateMutex.Unlock()
+	fake.CheckDelegateStub = nil
+	fake.checkDelegateReturns = struct {
+		result1 exec.CheckDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) CheckDelegateReturnsOnCall(i int, result1 exec.CheckDelegate) {
+	fake.checkDelegateMutex.Lock()
+	defer fake.checkDelegateMutex.Unlock()
+	fake.CheckDelegateStub = nil
+	if fake.checkDelegateReturnsOnCall == nil {
+		fake.checkDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.CheckDelegate
+		})
+	}
+	fake.checkDelegateReturnsOnCall[i] = struct {
+		result1 exec.CheckDelegate
+	}{result1}
+}
+
 func (fake *FakeBuildDelegate) Finish(arg1 lager.Logger, arg2 error, arg3 bool) {
 	fake.finishMutex.Lock()
 	fake.finishArgsForCall = append(fake.finishArgsForCall, struct {
@@ -219,6 +323,126 @@ func (fake *FakeBuildDelegate) GetDelegateReturnsOnCall(i int, result1 exec.GetD
 	}{result1}
 }
 
+func (fake *FakeBuildDelegate) LoadVarDelegate(arg1 atc.PlanID) exec.LoadVarDelegate {
+	fake.loadVarDelegateMutex.Lock()
+	ret, specificReturn := fake.loadVarDelegateReturnsOnCall[len(fake.loadVarDelegateArgsForCall)]
+	fake.loadVarDelegateArgsForCall = append(fake.loadVarDelegateArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("LoadVarDelegate", []interface{}{arg1})
+	fake.loadVarDelegateMutex.Unlock()
+	if fake.LoadVarDelegateStub != nil {
+		return fake.LoadVarDelegateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.loadVarDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateCallCount() int {
+	fake.loadVarDelegateMutex.RLock()
+	defer fake.loadVarDelegateMutex.RUnlock()
+	return len(fake.loadVarDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateCalls(stub func(atc.PlanID) exec.LoadVarDelegate) {
+	fake.loadVarDelegateMutex.Lock()
+	defer fake.loadVarDelegateMutex.Unlock()
+	fake.LoadVarDelegateStub = stub
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateArgsForCall(i int) atc.PlanID {
+	fake.loadVarDelegateMutex.RLock()
+	defer fake.loadVarDelegateMutex.RUnlock()
+	argsForCall := fake.loadVarDelegateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateReturns(result1 exec.LoadVarDelegate) {
+	fake.loadVarDelegateMutex.Lock()
+	defer fake.loadVarDelegateMutex.Unlock()
+	fake.LoadVarDelegateStub = nil
+	fake.loadVarDelegateReturns = struct {
+		result1 exec.LoadVarDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) LoadVarDelegateReturnsOnCall(i int, result1 exec.LoadVarDelegate) {
+	fake.loadVarDelegateMutex.Lock()
+	defer fake.loadVarDelegateMutex.Unlock()
+	fake.LoadVarDelegateStub = nil
+	if fake.loadVarDelegateReturnsOnCall == nil {
+		fake.loadVarDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.LoadVarDelegate
+		})
+	}
+	fake.loadVarDelegateReturnsOnCall[i] = struct {
+		result1 exec.LoadVarDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) LocalScope(arg1 map[string]interface{}) engine.BuildDelegate {
+	fake.localScopeMutex.Lock()
+	ret, specificReturn := fake.localScopeReturnsOnCall[len(fake.localScopeArgsForCall)]
+	fake.localScopeArgsForCall = append(fake.localScopeArgsForCall, struct {
+		arg1 map[string]interface{}
+	}{arg1})
+	fake.recordInvocation("LocalScope", []interface{}{arg1})
+	fake.localScopeMutex.Unlock()
+	if fake.LocalScopeStub != nil {
+		return fake.LocalScopeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.localScopeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) LocalScopeCallCount() int {
+	fake.localScopeMutex.RLock()
+	defer fake.localScopeMutex.RUnlock()
+	return len(fake.localScopeArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) LocalScopeCalls(stub func(map[string]interface{}) engine.BuildDelegate) {
+	fake.localScopeMutex.Lock()
+	defer fake.localScopeMutex.Unlock()
+	fake.LocalScopeStub = stub
+}
+
+func (fake *FakeBuildDelegate) LocalScopeArgsForCall(i int) map[string]interface{} {
+	fake.localScopeMutex.RLock()
+	defer fake.localScopeMutex.RUnlock()
+	argsForCall := fake.localScopeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildDelegate) LocalScopeReturns(result1 engine.BuildDelegate) {
+	fake.localScopeMutex.Lock()
+	defer fake.localScopeMutex.Unlock()
+	fake.LocalScopeStub = nil
+	fake.localScopeReturns = struct {
+		result1 engine.BuildDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) LocalScopeReturnsOnCall(i int, result1 engine.BuildDelegate) {
+	fake.localScopeMutex.Lock()
+	defer fake.localScopeMutex.Unlock()
+	fake.LocalScopeStub = nil
+	if fake.localScopeReturnsOnCall == nil {
+		fake.localScopeReturnsOnCall = make(map[int]struct {
+			result1 engine.BuildDelegate
+		})
+	}
+	fake.localScopeReturnsOnCall[i] = struct {
+		result1 engine.BuildDelegate
+	}{result1}
+}
+
 func (fake *FakeBuildDelegate) PutDelegate(arg1 atc.PlanID) exec.PutDelegate {
 	fake.putDelegateMutex.Lock()
 	ret, specificReturn := fake.putDelegateReturnsOnCall[len(fake.putDelegateArgsForCall)]
@@ -279,6 +503,66 @@ func (fake *FakeBuildDelegate) PutDelegateReturnsOnCall(i int, result1 exec.PutD
 	}{result1}
 }
 
+func (fake *FakeBuildDelegate) SetPipelineDelegate(arg1 atc.PlanID) exec.SetPipelineDelegate {
+	fake.setPipelineDelegateMutex.Lock()
+	ret, specificReturn := fake.setPipelineDelegateReturnsOnCall[len(fake.setPipelineDelegateArgsForCall)]
+	fake.setPipelineDelegateArgsForCall = append(fake.setPipelineDelegateArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("SetPipelineDelegate", []interface{}{arg1})
+	fake.setPipelineDelegateMutex.Unlock()
+	if fake.SetPipelineDelegateStub != nil {
+		return fake.SetPipelineDelegateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setPipelineDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) SetPipelineDelegateCallCount() int {
+	fake.setPipelineDelegateMutex.RLock()
+	defer fake.setPipelineDelegateMutex.RUnlock()
+	return len(fake.setPipelineDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) SetPipelineDelegateCalls(stub func(atc.PlanID) exec.SetPipelineDelegate) {
+	fake.setPipelineDelegateMutex.Lock()
+	defer fake.setPipelineDelegateMutex.Unlock()
+	fake.SetPipelineDelegateStub = stub
+}
+
+func (fake *FakeBuildDelegate) SetPipelineDelegateArgsForCall(i int) atc.PlanID {
+	fake.setPipelineDelegateMutex.RLock()
+	defer fake.setPipelineDelegateMutex.RUnlock()
+	argsForCall := fake.setPipelineDelegateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildDelegate) SetPipelineDelegateReturns(result1 exec.SetPipelineDelegate) {
+	fake.setPipelineDelegateMutex.Lock()
+	defer fake.setPipelineDelegateMutex.Unlock()
+	fake.SetPipelineDelegateStub = nil
+	fake.setPipelineDelegateReturns = struct {
+		result1 exec.SetPipelineDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) SetPipelineDelegateReturnsOnCall(i int, result1 exec.SetPipelineDelegate) {
+	fake.setPipelineDelegateMutex.Lock()
+	defer fake.setPipelineDelegateMutex.Unlock()
+	fake.SetPipelineDelegateStub = nil
+	if fake.setPipelineDelegateReturnsOnCall == nil {
+		fake.setPipelineDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.SetPipelineDelegate
+		})
+	}
+	fake.setPipelineDelegateReturnsOnCall[i] = struct {
+		result1 exec.SetPipelineDelegate
+	}{result1}
+}
+
 func (fake *FakeBuildDelegate) TaskDelegate(arg1 atc.PlanID) exec.TaskDelegate {
 	fake.taskDelegateMutex.Lock()
 	ret, specificReturn := fake.taskDelegateReturnsOnCall[len(fake.taskDelegateArgsForCall)]
@@ -344,12 +628,20 @@ func (fake *FakeBuildDelegate) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.buildStepDelegateMutex.RLock()
 	defer fake.buildStepDelegateMutex.RUnlock()
+	fake.checkDelegateMutex.RLock()
+	defer fake.checkDelegateMutex.RUnlock()
 	fake.finishMutex.RLock()
 	defer fake.finishMutex.RUnlock()
 	fake.getDelegateMutex.RLock()
 	defer fake.getDelegateMutex.RUnlock()
+	fake.loadVarDelegateMutex.RLock()
+	defer fake.loadVarDelegateMutex.RUnlock()
+	fake.localScopeMutex.RLock()
+	defer fake.localScopeMutex.RUnlock()
 	fake.putDelegateMutex.RLock()
 	defer fake.putDelegateMutex.RUnlock()
+	fake.setPipelineDelegateMutex.RLock()
+	defer fake.setPipelineDelegateMutex.RUnlock()
 	fake.taskDelegateMutex.RLock()
 	defer fake.taskDelegateMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 type execMetadata atc.Plan
@@ -143,7 +144,15 @@ func (build *execBuild) Abort(lager.Logger) error {
 func (build *execBuild) Resume(logger lager.Logger) {
 	step := build.buildStep(logger, atc.Plan(build.metadata))
 
-	runCtx := lagerctx.NewContext(build.ctx, logger)
+	ctx, span := tracing.StartSpan(build.ctx, "build", tracing.Attrs{
+		"team":     build.stepMetadata.TeamName,
+		"pipeline": build.stepMetadata.PipelineName,
+		"job":      build.stepMetadata.JobName,
+		"build":    build.stepMetadata.BuildName,
+		"build-id": strconv.Itoa(build.stepMetadata.BuildID),
+	})
+
+	runCtx := lagerctx.NewContext(ctx, logger)
 
 	state := build.runState()
 	defer build.clearRunState()
@@ -157,9 +166,11 @@ func (build *execBuild) Resume(logger lager.Logger) {
 		select {
 		case <-build.releaseCh:
 			logger.Info("releasing")
+			tracing.End(span, nil)
 			return
 		case err := <-done:
 			build.delegate.Finish(logger.Session("finish"), err, step.Succeeded())
+			tracing.End(span, err)
 			return
 		}
 	}
@@ -179,6 +190,14 @@ func (build *execBuild) buildStep(logger lager.Logger, plan atc.Plan) exec.Step
 		return build.buildAggregateStep(logger, plan)
 	}
 
+	if plan.InParallel != nil {
+		return build.buildParallelStep(logger, plan)
+	}
+
+	if plan.Across != nil {
+		return build.buildAcrossStep(logger, plan)
+	}
+
 	if plan.Do != nil {
 		return build.buildDoStep(logger, plan)
 	}
@@ -211,6 +230,18 @@ func (build *execBuild) buildStep(logger lager.Logger, plan atc.Plan) exec.Step
 		return build.buildTaskStep(logger, plan)
 	}
 
+	if plan.SetPipeline != nil {
+		return build.buildSetPipelineStep(logger, plan)
+	}
+
+	if plan.LoadVar != nil {
+		return build.buildLoadVarStep(logger, plan)
+	}
+
+	if plan.Check != nil {
+		return build.buildCheckStep(logger, plan)
+	}
+
 	if plan.Get != nil {
 		return build.buildGetStep(logger, plan)
 	}
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
 )
@@ -16,9 +17,17 @@ type BuildDelegate interface {
 	GetDelegate(atc.PlanID) exec.GetDelegate
 	PutDelegate(atc.PlanID) exec.PutDelegate
 	TaskDelegate(atc.PlanID) exec.TaskDelegate
+	SetPipelineDelegate(atc.PlanID) exec.SetPipelineDelegate
+	LoadVarDelegate(atc.PlanID) exec.LoadVarDelegate
+	CheckDelegate(atc.PlanID) exec.CheckDelegate
 
 	BuildStepDelegate(atc.PlanID) exec.BuildStepDelegate
 
+	// LocalScope returns a delegate for the steps of a nested scope of the
+	// build, e.g. one combination of an across step, which have the given
+	// vars set locally.
+	LocalScope(vars map[string]interface{}) BuildDelegate
+
 	Finish(lager.Logger, error, bool)
 }
 
@@ -28,40 +37,76 @@ type BuildDelegateFactory interface {
 	Delegate(db.Build) BuildDelegate
 }
 
-type buildDelegateFactory struct{}
+type buildDelegateFactory struct {
+	variablesFactory creds.VariablesFactory
+	enableRedaction  bool
+}
 
-func NewBuildDelegateFactory() BuildDelegateFactory {
-	return buildDelegateFactory{}
+// NewBuildDelegateFactory returns a BuildDelegateFactory whose delegates
+// interpolate vars from the given factory. If enableRedaction is set, any
+// credentials they interpolate are redacted from the build's logs.
+func NewBuildDelegateFactory(variablesFactory creds.VariablesFactory, enableRedaction bool) BuildDelegateFactory {
+	return buildDelegateFactory{
+		variablesFactory: variablesFactory,
+		enableRedaction:  enableRedaction,
+	}
 }
 
 func (factory buildDelegateFactory) Delegate(build db.Build) BuildDelegate {
-	return newBuildDelegate(build)
+	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	return newBuildDelegate(build, creds.NewBuildVariables(variables, factory.enableRedaction))
 }
 
 type delegate struct {
 	build db.Build
+
+	// shared by all of the build's steps so that vars set by one step are
+	// visible to the steps after it
+	variables *creds.BuildVariables
 }
 
-func newBuildDelegate(build db.Build) BuildDelegate {
+func newBuildDelegate(build db.Build, variables *creds.BuildVariables) BuildDelegate {
 	return &delegate{
-		build: build,
+		build:     build,
+		variables: variables,
 	}
 }
 
 func (delegate *delegate) GetDelegate(planID atc.PlanID) exec.GetDelegate {
-	return NewGetDelegate(delegate.build, planID, clock.NewClock())
+	return NewGetDelegate(delegate.build, planID, delegate.variables, clock.NewClock())
 }
 
 func (delegate *delegate) PutDelegate(planID atc.PlanID) exec.PutDelegate {
-	return NewPutDelegate(delegate.build, planID, clock.NewClock())
+	return NewPutDelegate(delegate.build, planID, delegate.variables, clock.NewClock())
 }
 
 func (delegate *delegate) TaskDelegate(planID atc.PlanID) exec.TaskDelegate {
-	return NewTaskDelegate(delegate.build, planID, clock.NewClock())
+	return NewTaskDelegate(delegate.build, planID, delegate.variables, clock.NewClock())
+}
+
+func (delegate *delegate) SetPipelineDelegate(planID atc.PlanID) exec.SetPipelineDelegate {
+	return NewSetPipelineDelegate(delegate.build, planID, delegate.variables, clock.NewClock())
+}
+
+func (delegate *delegate) LoadVarDelegate(planID atc.PlanID) exec.LoadVarDelegate {
+	return NewLoadVarDelegate(delegate.build, planID, delegate.variables, clock.NewClock())
+}
+
+func (delegate *delegate) CheckDelegate(planID atc.PlanID) exec.CheckDelegate {
+	return NewCheckDelegate(delegate.build, planID, delegate.variables, clock.NewClock())
 }
 
 func (delegate *delegate) BuildStepDelegate(planID atc.PlanID) exec.BuildStepDelegate {
-	return NewBuildStepDelegate(delegate.build, planID, clock.NewClock())
+	return NewBuildStepDelegate(delegate.build, planID, delegate.variables, clock.NewClock())
+}
+
+func (delegate *delegate) LocalScope(vars map[string]interface{}) BuildDelegate {
+	variables := delegate.variables.NewLocalScope()
+	for name, val := range vars {
+		variables.AddLocalVar(name, val)
+	}
+
+	return newBuildDelegate(delegate.build, variables)
 }
 
 func (delegate *delegate) Finish(logger lager.Logger, err error, succeeded bool) {
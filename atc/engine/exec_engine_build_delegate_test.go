@@ -5,6 +5,8 @@ import (
 	"errors"
 
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/engine"
@@ -17,6 +19,9 @@ var _ = Describe("BuildDelegate", func() {
 	var (
 		factory BuildDelegateFactory
 
+		fakeVariablesFactory *credsfakes.FakeVariablesFactory
+		fakeVariables        *credsfakes.FakeVariables
+
 		fakeBuild *dbfakes.FakeBuild
 
 		delegate BuildDelegate
@@ -25,14 +30,37 @@ var _ = Describe("BuildDelegate", func() {
 	)
 
 	BeforeEach(func() {
-		factory = NewBuildDelegateFactory()
+		fakeVariables = new(credsfakes.FakeVariables)
+		fakeVariablesFactory = new(credsfakes.FakeVariablesFactory)
+		fakeVariablesFactory.NewVariablesReturns(fakeVariables)
+
+		factory = NewBuildDelegateFactory(fakeVariablesFactory, true)
 
 		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.TeamNameReturns("some-team")
+		fakeBuild.PipelineNameReturns("some-pipeline")
 		delegate = factory.Delegate(fakeBuild)
 
 		logger = lagertest.NewTestLogger("test")
 	})
 
+	It("uses the variables of the build's team and pipeline", func() {
+		teamName, pipelineName := fakeVariablesFactory.NewVariablesArgsForCall(0)
+		Expect(teamName).To(Equal("some-team"))
+		Expect(pipelineName).To(Equal("some-pipeline"))
+	})
+
+	Describe("step delegates", func() {
+		It("share the build's vars", func() {
+			delegate.LoadVarDelegate("some-plan-id").Variables().AddLocalVar("some-var", "some-value")
+
+			val, found, err := delegate.TaskDelegate("some-other-plan-id").Variables().Get(template.VariableDefinition{Name: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("some-value"))
+		})
+	})
+
 	Describe("Finish", func() {
 		Context("when build was aborted", func() {
 			BeforeEach(func() {
@@ -470,6 +470,67 @@ var _ = Describe("ExecEngine", func() {
 				})
 			})
 
+			Context("that contains an across step", func() {
+				var (
+					scopedDelegates     []*enginefakes.FakeBuildDelegate
+					scopedTaskDelegates []*execfakes.FakeTaskDelegate
+				)
+
+				BeforeEach(func() {
+					expectedPlan = planFactory.NewPlan(atc.AcrossPlan{
+						Vars: []atc.AcrossVar{
+							{
+								Var:    "go_version",
+								Values: []interface{}{"1.11", "1.12"},
+							},
+						},
+						Steps: []atc.VarScopedPlan{
+							{
+								Step:   planFactory.NewPlan(atc.TaskPlan{Name: "some-task"}),
+								Values: []interface{}{"1.11"},
+							},
+							{
+								Step:   planFactory.NewPlan(atc.TaskPlan{Name: "some-task"}),
+								Values: []interface{}{"1.12"},
+							},
+						},
+					})
+
+					scopedDelegates = nil
+					scopedTaskDelegates = nil
+					fakeDelegate.LocalScopeStub = func(map[string]interface{}) engine.BuildDelegate {
+						scopedTaskDelegate := new(execfakes.FakeTaskDelegate)
+						scopedTaskDelegates = append(scopedTaskDelegates, scopedTaskDelegate)
+
+						scopedDelegate := new(enginefakes.FakeBuildDelegate)
+						scopedDelegate.TaskDelegateReturns(scopedTaskDelegate)
+						scopedDelegates = append(scopedDelegates, scopedDelegate)
+
+						return scopedDelegate
+					}
+				})
+
+				It("constructs each combination's steps with its values as local vars", func() {
+					build, err := execEngine.CreateBuild(logger, dbBuild, expectedPlan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+					Expect(fakeDelegate.LocalScopeCallCount()).To(Equal(2))
+					Expect(fakeDelegate.LocalScopeArgsForCall(0)).To(Equal(map[string]interface{}{"go_version": "1.11"}))
+					Expect(fakeDelegate.LocalScopeArgsForCall(1)).To(Equal(map[string]interface{}{"go_version": "1.12"}))
+
+					Expect(fakeFactory.TaskCallCount()).To(Equal(2))
+
+					_, plan, _, _, delegate := fakeFactory.TaskArgsForCall(0)
+					Expect(plan).To(Equal(expectedPlan.Across.Steps[0].Step))
+					Expect(delegate).To(BeIdenticalTo(scopedTaskDelegates[0]))
+
+					_, plan, _, _, delegate = fakeFactory.TaskArgsForCall(1)
+					Expect(plan).To(Equal(expectedPlan.Across.Steps[1].Step))
+					Expect(delegate).To(BeIdenticalTo(scopedTaskDelegates[1]))
+				})
+			})
+
 			Context("that contains outputs", func() {
 				var (
 					expectedPlan     atc.Plan
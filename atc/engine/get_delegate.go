@@ -1,34 +1,53 @@
 package engine
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 )
 
 type getDelegate struct {
-	exec.BuildStepDelegate
+	*BuildStepDelegate
 
 	build       db.Build
 	eventOrigin event.Origin
+	metrics     *stepMetrics
 }
 
-func NewGetDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.GetDelegate {
+func NewGetDelegate(build db.Build, planID atc.PlanID, variables *creds.BuildVariables, clock clock.Clock) exec.GetDelegate {
 	return &getDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock),
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, variables, clock),
 
 		build: build,
 		eventOrigin: event.Origin{
 			ID: event.OriginID(planID),
 		},
+		metrics: newStepMetrics(build, "get", clock),
 	}
 }
 
+func (d *getDelegate) Initializing(logger lager.Logger) {
+	d.metrics.started()
+
+	logger.Debug("initializing")
+}
+
+func (d *getDelegate) ImageFetched(logger lager.Logger, duration time.Duration) {
+	d.metrics.imageFetched(logger, duration)
+}
+
 func (d *getDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus, info exec.VersionInfo) {
+	d.Flush(logger)
+
+	d.metrics.finished(logger)
+
 	err := d.build.SaveEvent(event.FinishGet{
 		Origin:          d.eventOrigin,
 		ExitStatus:      int(exitStatus),
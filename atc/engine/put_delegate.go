@@ -1,34 +1,53 @@
 package engine
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 )
 
 type putDelegate struct {
-	exec.BuildStepDelegate
+	*BuildStepDelegate
 
 	build       db.Build
 	eventOrigin event.Origin
+	metrics     *stepMetrics
 }
 
-func NewPutDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.PutDelegate {
+func NewPutDelegate(build db.Build, planID atc.PlanID, variables *creds.BuildVariables, clock clock.Clock) exec.PutDelegate {
 	return &putDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock),
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, variables, clock),
 
 		build: build,
 		eventOrigin: event.Origin{
 			ID: event.OriginID(planID),
 		},
+		metrics: newStepMetrics(build, "put", clock),
 	}
 }
 
+func (d *putDelegate) Initializing(logger lager.Logger) {
+	d.metrics.started()
+
+	logger.Debug("initializing")
+}
+
+func (d *putDelegate) ImageFetched(logger lager.Logger, duration time.Duration) {
+	d.metrics.imageFetched(logger, duration)
+}
+
 func (d *putDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus, info exec.VersionInfo) {
+	d.Flush(logger)
+
+	d.metrics.finished(logger)
+
 	err := d.build.SaveEvent(event.FinishPut{
 		Origin:          d.eventOrigin,
 		ExitStatus:      int(exitStatus),
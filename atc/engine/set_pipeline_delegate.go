@@ -0,0 +1,52 @@
+package engine
+
+import (
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+)
+
+type setPipelineDelegate struct {
+	*BuildStepDelegate
+
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func NewSetPipelineDelegate(build db.Build, planID atc.PlanID, variables *creds.BuildVariables, clock clock.Clock) exec.SetPipelineDelegate {
+	return &setPipelineDelegate{
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, variables, clock),
+
+		build: build,
+		eventOrigin: event.Origin{
+			ID: event.OriginID(planID),
+		},
+		clock: clock,
+	}
+}
+
+func (d *setPipelineDelegate) Finished(logger lager.Logger, succeeded bool, changed bool) {
+	d.Flush(logger)
+
+	err := d.build.SaveEvent(event.FinishSetPipeline{
+		Origin:    d.eventOrigin,
+		Time:      d.clock.Now().Unix(),
+		Succeeded: succeeded,
+		Changed:   changed,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-finish-set-pipeline-event", err)
+		return
+	}
+
+	logger.Info("finished", lager.Data{
+		"succeeded": succeeded,
+		"changed":   changed,
+	})
+}
@@ -0,0 +1,55 @@
+package engine
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// stepMetrics emits the duration metrics of a step which runs in a container.
+type stepMetrics struct {
+	build    db.Build
+	stepType string
+	clock    clock.Clock
+
+	startTime time.Time
+}
+
+func newStepMetrics(build db.Build, stepType string, clock clock.Clock) *stepMetrics {
+	return &stepMetrics{
+		build:    build,
+		stepType: stepType,
+		clock:    clock,
+	}
+}
+
+func (metrics *stepMetrics) started() {
+	metrics.startTime = metrics.clock.Now()
+}
+
+func (metrics *stepMetrics) finished(logger lager.Logger) {
+	if metrics.startTime.IsZero() {
+		return
+	}
+
+	metric.StepDuration{
+		TeamName:     metrics.build.TeamName(),
+		PipelineName: metrics.build.PipelineName(),
+		JobName:      metrics.build.JobName(),
+		StepType:     metrics.stepType,
+		Duration:     metrics.clock.Since(metrics.startTime),
+	}.Emit(logger)
+}
+
+func (metrics *stepMetrics) imageFetched(logger lager.Logger, duration time.Duration) {
+	metric.ImageFetchDuration{
+		TeamName:     metrics.build.TeamName(),
+		PipelineName: metrics.build.PipelineName(),
+		JobName:      metrics.build.JobName(),
+		StepType:     metrics.stepType,
+		Duration:     duration,
+	}.Emit(logger)
+}
@@ -7,30 +7,35 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 )
 
 type taskDelegate struct {
-	exec.BuildStepDelegate
+	*BuildStepDelegate
 
 	build       db.Build
 	eventOrigin event.Origin
+	metrics     *stepMetrics
 }
 
-func NewTaskDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.TaskDelegate {
+func NewTaskDelegate(build db.Build, planID atc.PlanID, variables *creds.BuildVariables, clock clock.Clock) exec.TaskDelegate {
 	return &taskDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock),
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, variables, clock),
 
 		build: build,
 		eventOrigin: event.Origin{
 			ID: event.OriginID(planID),
 		},
+		metrics: newStepMetrics(build, "task", clock),
 	}
 }
 
 func (d *taskDelegate) Initializing(logger lager.Logger, taskConfig atc.TaskConfig) {
+	d.metrics.started()
+
 	err := d.build.SaveEvent(event.InitializeTask{
 		Origin:     d.eventOrigin,
 		Time:       time.Now().Unix(),
@@ -58,7 +63,15 @@ func (d *taskDelegate) Starting(logger lager.Logger, taskConfig atc.TaskConfig)
 	logger.Debug("starting")
 }
 
+func (d *taskDelegate) ImageFetched(logger lager.Logger, duration time.Duration) {
+	d.metrics.imageFetched(logger, duration)
+}
+
 func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus) {
+	d.Flush(logger)
+
+	d.metrics.finished(logger)
+
 	err := d.build.SaveEvent(event.FinishTask{
 		ExitStatus: int(exitStatus),
 		Time:       time.Now().Unix(),
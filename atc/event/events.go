@@ -129,3 +129,31 @@ type FinishPut struct {
 
 func (FinishPut) EventType() atc.EventType  { return EventTypeFinishPut }
 func (FinishPut) Version() atc.EventVersion { return "5.0" }
+
+type FinishSetPipeline struct {
+	Origin    Origin `json:"origin"`
+	Time      int64  `json:"time"`
+	Succeeded bool   `json:"succeeded"`
+	Changed   bool   `json:"changed"`
+}
+
+func (FinishSetPipeline) EventType() atc.EventType  { return EventTypeFinishSetPipeline }
+func (FinishSetPipeline) Version() atc.EventVersion { return "1.0" }
+
+type FinishLoadVar struct {
+	Origin    Origin `json:"origin"`
+	Time      int64  `json:"time"`
+	Succeeded bool   `json:"succeeded"`
+}
+
+func (FinishLoadVar) EventType() atc.EventType  { return EventTypeFinishLoadVar }
+func (FinishLoadVar) Version() atc.EventVersion { return "1.0" }
+
+type FinishCheck struct {
+	Origin    Origin `json:"origin"`
+	Time      int64  `json:"time"`
+	Succeeded bool   `json:"succeeded"`
+}
+
+func (FinishCheck) EventType() atc.EventType  { return EventTypeFinishCheck }
+func (FinishCheck) Version() atc.EventVersion { return "1.0" }
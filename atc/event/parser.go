@@ -38,6 +38,9 @@ func init() {
 	registerEvent(FinishTask{})
 	registerEvent(FinishGet{})
 	registerEvent(FinishPut{})
+	registerEvent(FinishSetPipeline{})
+	registerEvent(FinishLoadVar{})
+	registerEvent(FinishCheck{})
 	registerEvent(Status{})
 	registerEvent(Log{})
 	registerEvent(Error{})
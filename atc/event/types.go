@@ -27,6 +27,15 @@ const (
 	// finished putting something
 	EventTypeFinishPut atc.EventType = "finish-put"
 
+	// finished setting a pipeline
+	EventTypeFinishSetPipeline atc.EventType = "finish-set-pipeline"
+
+	// finished loading a var
+	EventTypeFinishLoadVar atc.EventType = "finish-load-var"
+
+	// finished checking a resource
+	EventTypeFinishCheck atc.EventType = "finish-check"
+
 	// error occurred
 	EventTypeError atc.EventType = "error"
 )
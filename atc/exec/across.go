@@ -0,0 +1,72 @@
+package exec
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/exec/artifact"
+)
+
+// Across constructs a step which runs the given steps, one for each
+// combination of the vars' values in the order produced for an
+// atc.AcrossPlan.
+//
+// The steps are run as nested InParallel steps, one level for each var, so
+// that each var's max in flight and fail fast settings apply to the steps
+// for each of its values.
+//
+// Each step runs with its own scope of the build's artifacts, so that the
+// artifacts produced for one combination do not replace those of another.
+func Across(vars []atc.AcrossVar, steps []Step) Step {
+	scoped := make([]Step, len(steps))
+	for i, step := range steps {
+		scoped[i] = artifactScopedStep{step}
+	}
+
+	return across(vars, scoped)
+}
+
+func across(vars []atc.AcrossVar, steps []Step) Step {
+	if len(vars) == 0 {
+		if len(steps) == 1 {
+			return steps[0]
+		}
+
+		return InParallel(steps, 0, false)
+	}
+
+	acrossVar := vars[0]
+
+	var branches []Step
+	if len(acrossVar.Values) > 0 {
+		size := len(steps) / len(acrossVar.Values)
+
+		for i := range acrossVar.Values {
+			branches = append(branches, across(vars[1:], steps[i*size:(i+1)*size]))
+		}
+	}
+
+	return InParallel(branches, acrossVar.MaxInFlight, acrossVar.FailFast)
+}
+
+// artifactScopedStep runs a step with a local scope of the build's artifacts.
+type artifactScopedStep struct {
+	Step
+}
+
+func (step artifactScopedStep) Run(ctx context.Context, state RunState) error {
+	return step.Step.Run(ctx, scopedRunState{
+		RunState:  state,
+		artifacts: state.Artifacts().NewLocalScope(),
+	})
+}
+
+type scopedRunState struct {
+	RunState
+
+	artifacts *artifact.Repository
+}
+
+func (state scopedRunState) Artifacts() *artifact.Repository {
+	return state.artifacts
+}
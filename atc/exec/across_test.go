@@ -0,0 +1,186 @@
+package exec_test
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/artifact"
+	"github.com/concourse/concourse/atc/exec/artifact/artifactfakes"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Across", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		vars []atc.AcrossVar
+
+		fakeSteps []*execfakes.FakeStep
+
+		lock    sync.Mutex
+		started []int
+
+		repo  *artifact.Repository
+		state *execfakes.FakeRunState
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		vars = []atc.AcrossVar{
+			{
+				Var:    "go_version",
+				Values: []interface{}{"1.11", "1.12"},
+			},
+			{
+				Var:    "os",
+				Values: []interface{}{"linux", "windows"},
+			},
+		}
+
+		started = nil
+
+		fakeSteps = make([]*execfakes.FakeStep, 4)
+		for i := range fakeSteps {
+			i := i
+
+			fakeSteps[i] = new(execfakes.FakeStep)
+			fakeSteps[i].SucceededReturns(true)
+			fakeSteps[i].RunStub = func(context.Context, RunState) error {
+				lock.Lock()
+				started = append(started, i)
+				lock.Unlock()
+				return nil
+			}
+		}
+
+		repo = artifact.NewRepository()
+		state = new(execfakes.FakeRunState)
+		state.ArtifactsReturns(repo)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		steps := make([]Step, len(fakeSteps))
+		for i, fakeStep := range fakeSteps {
+			steps[i] = fakeStep
+		}
+
+		step = Across(vars, steps)
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("runs every step", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+
+		for _, fakeStep := range fakeSteps {
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+		}
+	})
+
+	It("succeeds", func() {
+		Expect(step.Succeeded()).To(BeTrue())
+	})
+
+	Context("when the steps produce artifacts", func() {
+		var (
+			buildSource *artifactfakes.FakeRegisterableSource
+			inputs      []worker.ArtifactSource
+			seen        []worker.ArtifactSource
+		)
+
+		BeforeEach(func() {
+			buildSource = new(artifactfakes.FakeRegisterableSource)
+			repo.RegisterSource("some-input", buildSource)
+
+			inputs = make([]worker.ArtifactSource, len(fakeSteps))
+			seen = make([]worker.ArtifactSource, len(fakeSteps))
+			for i := range fakeSteps {
+				i := i
+
+				fakeSteps[i].RunStub = func(_ context.Context, state RunState) error {
+					inputs[i], _ = state.Artifacts().SourceFor("some-input")
+
+					state.Artifacts().RegisterSource("some-output", new(artifactfakes.FakeRegisterableSource))
+
+					// give the other steps a chance to replace the output
+					runtime.Gosched()
+
+					seen[i], _ = state.Artifacts().SourceFor("some-output")
+					return nil
+				}
+			}
+		})
+
+		It("runs each step with its own scope of the build's artifacts", func() {
+			for i := range seen {
+				Expect(inputs[i]).To(Equal(buildSource))
+				Expect(seen[i]).ToNot(BeNil())
+
+				for j := range seen[:i] {
+					Expect(seen[i]).ToNot(BeIdenticalTo(seen[j]))
+				}
+			}
+
+			_, found := repo.SourceFor("some-output")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when a var has a max in flight", func() {
+		BeforeEach(func() {
+			vars[0].MaxInFlight = 1
+		})
+
+		It("runs the steps for each of its values one at a time", func() {
+			Expect(started).To(HaveLen(4))
+			Expect(started[:2]).To(ConsistOf(0, 1))
+			Expect(started[2:]).To(ConsistOf(2, 3))
+		})
+	})
+
+	Context("when a var fails fast", func() {
+		BeforeEach(func() {
+			vars[0].MaxInFlight = 1
+			vars[0].FailFast = true
+
+			fakeSteps[1].SucceededReturns(false)
+		})
+
+		It("does not run the steps for its remaining values", func() {
+			Expect(fakeSteps[0].RunCallCount()).To(Equal(1))
+			Expect(fakeSteps[1].RunCallCount()).To(Equal(1))
+			Expect(fakeSteps[2].RunCallCount()).To(Equal(0))
+			Expect(fakeSteps[3].RunCallCount()).To(Equal(0))
+		})
+
+		It("does not succeed", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when a var has no values", func() {
+		BeforeEach(func() {
+			vars[1].Values = nil
+			fakeSteps = nil
+		})
+
+		It("succeeds without running anything", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+	})
+})
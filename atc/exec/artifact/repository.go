@@ -24,6 +24,8 @@ type Name string
 type Repository struct {
 	repo  map[Name]worker.ArtifactSource
 	repoL sync.RWMutex
+
+	parent *Repository
 }
 
 // NewArtifactRepository constructs a new repository.
@@ -33,6 +35,17 @@ func NewRepository() *Repository {
 	}
 }
 
+// NewLocalScope constructs a repository for a nested scope of the build, e.g.
+// one combination of an across step. Sources registered in the scope are not
+// visible outside of it, while sources from the enclosing repository remain
+// visible within it.
+func (repo *Repository) NewLocalScope() *Repository {
+	return &Repository{
+		repo:   make(map[Name]worker.ArtifactSource),
+		parent: repo,
+	}
+}
+
 //go:generate counterfeiter . RegisterableSource
 // A RegisterableSource	artifact is an ArtifactSource which can be added to the registry
 type RegisterableSource interface {
@@ -54,6 +67,11 @@ func (repo *Repository) SourceFor(name Name) (worker.ArtifactSource, bool) {
 	repo.repoL.RLock()
 	source, found := repo.repo[name]
 	repo.repoL.RUnlock()
+
+	if !found && repo.parent != nil {
+		return repo.parent.SourceFor(name)
+	}
+
 	return source, found
 }
 
@@ -62,6 +80,9 @@ func (repo *Repository) SourceFor(name Name) (worker.ArtifactSource, bool) {
 // affect each other.
 func (repo *Repository) AsMap() map[Name]worker.ArtifactSource {
 	result := make(map[Name]worker.ArtifactSource)
+	if repo.parent != nil {
+		result = repo.parent.AsMap()
+	}
 
 	repo.repoL.RLock()
 	for name, source := range repo.repo {
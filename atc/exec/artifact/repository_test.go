@@ -3,6 +3,7 @@ package artifact_test
 import (
 	. "github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/exec/artifact/artifactfakes"
+	"github.com/concourse/concourse/atc/worker"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -73,4 +74,49 @@ var _ = Describe("ArtifactRepository", func() {
 			})
 		})
 	})
+
+	Describe("NewLocalScope", func() {
+		var (
+			parentSource *artifactfakes.FakeRegisterableSource
+			scopedSource *artifactfakes.FakeRegisterableSource
+
+			scope *Repository
+		)
+
+		BeforeEach(func() {
+			parentSource = new(artifactfakes.FakeRegisterableSource)
+			repo.RegisterSource("parent-source", parentSource)
+
+			scope = repo.NewLocalScope()
+
+			scopedSource = new(artifactfakes.FakeRegisterableSource)
+			scope.RegisterSource("scoped-source", scopedSource)
+		})
+
+		It("yields sources from the enclosing repository", func() {
+			source, found := scope.SourceFor("parent-source")
+			Expect(source).To(Equal(parentSource))
+			Expect(found).To(BeTrue())
+		})
+
+		It("does not register sources in the enclosing repository", func() {
+			source, found := repo.SourceFor("scoped-source")
+			Expect(source).To(BeNil())
+			Expect(found).To(BeFalse())
+		})
+
+		It("prefers its own sources over those of the enclosing repository", func() {
+			overridingSource := new(artifactfakes.FakeRegisterableSource)
+			scope.RegisterSource("parent-source", overridingSource)
+
+			source, found := scope.SourceFor("parent-source")
+			Expect(source).To(Equal(overridingSource))
+			Expect(found).To(BeTrue())
+
+			Expect(scope.AsMap()).To(Equal(map[Name]worker.ArtifactSource{
+				"parent-source": overridingSource,
+				"scoped-source": scopedSource,
+			}))
+		})
+	})
 })
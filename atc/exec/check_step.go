@@ -0,0 +1,110 @@
+package exec
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/radar"
+	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
+)
+
+//go:generate counterfeiter . CheckDelegate
+
+type CheckDelegate interface {
+	BuildStepDelegate
+
+	Finished(logger lager.Logger, succeeded bool)
+}
+
+// ErrCheckOutsidePipeline is returned when a check step runs in a build which
+// does not belong to a pipeline, as there is no resource to check.
+var ErrCheckOutsidePipeline = errors.New("check step must be run in a pipeline's build")
+
+// CheckStep checks a resource in the build's pipeline for new versions,
+// writing the output of the resource's check script to the build log.
+type CheckStep struct {
+	planID         atc.PlanID
+	plan           atc.CheckPlan
+	build          db.Build
+	scannerFactory radar.ScannerFactory
+	delegate       CheckDelegate
+	succeeded      bool
+}
+
+func NewCheckStep(
+	planID atc.PlanID,
+	plan atc.CheckPlan,
+	build db.Build,
+	scannerFactory radar.ScannerFactory,
+	delegate CheckDelegate,
+) Step {
+	return &CheckStep{
+		planID:         planID,
+		plan:           plan,
+		build:          build,
+		scannerFactory: scannerFactory,
+		delegate:       delegate,
+	}
+}
+
+// Run checks the resource from the planned version, or from its latest
+// version if none was given. Any versions found are saved to the resource.
+//
+// If the check script exits nonzero the step fails, and its stderr will
+// already have been written to the build log.
+func (step *CheckStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "check", tracing.Attrs{
+		"name": step.plan.Name,
+	})
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *CheckStep) run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).WithData(lager.Data{
+		"plan-id":  step.planID,
+		"resource": step.plan.Name,
+	})
+
+	pipeline, found, err := step.build.Pipeline()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return ErrCheckOutsidePipeline
+	}
+
+	scanner := step.scannerFactory.NewResourceScanner(pipeline)
+
+	err = scanner.ScanFromVersion(ctx, logger, step.plan.Name, step.plan.FromVersion, resource.IOConfig{
+		Stdout: step.delegate.Stdout(),
+		Stderr: step.delegate.Stderr(),
+	})
+	if err != nil {
+		if _, ok := err.(resource.ErrResourceScriptFailed); ok {
+			step.delegate.Finished(logger, false)
+			return nil
+		}
+
+		return err
+	}
+
+	step.succeeded = true
+	step.delegate.Finished(logger, true)
+
+	return nil
+}
+
+// Succeeded returns true if the resource's check script succeeded.
+func (step *CheckStep) Succeeded() bool {
+	return step.succeeded
+}
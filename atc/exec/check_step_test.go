@@ -0,0 +1,147 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/radar/radarfakes"
+	"github.com/concourse/concourse/atc/resource"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("CheckStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeBuild          *dbfakes.FakeBuild
+		fakePipeline       *dbfakes.FakePipeline
+		fakeScannerFactory *radarfakes.FakeScannerFactory
+		fakeScanner        *radarfakes.FakeScanner
+		fakeDelegate       *execfakes.FakeCheckDelegate
+
+		stdout, stderr *gbytes.Buffer
+
+		plan atc.CheckPlan
+
+		step    exec.Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakePipeline = new(dbfakes.FakePipeline)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.PipelineReturns(fakePipeline, true, nil)
+
+		fakeScanner = new(radarfakes.FakeScanner)
+
+		fakeScannerFactory = new(radarfakes.FakeScannerFactory)
+		fakeScannerFactory.NewResourceScannerReturns(fakeScanner)
+
+		stdout = gbytes.NewBuffer()
+		stderr = gbytes.NewBuffer()
+
+		fakeDelegate = new(execfakes.FakeCheckDelegate)
+		fakeDelegate.StdoutReturns(stdout)
+		fakeDelegate.StderrReturns(stderr)
+
+		plan = atc.CheckPlan{
+			Name:        "some-resource",
+			Type:        "some-type",
+			FromVersion: atc.Version{"some": "version"},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewCheckStep("some-plan-id", plan, fakeBuild, fakeScannerFactory, fakeDelegate)
+		stepErr = step.Run(ctx, exec.NewRunState())
+	})
+
+	It("scans the resource in the build's pipeline from the planned version", func() {
+		Expect(fakeScannerFactory.NewResourceScannerArgsForCall(0)).To(Equal(fakePipeline))
+
+		Expect(fakeScanner.ScanFromVersionCallCount()).To(Equal(1))
+		_, _, resourceName, fromVersion, ioConfig := fakeScanner.ScanFromVersionArgsForCall(0)
+		Expect(resourceName).To(Equal("some-resource"))
+		Expect(fromVersion).To(Equal(atc.Version{"some": "version"}))
+		Expect(ioConfig).To(Equal(resource.IOConfig{
+			Stdout: stdout,
+			Stderr: stderr,
+		}))
+	})
+
+	It("scans with the step's context, so that aborting the build stops the check", func() {
+		scanCtx, _, _, _, _ := fakeScanner.ScanFromVersionArgsForCall(0)
+		Expect(scanCtx.Err()).ToNot(HaveOccurred())
+
+		cancel()
+
+		Expect(scanCtx.Done()).To(BeClosed())
+	})
+
+	It("succeeds", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(step.Succeeded()).To(BeTrue())
+	})
+
+	It("finishes with success", func() {
+		Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+		_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+		Expect(succeeded).To(BeTrue())
+	})
+
+	Context("when the check script fails", func() {
+		BeforeEach(func() {
+			fakeScanner.ScanFromVersionReturns(resource.ErrResourceScriptFailed{ExitStatus: 1})
+		})
+
+		It("fails without erroring", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+
+		It("finishes with failure", func() {
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeFalse())
+		})
+	})
+
+	Context("when scanning errors", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeScanner.ScanFromVersionReturns(disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+			Expect(step.Succeeded()).To(BeFalse())
+			Expect(fakeDelegate.FinishedCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the build does not belong to a pipeline", func() {
+		BeforeEach(func() {
+			fakeBuild.PipelineReturns(nil, false, nil)
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(Equal(exec.ErrCheckOutsidePipeline))
+			Expect(fakeScannerFactory.NewResourceScannerCallCount()).To(BeZero())
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -41,6 +42,29 @@ type Factory interface {
 		TaskDelegate,
 	) Step
 
+	// SetPipeline constructs a SetPipeline step.
+	SetPipeline(
+		lager.Logger,
+		atc.Plan,
+		db.Build,
+		SetPipelineDelegate,
+	) Step
+
+	// LoadVar constructs a LoadVar step.
+	LoadVar(
+		lager.Logger,
+		atc.Plan,
+		LoadVarDelegate,
+	) Step
+
+	// Check constructs a Check step.
+	Check(
+		lager.Logger,
+		atc.Plan,
+		db.Build,
+		CheckDelegate,
+	) Step
+
 	ArtifactInputStep(
 		lager.Logger,
 		atc.Plan,
@@ -71,6 +95,8 @@ type BuildStepDelegate interface {
 	Stderr() io.Writer
 
 	Errored(lager.Logger, string)
+
+	Variables() *creds.BuildVariables
 }
 
 // Privileged is used to indicate whether the given step should run with
@@ -11,6 +11,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec/artifact"
+	"github.com/concourse/concourse/atc/radar"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/worker"
 )
@@ -21,10 +22,12 @@ type gardenFactory struct {
 	resourceFetcher       resource.Fetcher
 	resourceCacheFactory  db.ResourceCacheFactory
 	resourceConfigFactory db.ResourceConfigFactory
-	variablesFactory      creds.VariablesFactory
 	defaultLimits         atc.ContainerLimits
 	strategy              worker.ContainerPlacementStrategy
+	placementOptions      worker.PlacementOptions
 	resourceFactory       resource.ResourceFactory
+	teamFactory           db.TeamFactory
+	scannerFactory        radar.ScannerFactory
 }
 
 func NewGardenFactory(
@@ -33,10 +36,12 @@ func NewGardenFactory(
 	resourceFetcher resource.Fetcher,
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
-	variablesFactory creds.VariablesFactory,
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
+	placementOptions worker.PlacementOptions,
 	resourceFactory resource.ResourceFactory,
+	teamFactory db.TeamFactory,
+	scannerFactory radar.ScannerFactory,
 ) Factory {
 	return &gardenFactory{
 		pool:                  pool,
@@ -44,10 +49,12 @@ func NewGardenFactory(
 		resourceFetcher:       resourceFetcher,
 		resourceCacheFactory:  resourceCacheFactory,
 		resourceConfigFactory: resourceConfigFactory,
-		variablesFactory:      variablesFactory,
 		defaultLimits:         defaultLimits,
 		strategy:              strategy,
+		placementOptions:      placementOptions,
 		resourceFactory:       resourceFactory,
+		teamFactory:           teamFactory,
+		scannerFactory:        scannerFactory,
 	}
 }
 
@@ -61,7 +68,7 @@ func (factory *gardenFactory) Get(
 ) Step {
 	workerMetadata.WorkingDirectory = resource.ResourcesDir("get")
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := delegate.Variables()
 
 	getStep := NewGetStep(
 		build,
@@ -85,7 +92,7 @@ func (factory *gardenFactory) Get(
 
 		creds.NewVersionedResourceTypes(variables, plan.Get.VersionedResourceTypes),
 
-		factory.strategy,
+		factory.placementStrategy(logger, plan.Get.Placement),
 		factory.pool,
 	)
 
@@ -102,7 +109,7 @@ func (factory *gardenFactory) Put(
 ) Step {
 	workerMetadata.WorkingDirectory = resource.ResourcesDir("put")
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := delegate.Variables()
 
 	var putInputs PutInputs
 	if plan.Put.Inputs == nil {
@@ -137,7 +144,7 @@ func (factory *gardenFactory) Put(
 
 		creds.NewVersionedResourceTypes(variables, plan.Put.VersionedResourceTypes),
 
-		factory.strategy,
+		factory.placementStrategy(logger, plan.Put.Placement),
 		factory.resourceFactory,
 	)
 
@@ -154,7 +161,7 @@ func (factory *gardenFactory) Task(
 	workingDirectory := factory.taskWorkingDirectory(artifact.Name(plan.Task.Name))
 	containerMetadata.WorkingDirectory = workingDirectory
 
-	credMgrVariables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := delegate.Variables()
 
 	var taskConfigSource TaskConfigSource
 	var taskVars []boshtemplate.Variables
@@ -162,14 +169,14 @@ func (factory *gardenFactory) Task(
 		// external task - construct a source which reads it from file
 		taskConfigSource = FileConfigSource{ConfigPath: plan.Task.ConfigPath}
 
-		// for interpolation - use 'vars' from the pipeline, and then fill remaining with cred mgr variables
-		taskVars = []boshtemplate.Variables{boshtemplate.StaticVariables(plan.Task.Vars), credMgrVariables}
+		// for interpolation - use 'vars' from the pipeline, and then fill remaining with build and cred mgr variables
+		taskVars = []boshtemplate.Variables{TaskVars{Vars: plan.Task.Vars, Variables: variables}, variables}
 	} else {
 		// embedded task - first we take it
 		taskConfigSource = StaticConfigSource{Config: plan.Task.Config}
 
-		// for interpolation - use just cred mgr variables
-		taskVars = []boshtemplate.Variables{credMgrVariables}
+		// for interpolation - use just build and cred mgr variables
+		taskVars = []boshtemplate.Variables{variables}
 	}
 
 	// override params
@@ -201,14 +208,78 @@ func (factory *gardenFactory) Task(
 		plan.ID,
 		containerMetadata,
 
-		creds.NewVersionedResourceTypes(credMgrVariables, plan.Task.VersionedResourceTypes),
+		creds.NewVersionedResourceTypes(variables, plan.Task.VersionedResourceTypes),
 		factory.defaultLimits,
-		factory.strategy,
+		factory.placementStrategy(logger, plan.Task.Placement),
 	)
 
 	return LogError(taskStep, delegate)
 }
 
+// placementStrategy returns the strategy chaining the given placement filters,
+// or the default strategy if the step does not configure its placement.
+func (factory *gardenFactory) placementStrategy(logger lager.Logger, placement []string) worker.ContainerPlacementStrategy {
+	if len(placement) == 0 {
+		return factory.strategy
+	}
+
+	strategy, err := worker.NewPlacementStrategy(placement, factory.placementOptions)
+	if err != nil {
+		logger.Error("failed-to-construct-placement-strategy", err, lager.Data{"placement": placement})
+		return factory.strategy
+	}
+
+	return strategy
+}
+
+func (factory *gardenFactory) SetPipeline(
+	logger lager.Logger,
+	plan atc.Plan,
+	build db.Build,
+	delegate SetPipelineDelegate,
+) Step {
+	setPipelineStep := NewSetPipelineStep(
+		plan.ID,
+		*plan.SetPipeline,
+		build,
+		factory.teamFactory,
+		delegate,
+	)
+
+	return LogError(setPipelineStep, delegate)
+}
+
+func (factory *gardenFactory) LoadVar(
+	logger lager.Logger,
+	plan atc.Plan,
+	delegate LoadVarDelegate,
+) Step {
+	loadVarStep := NewLoadVarStep(
+		plan.ID,
+		*plan.LoadVar,
+		delegate,
+	)
+
+	return LogError(loadVarStep, delegate)
+}
+
+func (factory *gardenFactory) Check(
+	logger lager.Logger,
+	plan atc.Plan,
+	build db.Build,
+	delegate CheckDelegate,
+) Step {
+	checkStep := NewCheckStep(
+		plan.ID,
+		*plan.Check,
+		build,
+		factory.scannerFactory,
+		delegate,
+	)
+
+	return LogError(checkStep, delegate)
+}
+
 func (factory *gardenFactory) ArtifactInputStep(
 	logger lager.Logger,
 	plan atc.Plan,
@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -14,6 +15,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -38,6 +40,8 @@ func (e ErrResourceNotFound) Error() string {
 type GetDelegate interface {
 	BuildStepDelegate
 
+	Initializing(lager.Logger)
+	ImageFetched(lager.Logger, time.Duration)
 	Finished(lager.Logger, ExitStatus, VersionInfo)
 }
 
@@ -150,8 +154,21 @@ func NewGetStep(
 // At the end, the resulting ArtifactSource (either from using the cache or
 // fetching the resource) is registered under the step's SourceName.
 func (step *GetStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "get", tracing.Attrs{
+		"name": step.name,
+	})
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *GetStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
+	step.delegate.Initializing(logger)
+
 	version, err := step.versionSource.Version(state)
 	if err != nil {
 		return err
@@ -206,7 +223,7 @@ func (step *GetStep) Run(ctx context.Context, state RunState) error {
 		ResourceTypes: step.resourceTypes,
 	}
 
-	chosenWorker, err := step.workerPool.FindOrChooseWorkerForContainer(logger, resourceInstance.ContainerOwner(), containerSpec, workerSpec, step.strategy)
+	chosenWorker, err := step.workerPool.FindOrChooseWorkerForContainer(ctx, logger, resourceInstance.ContainerOwner(), containerSpec, workerSpec, step.strategy)
 	if err != nil {
 		return err
 	}
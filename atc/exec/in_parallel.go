@@ -0,0 +1,113 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// InParallelStep is a step of steps to run in parallel, optionally limiting
+// how many of them run at once.
+type InParallelStep struct {
+	steps    []Step
+	limit    int
+	failFast bool
+}
+
+// InParallel constructs an InParallelStep. A limit of zero (or less) allows
+// all steps to run at once.
+func InParallel(steps []Step, limit int, failFast bool) InParallelStep {
+	if limit < 1 {
+		limit = len(steps)
+	}
+
+	return InParallelStep{
+		steps:    steps,
+		limit:    limit,
+		failFast: failFast,
+	}
+}
+
+// Run executes the steps in parallel, running at most limit steps at a time.
+// Steps are started in the order they were configured.
+//
+// If failFast is set, the first step to fail or error will cancel any
+// running steps and prevent any remaining steps from starting. Otherwise it
+// will wait for all steps to exit, even if one step fails or errors.
+//
+// After all started steps finish, their errors (if any) will be aggregated
+// and returned as a single error.
+func (step InParallelStep) Run(ctx context.Context, state RunState) error {
+	runCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errs := make(chan error, len(step.steps))
+	sem := make(chan struct{}, step.limit)
+
+	started := 0
+
+	for _, s := range step.steps {
+		select {
+		case sem <- struct{}{}:
+		case <-runCtx.Done():
+		}
+
+		if runCtx.Err() != nil {
+			break
+		}
+
+		started++
+
+		s := s
+		go func() {
+			defer func() { <-sem }()
+
+			err := s.Run(runCtx, state)
+			if step.failFast && (err != nil || !s.Succeeded()) {
+				cancel()
+			}
+
+			errs <- err
+		}()
+	}
+
+	var errorMessages []string
+	for i := 0; i < started; i++ {
+		err := <-errs
+		if err == nil {
+			continue
+		}
+
+		// steps which were interrupted because a sibling failed fast are not
+		// themselves the cause of the failure
+		if err == context.Canceled && ctx.Err() == nil {
+			continue
+		}
+
+		errorMessages = append(errorMessages, err.Error())
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("one or more parallel steps errored:\n%s", strings.Join(errorMessages, "\n"))
+	}
+
+	return nil
+}
+
+// Succeeded is true if all of the steps' Succeeded is true. Steps which were
+// never started because a sibling failed fast are not considered successful.
+func (step InParallelStep) Succeeded() bool {
+	succeeded := true
+
+	for _, step := range step.steps {
+		if !step.Succeeded() {
+			succeeded = false
+		}
+	}
+
+	return succeeded
+}
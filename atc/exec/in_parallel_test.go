@@ -0,0 +1,324 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/artifact"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InParallel", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStepA *execfakes.FakeStep
+		fakeStepB *execfakes.FakeStep
+		fakeStepC *execfakes.FakeStep
+
+		limit    int
+		failFast bool
+
+		repo  *artifact.Repository
+		state *execfakes.FakeRunState
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStepA = new(execfakes.FakeStep)
+		fakeStepB = new(execfakes.FakeStep)
+		fakeStepC = new(execfakes.FakeStep)
+
+		limit = 0
+		failFast = false
+
+		repo = artifact.NewRepository()
+		state = new(execfakes.FakeRunState)
+		state.ArtifactsReturns(repo)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = InParallel([]Step{fakeStepA, fakeStepB, fakeStepC}, limit, failFast)
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("succeeds", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+	})
+
+	It("passes the run state to all steps", func() {
+		Expect(fakeStepA.RunCallCount()).To(Equal(1))
+		_, s := fakeStepA.RunArgsForCall(0)
+		Expect(s).To(Equal(state))
+
+		Expect(fakeStepB.RunCallCount()).To(Equal(1))
+		_, s = fakeStepB.RunArgsForCall(0)
+		Expect(s).To(Equal(state))
+
+		Expect(fakeStepC.RunCallCount()).To(Equal(1))
+		_, s = fakeStepC.RunArgsForCall(0)
+		Expect(s).To(Equal(state))
+	})
+
+	Describe("executing each step", func() {
+		Context("when there is no limit", func() {
+			BeforeEach(func() {
+				wg := new(sync.WaitGroup)
+				wg.Add(3)
+
+				stub := func(context.Context, RunState) error {
+					wg.Done()
+					wg.Wait()
+					return nil
+				}
+
+				fakeStepA.RunStub = stub
+				fakeStepB.RunStub = stub
+				fakeStepC.RunStub = stub
+			})
+
+			It("happens concurrently", func() {
+				Expect(fakeStepA.RunCallCount()).To(Equal(1))
+				Expect(fakeStepB.RunCallCount()).To(Equal(1))
+				Expect(fakeStepC.RunCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the limit is lower than the number of steps", func() {
+			var (
+				lock       sync.Mutex
+				running    int
+				maxRunning int
+			)
+
+			BeforeEach(func() {
+				limit = 2
+
+				running = 0
+				maxRunning = 0
+
+				started := make(chan struct{})
+				release := make(chan struct{})
+
+				stub := func(context.Context, RunState) error {
+					lock.Lock()
+					running++
+					if running > maxRunning {
+						maxRunning = running
+					}
+					lock.Unlock()
+
+					started <- struct{}{}
+					<-release
+
+					lock.Lock()
+					running--
+					lock.Unlock()
+
+					return nil
+				}
+
+				go func() {
+					// hold the first two steps until both are running, so that a
+					// third step would be seen if it started alongside them
+					<-started
+					<-started
+					release <- struct{}{}
+
+					<-started
+					release <- struct{}{}
+					release <- struct{}{}
+				}()
+
+				fakeStepA.RunStub = stub
+				fakeStepB.RunStub = stub
+				fakeStepC.RunStub = stub
+			})
+
+			It("runs every step", func() {
+				Expect(fakeStepA.RunCallCount()).To(Equal(1))
+				Expect(fakeStepB.RunCallCount()).To(Equal(1))
+				Expect(fakeStepC.RunCallCount()).To(Equal(1))
+			})
+
+			It("runs as many steps at once as the limit allows", func() {
+				Expect(maxRunning).To(Equal(2))
+			})
+		})
+
+		Context("when the limit is 1", func() {
+			var order []string
+
+			BeforeEach(func() {
+				limit = 1
+
+				order = nil
+
+				fakeStepA.RunStub = func(context.Context, RunState) error {
+					order = append(order, "a")
+					return nil
+				}
+
+				fakeStepB.RunStub = func(context.Context, RunState) error {
+					order = append(order, "b")
+					return nil
+				}
+
+				fakeStepC.RunStub = func(context.Context, RunState) error {
+					order = append(order, "c")
+					return nil
+				}
+			})
+
+			It("runs the steps in order", func() {
+				Expect(order).To(Equal([]string{"a", "b", "c"}))
+			})
+		})
+	})
+
+	Describe("canceling", func() {
+		BeforeEach(func() {
+			cancel()
+		})
+
+		It("does not start any steps", func() {
+			Expect(fakeStepA.RunCallCount()).To(Equal(0))
+			Expect(fakeStepB.RunCallCount()).To(Equal(0))
+			Expect(fakeStepC.RunCallCount()).To(Equal(0))
+		})
+
+		It("returns ctx.Err()", func() {
+			Expect(stepErr).To(Equal(context.Canceled))
+		})
+	})
+
+	Context("when steps fail", func() {
+		disasterA := errors.New("nope A")
+		disasterB := errors.New("nope B")
+
+		BeforeEach(func() {
+			fakeStepA.RunReturns(disasterA)
+			fakeStepB.RunReturns(disasterB)
+		})
+
+		It("exits with an error including the original message", func() {
+			Expect(stepErr.Error()).To(ContainSubstring("nope A"))
+			Expect(stepErr.Error()).To(ContainSubstring("nope B"))
+		})
+
+		It("runs every step", func() {
+			Expect(fakeStepC.RunCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when fail fast is configured", func() {
+		BeforeEach(func() {
+			limit = 1
+			failFast = true
+		})
+
+		Context("and a step errors", func() {
+			BeforeEach(func() {
+				fakeStepA.SucceededReturns(true)
+				fakeStepB.RunReturns(errors.New("nope B"))
+			})
+
+			It("exits with the error", func() {
+				Expect(stepErr).To(MatchError(ContainSubstring("nope B")))
+			})
+
+			It("does not start the remaining steps", func() {
+				Expect(fakeStepA.RunCallCount()).To(Equal(1))
+				Expect(fakeStepB.RunCallCount()).To(Equal(1))
+				Expect(fakeStepC.RunCallCount()).To(Equal(0))
+			})
+
+			It("is not successful", func() {
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+		})
+
+		Context("and a step fails", func() {
+			BeforeEach(func() {
+				fakeStepA.SucceededReturns(false)
+			})
+
+			It("does not error", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+			})
+
+			It("does not start the remaining steps", func() {
+				Expect(fakeStepA.RunCallCount()).To(Equal(1))
+				Expect(fakeStepB.RunCallCount()).To(Equal(0))
+				Expect(fakeStepC.RunCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("and a running sibling is interrupted", func() {
+			BeforeEach(func() {
+				limit = 0
+
+				fakeStepA.RunStub = func(ctx context.Context, state RunState) error {
+					<-ctx.Done()
+					return ctx.Err()
+				}
+
+				fakeStepB.RunReturns(errors.New("nope B"))
+			})
+
+			It("cancels the running sibling", func() {
+				ctx, _ := fakeStepA.RunArgsForCall(0)
+				Expect(ctx.Err()).To(Equal(context.Canceled))
+			})
+
+			It("does not report the interruption as an error", func() {
+				Expect(stepErr).To(MatchError("one or more parallel steps errored:\nnope B"))
+			})
+		})
+	})
+
+	Describe("Succeeded", func() {
+		Context("when all steps are successful", func() {
+			BeforeEach(func() {
+				fakeStepA.SucceededReturns(true)
+				fakeStepB.SucceededReturns(true)
+				fakeStepC.SucceededReturns(true)
+			})
+
+			It("yields true", func() {
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+		})
+
+		Context("when some steps are not successful", func() {
+			BeforeEach(func() {
+				fakeStepA.SucceededReturns(true)
+				fakeStepB.SucceededReturns(false)
+				fakeStepC.SucceededReturns(true)
+			})
+
+			It("yields false", func() {
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+		})
+
+		Context("when there are no steps", func() {
+			It("returns true", func() {
+				Expect(InParallel([]Step{}, 0, false).Succeeded()).To(BeTrue())
+			})
+		})
+	})
+})
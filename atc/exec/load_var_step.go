@@ -0,0 +1,156 @@
+package exec
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/tracing"
+	"gopkg.in/yaml.v2"
+)
+
+//go:generate counterfeiter . LoadVarDelegate
+
+type LoadVarDelegate interface {
+	BuildStepDelegate
+
+	Finished(logger lager.Logger, succeeded bool)
+}
+
+// UnknownLoadVarFormatError is returned when the format of the file to load
+// a var from is neither configured nor detectable from its extension.
+type UnknownLoadVarFormatError struct {
+	Format string
+}
+
+func (err UnknownLoadVarFormatError) Error() string {
+	return fmt.Sprintf("unknown format: %s", err.Format)
+}
+
+// LoadVarStep loads a value from a file which has been fetched into the
+// artifact.Repository by an earlier step, and sets it as a var for the rest
+// of the build.
+type LoadVarStep struct {
+	planID    atc.PlanID
+	plan      atc.LoadVarPlan
+	delegate  LoadVarDelegate
+	succeeded bool
+}
+
+func NewLoadVarStep(
+	planID atc.PlanID,
+	plan atc.LoadVarPlan,
+	delegate LoadVarDelegate,
+) Step {
+	return &LoadVarStep{
+		planID:   planID,
+		plan:     plan,
+		delegate: delegate,
+	}
+}
+
+// Run reads the file out of the artifact.Repository and parses it according
+// to the configured format, falling back to one based on the file's
+// extension: JSON for .json, YAML for .yml and .yaml, and otherwise the
+// file's content with surrounding whitespace trimmed.
+//
+// The parsed value is added to the build's variables, where it takes
+// precedence over any var of the same name from the credential manager.
+func (step *LoadVarStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "load_var", tracing.Attrs{
+		"name": step.plan.Name,
+	})
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *LoadVarStep) run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).WithData(lager.Data{
+		"plan-id": step.planID,
+		"var":     step.plan.Name,
+	})
+
+	payload, err := readArtifactFile(logger, state.Artifacts(), step.plan.File)
+	if err != nil {
+		return err
+	}
+
+	value, err := step.parse(payload)
+	if err != nil {
+		return err
+	}
+
+	step.delegate.Variables().AddLocalVar(step.plan.Name, value)
+
+	fmt.Fprintf(step.delegate.Stdout(), "loaded var %s from file %s\n", step.plan.Name, step.plan.File)
+
+	step.succeeded = true
+	step.delegate.Finished(logger, true)
+
+	return nil
+}
+
+// Succeeded returns true if the var was loaded.
+func (step *LoadVarStep) Succeeded() bool {
+	return step.succeeded
+}
+
+func (step *LoadVarStep) parse(payload []byte) (interface{}, error) {
+	format := step.plan.Format
+	if format == "" {
+		format = detectLoadVarFormat(step.plan.File)
+	}
+
+	switch format {
+	case atc.LoadVarFormatRaw:
+		return string(payload), nil
+
+	case atc.LoadVarFormatTrim:
+		return strings.TrimSpace(string(payload)), nil
+
+	case atc.LoadVarFormatJSON:
+		var value interface{}
+		err := json.Unmarshal(payload, &value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s as json: %s", step.plan.File, err)
+		}
+
+		return value, nil
+
+	case atc.LoadVarFormatYAML:
+		var value interface{}
+		err := yaml.Unmarshal(payload, &value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s as yaml: %s", step.plan.File, err)
+		}
+
+		// vars must be able to be marshaled to JSON, e.g. for build events
+		value, err = atc.SanitizeYAML(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s as yaml: %s", step.plan.File, err)
+		}
+
+		return value, nil
+	}
+
+	return nil, UnknownLoadVarFormatError{format}
+}
+
+func detectLoadVarFormat(path string) string {
+	switch filepath.Ext(path) {
+	case ".json":
+		return atc.LoadVarFormatJSON
+	case ".yml", ".yaml":
+		return atc.LoadVarFormatYAML
+	default:
+		return atc.LoadVarFormatTrim
+	}
+}
@@ -0,0 +1,188 @@
+package exec_test
+
+import (
+	"context"
+	"io"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("LoadVarStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeDelegate *execfakes.FakeLoadVarDelegate
+		fakeSource   *workerfakes.FakeArtifactSource
+
+		variables *creds.BuildVariables
+
+		files map[string]string
+
+		stdout *gbytes.Buffer
+
+		plan  atc.LoadVarPlan
+		state exec.RunState
+
+		step    exec.Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		variables = creds.NewBuildVariables(new(credsfakes.FakeVariables), false)
+
+		stdout = gbytes.NewBuffer()
+
+		fakeDelegate = new(execfakes.FakeLoadVarDelegate)
+		fakeDelegate.StdoutReturns(stdout)
+		fakeDelegate.VariablesReturns(variables)
+
+		files = map[string]string{
+			"version":     "1.2.3\n",
+			"config.json": `{"some":{"nested":"value"}}`,
+			"config.yml":  "some:\n  nested: value\n  list:\n  - item: value\n",
+		}
+
+		fakeSource = new(workerfakes.FakeArtifactSource)
+		fakeSource.StreamFileStub = func(_ lager.Logger, path string) (io.ReadCloser, error) {
+			content, found := files[path]
+			if !found {
+				return nil, baggageclaim.ErrFileNotFound
+			}
+
+			return gbytes.BufferWithBytes([]byte(content)), nil
+		}
+
+		state = exec.NewRunState()
+		state.Artifacts().RegisterSource("some-artifact", fakeSource)
+
+		plan = atc.LoadVarPlan{
+			Name: "some-var",
+			File: "some-artifact/version",
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewLoadVarStep("some-plan-id", plan, fakeDelegate)
+		stepErr = step.Run(ctx, state)
+	})
+
+	loadedVar := func() interface{} {
+		val, found, err := variables.Get(template.VariableDefinition{Name: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		return val
+	}
+
+	Context("when no format is configured", func() {
+		It("trims the file's content", func() {
+			Expect(loadedVar()).To(Equal("1.2.3"))
+		})
+
+		Context("when the file is json", func() {
+			BeforeEach(func() {
+				plan.File = "some-artifact/config.json"
+			})
+
+			It("parses it as json", func() {
+				Expect(loadedVar()).To(Equal(map[string]interface{}{
+					"some": map[string]interface{}{"nested": "value"},
+				}))
+			})
+		})
+
+		Context("when the file is yaml", func() {
+			BeforeEach(func() {
+				plan.File = "some-artifact/config.yml"
+			})
+
+			It("parses it as yaml into values which can be marshaled to json", func() {
+				Expect(loadedVar()).To(Equal(map[string]interface{}{
+					"some": map[string]interface{}{
+						"nested": "value",
+						"list": []interface{}{
+							map[string]interface{}{"item": "value"},
+						},
+					},
+				}))
+			})
+		})
+	})
+
+	Context("when the format is raw", func() {
+		BeforeEach(func() {
+			plan.Format = "raw"
+		})
+
+		It("uses the file's content as-is", func() {
+			Expect(loadedVar()).To(Equal("1.2.3\n"))
+		})
+	})
+
+	Context("when the format is json but the file is not", func() {
+		BeforeEach(func() {
+			plan.Format = "json"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(MatchError(ContainSubstring("failed to parse some-artifact/version as json")))
+		})
+
+		It("does not succeed", func() {
+			Expect(step.Succeeded()).To(BeFalse())
+			Expect(fakeDelegate.FinishedCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the format is unknown", func() {
+		BeforeEach(func() {
+			plan.Format = "toml"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(Equal(exec.UnknownLoadVarFormatError{Format: "toml"}))
+		})
+	})
+
+	It("says which var it loaded", func() {
+		Expect(stdout).To(gbytes.Say("loaded var some-var from file some-artifact/version"))
+	})
+
+	It("finishes successfully", func() {
+		Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+		_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+		Expect(succeeded).To(BeTrue())
+	})
+
+	It("succeeds", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(step.Succeeded()).To(BeTrue())
+	})
+
+	Context("when the file does not exist", func() {
+		BeforeEach(func() {
+			plan.File = "some-artifact/bogus"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(Equal(exec.FileNotFoundError{Path: "some-artifact/bogus"}))
+		})
+	})
+})
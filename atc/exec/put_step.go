@@ -2,6 +2,7 @@ package exec
 
 import (
 	"context"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -9,6 +10,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -17,6 +19,8 @@ import (
 type PutDelegate interface {
 	BuildStepDelegate
 
+	Initializing(lager.Logger)
+	ImageFetched(lager.Logger, time.Duration)
 	Finished(lager.Logger, ExitStatus, VersionInfo)
 }
 
@@ -99,8 +103,21 @@ func NewPutStep(
 // The resource's put script is then invoked. If the context is canceled, the
 // script will be interrupted.
 func (step *PutStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "put", tracing.Attrs{
+		"name": step.name,
+	})
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *PutStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
+	step.delegate.Initializing(logger)
+
 	containerInputs, err := step.inputs.FindAll(state.Artifacts())
 	if err != nil {
 		return err
@@ -128,7 +145,7 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 	}
 
 	owner := db.NewBuildStepContainerOwner(step.build.ID(), step.planID, step.build.TeamID())
-	chosenWorker, err := step.pool.FindOrChooseWorkerForContainer(logger, owner, containerSpec, workerSpec, step.strategy)
+	chosenWorker, err := step.pool.FindOrChooseWorkerForContainer(ctx, logger, owner, containerSpec, workerSpec, step.strategy)
 	if err != nil {
 		return err
 	}
@@ -189,7 +206,7 @@ func (step *PutStep) Run(ctx context.Context, state RunState) error {
 
 	if step.resource != "" {
 		logger = logger.WithData(lager.Data{"step": step.name, "resource": step.resource, "resource-type": step.resourceType, "version": step.versionInfo.Version})
-		err = step.build.SaveOutput(logger, step.resourceType, source, step.resourceTypes, step.versionInfo.Version, db.NewResourceConfigMetadataFields(step.versionInfo.Metadata), step.name, step.resource)
+		err = step.build.SaveOutput(logger, step.resourceType, step.source.Identity(), step.resourceTypes, step.versionInfo.Version, db.NewResourceConfigMetadataFields(step.versionInfo.Metadata), step.name, step.resource)
 		if err != nil {
 			logger.Error("failed-to-save-output", err)
 			return err
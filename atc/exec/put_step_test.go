@@ -180,7 +180,7 @@ var _ = Describe("PutStep", func() {
 
 			It("finds/chooses a worker and creates a container with the correct type, session, and sources with no inputs specified (meaning it takes all artifacts)", func() {
 				Expect(fakePool.FindOrChooseWorkerForContainerCallCount()).To(Equal(1))
-				_, actualOwner, actualContainerSpec, actualWorkerSpec, strategy := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
+				_, _, actualOwner, actualContainerSpec, actualWorkerSpec, strategy := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
 				Expect(actualOwner).To(Equal(db.NewBuildStepContainerOwner(42, atc.PlanID(planID), 123)))
 				Expect(actualContainerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 					ResourceType: "some-resource-type",
@@ -282,7 +282,7 @@ var _ = Describe("PutStep", func() {
 
 				_, actualResourceType, actualSource, actualResourceTypes, version, metadata, outputName, resourceName := fakeBuild.SaveOutputArgsForCall(0)
 				Expect(actualResourceType).To(Equal("some-resource-type"))
-				Expect(actualSource).To(Equal(atc.Source{"some": "super-secret-source"}))
+				Expect(actualSource).To(Equal(atc.Source{"some": "((source-param))"}))
 				Expect(actualResourceTypes).To(Equal(resourceTypes))
 				Expect(version).To(Equal(atc.Version{"some": "version"}))
 				Expect(metadata).To(Equal(db.NewResourceConfigMetadataFields([]atc.MetadataField{{"some", "metadata"}})))
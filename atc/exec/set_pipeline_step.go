@@ -0,0 +1,229 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	boshtemplate "github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec/artifact"
+	"github.com/concourse/concourse/atc/template"
+	"github.com/concourse/concourse/atc/tracing"
+	"gopkg.in/yaml.v2"
+)
+
+//go:generate counterfeiter . SetPipelineDelegate
+
+type SetPipelineDelegate interface {
+	BuildStepDelegate
+
+	Finished(logger lager.Logger, succeeded bool, changed bool)
+}
+
+// SetPipelineStep configures a pipeline in the build's team from a config
+// file which has been fetched into the artifact.Repository by an earlier step.
+type SetPipelineStep struct {
+	planID      atc.PlanID
+	plan        atc.SetPipelinePlan
+	build       db.Build
+	teamFactory db.TeamFactory
+	delegate    SetPipelineDelegate
+	succeeded   bool
+}
+
+func NewSetPipelineStep(
+	planID atc.PlanID,
+	plan atc.SetPipelinePlan,
+	build db.Build,
+	teamFactory db.TeamFactory,
+	delegate SetPipelineDelegate,
+) Step {
+	return &SetPipelineStep{
+		planID:      planID,
+		plan:        plan,
+		build:       build,
+		teamFactory: teamFactory,
+		delegate:    delegate,
+	}
+}
+
+// Run reads the pipeline config file and var files out of the
+// artifact.Repository, interpolates the config with the configured vars and
+// validates it the same way as configs saved through the API.
+//
+// The differences between the pipeline's current config and the new config
+// are written to the build log. If there are any, the new config is saved,
+// failing if the pipeline was concurrently modified since its config was
+// read.
+func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "set_pipeline", tracing.Attrs{
+		"name": step.plan.Name,
+	})
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *SetPipelineStep) run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx).WithData(lager.Data{
+		"plan-id":  step.planID,
+		"pipeline": step.plan.Name,
+	})
+
+	repository := state.Artifacts()
+
+	payload, err := readArtifactFile(logger, repository, step.plan.File)
+	if err != nil {
+		return err
+	}
+
+	// vars configured on the step come first, followed by var files with
+	// values in later files taking precedence over earlier ones
+	params := []boshtemplate.Variables{boshtemplate.StaticVariables(step.plan.Vars)}
+	for i := len(step.plan.VarFiles) - 1; i >= 0; i-- {
+		path := step.plan.VarFiles[i]
+
+		varsPayload, err := readArtifactFile(logger, repository, path)
+		if err != nil {
+			return err
+		}
+
+		var vars boshtemplate.StaticVariables
+		err = yaml.Unmarshal(varsPayload, &vars)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal var file %s: %s", path, err)
+		}
+
+		params = append(params, vars)
+	}
+
+	payload, err = template.NewTemplateResolver(payload, params).Resolve(false, false)
+	if err != nil {
+		return err
+	}
+
+	var configStructure interface{}
+	err = yaml.Unmarshal(payload, &configStructure)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal pipeline config %s: %s", step.plan.File, err)
+	}
+
+	config, err := atc.DecodeConfig(configStructure)
+	if err != nil {
+		return err
+	}
+
+	warnings, errorMessages := config.Validate()
+	for _, warning := range warnings {
+		fmt.Fprintln(step.delegate.Stderr(), "[WARNING]", warning.Message)
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("invalid pipeline config:\n%s", strings.Join(errorMessages, "\n"))
+	}
+
+	team := step.teamFactory.GetByID(step.build.TeamID())
+
+	existingConfig, existingVersion, err := step.existingConfig(team)
+	if err != nil {
+		return err
+	}
+
+	changed := existingConfig.Diff(step.delegate.Stdout(), config)
+	if !changed {
+		fmt.Fprintln(step.delegate.Stdout(), "no changes to apply")
+
+		step.succeeded = true
+		step.delegate.Finished(logger, true, false)
+		return nil
+	}
+
+	_, _, err = team.SavePipeline(step.plan.Name, config, existingVersion, db.PipelineNoChange)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(step.delegate.Stdout(), "configuration updated")
+
+	step.succeeded = true
+	step.delegate.Finished(logger, true, true)
+
+	return nil
+}
+
+// Succeeded returns true if the pipeline was configured, or had no changes
+// to apply.
+func (step *SetPipelineStep) Succeeded() bool {
+	return step.succeeded
+}
+
+func (step *SetPipelineStep) existingConfig(team db.Team) (atc.Config, db.ConfigVersion, error) {
+	pipeline, found, err := team.Pipeline(step.plan.Name)
+	if err != nil {
+		return atc.Config{}, 0, err
+	}
+
+	if !found {
+		return atc.Config{}, 0, nil
+	}
+
+	jobs, err := pipeline.Jobs()
+	if err != nil {
+		return atc.Config{}, 0, err
+	}
+
+	resources, err := pipeline.Resources()
+	if err != nil {
+		return atc.Config{}, 0, err
+	}
+
+	resourceTypes, err := pipeline.ResourceTypes()
+	if err != nil {
+		return atc.Config{}, 0, err
+	}
+
+	config := atc.Config{
+		Groups:        pipeline.Groups(),
+		Resources:     resources.Configs(),
+		ResourceTypes: resourceTypes.Configs(),
+		Jobs:          jobs.Configs(),
+		VarSources:    pipeline.VarSources(),
+	}
+
+	return config, pipeline.ConfigVersion(), nil
+}
+
+func readArtifactFile(logger lager.Logger, repo *artifact.Repository, path string) ([]byte, error) {
+	segs := strings.SplitN(path, "/", 2)
+	if len(segs) != 2 {
+		return nil, UnspecifiedArtifactSourceError{path}
+	}
+
+	sourceName := artifact.Name(segs[0])
+	filePath := segs[1]
+
+	source, found := repo.SourceFor(sourceName)
+	if !found {
+		return nil, UnknownArtifactSourceError{sourceName, path}
+	}
+
+	stream, err := source.StreamFile(logger, filePath)
+	if err != nil {
+		if err == baggageclaim.ErrFileNotFound {
+			return nil, FileNotFoundError{Path: path}
+		}
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	return ioutil.ReadAll(stream)
+}
@@ -0,0 +1,368 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("SetPipelineStep", func() {
+	const pipelineConfig = `
+resources:
+- name: some-resource
+  type: git
+  source: {uri: ((uri))}
+
+jobs:
+- name: some-job
+  plan:
+  - get: some-resource
+`
+
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeBuild       *dbfakes.FakeBuild
+		fakeTeamFactory *dbfakes.FakeTeamFactory
+		fakeTeam        *dbfakes.FakeTeam
+		fakeDelegate    *execfakes.FakeSetPipelineDelegate
+		fakeSource      *workerfakes.FakeArtifactSource
+
+		files map[string]string
+
+		stdout *gbytes.Buffer
+		stderr *gbytes.Buffer
+
+		plan  atc.SetPipelinePlan
+		state exec.RunState
+
+		step    exec.Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.TeamIDReturns(123)
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeamFactory.GetByIDReturns(fakeTeam)
+
+		stdout = gbytes.NewBuffer()
+		stderr = gbytes.NewBuffer()
+
+		fakeDelegate = new(execfakes.FakeSetPipelineDelegate)
+		fakeDelegate.StdoutReturns(stdout)
+		fakeDelegate.StderrReturns(stderr)
+
+		files = map[string]string{
+			"pipeline.yml": pipelineConfig,
+		}
+
+		fakeSource = new(workerfakes.FakeArtifactSource)
+		fakeSource.StreamFileStub = func(_ lager.Logger, path string) (io.ReadCloser, error) {
+			content, found := files[path]
+			if !found {
+				return nil, baggageclaim.ErrFileNotFound
+			}
+
+			return gbytes.BufferWithBytes([]byte(content)), nil
+		}
+
+		state = exec.NewRunState()
+		state.Artifacts().RegisterSource("some-artifact", fakeSource)
+
+		plan = atc.SetPipelinePlan{
+			Name: "some-pipeline",
+			File: "some-artifact/pipeline.yml",
+			Vars: atc.Params{"uri": "some-uri"},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewSetPipelineStep(
+			"some-plan-id",
+			plan,
+			fakeBuild,
+			fakeTeamFactory,
+			fakeDelegate,
+		)
+
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("looks up the build's team", func() {
+		Expect(fakeTeamFactory.GetByIDArgsForCall(0)).To(Equal(123))
+	})
+
+	Context("when the pipeline does not exist", func() {
+		BeforeEach(func() {
+			fakeTeam.PipelineReturns(nil, false, nil)
+		})
+
+		It("saves the interpolated config", func() {
+			Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+
+			name, config, version, pausedState := fakeTeam.SavePipelineArgsForCall(0)
+			Expect(name).To(Equal("some-pipeline"))
+			Expect(config.Resources[0].Source).To(Equal(atc.Source{"uri": "some-uri"}))
+			Expect(config.Jobs[0].Name).To(Equal("some-job"))
+			Expect(version).To(Equal(db.ConfigVersion(0)))
+			Expect(pausedState).To(Equal(db.PipelineNoChange))
+		})
+
+		It("writes the diff to stdout", func() {
+			Expect(stdout).To(gbytes.Say("resources:"))
+			Expect(stdout).To(gbytes.Say("resource some-resource has been added"))
+			Expect(stdout).To(gbytes.Say("jobs:"))
+			Expect(stdout).To(gbytes.Say("job some-job has been added"))
+		})
+
+		It("finishes with a change", func() {
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded, changed := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeTrue())
+			Expect(changed).To(BeTrue())
+		})
+
+		It("succeeds", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+
+		Context("when saving the pipeline fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeTeam.SavePipelineReturns(nil, false, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(stepErr).To(Equal(disaster))
+			})
+
+			It("does not succeed", func() {
+				Expect(step.Succeeded()).To(BeFalse())
+				Expect(fakeDelegate.FinishedCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when the pipeline exists", func() {
+		var fakePipeline *dbfakes.FakePipeline
+
+		BeforeEach(func() {
+			fakePipeline = new(dbfakes.FakePipeline)
+			fakePipeline.ConfigVersionReturns(42)
+			fakeTeam.PipelineReturns(fakePipeline, true, nil)
+		})
+
+		Context("when the config has changed", func() {
+			It("saves the config with the current config version", func() {
+				Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+
+				_, _, version, _ := fakeTeam.SavePipelineArgsForCall(0)
+				Expect(version).To(Equal(db.ConfigVersion(42)))
+			})
+
+			Context("when a var source's config has changed", func() {
+				BeforeEach(func() {
+					files["pipeline.yml"] = pipelineConfig + `
+var_sources:
+- name: some-source
+  type: vault
+  config: {url: https://vault.example.com, client_token: new-token}
+`
+
+					fakePipeline.VarSourcesReturns(atc.VarSourceConfigs{
+						{
+							Name:   "some-source",
+							Type:   "vault",
+							Config: map[string]interface{}{"url": "https://vault.example.com", "client_token": "old-token"},
+						},
+					})
+				})
+
+				It("writes only the var source's name and type to stdout", func() {
+					Expect(stdout).To(gbytes.Say("var source some-source has changed"))
+					Expect(stdout.Contents()).ToNot(ContainSubstring("token"))
+					Expect(stdout.Contents()).ToNot(ContainSubstring("vault.example.com"))
+				})
+			})
+
+			Context("when the pipeline was concurrently modified", func() {
+				BeforeEach(func() {
+					fakeTeam.SavePipelineReturns(nil, false, db.ErrConfigComparisonFailed)
+				})
+
+				It("returns the error", func() {
+					Expect(stepErr).To(Equal(db.ErrConfigComparisonFailed))
+				})
+			})
+		})
+
+		Context("when the config has not changed", func() {
+			BeforeEach(func() {
+				fakeResource := new(dbfakes.FakeResource)
+				fakeResource.NameReturns("some-resource")
+				fakeResource.TypeReturns("git")
+				fakeResource.SourceReturns(atc.Source{"uri": "some-uri"})
+				fakePipeline.ResourcesReturns(db.Resources{fakeResource}, nil)
+
+				fakeJob := new(dbfakes.FakeJob)
+				fakeJob.ConfigReturns(atc.JobConfig{
+					Name: "some-job",
+					Plan: atc.PlanSequence{{Get: "some-resource"}},
+				})
+				fakePipeline.JobsReturns(db.Jobs{fakeJob}, nil)
+			})
+
+			It("does not save the pipeline", func() {
+				Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+			})
+
+			It("says so", func() {
+				Expect(stdout).To(gbytes.Say("no changes to apply"))
+			})
+
+			It("finishes without a change", func() {
+				Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+				_, succeeded, changed := fakeDelegate.FinishedArgsForCall(0)
+				Expect(succeeded).To(BeTrue())
+				Expect(changed).To(BeFalse())
+			})
+
+			Context("when the pipeline has var sources", func() {
+				BeforeEach(func() {
+					files["pipeline.yml"] = pipelineConfig + `
+var_sources:
+- name: some-source
+  type: vault
+  config: {url: https://vault.example.com}
+`
+
+					fakePipeline.VarSourcesReturns(atc.VarSourceConfigs{
+						{
+							Name:   "some-source",
+							Type:   "vault",
+							Config: map[string]interface{}{"url": "https://vault.example.com"},
+						},
+					})
+				})
+
+				It("does not save the pipeline", func() {
+					Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+
+	Context("when var files are configured", func() {
+		BeforeEach(func() {
+			files["vars-1.yml"] = "uri: uri-from-first-file"
+			files["vars-2.yml"] = "uri: uri-from-second-file"
+
+			plan.VarFiles = []string{
+				"some-artifact/vars-1.yml",
+				"some-artifact/vars-2.yml",
+			}
+		})
+
+		It("prefers vars configured on the step", func() {
+			_, config, _, _ := fakeTeam.SavePipelineArgsForCall(0)
+			Expect(config.Resources[0].Source).To(Equal(atc.Source{"uri": "some-uri"}))
+		})
+
+		Context("when no vars are configured on the step", func() {
+			BeforeEach(func() {
+				plan.Vars = nil
+			})
+
+			It("prefers later files", func() {
+				_, config, _, _ := fakeTeam.SavePipelineArgsForCall(0)
+				Expect(config.Resources[0].Source).To(Equal(atc.Source{"uri": "uri-from-second-file"}))
+			})
+		})
+
+		Context("when a var file does not exist", func() {
+			BeforeEach(func() {
+				plan.VarFiles = append(plan.VarFiles, "some-artifact/bogus.yml")
+			})
+
+			It("returns an error", func() {
+				Expect(stepErr).To(Equal(exec.FileNotFoundError{Path: "some-artifact/bogus.yml"}))
+			})
+		})
+	})
+
+	Context("when the pipeline file does not exist", func() {
+		BeforeEach(func() {
+			plan.File = "some-artifact/bogus.yml"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(Equal(exec.FileNotFoundError{Path: "some-artifact/bogus.yml"}))
+		})
+	})
+
+	Context("when the pipeline file's artifact does not exist", func() {
+		BeforeEach(func() {
+			plan.File = "bogus/pipeline.yml"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(BeAssignableToTypeOf(exec.UnknownArtifactSourceError{}))
+		})
+	})
+
+	Context("when the pipeline config has unknown keys", func() {
+		BeforeEach(func() {
+			files["pipeline.yml"] = `
+jobs:
+- name: some-job
+  bogus: key
+  plan: []
+`
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(BeAssignableToTypeOf(atc.ExtraKeysError{}))
+			Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the pipeline config is invalid", func() {
+		BeforeEach(func() {
+			files["pipeline.yml"] = `
+jobs:
+- name: some-job
+  plan:
+  - get: bogus-resource
+`
+		})
+
+		It("returns the validation errors", func() {
+			Expect(stepErr).To(MatchError(ContainSubstring("refers to a resource that does not exist")))
+			Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 	boshtemplate "github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/template"
 )
@@ -191,6 +192,37 @@ func (configSource InterpolateTemplateConfigSource) Warnings() []string {
 	return []string{}
 }
 
+// TaskVars are the vars given to a task step for interpolating its task file.
+// Their values may themselves refer to the build's vars, e.g. those of an
+// across step, which are interpolated when the task file is.
+type TaskVars struct {
+	Vars      atc.Params
+	Variables creds.Variables
+}
+
+func (vars TaskVars) Get(varDef boshtemplate.VariableDefinition) (interface{}, bool, error) {
+	val, found := vars.Vars[varDef.Name]
+	if !found {
+		return nil, false, nil
+	}
+
+	evaluated, err := creds.NewParams(vars.Variables, atc.Params{varDef.Name: val}).Evaluate()
+	if err != nil {
+		return nil, false, err
+	}
+
+	return evaluated[varDef.Name], true, nil
+}
+
+func (vars TaskVars) List() ([]boshtemplate.VariableDefinition, error) {
+	list := []boshtemplate.VariableDefinition{}
+	for name := range vars.Vars {
+		list = append(list, boshtemplate.VariableDefinition{Name: name})
+	}
+
+	return list, nil
+}
+
 // ValidatingConfigSource delegates to another ConfigSource, and validates its
 // task config.
 type ValidatingConfigSource struct {
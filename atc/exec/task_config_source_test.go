@@ -7,6 +7,7 @@ import (
 	boshtemplate "github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
 	. "github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/exec/execfakes"
@@ -432,4 +433,45 @@ run: {path: a/file}
 			}))
 		})
 	})
+	Describe("TaskVars", func() {
+		var (
+			fakeVariables *credsfakes.FakeVariables
+			vars          TaskVars
+		)
+
+		BeforeEach(func() {
+			fakeVariables = new(credsfakes.FakeVariables)
+			fakeVariables.GetReturns("1.12", true, nil)
+
+			vars = TaskVars{
+				Vars: atc.Params{
+					"go_version": "((go_version))",
+					"os":         "linux",
+				},
+				Variables: fakeVariables,
+			}
+		})
+
+		It("interpolates the build's vars into their values", func() {
+			val, found, err := vars.Get(boshtemplate.VariableDefinition{Name: "go_version"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("1.12"))
+
+			Expect(fakeVariables.GetArgsForCall(0).Name).To(Equal("go_version"))
+		})
+
+		It("returns values which do not refer to vars as they are", func() {
+			val, found, err := vars.Get(boshtemplate.VariableDefinition{Name: "os"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(val).To(Equal("linux"))
+		})
+
+		It("does not find vars which were not given", func() {
+			_, found, err := vars.Get(boshtemplate.VariableDefinition{Name: "bogus"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
 })
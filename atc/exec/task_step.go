@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
@@ -18,6 +19,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec/artifact"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -59,6 +61,7 @@ type TaskDelegate interface {
 	BuildStepDelegate
 
 	Initializing(lager.Logger, atc.TaskConfig)
+	ImageFetched(lager.Logger, time.Duration)
 	Starting(lager.Logger, atc.TaskConfig)
 	Finished(lager.Logger, ExitStatus)
 }
@@ -152,6 +155,17 @@ func NewTaskStep(
 // task's entire working directory is registered as an ArtifactSource under the
 // name of the task.
 func (action *TaskStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "task", tracing.Attrs{
+		"name": action.stepName,
+	})
+
+	err := action.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (action *TaskStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	repository := state.Artifacts()
@@ -179,13 +193,15 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	containerSpec.Env = append(containerSpec.Env, tracing.Env(ctx)...)
+
 	workerSpec, err := action.workerSpec(logger, action.resourceTypes, repository, config)
 	if err != nil {
 		return err
 	}
 
 	owner := db.NewBuildStepContainerOwner(action.buildID, action.planID, action.teamID)
-	chosenWorker, err := action.workerPool.FindOrChooseWorkerForContainer(logger, owner, containerSpec, workerSpec, action.strategy)
+	chosenWorker, err := action.workerPool.FindOrChooseWorkerForContainer(ctx, logger, owner, containerSpec, workerSpec, action.strategy)
 	if err != nil {
 		return err
 	}
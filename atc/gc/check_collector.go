@@ -0,0 +1,39 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type checkCollector struct {
+	checkLifecycle db.CheckLifecycle
+	expiry         time.Duration
+}
+
+func NewCheckCollector(
+	checkLifecycle db.CheckLifecycle,
+	expiry time.Duration,
+) Collector {
+	return &checkCollector{
+		checkLifecycle: checkLifecycle,
+		expiry:         expiry,
+	}
+}
+
+func (cc *checkCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("check-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	err := cc.checkLifecycle.RemoveExpiredChecks(cc.expiry)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-checks", err)
+		return err
+	}
+
+	return nil
+}
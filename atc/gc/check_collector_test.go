@@ -0,0 +1,97 @@
+package gc_test
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckCollector", func() {
+	var (
+		collector    gc.Collector
+		checkFactory db.CheckFactory
+		check        db.Check
+	)
+
+	BeforeEach(func() {
+		checkFactory = db.NewCheckFactory(dbConn, lockFactory)
+		collector = gc.NewCheckCollector(db.NewCheckLifecycle(dbConn), time.Hour)
+
+		created, err := checkFactory.CreateCheck(db.Checkable{ResourceID: usedResource.ID()}, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(BeTrue())
+
+		checks, err := checkFactory.StartChecks(1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checks).To(HaveLen(1))
+
+		check = checks[0]
+	})
+
+	checkExists := func() bool {
+		var count int
+		err := psql.Select("COUNT(*)").
+			From("checks").
+			Where(sq.Eq{"id": check.ID()}).
+			RunWith(dbConn).
+			QueryRow().
+			Scan(&count)
+		Expect(err).NotTo(HaveOccurred())
+		return count == 1
+	}
+
+	backdate := func(column string) {
+		_, err := psql.Update("checks").
+			Set(column, sq.Expr("now() - '2 HOURS'::INTERVAL")).
+			Where(sq.Eq{"id": check.ID()}).
+			RunWith(dbConn).
+			Exec()
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	JustBeforeEach(func() {
+		Expect(collector.Run(context.TODO())).To(Succeed())
+	})
+
+	Context("when the check is running", func() {
+		It("keeps the check", func() {
+			Expect(checkExists()).To(BeTrue())
+		})
+
+		Context("when it was started before the expiry", func() {
+			BeforeEach(func() {
+				backdate("start_time")
+			})
+
+			It("removes the abandoned check", func() {
+				Expect(checkExists()).To(BeFalse())
+			})
+		})
+	})
+
+	Context("when the check has finished", func() {
+		BeforeEach(func() {
+			Expect(check.Finish()).To(Succeed())
+		})
+
+		It("keeps the check", func() {
+			Expect(checkExists()).To(BeTrue())
+		})
+
+		Context("when it finished before the expiry", func() {
+			BeforeEach(func() {
+				backdate("end_time")
+			})
+
+			It("removes the check", func() {
+				Expect(checkExists()).To(BeFalse())
+			})
+		})
+	})
+})
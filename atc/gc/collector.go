@@ -22,6 +22,7 @@ type aggregateCollector struct {
 	containerCollector                  Collector
 	resourceConfigCheckSessionCollector Collector
 	artifactCollector                   Collector
+	checkCollector                      Collector
 }
 
 func NewCollector(
@@ -34,6 +35,7 @@ func NewCollector(
 	volumes Collector,
 	containers Collector,
 	resourceConfigCheckSessionCollector Collector,
+	checkCollector Collector,
 ) Collector {
 	return &aggregateCollector{
 		buildCollector:                      buildCollector,
@@ -45,6 +47,7 @@ func NewCollector(
 		volumeCollector:                     volumes,
 		containerCollector:                  containers,
 		resourceConfigCheckSessionCollector: resourceConfigCheckSessionCollector,
+		checkCollector:                      checkCollector,
 	}
 }
 
@@ -83,6 +86,11 @@ func (c *aggregateCollector) Run(ctx context.Context) error {
 		logger.Error("resource-config-check-session-collector", err)
 	}
 
+	err = c.checkCollector.Run(ctx)
+	if err != nil {
+		logger.Error("check-collector", err)
+	}
+
 	err = c.artifactCollector.Run(ctx)
 	if err != nil {
 		logger.Error("artifact-collector", err)
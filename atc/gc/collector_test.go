@@ -23,6 +23,7 @@ var _ = Describe("Aggregate Collector", func() {
 		fakeVolumeCollector                     *gcfakes.FakeCollector
 		fakeContainerCollector                  *gcfakes.FakeCollector
 		fakeResourceConfigCheckSessionCollector *gcfakes.FakeCollector
+		fakeCheckCollector                      *gcfakes.FakeCollector
 
 		err      error
 		disaster error
@@ -38,6 +39,7 @@ var _ = Describe("Aggregate Collector", func() {
 		fakeVolumeCollector = new(gcfakes.FakeCollector)
 		fakeContainerCollector = new(gcfakes.FakeCollector)
 		fakeResourceConfigCheckSessionCollector = new(gcfakes.FakeCollector)
+		fakeCheckCollector = new(gcfakes.FakeCollector)
 
 		subject = NewCollector(
 			fakeBuildCollector,
@@ -49,6 +51,7 @@ var _ = Describe("Aggregate Collector", func() {
 			fakeVolumeCollector,
 			fakeContainerCollector,
 			fakeResourceConfigCheckSessionCollector,
+			fakeCheckCollector,
 		)
 
 		disaster = errors.New("disaster")
@@ -81,6 +84,7 @@ var _ = Describe("Aggregate Collector", func() {
 				Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 				Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
 				Expect(fakeResourceConfigCheckSessionCollector.RunCallCount()).To(Equal(1))
+				Expect(fakeCheckCollector.RunCallCount()).To(Equal(1))
 			})
 		})
 
@@ -106,6 +110,7 @@ var _ = Describe("Aggregate Collector", func() {
 					Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 					Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
 					Expect(fakeResourceConfigCheckSessionCollector.RunCallCount()).To(Equal(1))
+					Expect(fakeCheckCollector.RunCallCount()).To(Equal(1))
 				})
 			})
 
@@ -131,6 +136,7 @@ var _ = Describe("Aggregate Collector", func() {
 						Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 						Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
 						Expect(fakeResourceConfigCheckSessionCollector.RunCallCount()).To(Equal(1))
+						Expect(fakeCheckCollector.RunCallCount()).To(Equal(1))
 					})
 				})
 
@@ -156,6 +162,7 @@ var _ = Describe("Aggregate Collector", func() {
 							Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 							Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
 							Expect(fakeResourceConfigCheckSessionCollector.RunCallCount()).To(Equal(1))
+							Expect(fakeCheckCollector.RunCallCount()).To(Equal(1))
 						})
 					})
 
@@ -181,6 +188,7 @@ var _ = Describe("Aggregate Collector", func() {
 								Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 								Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
 								Expect(fakeResourceConfigCheckSessionCollector.RunCallCount()).To(Equal(1))
+								Expect(fakeCheckCollector.RunCallCount()).To(Equal(1))
 							})
 						})
 
@@ -206,6 +214,7 @@ var _ = Describe("Aggregate Collector", func() {
 									Expect(fakeArtifactCollector.RunCallCount()).To(Equal(1))
 									Expect(fakeContainerCollector.RunCallCount()).To(Equal(1))
 									Expect(fakeResourceConfigCheckSessionCollector.RunCallCount()).To(Equal(1))
+									Expect(fakeCheckCollector.RunCallCount()).To(Equal(1))
 								})
 							})
 
@@ -231,11 +240,13 @@ var _ = Describe("Aggregate Collector", func() {
 										Expect(fakeResourceCacheCollector.RunCallCount()).To(Equal(1))
 										Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 										Expect(fakeResourceConfigCheckSessionCollector.RunCallCount()).To(Equal(1))
+										Expect(fakeCheckCollector.RunCallCount()).To(Equal(1))
 									})
 								})
 								Context("when the resource config check session collector succeeds", func() {
 									It("attempts to collect", func() {
 										Expect(fakeResourceConfigCheckSessionCollector.RunCallCount()).To(Equal(1))
+										Expect(fakeCheckCollector.RunCallCount()).To(Equal(1))
 									})
 
 									Context("when the collector errors", func() {
@@ -276,6 +287,7 @@ var _ = Describe("Aggregate Collector", func() {
 												Expect(fakeResourceConfigCollector.RunCallCount()).To(Equal(1))
 												Expect(fakeResourceCacheCollector.RunCallCount()).To(Equal(1))
 												Expect(fakeResourceConfigCheckSessionCollector.RunCallCount()).To(Equal(1))
+												Expect(fakeCheckCollector.RunCallCount()).To(Equal(1))
 												Expect(fakeVolumeCollector.RunCallCount()).To(Equal(1))
 											})
 										})
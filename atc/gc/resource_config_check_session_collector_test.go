@@ -54,7 +54,7 @@ var _ = Describe("ResourceConfigCheckSessionCollector", func() {
 				creds.VersionedResourceTypes{})
 			Expect(err).ToNot(HaveOccurred())
 
-			owner = db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), ownerExpiries)
+			owner = db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), "", ownerExpiries)
 
 			workerFactory := db.NewWorkerFactory(dbConn)
 			defaultWorkerPayload := atc.Worker{
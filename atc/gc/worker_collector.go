@@ -45,6 +45,36 @@ func (wc *workerCollector) Run(ctx context.Context) error {
 		logger.Info("marked-workers-as-stalled", lager.Data{"count": len(affected), "workers": affected})
 	}
 
+	affected, err = wc.workerLifecycle.CordonWorkersInMaintenance()
+	if err != nil {
+		logger.Error("failed-to-cordon-workers-in-maintenance", err)
+		return err
+	}
+
+	if len(affected) > 0 {
+		logger.Info("cordoned-workers-in-maintenance", lager.Data{"count": len(affected), "workers": affected})
+	}
+
+	affected, err = wc.workerLifecycle.UncordonWorkersAfterMaintenance()
+	if err != nil {
+		logger.Error("failed-to-uncordon-workers-after-maintenance", err)
+		return err
+	}
+
+	if len(affected) > 0 {
+		logger.Info("uncordoned-workers-after-maintenance", lager.Data{"count": len(affected), "workers": affected})
+	}
+
+	abortedBuilds, err := wc.workerLifecycle.AbortBuildsPastDrainDeadline()
+	if err != nil {
+		logger.Error("failed-to-abort-builds-past-drain-deadline", err)
+		return err
+	}
+
+	if len(abortedBuilds) > 0 {
+		logger.Info("aborted-builds-past-drain-deadline", lager.Data{"count": len(abortedBuilds), "builds": abortedBuilds})
+	}
+
 	affected, err = wc.workerLifecycle.DeleteFinishedRetiringWorkers()
 	if err != nil {
 		logger.Error("failed-to-delete-finished-retiring-workers", err)
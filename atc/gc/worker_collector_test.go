@@ -25,6 +25,9 @@ var _ = Describe("WorkerCollector", func() {
 
 		fakeWorkerLifecycle.DeleteUnresponsiveEphemeralWorkersReturns(nil, nil)
 		fakeWorkerLifecycle.StallUnresponsiveWorkersReturns(nil, nil)
+		fakeWorkerLifecycle.CordonWorkersInMaintenanceReturns(nil, nil)
+		fakeWorkerLifecycle.UncordonWorkersAfterMaintenanceReturns(nil, nil)
+		fakeWorkerLifecycle.AbortBuildsPastDrainDeadlineReturns(nil, nil)
 		fakeWorkerLifecycle.DeleteFinishedRetiringWorkersReturns(nil, nil)
 		fakeWorkerLifecycle.LandFinishedLandingWorkersReturns(nil, nil)
 	})
@@ -44,6 +47,27 @@ var _ = Describe("WorkerCollector", func() {
 			Expect(fakeWorkerLifecycle.StallUnresponsiveWorkersCallCount()).To(Equal(1))
 		})
 
+		It("tells the worker factory to cordon workers in maintenance", func() {
+			err := workerCollector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeWorkerLifecycle.CordonWorkersInMaintenanceCallCount()).To(Equal(1))
+		})
+
+		It("tells the worker factory to uncordon workers after maintenance", func() {
+			err := workerCollector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeWorkerLifecycle.UncordonWorkersAfterMaintenanceCallCount()).To(Equal(1))
+		})
+
+		It("tells the worker factory to abort builds past the drain deadline of their workers", func() {
+			err := workerCollector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeWorkerLifecycle.AbortBuildsPastDrainDeadlineCallCount()).To(Equal(1))
+		})
+
 		It("tells the worker factory to delete finished retiring workers", func() {
 			err := workerCollector.Run(context.TODO())
 			Expect(err).NotTo(HaveOccurred())
@@ -66,6 +90,30 @@ var _ = Describe("WorkerCollector", func() {
 			Expect(err).To(MatchError(returnedErr))
 		})
 
+		It("returns an error if cordoning workers in maintenance fails", func() {
+			returnedErr := errors.New("some-error")
+			fakeWorkerLifecycle.CordonWorkersInMaintenanceReturns(nil, returnedErr)
+
+			err := workerCollector.Run(context.TODO())
+			Expect(err).To(MatchError(returnedErr))
+		})
+
+		It("returns an error if uncordoning workers after maintenance fails", func() {
+			returnedErr := errors.New("some-error")
+			fakeWorkerLifecycle.UncordonWorkersAfterMaintenanceReturns(nil, returnedErr)
+
+			err := workerCollector.Run(context.TODO())
+			Expect(err).To(MatchError(returnedErr))
+		})
+
+		It("returns an error if aborting builds past the drain deadline fails", func() {
+			returnedErr := errors.New("some-error")
+			fakeWorkerLifecycle.AbortBuildsPastDrainDeadlineReturns(nil, returnedErr)
+
+			err := workerCollector.Run(context.TODO())
+			Expect(err).To(MatchError(returnedErr))
+		})
+
 		It("returns an error if deleting finished retiring workers fails", func() {
 			returnedErr := errors.New("some-error")
 			fakeWorkerLifecycle.DeleteFinishedRetiringWorkersReturns(nil, returnedErr)
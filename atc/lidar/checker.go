@@ -0,0 +1,133 @@
+package lidar
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/radar"
+)
+
+var ErrPipelineNotFound = errors.New("pipeline not found")
+
+// Checker runs the checks queued by the Scanner and by webhooks, running no
+// more than MaxInFlight of them at once. Every ATC runs a Checker.
+type Checker struct {
+	Logger         lager.Logger
+	CheckFactory   db.CheckFactory
+	ScannerFactory radar.ScannerFactory
+	Clock          clock.Clock
+	Interval       time.Duration
+	MaxInFlight    int
+}
+
+func (checker Checker) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	ticker := checker.Clock.NewTicker(checker.Interval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	inFlight := make(chan struct{}, checker.MaxInFlight)
+	running := &sync.WaitGroup{}
+
+	for {
+		select {
+		case <-ticker.C():
+			checker.startChecks(inFlight, running)
+		case <-signals:
+			running.Wait()
+			return nil
+		}
+	}
+}
+
+func (checker Checker) startChecks(inFlight chan struct{}, running *sync.WaitGroup) {
+	logger := checker.Logger.Session("tick")
+
+	available := cap(inFlight) - len(inFlight)
+	if available > 0 {
+		checks, err := checker.CheckFactory.StartChecks(available)
+		if err != nil {
+			logger.Error("failed-to-start-checks", err)
+		}
+
+		for _, check := range checks {
+			inFlight <- struct{}{}
+			running.Add(1)
+
+			go func(check db.Check) {
+				defer running.Done()
+				defer func() { <-inFlight }()
+
+				checker.runCheck(logger, check)
+			}(check)
+		}
+	}
+
+	metric.ChecksInFlight{
+		Checks: len(inFlight),
+	}.Emit(logger)
+}
+
+func (checker Checker) runCheck(logger lager.Logger, check db.Check) {
+	logger = logger.Session("check", lager.Data{
+		"check":    check.ID(),
+		"team":     check.TeamName(),
+		"pipeline": check.PipelineName(),
+		"name":     check.Name(),
+	})
+
+	metric.CheckQueueLatency{
+		TeamName:     check.TeamName(),
+		PipelineName: check.PipelineName(),
+		Duration:     check.StartTime().Sub(check.CreateTime()),
+	}.Emit(logger)
+
+	err := checker.check(logger, check)
+	if err != nil {
+		logger.Error("failed-to-check", err)
+		err = check.FinishWithError(err)
+	} else {
+		err = check.Finish()
+	}
+
+	if err != nil {
+		logger.Error("failed-to-finish-check", err)
+	}
+}
+
+func (checker Checker) check(logger lager.Logger, check db.Check) error {
+	pipeline, found, err := check.Pipeline()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return ErrPipelineNotFound
+	}
+
+	var scanner radar.Scanner
+	if check.ResourceTypeID() != 0 {
+		scanner = checker.ScannerFactory.NewResourceTypeScanner(pipeline)
+	} else {
+		scanner = checker.ScannerFactory.NewResourceScanner(pipeline)
+	}
+
+	if check.ManuallyTriggered() {
+		// check right away, even if it was checked within its interval
+		return scanner.Scan(logger, check.Name())
+	}
+
+	_, err = scanner.Run(logger, check.Name())
+	if err == radar.ErrFailedToAcquireLock {
+		// another ATC is already checking it
+		return nil
+	}
+
+	return err
+}
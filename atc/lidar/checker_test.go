@@ -0,0 +1,177 @@
+package lidar_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/lidar"
+	"github.com/concourse/concourse/atc/radar"
+	"github.com/concourse/concourse/atc/radar/radarfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("Checker", func() {
+	var (
+		fakeCheckFactory                 *dbfakes.FakeCheckFactory
+		fakeScannerFactory               *radarfakes.FakeScannerFactory
+		fakeResourceScanner              *radarfakes.FakeScanner
+		fakeResourceTypeScanner          *radarfakes.FakeScanner
+		fakePipeline                     *dbfakes.FakePipeline
+		fakeClock                        *fakeclock.FakeClock
+		interval                         = time.Second
+		fakeCheck, fakeResourceTypeCheck *dbfakes.FakeCheck
+
+		process ifrit.Process
+	)
+
+	BeforeEach(func() {
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+		fakeScannerFactory = new(radarfakes.FakeScannerFactory)
+		fakeResourceScanner = new(radarfakes.FakeScanner)
+		fakeResourceTypeScanner = new(radarfakes.FakeScanner)
+		fakeScannerFactory.NewResourceScannerReturns(fakeResourceScanner)
+		fakeScannerFactory.NewResourceTypeScannerReturns(fakeResourceTypeScanner)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+
+		fakeCheck = new(dbfakes.FakeCheck)
+		fakeCheck.ResourceIDReturns(1)
+		fakeCheck.NameReturns("some-resource")
+		fakeCheck.PipelineReturns(fakePipeline, true, nil)
+
+		fakeResourceTypeCheck = new(dbfakes.FakeCheck)
+		fakeResourceTypeCheck.ResourceTypeIDReturns(1)
+		fakeResourceTypeCheck.NameReturns("some-type")
+		fakeResourceTypeCheck.PipelineReturns(fakePipeline, true, nil)
+
+		fakeCheckFactory.StartChecksReturns([]db.Check{}, nil)
+		fakeCheckFactory.StartChecksReturnsOnCall(0, []db.Check{fakeCheck, fakeResourceTypeCheck}, nil)
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+	})
+
+	JustBeforeEach(func() {
+		process = ifrit.Invoke(lidar.Checker{
+			Logger:         lagertest.NewTestLogger("test"),
+			CheckFactory:   fakeCheckFactory,
+			ScannerFactory: fakeScannerFactory,
+			Clock:          fakeClock,
+			Interval:       interval,
+			MaxInFlight:    5,
+		})
+
+		fakeClock.WaitForWatcherAndIncrement(interval)
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive())
+	})
+
+	It("starts as many checks as it has room for", func() {
+		Eventually(fakeCheckFactory.StartChecksCallCount).Should(Equal(1))
+		Expect(fakeCheckFactory.StartChecksArgsForCall(0)).To(Equal(5))
+	})
+
+	It("runs resource checks with a resource scanner for their pipeline", func() {
+		Eventually(fakeResourceScanner.RunCallCount).Should(Equal(1))
+		_, name := fakeResourceScanner.RunArgsForCall(0)
+		Expect(name).To(Equal("some-resource"))
+		Expect(fakeScannerFactory.NewResourceScannerArgsForCall(0)).To(Equal(fakePipeline))
+	})
+
+	It("runs resource type checks with a resource type scanner", func() {
+		Eventually(fakeResourceTypeScanner.RunCallCount).Should(Equal(1))
+		_, name := fakeResourceTypeScanner.RunArgsForCall(0)
+		Expect(name).To(Equal("some-type"))
+	})
+
+	Context("when the check was manually triggered", func() {
+		BeforeEach(func() {
+			fakeCheck.ManuallyTriggeredReturns(true)
+		})
+
+		It("scans the resource regardless of its check interval", func() {
+			Eventually(fakeResourceScanner.ScanCallCount).Should(Equal(1))
+			_, name := fakeResourceScanner.ScanArgsForCall(0)
+			Expect(name).To(Equal("some-resource"))
+
+			Expect(fakeResourceScanner.RunCallCount()).To(BeZero())
+		})
+
+		It("finishes the check", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+		})
+	})
+
+	It("finishes the checks", func() {
+		Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+		Eventually(fakeResourceTypeCheck.FinishCallCount).Should(Equal(1))
+	})
+
+	Context("when the check fails", func() {
+		BeforeEach(func() {
+			fakeResourceScanner.RunReturns(0, errors.New("nope"))
+		})
+
+		It("finishes the check with the error", func() {
+			Eventually(fakeCheck.FinishWithErrorCallCount).Should(Equal(1))
+			Expect(fakeCheck.FinishWithErrorArgsForCall(0)).To(MatchError("nope"))
+			Expect(fakeCheck.FinishCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when another ATC is already checking", func() {
+		BeforeEach(func() {
+			fakeResourceScanner.RunReturns(0, radar.ErrFailedToAcquireLock)
+		})
+
+		It("finishes the check", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+		})
+	})
+
+	Context("when the pipeline is gone", func() {
+		BeforeEach(func() {
+			fakeCheck.PipelineReturns(nil, false, nil)
+		})
+
+		It("finishes the check with an error", func() {
+			Eventually(fakeCheck.FinishWithErrorCallCount).Should(Equal(1))
+			Expect(fakeCheck.FinishWithErrorArgsForCall(0)).To(Equal(lidar.ErrPipelineNotFound))
+		})
+	})
+
+	Context("when checks are still running on the next tick", func() {
+		var release chan struct{}
+
+		BeforeEach(func() {
+			release = make(chan struct{})
+			fakeResourceScanner.RunStub = func(lager.Logger, string) (time.Duration, error) {
+				<-release
+				return 0, nil
+			}
+		})
+
+		AfterEach(func() {
+			close(release)
+		})
+
+		It("only starts as many checks as it has room for", func() {
+			Eventually(fakeResourceTypeCheck.FinishCallCount).Should(Equal(1))
+
+			fakeClock.WaitForWatcherAndIncrement(interval)
+
+			Eventually(fakeCheckFactory.StartChecksCallCount).Should(Equal(2))
+			Expect(fakeCheckFactory.StartChecksArgsForCall(1)).To(Equal(4))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package lidar_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestLidar(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Lidar Suite")
+}
@@ -1,14 +1,14 @@
 // Code generated by counterfeiter. DO NOT EDIT.
-package radarfakes
+package lidarfakes
 
 import (
 	context "context"
 	sync "sync"
 
-	radar "github.com/concourse/concourse/atc/radar"
+	lidar "github.com/concourse/concourse/atc/lidar"
 )
 
-type FakeIntervalRunner struct {
+type FakeScanner struct {
 	RunStub        func(context.Context) error
 	runMutex       sync.RWMutex
 	runArgsForCall []struct {
@@ -24,7 +24,7 @@ type FakeIntervalRunner struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeIntervalRunner) Run(arg1 context.Context) error {
+func (fake *FakeScanner) Run(arg1 context.Context) error {
 	fake.runMutex.Lock()
 	ret, specificReturn := fake.runReturnsOnCall[len(fake.runArgsForCall)]
 	fake.runArgsForCall = append(fake.runArgsForCall, struct {
@@ -42,26 +42,26 @@ func (fake *FakeIntervalRunner) Run(arg1 context.Context) error {
 	return fakeReturns.result1
 }
 
-func (fake *FakeIntervalRunner) RunCallCount() int {
+func (fake *FakeScanner) RunCallCount() int {
 	fake.runMutex.RLock()
 	defer fake.runMutex.RUnlock()
 	return len(fake.runArgsForCall)
 }
 
-func (fake *FakeIntervalRunner) RunCalls(stub func(context.Context) error) {
+func (fake *FakeScanner) RunCalls(stub func(context.Context) error) {
 	fake.runMutex.Lock()
 	defer fake.runMutex.Unlock()
 	fake.RunStub = stub
 }
 
-func (fake *FakeIntervalRunner) RunArgsForCall(i int) context.Context {
+func (fake *FakeScanner) RunArgsForCall(i int) context.Context {
 	fake.runMutex.RLock()
 	defer fake.runMutex.RUnlock()
 	argsForCall := fake.runArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeIntervalRunner) RunReturns(result1 error) {
+func (fake *FakeScanner) RunReturns(result1 error) {
 	fake.runMutex.Lock()
 	defer fake.runMutex.Unlock()
 	fake.RunStub = nil
@@ -70,7 +70,7 @@ func (fake *FakeIntervalRunner) RunReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeIntervalRunner) RunReturnsOnCall(i int, result1 error) {
+func (fake *FakeScanner) RunReturnsOnCall(i int, result1 error) {
 	fake.runMutex.Lock()
 	defer fake.runMutex.Unlock()
 	fake.RunStub = nil
@@ -84,7 +84,7 @@ func (fake *FakeIntervalRunner) RunReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeIntervalRunner) Invocations() map[string][][]interface{} {
+func (fake *FakeScanner) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.runMutex.RLock()
@@ -96,7 +96,7 @@ func (fake *FakeIntervalRunner) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
-func (fake *FakeIntervalRunner) recordInvocation(key string, args []interface{}) {
+func (fake *FakeScanner) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
 	if fake.invocations == nil {
@@ -108,4 +108,4 @@ func (fake *FakeIntervalRunner) recordInvocation(key string, args []interface{})
 	fake.invocations[key] = append(fake.invocations[key], args)
 }
 
-var _ radar.IntervalRunner = new(FakeIntervalRunner)
+var _ lidar.Scanner = new(FakeScanner)
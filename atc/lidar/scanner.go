@@ -0,0 +1,139 @@
+package lidar
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// Checks are delayed by up to this fraction of their interval, so that
+// checks which fall due at the same time are spread out.
+const jitterFraction = 10
+
+//go:generate counterfeiter . Scanner
+
+type Scanner interface {
+	Run(context.Context) error
+}
+
+type scanner struct {
+	checkFactory                 db.CheckFactory
+	clock                        clock.Clock
+	resourceCheckingInterval     time.Duration
+	resourceTypeCheckingInterval time.Duration
+}
+
+// NewScanner returns a Scanner which queues a check for every resource and
+// resource type that is due to be checked. Only one ATC should run it at a
+// time.
+func NewScanner(
+	checkFactory db.CheckFactory,
+	clock clock.Clock,
+	resourceCheckingInterval time.Duration,
+	resourceTypeCheckingInterval time.Duration,
+) Scanner {
+	return &scanner{
+		checkFactory:                 checkFactory,
+		clock:                        clock,
+		resourceCheckingInterval:     resourceCheckingInterval,
+		resourceTypeCheckingInterval: resourceTypeCheckingInterval,
+	}
+}
+
+func (s *scanner) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("scanner")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	checkables, err := s.checkFactory.Checkables()
+	if err != nil {
+		logger.Error("failed-to-get-checkables", err)
+		return err
+	}
+
+	now := s.clock.Now()
+
+	for _, checkable := range checkables {
+		if now.Before(s.due(checkable)) {
+			continue
+		}
+
+		created, err := s.checkFactory.CreateCheck(checkable, false)
+		if err != nil {
+			logger.Error("failed-to-create-check", err, lager.Data{
+				"team":     checkable.TeamName,
+				"pipeline": checkable.PipelineName,
+				"name":     checkable.Name,
+			})
+			continue
+		}
+
+		if created {
+			logger.Debug("queued-check", lager.Data{
+				"team":     checkable.TeamName,
+				"pipeline": checkable.PipelineName,
+				"name":     checkable.Name,
+			})
+		}
+	}
+
+	depth, err := s.checkFactory.PendingChecks()
+	if err != nil {
+		logger.Error("failed-to-count-pending-checks", err)
+		return err
+	}
+
+	metric.CheckQueueDepth{
+		Depth: depth,
+	}.Emit(logger)
+
+	return nil
+}
+
+func (s *scanner) due(checkable db.Checkable) time.Time {
+	interval := s.resourceCheckingInterval
+	if checkable.ResourceTypeID != 0 {
+		interval = s.resourceTypeCheckingInterval
+	}
+
+	// an invalid interval is left to the check itself to report
+	if checkable.CheckEvery != "" {
+		configuredInterval, err := time.ParseDuration(checkable.CheckEvery)
+		if err == nil {
+			interval = configuredInterval
+		}
+	}
+
+	// a check which stopped short of checking, e.g. because the resource's
+	// pinned version was already found or its credentials could not be
+	// evaluated, does not update when it was last checked, but should still
+	// wait for the interval before it is tried again
+	since := checkable.LastChecked
+	if checkable.LastCheckEnded.After(since) {
+		since = checkable.LastCheckEnded
+	}
+
+	return since.Add(interval + jitter(checkable, interval))
+}
+
+// jitter returns a delay which is always the same for the checkable, so that
+// its checks stay evenly spaced.
+func jitter(checkable db.Checkable, interval time.Duration) time.Duration {
+	max := int64(interval / jitterFraction)
+	if max <= 0 {
+		return 0
+	}
+
+	hash := fnv.New32a()
+	fmt.Fprintf(hash, "%d:%d", checkable.ResourceID, checkable.ResourceTypeID)
+
+	return time.Duration(int64(hash.Sum32()) % max)
+}
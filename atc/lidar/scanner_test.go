@@ -0,0 +1,208 @@
+package lidar_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/lidar"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scanner", func() {
+	var (
+		fakeCheckFactory *dbfakes.FakeCheckFactory
+		fakeClock        *fakeclock.FakeClock
+		now              time.Time
+
+		scanner lidar.Scanner
+		runErr  error
+	)
+
+	BeforeEach(func() {
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+
+		now = time.Unix(123456789, 0)
+		fakeClock = fakeclock.NewFakeClock(now)
+
+		scanner = lidar.NewScanner(fakeCheckFactory, fakeClock, time.Minute, 2*time.Minute)
+	})
+
+	JustBeforeEach(func() {
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		runErr = scanner.Run(ctx)
+	})
+
+	queued := func() []db.Checkable {
+		checkables := []db.Checkable{}
+		for i := 0; i < fakeCheckFactory.CreateCheckCallCount(); i++ {
+			checkable, manuallyTriggered := fakeCheckFactory.CreateCheckArgsForCall(i)
+			Expect(manuallyTriggered).To(BeFalse())
+
+			checkables = append(checkables, checkable)
+		}
+
+		return checkables
+	}
+
+	Context("when a resource has never been checked", func() {
+		var checkable db.Checkable
+
+		BeforeEach(func() {
+			checkable = db.Checkable{ResourceID: 1, Name: "some-resource"}
+			fakeCheckFactory.CheckablesReturns([]db.Checkable{checkable}, nil)
+		})
+
+		It("queues a check", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(queued()).To(Equal([]db.Checkable{checkable}))
+		})
+
+		Context("when queueing the check fails", func() {
+			BeforeEach(func() {
+				fakeCheckFactory.CreateCheckReturns(false, errors.New("nope"))
+			})
+
+			It("does not fail", func() {
+				Expect(runErr).NotTo(HaveOccurred())
+			})
+		})
+	})
+
+	Context("when resources were last checked within their interval", func() {
+		BeforeEach(func() {
+			checkables := []db.Checkable{}
+			for id := 1; id <= 100; id++ {
+				checkables = append(checkables, db.Checkable{
+					ResourceID:  id,
+					LastChecked: now.Add(-time.Minute + time.Second),
+				})
+			}
+
+			fakeCheckFactory.CheckablesReturns(checkables, nil)
+		})
+
+		It("does not queue checks", func() {
+			Expect(queued()).To(BeEmpty())
+		})
+	})
+
+	Context("when resources were last checked longer ago than their interval and its jitter", func() {
+		BeforeEach(func() {
+			checkables := []db.Checkable{}
+			for id := 1; id <= 100; id++ {
+				checkables = append(checkables, db.Checkable{
+					ResourceID:  id,
+					LastChecked: now.Add(-time.Minute - 6*time.Second),
+				})
+			}
+
+			fakeCheckFactory.CheckablesReturns(checkables, nil)
+		})
+
+		It("queues checks", func() {
+			Expect(queued()).To(HaveLen(100))
+		})
+	})
+
+	Context("when resources are due, but still within their jitter", func() {
+		BeforeEach(func() {
+			checkables := []db.Checkable{}
+			for id := 1; id <= 100; id++ {
+				checkables = append(checkables, db.Checkable{
+					ResourceID:  id,
+					LastChecked: now.Add(-time.Minute),
+				})
+			}
+
+			fakeCheckFactory.CheckablesReturns(checkables, nil)
+		})
+
+		It("spreads their checks out", func() {
+			Expect(len(queued())).To(BeNumerically("<", 100))
+		})
+	})
+
+	Context("when a resource sets its own interval", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.CheckablesReturns([]db.Checkable{
+				{
+					ResourceID:  1,
+					CheckEvery:  "10s",
+					LastChecked: now.Add(-20 * time.Second),
+				},
+			}, nil)
+		})
+
+		It("queues a check once it has passed", func() {
+			Expect(queued()).To(HaveLen(1))
+		})
+	})
+
+	Context("when a resource sets an invalid interval", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.CheckablesReturns([]db.Checkable{
+				{
+					ResourceID:  1,
+					CheckEvery:  "bogus",
+					LastChecked: now.Add(-2 * time.Minute),
+				},
+			}, nil)
+		})
+
+		It("queues a check on the default interval, to report the error", func() {
+			Expect(queued()).To(HaveLen(1))
+		})
+	})
+
+	Context("when a resource's last check ended within its interval without checking", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.CheckablesReturns([]db.Checkable{
+				{
+					ResourceID:     1,
+					LastChecked:    now.Add(-time.Hour),
+					LastCheckEnded: now.Add(-time.Minute + time.Second),
+				},
+				{
+					ResourceID:     2,
+					LastCheckEnded: now.Add(-time.Minute + time.Second),
+				},
+			}, nil)
+		})
+
+		It("does not queue checks", func() {
+			Expect(queued()).To(BeEmpty())
+		})
+	})
+
+	Context("when a resource type was last checked within its interval", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.CheckablesReturns([]db.Checkable{
+				{
+					ResourceTypeID: 1,
+					LastChecked:    now.Add(-90 * time.Second),
+				},
+			}, nil)
+		})
+
+		It("uses the resource type checking interval", func() {
+			Expect(queued()).To(BeEmpty())
+		})
+	})
+
+	Context("when getting the checkables fails", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.CheckablesReturns(nil, errors.New("nope"))
+		})
+
+		It("fails", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+})
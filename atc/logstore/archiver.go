@@ -0,0 +1,151 @@
+package logstore
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// archiveBatchSize is the number of builds loaded from the database at a time
+// while archiving.
+const archiveBatchSize = 100
+
+//go:generate counterfeiter . Archiver
+
+type Archiver interface {
+	Run(context.Context) error
+}
+
+type archiver struct {
+	store             Store
+	buildFactory      db.BuildFactory
+	drainerConfigured bool
+}
+
+// NewArchiver returns an Archiver which uploads the events of completed
+// builds to the store, and then deletes them from the database.
+//
+// If a syslog drainer is configured, builds are only archived once they have
+// been drained.
+func NewArchiver(store Store, buildFactory db.BuildFactory, drainerConfigured bool) Archiver {
+	return &archiver{
+		store:             store,
+		buildFactory:      buildFactory,
+		drainerConfigured: drainerConfigured,
+	}
+}
+
+func (a *archiver) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("log-store-archiver")
+
+	afterID := 0
+	for {
+		builds, err := a.buildFactory.GetArchivableBuilds(afterID, archiveBatchSize)
+		if err != nil {
+			logger.Error("failed-to-get-archivable-builds", err)
+			return err
+		}
+
+		for _, build := range builds {
+			afterID = build.ID()
+
+			if a.drainerConfigured && !build.IsDrained() {
+				continue
+			}
+
+			// the error has been logged, and the build will be tried again on
+			// the next run; it should not hold up the builds after it
+			_ = a.archiveBuild(ctx, logger, build)
+		}
+
+		if len(builds) < archiveBatchSize {
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+func (a *archiver) archiveBuild(ctx context.Context, logger lager.Logger, build db.Build) error {
+	logger = logger.Session("archive-build", lager.Data{
+		"build": build.ID(),
+	})
+
+	file, err := ioutil.TempFile("", "build-events")
+	if err != nil {
+		logger.Error("failed-to-create-temp-file", err)
+		return err
+	}
+
+	defer os.Remove(file.Name())
+	defer db.Close(file)
+
+	err = writeEvents(build, file)
+	if err != nil {
+		logger.Error("failed-to-write-events", err)
+		return err
+	}
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		logger.Error("failed-to-rewind-events", err)
+		return err
+	}
+
+	err = a.store.Put(ctx, build.ID(), file)
+	if err != nil {
+		logger.Error("failed-to-upload-events", err)
+		return err
+	}
+
+	err = build.MarkEventsArchived()
+	if err != nil {
+		logger.Error("failed-to-mark-events-archived", err)
+		return err
+	}
+
+	logger.Debug("archived")
+
+	return nil
+}
+
+// writeEvents writes the build's events to w as gzipped, newline-delimited
+// JSON envelopes.
+func writeEvents(build db.Build, w io.Writer) error {
+	events, err := build.Events(0)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close(events)
+
+	gz := gzip.NewWriter(w)
+	encoder := json.NewEncoder(gz)
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				break
+			}
+
+			return err
+		}
+
+		err = encoder.Encode(ev)
+		if err != nil {
+			return err
+		}
+	}
+
+	return gz.Close()
+}
@@ -0,0 +1,189 @@
+package logstore_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/logstore"
+	"github.com/concourse/concourse/atc/logstore/logstorefakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func envelope(payload string) event.Envelope {
+	data := json.RawMessage(payload)
+	return event.Envelope{
+		Data:    &data,
+		Event:   "log",
+		Version: "5.0",
+	}
+}
+
+func eventSource(events ...event.Envelope) *dbfakes.FakeEventSource {
+	source := new(dbfakes.FakeEventSource)
+	for i, ev := range events {
+		source.NextReturnsOnCall(i, ev, nil)
+	}
+
+	source.NextReturnsOnCall(len(events), event.Envelope{}, db.ErrEndOfBuildEventStream)
+
+	return source
+}
+
+var _ = Describe("Archiver", func() {
+	var (
+		fakeStore        *logstorefakes.FakeStore
+		fakeBuildFactory *dbfakes.FakeBuildFactory
+		fakeBuild        *dbfakes.FakeBuild
+
+		drainerConfigured bool
+		uploaded          []byte
+		runErr            error
+	)
+
+	BeforeEach(func() {
+		fakeStore = new(logstorefakes.FakeStore)
+		fakeStore.PutStub = func(_ context.Context, _ int, events io.ReadSeeker) error {
+			var err error
+			uploaded, err = ioutil.ReadAll(events)
+			return err
+		}
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+		fakeBuild.EventsReturns(eventSource(
+			envelope(`{"payload":"hello"}`),
+			envelope(`{"payload":"world"}`),
+		), nil)
+
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeBuildFactory.GetArchivableBuildsReturns([]db.Build{fakeBuild}, nil)
+
+		drainerConfigured = false
+		uploaded = nil
+	})
+
+	JustBeforeEach(func() {
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		runErr = logstore.NewArchiver(fakeStore, fakeBuildFactory, drainerConfigured).Run(ctx)
+	})
+
+	It("uploads the build's events", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+
+		Expect(fakeStore.PutCallCount()).To(Equal(1))
+		_, buildID, _ := fakeStore.PutArgsForCall(0)
+		Expect(buildID).To(Equal(42))
+
+		source, err := logstore.NewEventSource(ioutil.NopCloser(bytes.NewReader(uploaded)), 0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(source.Next()).To(Equal(envelope(`{"payload":"hello"}`)))
+		Expect(source.Next()).To(Equal(envelope(`{"payload":"world"}`)))
+
+		_, err = source.Next()
+		Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+	})
+
+	It("marks the build's events as archived", func() {
+		Expect(fakeBuild.MarkEventsArchivedCallCount()).To(Equal(1))
+	})
+
+	Context("when uploading fails", func() {
+		BeforeEach(func() {
+			fakeStore.PutReturns(errors.New("nope"))
+		})
+
+		It("does not mark the build's events as archived", func() {
+			Expect(fakeBuild.MarkEventsArchivedCallCount()).To(BeZero())
+		})
+
+		It("does not return an error", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("when archiving one of the builds fails", func() {
+		var otherBuild *dbfakes.FakeBuild
+
+		BeforeEach(func() {
+			fakeBuild.EventsReturns(nil, errors.New("nope"))
+
+			otherBuild = new(dbfakes.FakeBuild)
+			otherBuild.IDReturns(43)
+			otherBuild.EventsReturns(eventSource(envelope(`{"payload":"hello"}`)), nil)
+
+			fakeBuildFactory.GetArchivableBuildsReturns([]db.Build{fakeBuild, otherBuild}, nil)
+		})
+
+		It("archives the builds after it", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+
+			Expect(fakeBuild.MarkEventsArchivedCallCount()).To(BeZero())
+			Expect(otherBuild.MarkEventsArchivedCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when there are more builds than fit in a batch", func() {
+		var batch []db.Build
+
+		BeforeEach(func() {
+			batch = []db.Build{}
+			for i := 1; i <= 100; i++ {
+				build := new(dbfakes.FakeBuild)
+				build.IDReturns(i)
+				build.EventsReturns(eventSource(), nil)
+				batch = append(batch, build)
+			}
+
+			fakeBuildFactory.GetArchivableBuildsReturnsOnCall(0, batch, nil)
+			fakeBuildFactory.GetArchivableBuildsReturnsOnCall(1, []db.Build{fakeBuild}, nil)
+		})
+
+		It("archives them a batch at a time", func() {
+			Expect(fakeBuildFactory.GetArchivableBuildsCallCount()).To(Equal(2))
+
+			afterID, limit := fakeBuildFactory.GetArchivableBuildsArgsForCall(0)
+			Expect(afterID).To(Equal(0))
+			Expect(limit).To(Equal(100))
+
+			afterID, _ = fakeBuildFactory.GetArchivableBuildsArgsForCall(1)
+			Expect(afterID).To(Equal(100))
+
+			Expect(fakeBuild.MarkEventsArchivedCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when a drainer is configured", func() {
+		BeforeEach(func() {
+			drainerConfigured = true
+		})
+
+		Context("when the build has not been drained", func() {
+			It("does not archive it", func() {
+				Expect(fakeStore.PutCallCount()).To(BeZero())
+				Expect(fakeBuild.MarkEventsArchivedCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the build has been drained", func() {
+			BeforeEach(func() {
+				fakeBuild.IsDrainedReturns(true)
+			})
+
+			It("archives it", func() {
+				Expect(fakeStore.PutCallCount()).To(Equal(1))
+				Expect(fakeBuild.MarkEventsArchivedCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,78 @@
+package logstore
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// Events returns the build's events from the store if they have been
+// archived, and from the database otherwise.
+func Events(ctx context.Context, store Store, build db.Build, from uint) (db.EventSource, error) {
+	if !build.EventsArchived() {
+		return build.Events(from)
+	}
+
+	body, err := store.Get(ctx, build.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	return NewEventSource(body, from)
+}
+
+// NewEventSource reads events written by the Archiver from body, starting
+// from the event with the given index.
+func NewEventSource(body io.ReadCloser, from uint) (db.EventSource, error) {
+	gz, err := gzip.NewReader(body)
+	if err != nil {
+		_ = body.Close()
+		return nil, err
+	}
+
+	source := &archivedEventSource{
+		body:    body,
+		decoder: json.NewDecoder(gz),
+	}
+
+	for i := uint(0); i < from; i++ {
+		_, err := source.Next()
+		if err == db.ErrEndOfBuildEventStream {
+			break
+		}
+
+		if err != nil {
+			_ = source.Close()
+			return nil, err
+		}
+	}
+
+	return source, nil
+}
+
+type archivedEventSource struct {
+	body    io.ReadCloser
+	decoder *json.Decoder
+}
+
+func (source *archivedEventSource) Next() (event.Envelope, error) {
+	var ev event.Envelope
+	err := source.decoder.Decode(&ev)
+	if err != nil {
+		if err == io.EOF {
+			return event.Envelope{}, db.ErrEndOfBuildEventStream
+		}
+
+		return event.Envelope{}, err
+	}
+
+	return ev, nil
+}
+
+func (source *archivedEventSource) Close() error {
+	return source.body.Close()
+}
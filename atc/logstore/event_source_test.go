@@ -0,0 +1,110 @@
+package logstore_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/logstore"
+	"github.com/concourse/concourse/atc/logstore/logstorefakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func archive(events ...event.Envelope) []byte {
+	buf := new(bytes.Buffer)
+
+	gz := gzip.NewWriter(buf)
+	encoder := json.NewEncoder(gz)
+	for _, ev := range events {
+		Expect(encoder.Encode(ev)).To(Succeed())
+	}
+
+	Expect(gz.Close()).To(Succeed())
+
+	return buf.Bytes()
+}
+
+var _ = Describe("Events", func() {
+	var (
+		fakeStore *logstorefakes.FakeStore
+		fakeBuild *dbfakes.FakeBuild
+	)
+
+	BeforeEach(func() {
+		fakeStore = new(logstorefakes.FakeStore)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(42)
+	})
+
+	Context("when the build's events have not been archived", func() {
+		It("returns them from the database", func() {
+			source := eventSource(envelope(`{"payload":"hello"}`))
+			fakeBuild.EventsReturns(source, nil)
+
+			events, err := logstore.Events(context.Background(), fakeStore, fakeBuild, 3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(Equal(source))
+
+			Expect(fakeBuild.EventsArgsForCall(0)).To(Equal(uint(3)))
+			Expect(fakeStore.GetCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the build's events have been archived", func() {
+		BeforeEach(func() {
+			fakeBuild.EventsArchivedReturns(true)
+
+			fakeStore.GetReturns(ioutil.NopCloser(bytes.NewReader(archive(
+				envelope(`{"payload":"a"}`),
+				envelope(`{"payload":"b"}`),
+				envelope(`{"payload":"c"}`),
+			))), nil)
+		})
+
+		It("returns them from the store, starting from the given event", func() {
+			events, err := logstore.Events(context.Background(), fakeStore, fakeBuild, 1)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, buildID := fakeStore.GetArgsForCall(0)
+			Expect(buildID).To(Equal(42))
+
+			Expect(events.Next()).To(Equal(envelope(`{"payload":"b"}`)))
+			Expect(events.Next()).To(Equal(envelope(`{"payload":"c"}`)))
+
+			_, err = events.Next()
+			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+
+			Expect(events.Close()).To(Succeed())
+		})
+
+		Context("when starting from beyond the last event", func() {
+			It("ends the stream", func() {
+				events, err := logstore.Events(context.Background(), fakeStore, fakeBuild, 5)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = events.Next()
+				Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+			})
+		})
+
+		Context("when the store fails", func() {
+			BeforeEach(func() {
+				fakeStore.GetReturns(nil, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				_, err := logstore.Events(context.Background(), fakeStore, fakeBuild, 0)
+				Expect(err).To(MatchError("nope"))
+			})
+		})
+	})
+})
@@ -0,0 +1,90 @@
+package logstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+type S3Config struct {
+	Bucket          string `long:"bucket" description:"Bucket to archive build events to."`
+	Prefix          string `long:"prefix" description:"Prefix of the keys of archived build events."`
+	Region          string `long:"region" description:"AWS region of the bucket."`
+	Endpoint        string `long:"endpoint" description:"Endpoint of an S3-compatible service, e.g. MinIO. Defaults to AWS S3."`
+	AccessKeyID     string `long:"access-key" description:"AWS Access key ID."`
+	SecretAccessKey string `long:"secret-key" description:"AWS Secret Access Key."`
+	SessionToken    string `long:"session-token" description:"AWS Session Token."`
+	ForcePathStyle  bool   `long:"force-path-style" description:"Address the bucket in the path rather than the host name, as required by MinIO."`
+}
+
+func (config S3Config) IsConfigured() bool {
+	return config.Bucket != ""
+}
+
+func (config S3Config) NewStore() (Store, error) {
+	awsConfig := &aws.Config{
+		Region:           aws.String(config.Region),
+		S3ForcePathStyle: aws.Bool(config.ForcePathStyle),
+	}
+
+	if config.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.Endpoint)
+	}
+
+	if config.AccessKeyID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, config.SessionToken)
+	}
+
+	session, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Store{
+		client: s3.New(session),
+		bucket: config.Bucket,
+		prefix: config.Prefix,
+	}, nil
+}
+
+type s3Store struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+func (store *s3Store) Put(ctx context.Context, buildID int, events io.ReadSeeker) error {
+	_, err := store.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(store.bucket),
+		Key:         aws.String(store.key(buildID)),
+		Body:        events,
+		ContentType: aws.String("application/gzip"),
+	})
+	return err
+}
+
+func (store *s3Store) Get(ctx context.Context, buildID int) (io.ReadCloser, error) {
+	output, err := store.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.key(buildID)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return output.Body, nil
+}
+
+func (store *s3Store) key(buildID int) string {
+	return fmt.Sprintf("%sbuilds/%d/events.json.gz", store.prefix, buildID)
+}
@@ -0,0 +1,105 @@
+package logstore_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/concourse/concourse/atc/logstore"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// objectServer is a minimal stand-in for an S3-compatible service such as
+// MinIO, addressed path-style.
+type objectServer struct {
+	lock    sync.Mutex
+	objects map[string][]byte
+}
+
+func (server *objectServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		server.objects[r.URL.Path] = body
+
+	case http.MethodGet:
+		object, found := server.objects[r.URL.Path]
+		if !found {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+			return
+		}
+
+		w.Write(object)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+var _ = Describe("S3", func() {
+	var (
+		objects *objectServer
+		server  *httptest.Server
+		store   logstore.Store
+	)
+
+	BeforeEach(func() {
+		objects = &objectServer{objects: map[string][]byte{}}
+		server = httptest.NewServer(objects)
+
+		var err error
+		store, err = logstore.S3Config{
+			Bucket:          "some-bucket",
+			Prefix:          "some-prefix/",
+			Region:          "us-east-1",
+			Endpoint:        server.URL,
+			AccessKeyID:     "some-access-key",
+			SecretAccessKey: "some-secret-key",
+			ForcePathStyle:  true,
+		}.NewStore()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("stores events under the build's key", func() {
+		err := store.Put(context.Background(), 42, strings.NewReader("some-events"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(objects.objects).To(HaveKeyWithValue(
+			"/some-bucket/some-prefix/builds/42/events.json.gz",
+			[]byte("some-events"),
+		))
+
+		body, err := store.Get(context.Background(), 42)
+		Expect(err).ToNot(HaveOccurred())
+
+		defer body.Close()
+
+		Expect(ioutil.ReadAll(body)).To(Equal([]byte("some-events")))
+	})
+
+	Context("when the build's events are not in the store", func() {
+		It("returns ErrNotFound", func() {
+			_, err := store.Get(context.Background(), 42)
+			Expect(err).To(Equal(logstore.ErrNotFound))
+		})
+	})
+})
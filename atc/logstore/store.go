@@ -0,0 +1,19 @@
+// Package logstore archives the events of completed builds to an object
+// store, so that they no longer need to be kept in the database.
+package logstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrNotFound = errors.New("build events not found in log store")
+
+//go:generate counterfeiter . Store
+
+// Store holds the archived events of builds, keyed by build ID.
+type Store interface {
+	Put(ctx context.Context, buildID int, events io.ReadSeeker) error
+	Get(ctx context.Context, buildID int) (io.ReadCloser, error)
+}
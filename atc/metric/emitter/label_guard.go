@@ -0,0 +1,72 @@
+package emitter
+
+import (
+	"sync"
+)
+
+const overflowLabel = "_other"
+
+// labelGuard limits the number of distinct team, pipeline and job label
+// combinations observed by each histogram, as each one is a separate series
+// with a sample per bucket.
+//
+// Combinations beyond the limit are observed with their pipeline and job
+// collapsed into a single overflow series for their team, provided the team
+// was observed before the limit was reached. Otherwise the team is collapsed
+// too, so that a histogram has at most one overflow series per team within
+// the limit plus one for all other teams.
+type labelGuard struct {
+	max int
+
+	metrics map[string]*labelSets
+	mu      sync.Mutex
+}
+
+type labelSets struct {
+	combinations map[[3]string]bool
+	teams        map[string]bool
+}
+
+func newLabelGuard(max int) *labelGuard {
+	return &labelGuard{
+		max:     max,
+		metrics: map[string]*labelSets{},
+	}
+}
+
+func (guard *labelGuard) guard(metric string, team string, pipeline string, job string) (string, string, string) {
+	if guard.max <= 0 {
+		return team, pipeline, job
+	}
+
+	guard.mu.Lock()
+	defer guard.mu.Unlock()
+
+	sets, found := guard.metrics[metric]
+	if !found {
+		sets = &labelSets{
+			combinations: map[[3]string]bool{},
+			teams:        map[string]bool{},
+		}
+
+		guard.metrics[metric] = sets
+	}
+
+	key := [3]string{team, pipeline, job}
+	if sets.combinations[key] {
+		return team, pipeline, job
+	}
+
+	if len(sets.combinations) >= guard.max {
+		if sets.teams[team] {
+			return team, overflowLabel, overflowLabel
+		}
+
+		return overflowLabel, overflowLabel, overflowLabel
+	}
+
+	sets.combinations[key] = true
+	sets.teams[team] = true
+
+	return team, pipeline, job
+}
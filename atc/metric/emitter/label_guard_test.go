@@ -0,0 +1,49 @@
+package emitter
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("labelGuard", func() {
+	It("passes through combinations up to the limit", func() {
+		guard := newLabelGuard(2)
+
+		Expect(labels(guard.guard("metric", "team", "pipeline-a", "job"))).To(Equal([]string{"team", "pipeline-a", "job"}))
+		Expect(labels(guard.guard("metric", "team", "pipeline-b", "job"))).To(Equal([]string{"team", "pipeline-b", "job"}))
+		Expect(labels(guard.guard("metric", "team", "pipeline-a", "job"))).To(Equal([]string{"team", "pipeline-a", "job"}))
+	})
+
+	It("collapses combinations beyond the limit", func() {
+		guard := newLabelGuard(1)
+
+		guard.guard("metric", "team", "pipeline-a", "job")
+		Expect(labels(guard.guard("metric", "team", "pipeline-b", "job"))).To(Equal([]string{"team", "_other", "_other"}))
+	})
+
+	It("collapses the team beyond the limit if it was not observed within it", func() {
+		guard := newLabelGuard(1)
+
+		guard.guard("metric", "team", "pipeline-a", "job")
+		Expect(labels(guard.guard("metric", "other-team", "pipeline-a", "job"))).To(Equal([]string{"_other", "_other", "_other"}))
+	})
+
+	It("limits the combinations of each metric separately", func() {
+		guard := newLabelGuard(1)
+
+		guard.guard("metric-a", "team", "pipeline-a", "job")
+		Expect(labels(guard.guard("metric-b", "team", "pipeline-b", "job"))).To(Equal([]string{"team", "pipeline-b", "job"}))
+		Expect(labels(guard.guard("metric-a", "team", "pipeline-b", "job"))).To(Equal([]string{"team", "_other", "_other"}))
+	})
+
+	It("does not limit combinations when the limit is zero", func() {
+		guard := newLabelGuard(0)
+
+		guard.guard("metric", "team", "pipeline-a", "job")
+		Expect(labels(guard.guard("metric", "team", "pipeline-b", "job"))).To(Equal([]string{"team", "pipeline-b", "job"}))
+	})
+})
+
+func labels(team string, pipeline string, job string) []string {
+	return []string{team, pipeline, job}
+}
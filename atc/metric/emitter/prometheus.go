@@ -25,9 +25,22 @@ type PrometheusEmitter struct {
 	buildsStarted     prometheus.Counter
 	buildsSucceeded   prometheus.Counter
 
+	buildSchedulingLatency *prometheus.HistogramVec
+	stepDurations          *prometheus.HistogramVec
+	imageFetchDurations    *prometheus.HistogramVec
+	resourceCheckDurations *prometheus.HistogramVec
+	checkQueueLatency      *prometheus.HistogramVec
+
+	labelGuard *labelGuard
+
 	dbConnections  *prometheus.GaugeVec
 	dbQueriesTotal prometheus.Counter
 
+	credentialCacheLookups *prometheus.CounterVec
+
+	checkQueueDepth prometheus.Gauge
+	checksInFlight  prometheus.Gauge
+
 	errorLogs *prometheus.CounterVec
 
 	httpRequestsDuration *prometheus.HistogramVec
@@ -52,6 +65,8 @@ type PrometheusEmitter struct {
 type PrometheusConfig struct {
 	BindIP   string `long:"prometheus-bind-ip" description:"IP to listen on to expose Prometheus metrics."`
 	BindPort string `long:"prometheus-bind-port" description:"Port to listen on to expose Prometheus metrics."`
+
+	MaxHistogramLabelSets int `long:"prometheus-max-histogram-label-sets" default:"1000" description:"Maximum number of team, pipeline and job combinations to observe in each duration histogram. Beyond this, pipeline and job are reported as '_other', as is the team if it was not observed within the limit. 0 means unlimited."`
 }
 
 func init() {
@@ -159,6 +174,43 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(buildDurationsVec)
 
+	buildSchedulingLatency := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "builds",
+			Name:      "scheduling_latency_seconds",
+			Help:      "Time from a build being created to it being started, in seconds",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600, 1800},
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(buildSchedulingLatency)
+
+	// step metrics
+	stepDurations := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "duration_seconds",
+			Help:      "Time taken to run get, put and task steps, in seconds",
+			Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200, 1800, 3600, 7200},
+		},
+		[]string{"team", "pipeline", "job", "step_type"},
+	)
+	prometheus.MustRegister(stepDurations)
+
+	imageFetchDurations := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "image_fetch_duration_seconds",
+			Help:      "Time taken to fetch the image of a step's container, in seconds",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 120, 300, 600},
+		},
+		[]string{"team", "pipeline", "job", "step_type"},
+	)
+	prometheus.MustRegister(imageFetchDurations)
+
 	// worker metrics
 	workerContainers := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -247,6 +299,17 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	})
 	prometheus.MustRegister(dbQueriesTotal)
 
+	credentialCacheLookups := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "credentials",
+			Name:      "cache_lookups_total",
+			Help:      "Total number of credential lookups served from the cache (hit) or the credential managers (miss)",
+		},
+		[]string{"result"},
+	)
+	prometheus.MustRegister(credentialCacheLookups)
+
 	dbConnections := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "concourse",
@@ -269,6 +332,46 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(resourceChecksVec)
 
+	resourceCheckDurations := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "resource",
+			Name:      "check_duration_seconds",
+			Help:      "Time taken to run resource checks, in seconds",
+			Buckets:   []float64{0.1, 0.5, 1, 2, 5, 10, 30, 60, 120, 300},
+		},
+		[]string{"team", "pipeline"},
+	)
+	prometheus.MustRegister(resourceCheckDurations)
+
+	checkQueueDepth := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "concourse",
+		Subsystem: "lidar",
+		Name:      "check_queue_depth",
+		Help:      "Number of checks waiting to be run",
+	})
+	prometheus.MustRegister(checkQueueDepth)
+
+	checksInFlight := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "concourse",
+		Subsystem: "lidar",
+		Name:      "checks_in_flight",
+		Help:      "Number of checks being run by this ATC",
+	})
+	prometheus.MustRegister(checksInFlight)
+
+	checkQueueLatency := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "lidar",
+			Name:      "check_queue_latency_seconds",
+			Help:      "Time checks spent waiting in the queue before being run, in seconds",
+			Buckets:   []float64{0.1, 0.5, 1, 2, 5, 10, 30, 60, 120, 300},
+		},
+		[]string{"team", "pipeline"},
+	)
+	prometheus.MustRegister(checkQueueLatency)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -286,9 +389,22 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		buildsStarted:     buildsStarted,
 		buildsSucceeded:   buildsSucceeded,
 
+		buildSchedulingLatency: buildSchedulingLatency,
+		stepDurations:          stepDurations,
+		imageFetchDurations:    imageFetchDurations,
+		resourceCheckDurations: resourceCheckDurations,
+		checkQueueLatency:      checkQueueLatency,
+
+		labelGuard: newLabelGuard(config.MaxHistogramLabelSets),
+
 		dbConnections:  dbConnections,
 		dbQueriesTotal: dbQueriesTotal,
 
+		credentialCacheLookups: credentialCacheLookups,
+
+		checkQueueDepth: checkQueueDepth,
+		checksInFlight:  checksInFlight,
+
 		errorLogs: errorLogs,
 
 		httpRequestsDuration: httpRequestsDuration,
@@ -348,8 +464,20 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.databaseMetrics(logger, event)
 	case "database connections":
 		emitter.databaseMetrics(logger, event)
+	case "credential cache hits",
+		"credential cache misses":
+		emitter.credentialCacheMetrics(logger, event)
 	case "resource checked":
 		emitter.resourceMetric(logger, event)
+	case "check queue depth",
+		"checks in flight":
+		emitter.lidarMetrics(logger, event)
+	case "build scheduling latency (ms)",
+		"step duration (ms)",
+		"image fetch duration (ms)",
+		"resource check duration (ms)",
+		"check queue latency (ms)":
+		emitter.durationMetric(logger, event)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -549,6 +677,38 @@ func (emitter *PrometheusEmitter) schedulingMetrics(logger lager.Logger, event m
 	}
 }
 
+func (emitter *PrometheusEmitter) lidarMetrics(logger lager.Logger, event metric.Event) {
+	value, ok := event.Value.(int)
+	if !ok {
+		logger.Error("lidar-value-type-mismatch", fmt.Errorf("expected event.Value to be a int"))
+		return
+	}
+
+	switch event.Name {
+	case "check queue depth":
+		emitter.checkQueueDepth.Set(float64(value))
+	case "checks in flight":
+		emitter.checksInFlight.Set(float64(value))
+	default:
+	}
+}
+
+func (emitter *PrometheusEmitter) credentialCacheMetrics(logger lager.Logger, event metric.Event) {
+	value, ok := event.Value.(int)
+	if !ok {
+		logger.Error("credential-cache-value-type-mismatch", fmt.Errorf("expected event.Value to be a int"))
+		return
+	}
+
+	switch event.Name {
+	case "credential cache hits":
+		emitter.credentialCacheLookups.WithLabelValues("hit").Add(float64(value))
+	case "credential cache misses":
+		emitter.credentialCacheLookups.WithLabelValues("miss").Add(float64(value))
+	default:
+	}
+}
+
 func (emitter *PrometheusEmitter) databaseMetrics(logger lager.Logger, event metric.Event) {
 	value, ok := event.Value.(int)
 	if !ok {
@@ -585,6 +745,53 @@ func (emitter *PrometheusEmitter) resourceMetric(logger lager.Logger, event metr
 	emitter.resourceChecksVec.WithLabelValues(team, pipeline).Inc()
 }
 
+func (emitter *PrometheusEmitter) durationMetric(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team"]
+	if !exists {
+		logger.Error("failed-to-find-team-in-event", fmt.Errorf("expected team to exist in event.Attributes"))
+		return
+	}
+
+	pipeline, exists := event.Attributes["pipeline"]
+	if !exists {
+		logger.Error("failed-to-find-pipeline-in-event", fmt.Errorf("expected pipeline to exist in event.Attributes"))
+		return
+	}
+
+	duration, ok := event.Value.(float64)
+	if !ok {
+		logger.Error("duration-event-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+		return
+	}
+
+	// seconds are the standard prometheus base unit for time
+	duration = duration / 1000
+
+	job := event.Attributes["job"]
+	stepType := event.Attributes["step_type"]
+
+	team, pipeline, job = emitter.labelGuard.guard(event.Name, team, pipeline, job)
+
+	switch event.Name {
+	case "build scheduling latency (ms)":
+		// concourse_builds_scheduling_latency_seconds
+		emitter.buildSchedulingLatency.WithLabelValues(team, pipeline, job).Observe(duration)
+	case "step duration (ms)":
+		// concourse_steps_duration_seconds
+		emitter.stepDurations.WithLabelValues(team, pipeline, job, stepType).Observe(duration)
+	case "image fetch duration (ms)":
+		// concourse_steps_image_fetch_duration_seconds
+		emitter.imageFetchDurations.WithLabelValues(team, pipeline, job, stepType).Observe(duration)
+	case "resource check duration (ms)":
+		// concourse_resource_check_duration_seconds
+		emitter.resourceCheckDurations.WithLabelValues(team, pipeline).Observe(duration)
+	case "check queue latency (ms)":
+		// concourse_lidar_check_queue_latency_seconds
+		emitter.checkQueueLatency.WithLabelValues(team, pipeline).Observe(duration)
+	default:
+	}
+}
+
 // updateLastSeen tracks for each worker when it last received a metric event.
 func (emitter *PrometheusEmitter) updateLastSeen(event metric.Event) {
 	emitter.mu.Lock()
@@ -22,6 +22,9 @@ var FailedVolumes = Meter(0)
 var ContainersDeleted = Meter(0)
 var VolumesDeleted = Meter(0)
 
+var CredentialCacheHits = Meter(0)
+var CredentialCacheMisses = Meter(0)
+
 type SchedulingFullDuration struct {
 	PipelineName string
 	Duration     time.Duration
@@ -354,6 +357,79 @@ func (event BuildFinished) Emit(logger lager.Logger) {
 	)
 }
 
+type BuildSchedulingLatency struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+	Duration     time.Duration
+}
+
+func (event BuildSchedulingLatency) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("build-scheduling-latency"),
+		Event{
+			Name:  "build scheduling latency (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team":     event.TeamName,
+				"pipeline": event.PipelineName,
+				"job":      event.JobName,
+			},
+		},
+	)
+}
+
+type StepDuration struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+	StepType     string
+	Duration     time.Duration
+}
+
+func (event StepDuration) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("step-duration"),
+		Event{
+			Name:  "step duration (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team":      event.TeamName,
+				"pipeline":  event.PipelineName,
+				"job":       event.JobName,
+				"step_type": event.StepType,
+			},
+		},
+	)
+}
+
+type ImageFetchDuration struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+	StepType     string
+	Duration     time.Duration
+}
+
+func (event ImageFetchDuration) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("image-fetch-duration"),
+		Event{
+			Name:  "image fetch duration (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team":      event.TeamName,
+				"pipeline":  event.PipelineName,
+				"job":       event.JobName,
+				"step_type": event.StepType,
+			},
+		},
+	)
+}
+
 func ms(duration time.Duration) float64 {
 	return float64(duration) / 1000000
 }
@@ -439,6 +515,81 @@ func (event ResourceCheck) Emit(logger lager.Logger) {
 	)
 }
 
+type ResourceCheckDuration struct {
+	PipelineName string
+	ResourceName string
+	TeamName     string
+	Duration     time.Duration
+}
+
+func (event ResourceCheckDuration) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("resource-check-duration"),
+		Event{
+			Name:  "resource check duration (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team":      event.TeamName,
+				"pipeline":  event.PipelineName,
+				"resource":  event.ResourceName,
+				"step_type": "check",
+			},
+		},
+	)
+}
+
+type CheckQueueDepth struct {
+	Depth int
+}
+
+func (event CheckQueueDepth) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("check-queue-depth"),
+		Event{
+			Name:  "check queue depth",
+			Value: event.Depth,
+			State: EventStateOK,
+		},
+	)
+}
+
+type ChecksInFlight struct {
+	Checks int
+}
+
+func (event ChecksInFlight) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("checks-in-flight"),
+		Event{
+			Name:  "checks in flight",
+			Value: event.Checks,
+			State: EventStateOK,
+		},
+	)
+}
+
+type CheckQueueLatency struct {
+	TeamName     string
+	PipelineName string
+	Duration     time.Duration
+}
+
+func (event CheckQueueLatency) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("check-queue-latency"),
+		Event{
+			Name:  "check queue latency (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team":     event.TeamName,
+				"pipeline": event.PipelineName,
+			},
+		},
+	)
+}
+
 var lockTypeNames = map[int]string{
 	lock.LockTypeResourceConfigChecking: "ResourceConfigChecking",
 	lock.LockTypeBuildTracking:          "BuildTracking",
@@ -1,6 +1,7 @@
 package metric
 
 import (
+	"context"
 	"database/sql"
 
 	"github.com/Masterminds/squirrel"
@@ -35,6 +36,24 @@ func (e *countingConn) Exec(query string, args ...interface{}) (sql.Result, erro
 	return e.Conn.Exec(query, args...)
 }
 
+func (e *countingConn) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	DatabaseQueries.Inc()
+
+	return e.Conn.QueryContext(ctx, query, args...)
+}
+
+func (e *countingConn) QueryRowContext(ctx context.Context, query string, args ...interface{}) squirrel.RowScanner {
+	DatabaseQueries.Inc()
+
+	return e.Conn.QueryRowContext(ctx, query, args...)
+}
+
+func (e *countingConn) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	DatabaseQueries.Inc()
+
+	return e.Conn.ExecContext(ctx, query, args...)
+}
+
 func (e *countingConn) Begin() (db.Tx, error) {
 	tx, err := e.Conn.Begin()
 	if err != nil {
@@ -4,18 +4,23 @@ type Plan struct {
 	ID       PlanID `json:"id"`
 	Attempts []int  `json:"attempts,omitempty"`
 
-	Aggregate *AggregatePlan `json:"aggregate,omitempty"`
-	Do        *DoPlan        `json:"do,omitempty"`
-	Get       *GetPlan       `json:"get,omitempty"`
-	Put       *PutPlan       `json:"put,omitempty"`
-	Task      *TaskPlan      `json:"task,omitempty"`
-	OnAbort   *OnAbortPlan   `json:"on_abort,omitempty"`
-	Ensure    *EnsurePlan    `json:"ensure,omitempty"`
-	OnSuccess *OnSuccessPlan `json:"on_success,omitempty"`
-	OnFailure *OnFailurePlan `json:"on_failure,omitempty"`
-	Try       *TryPlan       `json:"try,omitempty"`
-	Timeout   *TimeoutPlan   `json:"timeout,omitempty"`
-	Retry     *RetryPlan     `json:"retry,omitempty"`
+	Aggregate   *AggregatePlan   `json:"aggregate,omitempty"`
+	InParallel  *InParallelPlan  `json:"in_parallel,omitempty"`
+	Do          *DoPlan          `json:"do,omitempty"`
+	Get         *GetPlan         `json:"get,omitempty"`
+	Put         *PutPlan         `json:"put,omitempty"`
+	Task        *TaskPlan        `json:"task,omitempty"`
+	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
+	LoadVar     *LoadVarPlan     `json:"load_var,omitempty"`
+	Check       *CheckPlan       `json:"check,omitempty"`
+	OnAbort     *OnAbortPlan     `json:"on_abort,omitempty"`
+	Ensure      *EnsurePlan      `json:"ensure,omitempty"`
+	OnSuccess   *OnSuccessPlan   `json:"on_success,omitempty"`
+	OnFailure   *OnFailurePlan   `json:"on_failure,omitempty"`
+	Try         *TryPlan         `json:"try,omitempty"`
+	Timeout     *TimeoutPlan     `json:"timeout,omitempty"`
+	Retry       *RetryPlan       `json:"retry,omitempty"`
+	Across      *AcrossPlan      `json:"across,omitempty"`
 
 	// used for 'fly execute'
 	ArtifactInput  *ArtifactInputPlan  `json:"artifact_input,omitempty"`
@@ -67,6 +72,31 @@ type TryPlan struct {
 
 type AggregatePlan []Plan
 
+type InParallelPlan struct {
+	Steps    []Plan `json:"steps"`
+	Limit    int    `json:"limit,omitempty"`
+	FailFast bool   `json:"fail_fast,omitempty"`
+}
+
+type AcrossPlan struct {
+	Vars  []AcrossVar     `json:"vars"`
+	Steps []VarScopedPlan `json:"steps"`
+}
+
+type AcrossVar struct {
+	Var         string        `json:"var"`
+	Values      []interface{} `json:"values"`
+	MaxInFlight int           `json:"max_in_flight,omitempty"`
+	FailFast    bool          `json:"fail_fast,omitempty"`
+}
+
+// VarScopedPlan is the step run for one combination of an AcrossPlan's var
+// values, given in the same order as the AcrossPlan's vars.
+type VarScopedPlan struct {
+	Step   Plan          `json:"step"`
+	Values []interface{} `json:"values"`
+}
+
 type DoPlan []Plan
 
 type GetPlan struct {
@@ -78,18 +108,20 @@ type GetPlan struct {
 	Version     *Version `json:"version,omitempty"`
 	VersionFrom *PlanID  `json:"version_from,omitempty"`
 	Tags        Tags     `json:"tags,omitempty"`
+	Placement   []string `json:"placement,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
 type PutPlan struct {
-	Type     string        `json:"type"`
-	Name     string        `json:"name,omitempty"`
-	Resource string        `json:"resource"`
-	Source   Source        `json:"source"`
-	Params   Params        `json:"params,omitempty"`
-	Tags     Tags          `json:"tags,omitempty"`
-	Inputs   *InputsConfig `json:"inputs,omitempty"`
+	Type      string        `json:"type"`
+	Name      string        `json:"name,omitempty"`
+	Resource  string        `json:"resource"`
+	Source    Source        `json:"source"`
+	Params    Params        `json:"params,omitempty"`
+	Tags      Tags          `json:"tags,omitempty"`
+	Placement []string      `json:"placement,omitempty"`
+	Inputs    *InputsConfig `json:"inputs,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
@@ -97,8 +129,9 @@ type PutPlan struct {
 type TaskPlan struct {
 	Name string `json:"name,omitempty"`
 
-	Privileged bool `json:"privileged"`
-	Tags       Tags `json:"tags,omitempty"`
+	Privileged bool     `json:"privileged"`
+	Tags       Tags     `json:"tags,omitempty"`
+	Placement  []string `json:"placement,omitempty"`
 
 	ConfigPath string      `json:"config_path,omitempty"`
 	Config     *TaskConfig `json:"config,omitempty"`
@@ -112,6 +145,34 @@ type TaskPlan struct {
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
+type SetPipelinePlan struct {
+	Name     string   `json:"name"`
+	File     string   `json:"file"`
+	Vars     Params   `json:"vars,omitempty"`
+	VarFiles []string `json:"var_files,omitempty"`
+}
+
+const (
+	LoadVarFormatRaw  = "raw"
+	LoadVarFormatTrim = "trim"
+	LoadVarFormatJSON = "json"
+	LoadVarFormatYAML = "yaml"
+)
+
+type LoadVarPlan struct {
+	Name   string `json:"name"`
+	File   string `json:"file"`
+	Format string `json:"format,omitempty"`
+}
+
+// CheckPlan checks a pipeline's resource for new versions, e.g. when a check
+// is requested through the API.
+type CheckPlan struct {
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	FromVersion Version `json:"from_version,omitempty"`
+}
+
 type RetryPlan []Plan
 
 type DependentGetPlan struct {
@@ -27,14 +27,24 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 	switch t := step.(type) {
 	case AggregatePlan:
 		plan.Aggregate = &t
+	case InParallelPlan:
+		plan.InParallel = &t
 	case DoPlan:
 		plan.Do = &t
 	case GetPlan:
 		plan.Get = &t
 	case PutPlan:
 		plan.Put = &t
+	case AcrossPlan:
+		plan.Across = &t
 	case TaskPlan:
 		plan.Task = &t
+	case SetPipelinePlan:
+		plan.SetPipeline = &t
+	case CheckPlan:
+		plan.Check = &t
+	case LoadVarPlan:
+		plan.LoadVar = &t
 	case OnAbortPlan:
 		plan.OnAbort = &t
 	case EnsurePlan:
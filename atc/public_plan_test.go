@@ -295,6 +295,90 @@ var _ = Describe("Plan", func() {
 							Name: "some-name",
 						},
 					},
+
+					atc.Plan{
+						ID: "33",
+						InParallel: &atc.InParallelPlan{
+							Steps: []atc.Plan{
+								atc.Plan{
+									ID: "34",
+									Task: &atc.TaskPlan{
+										Name:       "name",
+										ConfigPath: "some/config/path.yml",
+										Config: &atc.TaskConfig{
+											Params: map[string]string{"some": "secret"},
+										},
+									},
+								},
+							},
+							Limit:    1,
+							FailFast: true,
+						},
+					},
+
+					atc.Plan{
+						ID: "35",
+						SetPipeline: &atc.SetPipelinePlan{
+							Name:     "some-pipeline",
+							File:     "some/pipeline.yml",
+							Vars:     atc.Params{"some": "secret"},
+							VarFiles: []string{"some/vars.yml"},
+						},
+					},
+
+					atc.Plan{
+						ID: "36",
+						Across: &atc.AcrossPlan{
+							Vars: []atc.AcrossVar{
+								{
+									Var:         "some-var",
+									Values:      []interface{}{"a", "b"},
+									MaxInFlight: 1,
+									FailFast:    true,
+								},
+							},
+							Steps: []atc.VarScopedPlan{
+								{
+									Step: atc.Plan{
+										ID: "37",
+										Task: &atc.TaskPlan{
+											Name:       "name",
+											ConfigPath: "some/config/path.yml",
+										},
+									},
+									Values: []interface{}{"a"},
+								},
+								{
+									Step: atc.Plan{
+										ID: "38",
+										Task: &atc.TaskPlan{
+											Name:       "name",
+											ConfigPath: "some/config/path.yml",
+										},
+									},
+									Values: []interface{}{"b"},
+								},
+							},
+						},
+					},
+
+					atc.Plan{
+						ID: "39",
+						LoadVar: &atc.LoadVarPlan{
+							Name:   "some-var",
+							File:   "some/file.json",
+							Format: "json",
+						},
+					},
+
+					atc.Plan{
+						ID: "40",
+						Check: &atc.CheckPlan{
+							Name:        "some-resource",
+							Type:        "some-type",
+							FromVersion: atc.Version{"some": "version"},
+						},
+					},
 				},
 			}
 
@@ -511,6 +595,77 @@ var _ = Describe("Plan", func() {
 			"artifact_output": {
 				"name": "some-name"
 			}
+		},
+		{
+			"id": "33",
+			"in_parallel": {
+				"steps": [
+					{
+						"id": "34",
+						"task": {
+							"name": "name",
+							"privileged": false
+						}
+					}
+				],
+				"limit": 1,
+				"fail_fast": true
+			}
+		},
+		{
+			"id": "35",
+			"set_pipeline": {
+				"name": "some-pipeline"
+			}
+		},
+		{
+			"id": "36",
+			"across": {
+				"vars": [
+					{
+						"var": "some-var",
+						"values": ["a", "b"],
+						"max_in_flight": 1,
+						"fail_fast": true
+					}
+				],
+				"steps": [
+					{
+						"step": {
+							"id": "37",
+							"task": {
+								"name": "name",
+								"privileged": false
+							}
+						},
+						"values": ["a"]
+					},
+					{
+						"step": {
+							"id": "38",
+							"task": {
+								"name": "name",
+								"privileged": false
+							}
+						},
+						"values": ["b"]
+					}
+				]
+			}
+		},
+		{
+			"id": "39",
+			"load_var": {
+				"name": "some-var"
+			}
+		},
+		{
+			"id": "40",
+			"check": {
+				"name": "some-resource",
+				"type": "some-type",
+				"from_version": {"some": "version"}
+			}
 		}
   ]
 }
@@ -2,12 +2,14 @@
 package radarfakes
 
 import (
+	context "context"
 	sync "sync"
 	time "time"
 
 	lager "code.cloudfoundry.org/lager"
 	atc "github.com/concourse/concourse/atc"
 	radar "github.com/concourse/concourse/atc/radar"
+	resource "github.com/concourse/concourse/atc/resource"
 )
 
 type FakeScanner struct {
@@ -37,12 +39,14 @@ type FakeScanner struct {
 	scanReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ScanFromVersionStub        func(lager.Logger, string, atc.Version) error
+	ScanFromVersionStub        func(context.Context, lager.Logger, string, atc.Version, resource.IOConfig) error
 	scanFromVersionMutex       sync.RWMutex
 	scanFromVersionArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 string
-		arg3 atc.Version
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 atc.Version
+		arg5 resource.IOConfig
 	}
 	scanFromVersionReturns struct {
 		result1 error
@@ -179,18 +183,20 @@ func (fake *FakeScanner) ScanReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeScanner) ScanFromVersion(arg1 lager.Logger, arg2 string, arg3 atc.Version) error {
+func (fake *FakeScanner) ScanFromVersion(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 atc.Version, arg5 resource.IOConfig) error {
 	fake.scanFromVersionMutex.Lock()
 	ret, specificReturn := fake.scanFromVersionReturnsOnCall[len(fake.scanFromVersionArgsForCall)]
 	fake.scanFromVersionArgsForCall = append(fake.scanFromVersionArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 string
-		arg3 atc.Version
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("ScanFromVersion", []interface{}{arg1, arg2, arg3})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 atc.Version
+		arg5 resource.IOConfig
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("ScanFromVersion", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.scanFromVersionMutex.Unlock()
 	if fake.ScanFromVersionStub != nil {
-		return fake.ScanFromVersionStub(arg1, arg2, arg3)
+		return fake.ScanFromVersionStub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1
@@ -205,17 +211,17 @@ func (fake *FakeScanner) ScanFromVersionCallCount() int {
 	return len(fake.scanFromVersionArgsForCall)
 }
 
-func (fake *FakeScanner) ScanFromVersionCalls(stub func(lager.Logger, string, atc.Version) error) {
+func (fake *FakeScanner) ScanFromVersionCalls(stub func(context.Context, lager.Logger, string, atc.Version, resource.IOConfig) error) {
 	fake.scanFromVersionMutex.Lock()
 	defer fake.scanFromVersionMutex.Unlock()
 	fake.ScanFromVersionStub = stub
 }
 
-func (fake *FakeScanner) ScanFromVersionArgsForCall(i int) (lager.Logger, string, atc.Version) {
+func (fake *FakeScanner) ScanFromVersionArgsForCall(i int) (context.Context, lager.Logger, string, atc.Version, resource.IOConfig) {
 	fake.scanFromVersionMutex.RLock()
 	defer fake.scanFromVersionMutex.RUnlock()
 	argsForCall := fake.scanFromVersionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeScanner) ScanFromVersionReturns(result1 error) {
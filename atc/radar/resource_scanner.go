@@ -2,8 +2,10 @@ package radar
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"time"
 
@@ -28,6 +30,7 @@ type resourceScanner struct {
 	dbPipeline            db.Pipeline
 	externalURL           string
 	variables             creds.Variables
+	fingerprintKey        []byte
 	strategy              worker.ContainerPlacementStrategy
 }
 
@@ -40,6 +43,7 @@ func NewResourceScanner(
 	dbPipeline db.Pipeline,
 	externalURL string,
 	variables creds.Variables,
+	fingerprintKey []byte,
 	strategy worker.ContainerPlacementStrategy,
 ) Scanner {
 	return &resourceScanner{
@@ -51,6 +55,7 @@ func NewResourceScanner(
 		dbPipeline:            dbPipeline,
 		externalURL:           externalURL,
 		variables:             variables,
+		fingerprintKey:        fingerprintKey,
 		strategy:              strategy,
 	}
 }
@@ -60,28 +65,28 @@ var ErrResourceTypeNotFound = errors.New("resource type not found")
 var ErrResourceTypeCheckError = errors.New("resource type failed to check")
 
 func (scanner *resourceScanner) Run(logger lager.Logger, resourceName string) (time.Duration, error) {
-	interval, err := scanner.scan(logger.Session("tick"), resourceName, nil, false, false)
+	interval, err := scanner.scan(context.Background(), logger.Session("tick"), resourceName, nil, false, false, resource.IOConfig{})
 
 	err = swallowErrResourceScriptFailed(err)
 
 	return interval, err
 }
 
-func (scanner *resourceScanner) ScanFromVersion(logger lager.Logger, resourceName string, fromVersion atc.Version) error {
-	_, err := scanner.scan(logger, resourceName, fromVersion, true, true)
+func (scanner *resourceScanner) ScanFromVersion(ctx context.Context, logger lager.Logger, resourceName string, fromVersion atc.Version, ioConfig resource.IOConfig) error {
+	_, err := scanner.scan(ctx, logger, resourceName, fromVersion, true, true, ioConfig)
 
 	return err
 }
 
 func (scanner *resourceScanner) Scan(logger lager.Logger, resourceName string) error {
-	_, err := scanner.scan(logger, resourceName, nil, true, false)
+	_, err := scanner.scan(context.Background(), logger, resourceName, nil, true, false, resource.IOConfig{})
 
 	err = swallowErrResourceScriptFailed(err)
 
 	return err
 }
 
-func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, fromVersion atc.Version, mustComplete bool, saveGiven bool) (time.Duration, error) {
+func (scanner *resourceScanner) scan(ctx context.Context, logger lager.Logger, resourceName string, fromVersion atc.Version, mustComplete bool, saveGiven bool, ioConfig resource.IOConfig) (time.Duration, error) {
 	lockLogger := logger.Session("lock", lager.Data{
 		"resource": resourceName,
 	})
@@ -130,6 +135,8 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 				scanner.setResourceCheckError(logger, savedResource, parentType.CheckError())
 				logger.Error("resource-type-failed-to-check", err, lager.Data{"resource-type": parentType.Name()})
 				return 0, ErrResourceTypeCheckError
+			} else if ctx.Err() != nil {
+				return 0, ctx.Err()
 			} else {
 				logger.Debug("waiting-on-resource-type-version", lager.Data{"resource-type": parentType.Name()})
 				scanner.clock.Sleep(10 * time.Second)
@@ -168,7 +175,7 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 
 	resourceConfigScope, err := savedResource.SetResourceConfig(
 		logger,
-		source,
+		savedResource.Source(),
 		versionedResourceTypes,
 	)
 	if err != nil {
@@ -201,6 +208,10 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 	}
 
 	for {
+		if ctx.Err() != nil {
+			return interval, ctx.Err()
+		}
+
 		lock, acquired, err := resourceConfigScope.AcquireResourceCheckingLock(
 			logger,
 			interval,
@@ -249,6 +260,7 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 	}
 
 	return interval, scanner.check(
+		ctx,
 		logger,
 		savedResource,
 		resourceConfigScope,
@@ -257,10 +269,12 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 		source,
 		saveGiven,
 		timeout,
+		ioConfig,
 	)
 }
 
 func (scanner *resourceScanner) check(
+	ctx context.Context,
 	logger lager.Logger,
 	savedResource db.Resource,
 	resourceConfigScope db.ResourceConfigScope,
@@ -269,6 +283,7 @@ func (scanner *resourceScanner) check(
 	source atc.Source,
 	saveGiven bool,
 	timeout time.Duration,
+	ioConfig resource.IOConfig,
 ) error {
 	pipelinePaused, err := scanner.dbPipeline.CheckPaused()
 	if err != nil {
@@ -316,23 +331,39 @@ func (scanner *resourceScanner) check(
 		TeamID:        scanner.dbPipeline.TeamID(),
 	}
 
-	owner := db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), ContainerExpiries)
+	owner := db.NewResourceConfigCheckSessionContainerOwner(
+		resourceConfigScope.ResourceConfig(),
+		creds.Fingerprint(scanner.fingerprintKey, source),
+		ContainerExpiries,
+	)
 	containerMetadata := db.ContainerMetadata{
 		Type: db.ContainerTypeCheck,
 	}
 
-	chosenWorker, err := scanner.pool.FindOrChooseWorkerForContainer(logger, owner, containerSpec, workerSpec, scanner.strategy)
+	startTime := scanner.clock.Now()
+
+	// the check timeout also bounds waiting for a worker with the capacity to
+	// run the check, as the resource's checking lock is held meanwhile
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	chosenWorker, err := scanner.pool.FindOrChooseWorkerForContainer(ctx, logger, owner, containerSpec, workerSpec, scanner.strategy)
 	if err != nil {
+		if err == context.DeadlineExceeded {
+			err = fmt.Errorf("Timed out after %v while waiting for a worker to check on - perhaps increase your resource check timeout?", timeout)
+		}
+
 		logger.Error("failed-to-choose-a-worker", err)
 		chkErr := resourceConfigScope.SetCheckError(err)
 		if chkErr != nil {
 			logger.Error("failed-to-set-check-error-on-resource-config", chkErr)
 		}
+		scanner.recordCheck(logger, resourceConfigScope, startTime, "", nil, err)
 		return err
 	}
 
 	container, err := chosenWorker.FindOrCreateContainer(
-		context.Background(),
+		ctx,
 		logger,
 		worker.NoopImageFetchingDelegate{},
 		owner,
@@ -346,6 +377,7 @@ func (scanner *resourceScanner) check(
 		if chkErr != nil {
 			logger.Error("failed-to-set-check-error-on-resource-config", chkErr)
 		}
+		scanner.recordCheck(logger, resourceConfigScope, startTime, chosenWorker.Name(), nil, err)
 		return err
 	}
 
@@ -353,11 +385,10 @@ func (scanner *resourceScanner) check(
 		"from": fromVersion,
 	})
 
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
-	defer cancel()
+	checkStart := scanner.clock.Now()
 
 	res := scanner.resourceFactory.NewResourceForContainer(container)
-	newVersions, err := res.Check(ctx, source, fromVersion)
+	newVersions, err := res.Check(ctx, ioConfig, source, fromVersion)
 	if err == context.DeadlineExceeded {
 		err = fmt.Errorf("Timed out after %v while checking for new versions - perhaps increase your resource check timeout?", timeout)
 	}
@@ -370,6 +401,15 @@ func (scanner *resourceScanner) check(
 		Success:      err == nil,
 	}.Emit(logger)
 
+	metric.ResourceCheckDuration{
+		PipelineName: scanner.dbPipeline.Name(),
+		ResourceName: savedResource.Name(),
+		TeamName:     scanner.dbPipeline.TeamName(),
+		Duration:     scanner.clock.Since(checkStart),
+	}.Emit(logger)
+
+	scanner.recordCheck(logger, resourceConfigScope, startTime, chosenWorker.Name(), newVersions, err)
+
 	if err != nil {
 		if rErr, ok := err.(resource.ErrResourceScriptFailed); ok {
 			logger.Info("check-failed", lager.Data{"exit-status": rErr.ExitStatus})
@@ -380,6 +420,10 @@ func (scanner *resourceScanner) check(
 		return err
 	}
 
+	if ioConfig.Stdout != nil {
+		writeVersionsFound(ioConfig.Stdout, newVersions)
+	}
+
 	if len(newVersions) == 0 || (!saveGiven && reflect.DeepEqual(newVersions, []atc.Version{fromVersion})) {
 		logger.Debug("no-new-versions")
 	} else {
@@ -410,6 +454,55 @@ func (scanner *resourceScanner) check(
 	return nil
 }
 
+// recordCheck adds the check to the scope's check history. The stderr of a
+// failed check script is recorded separately from its error.
+func (scanner *resourceScanner) recordCheck(
+	logger lager.Logger,
+	resourceConfigScope db.ResourceConfigScope,
+	startTime time.Time,
+	workerName string,
+	versions []atc.Version,
+	checkErr error,
+) {
+	check := atc.ResourceCheck{
+		StartTime:  startTime.Unix(),
+		EndTime:    scanner.clock.Now().Unix(),
+		WorkerName: workerName,
+		Versions:   versions,
+	}
+
+	if rErr, ok := checkErr.(resource.ErrResourceScriptFailed); ok {
+		check.Stderr = rErr.Stderr
+		rErr.Stderr = ""
+		check.Error = rErr.Error()
+	} else if checkErr != nil {
+		check.Error = checkErr.Error()
+	}
+
+	err := resourceConfigScope.RecordCheck(check)
+	if err != nil {
+		logger.Error("failed-to-record-check", err)
+	}
+}
+
+// writeVersionsFound writes the versions returned by a check in the order
+// they were returned, for checks run as a build step.
+func writeVersionsFound(dest io.Writer, versions []atc.Version) {
+	if len(versions) == 0 {
+		fmt.Fprintln(dest, "no versions found")
+		return
+	}
+
+	for _, version := range versions {
+		payload, err := json.Marshal(version)
+		if err != nil {
+			continue
+		}
+
+		fmt.Fprintf(dest, "found version: %s\n", payload)
+	}
+}
+
 func swallowErrResourceScriptFailed(err error) error {
 	if _, ok := err.(resource.ErrResourceScriptFailed); ok {
 		return nil
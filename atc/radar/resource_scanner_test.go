@@ -24,6 +24,7 @@ import (
 	rfakes "github.com/concourse/concourse/atc/resource/resourcefakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("ResourceScanner", func() {
@@ -130,6 +131,7 @@ var _ = Describe("ResourceScanner", func() {
 			fakeDBPipeline,
 			"https://www.example.com",
 			variables,
+			[]byte("some-fingerprint-key"),
 			fakeStrategy,
 		)
 	})
@@ -239,7 +241,7 @@ var _ = Describe("ResourceScanner", func() {
 				It("constructs the resource of the correct type", func() {
 					Expect(fakeDBResource.SetResourceConfigCallCount()).To(Equal(1))
 					_, resourceSource, resourceTypes := fakeDBResource.SetResourceConfigArgsForCall(0)
-					Expect(resourceSource).To(Equal(atc.Source{"uri": "some-secret-sauce"}))
+					Expect(resourceSource).To(Equal(atc.Source{"uri": "((source-params))"}))
 					Expect(resourceTypes).To(Equal(creds.NewVersionedResourceTypes(variables, atc.VersionedResourceTypes{
 						versionedResourceType,
 					})))
@@ -248,8 +250,8 @@ var _ = Describe("ResourceScanner", func() {
 					err := fakeDBResource.SetCheckSetupErrorArgsForCall(0)
 					Expect(err).To(BeNil())
 
-					_, owner, containerSpec, workerSpec, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
-					Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, radar.ContainerExpiries)))
+					_, _, owner, containerSpec, workerSpec, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
+					Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, creds.Fingerprint([]byte("some-fingerprint-key"), atc.Source{"uri": "some-secret-sauce"}), radar.ContainerExpiries)))
 					Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 						ResourceType: "git",
 					}))
@@ -269,7 +271,7 @@ var _ = Describe("ResourceScanner", func() {
 
 					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
 					_, _, _, owner, metadata, containerSpec, resourceTypes := fakeWorker.FindOrCreateContainerArgsForCall(0)
-					Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, radar.ContainerExpiries)))
+					Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, creds.Fingerprint([]byte("some-fingerprint-key"), atc.Source{"uri": "some-secret-sauce"}), radar.ContainerExpiries)))
 					Expect(metadata).To(Equal(db.ContainerMetadata{
 						Type: db.ContainerTypeCheck,
 					}))
@@ -430,7 +432,7 @@ var _ = Describe("ResourceScanner", func() {
 
 				Context("when there is no current version", func() {
 					It("checks from nil", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(BeNil())
 					})
 				})
@@ -445,7 +447,7 @@ var _ = Describe("ResourceScanner", func() {
 					})
 
 					It("checks from it", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(Equal(atc.Version{"version": "1"}))
 					})
 				})
@@ -469,7 +471,7 @@ var _ = Describe("ResourceScanner", func() {
 						}
 
 						check := 0
-						fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+						fakeResource.CheckStub = func(ctx context.Context, ioConfig resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 							defer GinkgoRecover()
 
 							Expect(source).To(Equal(resourceConfig.Source))
@@ -493,6 +495,15 @@ var _ = Describe("ResourceScanner", func() {
 						}))
 					})
 
+					It("records the check and the versions found", func() {
+						Expect(fakeResourceConfigScope.RecordCheckCallCount()).To(Equal(1))
+
+						check := fakeResourceConfigScope.RecordCheckArgsForCall(0)
+						Expect(check.WorkerName).To(Equal("some-worker"))
+						Expect(check.Versions).To(Equal(nextVersions))
+						Expect(check.Error).To(BeEmpty())
+					})
+
 					Context("when saving versions fails", func() {
 						BeforeEach(func() {
 							fakeResourceConfigScope.SaveVersionsReturns(errors.New("failed"))
@@ -518,7 +529,11 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				Context("when checking fails with ErrResourceScriptFailed", func() {
-					scriptFail := resource.ErrResourceScriptFailed{}
+					scriptFail := resource.ErrResourceScriptFailed{
+						Path:       "/opt/resource/check",
+						ExitStatus: 1,
+						Stderr:     "some-stderr",
+					}
 
 					BeforeEach(func() {
 						fakeResource.CheckReturns(nil, scriptFail)
@@ -527,6 +542,15 @@ var _ = Describe("ResourceScanner", func() {
 					It("returns no error", func() {
 						Expect(runErr).NotTo(HaveOccurred())
 					})
+
+					It("records the check with its error and stderr", func() {
+						Expect(fakeResourceConfigScope.RecordCheckCallCount()).To(Equal(1))
+
+						check := fakeResourceConfigScope.RecordCheckArgsForCall(0)
+						Expect(check.WorkerName).To(Equal("some-worker"))
+						Expect(check.Error).To(Equal("resource script '/opt/resource/check []' failed: exit status 1"))
+						Expect(check.Stderr).To(Equal("some-stderr"))
+					})
 				})
 
 				Context("when the pipeline is paused", func() {
@@ -671,7 +695,7 @@ var _ = Describe("ResourceScanner", func() {
 			It("constructs the resource of the correct type", func() {
 				Expect(fakeDBResource.SetResourceConfigCallCount()).To(Equal(1))
 				_, resourceSource, resourceTypes := fakeDBResource.SetResourceConfigArgsForCall(0)
-				Expect(resourceSource).To(Equal(atc.Source{"uri": "some-secret-sauce"}))
+				Expect(resourceSource).To(Equal(atc.Source{"uri": "((source-params))"}))
 				Expect(resourceTypes).To(Equal(creds.NewVersionedResourceTypes(variables, atc.VersionedResourceTypes{
 					versionedResourceType,
 				})))
@@ -680,8 +704,8 @@ var _ = Describe("ResourceScanner", func() {
 				err := fakeDBResource.SetCheckSetupErrorArgsForCall(0)
 				Expect(err).To(BeNil())
 
-				_, owner, containerSpec, workerSpec, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
-				Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, radar.ContainerExpiries)))
+				_, _, owner, containerSpec, workerSpec, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
+				Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, creds.Fingerprint([]byte("some-fingerprint-key"), atc.Source{"uri": "some-secret-sauce"}), radar.ContainerExpiries)))
 				Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 					ResourceType: "git",
 				}))
@@ -700,7 +724,7 @@ var _ = Describe("ResourceScanner", func() {
 				}))
 
 				_, _, _, owner, metadata, containerSpec, resourceTypes := fakeWorker.FindOrCreateContainerArgsForCall(0)
-				Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, radar.ContainerExpiries)))
+				Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, creds.Fingerprint([]byte("some-fingerprint-key"), atc.Source{"uri": "some-secret-sauce"}), radar.ContainerExpiries)))
 				Expect(metadata).To(Equal(db.ContainerMetadata{
 					Type: db.ContainerTypeCheck,
 				}))
@@ -773,6 +797,35 @@ var _ = Describe("ResourceScanner", func() {
 					resourceErr := fakeResourceConfigScope.SetCheckErrorArgsForCall(0)
 					Expect(resourceErr).To(MatchError("catastrophe"))
 				})
+
+				It("records the check with the error", func() {
+					Expect(fakeResourceConfigScope.RecordCheckCallCount()).To(Equal(1))
+
+					check := fakeResourceConfigScope.RecordCheckArgsForCall(0)
+					Expect(check.WorkerName).To(BeEmpty())
+					Expect(check.Error).To(Equal("catastrophe"))
+				})
+			})
+
+			It("waits for a worker no longer than the check timeout", func() {
+				chooseCtx, _, _, _, _, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
+
+				deadline, ok := chooseCtx.Deadline()
+				Expect(ok).To(BeTrue())
+				Expect(deadline).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+			})
+
+			Context("when no worker can run the check before the check timeout", func() {
+				BeforeEach(func() {
+					fakePool.FindOrChooseWorkerForContainerReturns(nil, context.DeadlineExceeded)
+				})
+
+				It("sets the check error and returns the error", func() {
+					Expect(scanErr).To(MatchError("Timed out after 1h0m0s while waiting for a worker to check on - perhaps increase your resource check timeout?"))
+
+					resourceErr := fakeResourceConfigScope.SetCheckErrorArgsForCall(0)
+					Expect(resourceErr).To(Equal(scanErr))
+				})
 			})
 
 			Context("when the resource config has a specified check interval", func() {
@@ -819,7 +872,7 @@ var _ = Describe("ResourceScanner", func() {
 
 				It("times out after the specified timeout", func() {
 					now := time.Now()
-					ctx, _, _ := fakeResource.CheckArgsForCall(0)
+					ctx, _, _, _ := fakeResource.CheckArgsForCall(0)
 					deadline, _ := ctx.Deadline()
 					Expect(deadline).Should(BeTemporally("~", now.Add(10*time.Second), time.Second))
 				})
@@ -869,7 +922,7 @@ var _ = Describe("ResourceScanner", func() {
 					})
 
 					It("checks from the pinned version", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(Equal(atc.Version{"version": "1"}))
 					})
 				})
@@ -901,7 +954,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -934,7 +987,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
@@ -968,7 +1021,7 @@ var _ = Describe("ResourceScanner", func() {
 					}
 
 					check := 0
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, ioConfig resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 						defer GinkgoRecover()
 
 						Expect(source).To(Equal(resourceConfig.Source))
@@ -1009,7 +1062,7 @@ var _ = Describe("ResourceScanner", func() {
 
 			Context("when the check does not return any new versions", func() {
 				BeforeEach(func() {
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, ioConfig resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 						return []atc.Version{}, nil
 					}
 				})
@@ -1061,8 +1114,11 @@ var _ = Describe("ResourceScanner", func() {
 
 	Describe("ScanFromVersion", func() {
 		var (
+			ctx          context.Context
+			cancel       func()
 			fakeResource *rfakes.FakeResource
 			fromVersion  atc.Version
+			ioConfig     resource.IOConfig
 
 			scanErr error
 		)
@@ -1078,10 +1134,17 @@ var _ = Describe("ResourceScanner", func() {
 			fakeResourceFactory.NewResourceForContainerReturns(fakeResource)
 
 			fromVersion = nil
+			ioConfig = resource.IOConfig{}
+
+			ctx, cancel = context.WithCancel(context.Background())
+		})
+
+		AfterEach(func() {
+			cancel()
 		})
 
 		JustBeforeEach(func() {
-			scanErr = scanner.ScanFromVersion(lagertest.NewTestLogger("test"), "some-resource", fromVersion)
+			scanErr = scanner.ScanFromVersion(ctx, lagertest.NewTestLogger("test"), "some-resource", fromVersion, ioConfig)
 		})
 
 		Context("if the lock can be acquired and last checked updated", func() {
@@ -1090,13 +1153,67 @@ var _ = Describe("ResourceScanner", func() {
 				fakeResourceConfigScope.UpdateLastCheckedReturns(true, nil)
 			})
 
+			It("checks with a context which is cancelled along with the given one", func() {
+				chooseCtx, _, _, _, _, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
+				checkCtx, _, _, _ := fakeResource.CheckArgsForCall(0)
+
+				cancel()
+
+				Expect(chooseCtx.Done()).To(BeClosed())
+				Expect(checkCtx.Done()).To(BeClosed())
+			})
+
+			Context("when the context is already cancelled", func() {
+				BeforeEach(func() {
+					cancel()
+				})
+
+				It("returns the context's error without checking", func() {
+					Expect(scanErr).To(Equal(context.Canceled))
+					Expect(fakeResource.CheckCallCount()).To(BeZero())
+				})
+			})
+
 			Context("when fromVersion is nil", func() {
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
 
+			Context("when output writers are given", func() {
+				var stdout *gbytes.Buffer
+
+				BeforeEach(func() {
+					stdout = gbytes.NewBuffer()
+
+					ioConfig = resource.IOConfig{
+						Stdout: stdout,
+						Stderr: gbytes.NewBuffer(),
+					}
+				})
+
+				It("checks with them", func() {
+					_, actualIOConfig, _, _ := fakeResource.CheckArgsForCall(0)
+					Expect(actualIOConfig).To(Equal(ioConfig))
+				})
+
+				It("writes that no versions were found", func() {
+					Expect(stdout).To(gbytes.Say("no versions found"))
+				})
+
+				Context("when the check returns versions", func() {
+					BeforeEach(func() {
+						fakeResource.CheckReturns([]atc.Version{{"version": "1"}, {"version": "2"}}, nil)
+					})
+
+					It("writes the versions found", func() {
+						Expect(stdout).To(gbytes.Say(`found version: {"version":"1"}`))
+						Expect(stdout).To(gbytes.Say(`found version: {"version":"2"}`))
+					})
+				})
+			})
+
 			Context("when fromVersion is specified", func() {
 				BeforeEach(func() {
 					fromVersion = atc.Version{
@@ -1105,7 +1222,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
@@ -23,6 +23,7 @@ type resourceTypeScanner struct {
 	dbPipeline            db.Pipeline
 	externalURL           string
 	variables             creds.Variables
+	fingerprintKey        []byte
 	strategy              worker.ContainerPlacementStrategy
 }
 
@@ -35,6 +36,7 @@ func NewResourceTypeScanner(
 	dbPipeline db.Pipeline,
 	externalURL string,
 	variables creds.Variables,
+	fingerprintKey []byte,
 	strategy worker.ContainerPlacementStrategy,
 ) Scanner {
 	return &resourceTypeScanner{
@@ -46,25 +48,26 @@ func NewResourceTypeScanner(
 		dbPipeline:            dbPipeline,
 		externalURL:           externalURL,
 		variables:             variables,
+		fingerprintKey:        fingerprintKey,
 		strategy:              strategy,
 	}
 }
 
 func (scanner *resourceTypeScanner) Run(logger lager.Logger, resourceTypeName string) (time.Duration, error) {
-	return scanner.scan(logger.Session("tick"), resourceTypeName, nil, false, false)
+	return scanner.scan(context.Background(), logger.Session("tick"), resourceTypeName, nil, false, false, resource.IOConfig{})
 }
 
-func (scanner *resourceTypeScanner) ScanFromVersion(logger lager.Logger, resourceTypeName string, fromVersion atc.Version) error {
-	_, err := scanner.scan(logger, resourceTypeName, fromVersion, true, true)
+func (scanner *resourceTypeScanner) ScanFromVersion(ctx context.Context, logger lager.Logger, resourceTypeName string, fromVersion atc.Version, ioConfig resource.IOConfig) error {
+	_, err := scanner.scan(ctx, logger, resourceTypeName, fromVersion, true, true, ioConfig)
 	return err
 }
 
 func (scanner *resourceTypeScanner) Scan(logger lager.Logger, resourceTypeName string) error {
-	_, err := scanner.scan(logger, resourceTypeName, nil, true, false)
+	_, err := scanner.scan(context.Background(), logger, resourceTypeName, nil, true, false, resource.IOConfig{})
 	return err
 }
 
-func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName string, fromVersion atc.Version, mustComplete bool, saveGiven bool) (time.Duration, error) {
+func (scanner *resourceTypeScanner) scan(ctx context.Context, logger lager.Logger, resourceTypeName string, fromVersion atc.Version, mustComplete bool, saveGiven bool, ioConfig resource.IOConfig) (time.Duration, error) {
 	lockLogger := logger.Session("lock", lager.Data{
 		"resource-type": resourceTypeName,
 	})
@@ -102,7 +105,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 			continue
 		}
 
-		if err = scanner.Scan(logger, parentType.Name()); err != nil {
+		if _, err = scanner.scan(ctx, logger, parentType.Name(), nil, true, false, resource.IOConfig{}); err != nil {
 			logger.Error("failed-to-scan-parent-resource-type-version", err)
 			scanner.setCheckError(logger, savedResourceType, err)
 			return 0, err
@@ -129,7 +132,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 
 	resourceConfigScope, err := savedResourceType.SetResourceConfig(
 		logger,
-		source,
+		savedResourceType.Source(),
 		versionedResourceTypes.Without(savedResourceType.Name()),
 	)
 	if err != nil {
@@ -143,6 +146,10 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 
 	reattempt := true
 	for reattempt {
+		if ctx.Err() != nil {
+			return interval, ctx.Err()
+		}
+
 		reattempt = mustComplete
 		lock, acquired, err := resourceConfigScope.AcquireResourceCheckingLock(
 			logger,
@@ -203,6 +210,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 	}
 
 	return interval, scanner.check(
+		ctx,
 		logger,
 		savedResourceType,
 		resourceConfigScope,
@@ -210,10 +218,12 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 		versionedResourceTypes,
 		source,
 		saveGiven,
+		ioConfig,
 	)
 }
 
 func (scanner *resourceTypeScanner) check(
+	ctx context.Context,
 	logger lager.Logger,
 	savedResourceType db.ResourceType,
 	resourceConfigScope db.ResourceConfigScope,
@@ -221,6 +231,7 @@ func (scanner *resourceTypeScanner) check(
 	versionedResourceTypes creds.VersionedResourceTypes,
 	source atc.Source,
 	saveGiven bool,
+	ioConfig resource.IOConfig,
 ) error {
 	pipelinePaused, err := scanner.dbPipeline.CheckPaused()
 	if err != nil {
@@ -251,9 +262,19 @@ func (scanner *resourceTypeScanner) check(
 		TeamID:        scanner.dbPipeline.TeamID(),
 	}
 
-	owner := db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), ContainerExpiries)
+	owner := db.NewResourceConfigCheckSessionContainerOwner(
+		resourceConfigScope.ResourceConfig(),
+		creds.Fingerprint(scanner.fingerprintKey, source),
+		ContainerExpiries,
+	)
+
+	// resource types have no check timeout of their own, so the global one
+	// bounds waiting for a worker with the capacity to run the check, as the
+	// resource type's checking lock is held meanwhile
+	ctx, cancel := context.WithTimeout(ctx, GlobalResourceCheckTimeout)
+	defer cancel()
 
-	chosenWorker, err := scanner.pool.FindOrChooseWorkerForContainer(logger, owner, containerSpec, workerSpec, scanner.strategy)
+	chosenWorker, err := scanner.pool.FindOrChooseWorkerForContainer(ctx, logger, owner, containerSpec, workerSpec, scanner.strategy)
 	if err != nil {
 		chkErr := resourceConfigScope.SetCheckError(err)
 		if chkErr != nil {
@@ -264,10 +285,10 @@ func (scanner *resourceTypeScanner) check(
 	}
 
 	container, err := chosenWorker.FindOrCreateContainer(
-		context.Background(),
+		ctx,
 		logger,
 		worker.NoopImageFetchingDelegate{},
-		db.NewResourceConfigCheckSessionContainerOwner(resourceConfigScope.ResourceConfig(), ContainerExpiries),
+		owner,
 		db.ContainerMetadata{
 			Type: db.ContainerTypeCheck,
 		},
@@ -284,7 +305,7 @@ func (scanner *resourceTypeScanner) check(
 	}
 
 	res := scanner.resourceFactory.NewResourceForContainer(container)
-	newVersions, err := res.Check(context.TODO(), source, fromVersion)
+	newVersions, err := res.Check(ctx, ioConfig, source, fromVersion)
 	resourceConfigScope.SetCheckError(err)
 	if err != nil {
 		if rErr, ok := err.(resource.ErrResourceScriptFailed); ok {
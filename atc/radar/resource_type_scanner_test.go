@@ -54,6 +54,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 	BeforeEach(func() {
 		fakeLock = &lockfakes.FakeLock{}
 		interval = 1 * time.Minute
+		GlobalResourceCheckTimeout = 1 * time.Hour
 		variables = template.StaticVariables{
 			"source-params": "some-secret-sauce",
 		}
@@ -108,6 +109,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 			fakeDBPipeline,
 			"https://www.example.com",
 			variables,
+			[]byte("some-fingerprint-key"),
 			fakeStrategy,
 		)
 	})
@@ -181,11 +183,11 @@ var _ = Describe("ResourceTypeScanner", func() {
 				It("constructs the resource of the correct type", func() {
 					Expect(fakeResourceType.SetResourceConfigCallCount()).To(Equal(1))
 					_, resourceSource, resourceTypes := fakeResourceType.SetResourceConfigArgsForCall(0)
-					Expect(resourceSource).To(Equal(atc.Source{"custom": "some-secret-sauce"}))
+					Expect(resourceSource).To(Equal(atc.Source{"custom": "((source-params))"}))
 					Expect(resourceTypes).To(Equal(creds.VersionedResourceTypes{}))
 
-					_, owner, containerSpec, workerSpec, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
-					Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, ContainerExpiries)))
+					_, _, owner, containerSpec, workerSpec, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
+					Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, creds.Fingerprint([]byte("some-fingerprint-key"), atc.Source{"custom": "some-secret-sauce"}), ContainerExpiries)))
 					Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 						ResourceType: "registry-image",
 					}))
@@ -200,7 +202,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
 					_, _, _, owner, metadata, containerSpec, resourceTypes := fakeWorker.FindOrCreateContainerArgsForCall(0)
-					Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, ContainerExpiries)))
+					Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, creds.Fingerprint([]byte("some-fingerprint-key"), atc.Source{"custom": "some-secret-sauce"}), ContainerExpiries)))
 					Expect(metadata).To(Equal(db.ContainerMetadata{
 						Type: db.ContainerTypeCheck,
 					}))
@@ -234,7 +236,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 					It("constructs the resource of the correct type", func() {
 						Expect(fakeResourceType.SetResourceConfigCallCount()).To(Equal(1))
 						_, resourceSource, resourceTypes := fakeResourceType.SetResourceConfigArgsForCall(0)
-						Expect(resourceSource).To(Equal(atc.Source{"custom": "some-secret-sauce"}))
+						Expect(resourceSource).To(Equal(atc.Source{"custom": "((source-params))"}))
 						Expect(resourceTypes).To(Equal(creds.NewVersionedResourceTypes(variables, atc.VersionedResourceTypes{
 							versionedResourceType,
 						})))
@@ -243,8 +245,8 @@ var _ = Describe("ResourceTypeScanner", func() {
 						err := fakeResourceType.SetCheckSetupErrorArgsForCall(0)
 						Expect(err).To(BeNil())
 
-						_, owner, containerSpec, workerSpec, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
-						Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, ContainerExpiries)))
+						_, _, owner, containerSpec, workerSpec, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
+						Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, creds.Fingerprint([]byte("some-fingerprint-key"), atc.Source{"custom": "some-secret-sauce"}), ContainerExpiries)))
 						Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 							ResourceType: "registry-image",
 						}))
@@ -257,7 +259,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 						Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
 						_, _, _, owner, metadata, containerSpec, resourceTypes := fakeWorker.FindOrCreateContainerArgsForCall(0)
-						Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, ContainerExpiries)))
+						Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, creds.Fingerprint([]byte("some-fingerprint-key"), atc.Source{"custom": "some-secret-sauce"}), ContainerExpiries)))
 						Expect(metadata).To(Equal(db.ContainerMetadata{
 							Type: db.ContainerTypeCheck,
 						}))
@@ -334,7 +336,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 					})
 
 					It("checks from nil", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(BeNil())
 					})
 				})
@@ -350,7 +352,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 					It("checks with it", func() {
 						Expect(fakeResource.CheckCallCount()).To(Equal(1))
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, _, version := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(Equal(atc.Version{"version": "42"}))
 					})
 				})
@@ -374,7 +376,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 						}
 
 						check := 0
-						fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+						fakeResource.CheckStub = func(ctx context.Context, ioConfig resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 							defer GinkgoRecover()
 
 							Expect(source).To(Equal(atc.Source{"custom": "some-secret-sauce"}))
@@ -470,15 +472,15 @@ var _ = Describe("ResourceTypeScanner", func() {
 			It("constructs the resource of the correct type", func() {
 				Expect(fakeResourceType.SetResourceConfigCallCount()).To(Equal(1))
 				_, resourceSource, resourceTypes := fakeResourceType.SetResourceConfigArgsForCall(0)
-				Expect(resourceSource).To(Equal(atc.Source{"custom": "some-secret-sauce"}))
+				Expect(resourceSource).To(Equal(atc.Source{"custom": "((source-params))"}))
 				Expect(resourceTypes).To(Equal(creds.VersionedResourceTypes{}))
 
 				Expect(fakeResourceType.SetCheckSetupErrorCallCount()).To(Equal(1))
 				err := fakeResourceType.SetCheckSetupErrorArgsForCall(0)
 				Expect(err).To(BeNil())
 
-				_, owner, containerSpec, workerSpec, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
-				Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, ContainerExpiries)))
+				_, _, owner, containerSpec, workerSpec, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
+				Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, creds.Fingerprint([]byte("some-fingerprint-key"), atc.Source{"custom": "some-secret-sauce"}), ContainerExpiries)))
 				Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 					ResourceType: "registry-image",
 				}))
@@ -493,7 +495,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 				Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
 				_, _, _, owner, metadata, containerSpec, resourceTypes := fakeWorker.FindOrCreateContainerArgsForCall(0)
-				Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, ContainerExpiries)))
+				Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, creds.Fingerprint([]byte("some-fingerprint-key"), atc.Source{"custom": "some-secret-sauce"}), ContainerExpiries)))
 				Expect(metadata).To(Equal(db.ContainerMetadata{
 					Type: db.ContainerTypeCheck,
 				}))
@@ -598,13 +600,13 @@ var _ = Describe("ResourceTypeScanner", func() {
 				It("constructs the resource of the correct type", func() {
 					Expect(fakeResourceType.SetResourceConfigCallCount()).To(Equal(1))
 					_, resourceSource, resourceTypes := fakeResourceType.SetResourceConfigArgsForCall(0)
-					Expect(resourceSource).To(Equal(atc.Source{"custom": "some-secret-sauce"}))
+					Expect(resourceSource).To(Equal(atc.Source{"custom": "((source-params))"}))
 					Expect(resourceTypes).To(Equal(creds.NewVersionedResourceTypes(variables, atc.VersionedResourceTypes{
 						versionedResourceType,
 					})))
 
-					_, owner, containerSpec, workerSpec, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
-					Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, ContainerExpiries)))
+					_, _, owner, containerSpec, workerSpec, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
+					Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, creds.Fingerprint([]byte("some-fingerprint-key"), atc.Source{"custom": "some-secret-sauce"}), ContainerExpiries)))
 					Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 						ResourceType: "registry-image",
 					}))
@@ -617,7 +619,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
 					_, _, _, owner, metadata, containerSpec, resourceTypes := fakeWorker.FindOrCreateContainerArgsForCall(0)
-					Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, ContainerExpiries)))
+					Expect(owner).To(Equal(db.NewResourceConfigCheckSessionContainerOwner(fakeResourceConfig, creds.Fingerprint([]byte("some-fingerprint-key"), atc.Source{"custom": "some-secret-sauce"}), ContainerExpiries)))
 					Expect(metadata).To(Equal(db.ContainerMetadata{
 						Type: db.ContainerTypeCheck,
 					}))
@@ -687,13 +689,21 @@ var _ = Describe("ResourceTypeScanner", func() {
 				})
 			})
 
+			It("waits for a worker no longer than the global check timeout", func() {
+				chooseCtx, _, _, _, _, _ := fakePool.FindOrChooseWorkerForContainerArgsForCall(0)
+
+				deadline, ok := chooseCtx.Deadline()
+				Expect(ok).To(BeTrue())
+				Expect(deadline).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+			})
+
 			Context("when there is no current version", func() {
 				BeforeEach(func() {
 					fakeResourceType.VersionReturns(nil)
 				})
 
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -709,7 +719,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 				It("checks with it", func() {
 					Expect(fakeResource.CheckCallCount()).To(Equal(1))
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "42"}))
 				})
 			})
@@ -733,7 +743,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 					}
 
 					check := 0
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, ioConfig resource.IOConfig, source atc.Source, from atc.Version) ([]atc.Version, error) {
 						defer GinkgoRecover()
 
 						Expect(source).To(Equal(atc.Source{"custom": "some-secret-sauce"}))
@@ -887,7 +897,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 		})
 
 		JustBeforeEach(func() {
-			scanErr = scanner.ScanFromVersion(lagertest.NewTestLogger("test"), "some-resource-type", fromVersion)
+			scanErr = scanner.ScanFromVersion(context.Background(), lagertest.NewTestLogger("test"), "some-resource-type", fromVersion, resource.IOConfig{})
 		})
 
 		Context("if the lock can be acquired", func() {
@@ -903,7 +913,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 			Context("when fromVersion is nil", func() {
 				It("checks from the current version", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"custom": "version"}))
 				})
 			})
@@ -916,7 +926,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, _, version := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
@@ -1,19 +1,30 @@
 package radar
 
 import (
+	"context"
 	"time"
 
 	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/worker"
 )
 
+//go:generate counterfeiter . Scanner
+
+type Scanner interface {
+	Run(lager.Logger, string) (time.Duration, error)
+	Scan(lager.Logger, string) error
+	ScanFromVersion(context.Context, lager.Logger, string, atc.Version, resource.IOConfig) error
+}
+
 // ScannerFactory is the same interface as resourceserver/server.go
 // They are in two places because there would be cyclic dependencies otherwise
 
-// go:generate counterfeiter . ScannerFactory
+//go:generate counterfeiter . ScannerFactory
 type ScannerFactory interface {
 	NewResourceScanner(dbPipeline db.Pipeline) Scanner
 	NewResourceTypeScanner(dbPipeline db.Pipeline) Scanner
@@ -27,6 +38,7 @@ type scannerFactory struct {
 	resourceCheckingInterval     time.Duration
 	externalURL                  string
 	variablesFactory             creds.VariablesFactory
+	fingerprintKey               []byte
 	strategy                     worker.ContainerPlacementStrategy
 }
 
@@ -44,6 +56,7 @@ func NewScannerFactory(
 	resourceCheckingInterval time.Duration,
 	externalURL string,
 	variablesFactory creds.VariablesFactory,
+	fingerprintKey []byte,
 	strategy worker.ContainerPlacementStrategy,
 ) ScannerFactory {
 	return &scannerFactory{
@@ -54,6 +67,7 @@ func NewScannerFactory(
 		resourceTypeCheckingInterval: resourceTypeCheckingInterval,
 		externalURL:                  externalURL,
 		variablesFactory:             variablesFactory,
+		fingerprintKey:               fingerprintKey,
 		strategy:                     strategy,
 	}
 }
@@ -70,6 +84,7 @@ func (f *scannerFactory) NewResourceScanner(dbPipeline db.Pipeline) Scanner {
 		dbPipeline,
 		f.externalURL,
 		variables,
+		f.fingerprintKey,
 		f.strategy,
 	)
 }
@@ -86,6 +101,7 @@ func (f *scannerFactory) NewResourceTypeScanner(dbPipeline db.Pipeline) Scanner
 		dbPipeline,
 		f.externalURL,
 		variables,
+		f.fingerprintKey,
 		f.strategy,
 	)
 }
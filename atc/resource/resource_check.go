@@ -11,7 +11,7 @@ type checkRequest struct {
 	Version atc.Version `json:"version"`
 }
 
-func (resource *resource) Check(ctx context.Context, source atc.Source, fromVersion atc.Version) ([]atc.Version, error) {
+func (resource *resource) Check(ctx context.Context, ioConfig IOConfig, source atc.Source, fromVersion atc.Version) ([]atc.Version, error) {
 	var versions []atc.Version
 
 	err := resource.runScript(
@@ -20,7 +20,7 @@ func (resource *resource) Check(ctx context.Context, source atc.Source, fromVers
 		nil,
 		checkRequest{source, fromVersion},
 		&versions,
-		nil,
+		ioConfig.Stderr,
 		false,
 	)
 	if err != nil {
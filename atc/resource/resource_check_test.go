@@ -8,15 +8,18 @@ import (
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/resource"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("Resource Check", func() {
 	var (
-		source  atc.Source
-		version atc.Version
+		source   atc.Source
+		version  atc.Version
+		ioConfig resource.IOConfig
 
 		checkScriptStdout     string
 		checkScriptStderr     string
@@ -32,6 +35,7 @@ var _ = Describe("Resource Check", func() {
 	BeforeEach(func() {
 		source = atc.Source{"some": "source"}
 		version = atc.Version{"some": "version"}
+		ioConfig = resource.IOConfig{}
 
 		checkScriptStdout = "[]"
 		checkScriptStderr = ""
@@ -62,7 +66,7 @@ var _ = Describe("Resource Check", func() {
 			return checkScriptProcess, nil
 		}
 
-		checkResult, checkErr = resourceForContainer.Check(context.TODO(), source, version)
+		checkResult, checkErr = resourceForContainer.Check(context.TODO(), ioConfig, source, version)
 	})
 
 	It("runs /opt/resource/check the request on stdin", func() {
@@ -119,6 +123,22 @@ var _ = Describe("Resource Check", func() {
 			Expect(checkErr.Error()).To(ContainSubstring("exit status 9"))
 			Expect(checkErr.Error()).To(ContainSubstring("some-stderr"))
 		})
+
+		Context("when a stderr writer is given", func() {
+			var stderrBuf *gbytes.Buffer
+
+			BeforeEach(func() {
+				stderrBuf = gbytes.NewBuffer()
+				ioConfig = resource.IOConfig{Stderr: stderrBuf}
+			})
+
+			It("writes stderr of the process to it", func() {
+				Expect(checkErr).To(HaveOccurred())
+				Expect(checkErr.Error()).To(ContainSubstring("exit status 9"))
+
+				Expect(stderrBuf).To(gbytes.Say("some-stderr"))
+			})
+		})
 	})
 
 	Context("when the output of /opt/resource/check is malformed", func() {
@@ -0,0 +1,13 @@
+package atc
+
+// ResourceCheck records a single run of a resource's check, for debugging
+// checks which fail intermittently.
+type ResourceCheck struct {
+	ID         int       `json:"id"`
+	StartTime  int64     `json:"start_time"`
+	EndTime    int64     `json:"end_time"`
+	WorkerName string    `json:"worker_name,omitempty"`
+	Versions   []Version `json:"versions"`
+	Error      string    `json:"error,omitempty"`
+	Stderr     string    `json:"stderr,omitempty"`
+}
@@ -2,6 +2,10 @@ package atc
 
 type CheckRequestBody struct {
 	From Version `json:"from"`
+
+	// Watch starts a build which runs the check, rather than responding
+	// once the check has finished.
+	Watch bool `json:"watch,omitempty"`
 }
 
 type CheckResponseBody struct {
@@ -40,6 +40,9 @@ const (
 	CheckResourceWebHook = "CheckResourceWebHook"
 	CheckResourceType    = "CheckResourceType"
 
+	ListResourceWebhookDeliveries = "ListResourceWebhookDeliveries"
+	ListResourceChecks            = "ListResourceChecks"
+
 	ListResourceVersions          = "ListResourceVersions"
 	GetResourceVersion            = "GetResourceVersion"
 	EnableResourceVersion         = "EnableResourceVersion"
@@ -67,13 +70,17 @@ const (
 	CreatePipelineBuild = "CreatePipelineBuild"
 	PipelineBadge       = "PipelineBadge"
 
-	RegisterWorker  = "RegisterWorker"
-	LandWorker      = "LandWorker"
-	RetireWorker    = "RetireWorker"
-	PruneWorker     = "PruneWorker"
-	HeartbeatWorker = "HeartbeatWorker"
-	ListWorkers     = "ListWorkers"
-	DeleteWorker    = "DeleteWorker"
+	RegisterWorker            = "RegisterWorker"
+	LandWorker                = "LandWorker"
+	RetireWorker              = "RetireWorker"
+	ListBlockingBuilds        = "ListBlockingBuilds"
+	CordonWorker              = "CordonWorker"
+	UncordonWorker            = "UncordonWorker"
+	ScheduleWorkerMaintenance = "ScheduleWorkerMaintenance"
+	PruneWorker               = "PruneWorker"
+	HeartbeatWorker           = "HeartbeatWorker"
+	ListWorkers               = "ListWorkers"
+	DeleteWorker              = "DeleteWorker"
 
 	SetLogLevel = "SetLogLevel"
 	GetLogLevel = "GetLogLevel"
@@ -97,6 +104,7 @@ const (
 	RenameTeam     = "RenameTeam"
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
+	SetTeamQuotas  = "SetTeamQuotas"
 
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
@@ -158,6 +166,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name", Method: "GET", Name: GetResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check", Method: "POST", Name: CheckResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", Method: "POST", Name: CheckResourceWebHook},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/webhook_deliveries", Method: "GET", Name: ListResourceWebhookDeliveries},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/checks", Method: "GET", Name: ListResourceChecks},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/check", Method: "POST", Name: CheckResourceType},
 
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions", Method: "GET", Name: ListResourceVersions},
@@ -177,6 +187,10 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/workers", Method: "POST", Name: RegisterWorker},
 	{Path: "/api/v1/workers/:worker_name/land", Method: "PUT", Name: LandWorker},
 	{Path: "/api/v1/workers/:worker_name/retire", Method: "PUT", Name: RetireWorker},
+	{Path: "/api/v1/workers/:worker_name/blocking-builds", Method: "GET", Name: ListBlockingBuilds},
+	{Path: "/api/v1/workers/:worker_name/cordon", Method: "PUT", Name: CordonWorker},
+	{Path: "/api/v1/workers/:worker_name/uncordon", Method: "PUT", Name: UncordonWorker},
+	{Path: "/api/v1/workers/:worker_name/maintenance", Method: "PUT", Name: ScheduleWorkerMaintenance},
 	{Path: "/api/v1/workers/:worker_name/prune", Method: "PUT", Name: PruneWorker},
 	{Path: "/api/v1/workers/:worker_name/heartbeat", Method: "PUT", Name: HeartbeatWorker},
 	{Path: "/api/v1/workers/:worker_name", Method: "DELETE", Name: DeleteWorker},
@@ -203,6 +217,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/rename", Method: "PUT", Name: RenameTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
+	{Path: "/api/v1/teams/:team_name/quotas", Method: "PUT", Name: SetTeamQuotas},
 
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
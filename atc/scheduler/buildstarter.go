@@ -1,6 +1,8 @@
 package scheduler
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -9,6 +11,11 @@ import (
 	"github.com/concourse/concourse/atc/scheduler/maxinflight"
 )
 
+// ErrInputOverridesNotSatisfied is the error a manually triggered build fails
+// with when its overridden versions cannot be used together with the rest of
+// its inputs, e.g. because they have not passed the jobs they are meant to.
+var ErrInputOverridesNotSatisfied = errors.New("no versions of the build's inputs satisfy both the overridden versions and the job's passed constraints")
+
 //go:generate counterfeiter . BuildStarter
 
 type BuildStarter interface {
@@ -92,7 +99,18 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, nil
 	}
 
+	var buildInputs []db.BuildInput
+	var inputsOverridden bool
+
 	if nextPendingBuild.IsManuallyTriggered() {
+		overrides, err := nextPendingBuild.InputOverrides()
+		if err != nil {
+			logger.Error("failed-to-get-input-overrides", err)
+			return false, err
+		}
+
+		inputsOverridden = len(overrides.Inputs) != 0
+
 		jobBuildInputs := job.Config().Inputs()
 		for _, input := range jobBuildInputs {
 			resource, found := resources.Lookup(input.Resource)
@@ -106,6 +124,10 @@ func (s *buildStarter) tryStartNextPendingBuild(
 				continue
 			}
 
+			if _, overridden := overrides.Inputs[input.Name]; overridden {
+				continue
+			}
+
 			if resource.LastCheckFinished().Before(nextPendingBuild.CreateTime()) {
 				return false, nil
 			}
@@ -117,9 +139,37 @@ func (s *buildStarter) tryStartNextPendingBuild(
 			return false, err
 		}
 
-		_, err = s.inputMapper.SaveNextInputMapping(logger, versions, job, resources)
-		if err != nil {
-			return false, err
+		if inputsOverridden {
+			inputMapping, ok, err := s.inputMapper.MapInputOverrides(logger, versions, job, resources, overrides)
+			if err != nil {
+				return false, err
+			}
+
+			if !ok {
+				logger.Info("input-overrides-not-satisfied")
+
+				err := nextPendingBuild.FinishWithError(ErrInputOverridesNotSatisfied)
+				if err != nil {
+					logger.Error("failed-to-mark-build-as-errored", err)
+				}
+
+				return false, nil
+			}
+
+			buildInputs, err = job.GetBuildInputsForMapping(inputMapping)
+			if err != nil {
+				logger.Error("failed-to-get-build-inputs-for-overrides", err)
+				return false, err
+			}
+
+			for i, input := range buildInputs {
+				_, buildInputs[i].Override = overrides.Inputs[input.Name]
+			}
+		} else {
+			_, err = s.inputMapper.SaveNextInputMapping(logger, versions, job, resources)
+			if err != nil {
+				return false, err
+			}
 		}
 
 		dbResourceTypes, err := s.pipeline.ResourceTypes()
@@ -129,13 +179,16 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		resourceTypes = dbResourceTypes.Deserialize()
 	}
 
-	buildInputs, found, err := job.GetNextBuildInputs()
-	if err != nil {
-		logger.Error("failed-to-get-next-build-inputs", err)
-		return false, err
-	}
-	if !found {
-		return false, nil
+	if !inputsOverridden {
+		var found bool
+		buildInputs, found, err = job.GetNextBuildInputs()
+		if err != nil {
+			logger.Error("failed-to-get-next-build-inputs", err)
+			return false, err
+		}
+		if !found {
+			return false, nil
+		}
 	}
 
 	pipelinePaused, err := s.pipeline.CheckPaused()
@@ -152,6 +205,10 @@ func (s *buildStarter) tryStartNextPendingBuild(
 	}
 
 	updated, err := nextPendingBuild.Schedule()
+	if err == db.ErrRunningBuildsQuotaReached {
+		logger.Debug("team-running-builds-quota-reached")
+		return false, nil
+	}
 	if err != nil {
 		logger.Error("failed-to-update-build-to-scheduled", err)
 		return false, err
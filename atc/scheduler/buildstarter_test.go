@@ -282,6 +282,117 @@ var _ = Describe("BuildStarter", func() {
 						})
 					})
 				})
+
+				Context("when the build has input overrides", func() {
+					var overriddenResource *dbfakes.FakeResource
+					var versionsDB *algorithm.VersionsDB
+
+					BeforeEach(func() {
+						createdBuild.CreateTimeReturns(time.Now())
+						createdBuild.InputOverridesReturns(atc.InputOverrides{
+							Inputs: map[string]atc.Version{"input-1": {"ref": "old"}},
+						}, nil)
+
+						job.ConfigReturns(atc.JobConfig{Plan: atc.PlanSequence{{Get: "input-1", Resource: "overridden-resource"}, {Get: "input-2", Resource: "some-resource"}}})
+
+						resource.LastCheckFinishedReturns(time.Now().Add(time.Minute))
+
+						overriddenResource = new(dbfakes.FakeResource)
+						overriddenResource.NameReturns("overridden-resource")
+						overriddenResource.LastCheckFinishedReturns(time.Now().Add(-time.Minute))
+
+						resources = db.Resources{resource, overriddenResource}
+
+						versionsDB = &algorithm.VersionsDB{JobIDs: map[string]int{"j1": 1}}
+						fakePipeline.LoadVersionsDBReturns(versionsDB, nil)
+					})
+
+					It("does not wait for the overridden inputs' resources to be checked", func() {
+						Expect(fakePipeline.LoadVersionsDBCallCount()).To(Equal(1))
+					})
+
+					It("maps the inputs using the overrides instead of saving the next input mapping", func() {
+						Expect(fakeInputMapper.SaveNextInputMappingCallCount()).To(BeZero())
+
+						Expect(fakeInputMapper.MapInputOverridesCallCount()).To(Equal(1))
+						_, actualVersionsDB, actualJob, _, actualOverrides := fakeInputMapper.MapInputOverridesArgsForCall(0)
+						Expect(actualVersionsDB).To(Equal(versionsDB))
+						Expect(actualJob.Name()).To(Equal(job.Name()))
+						Expect(actualOverrides).To(Equal(atc.InputOverrides{
+							Inputs: map[string]atc.Version{"input-1": {"ref": "old"}},
+						}))
+					})
+
+					Context("when mapping the inputs fails", func() {
+						BeforeEach(func() {
+							fakeInputMapper.MapInputOverridesReturns(nil, false, disaster)
+						})
+
+						It("returns the error", func() {
+							Expect(tryStartErr).To(Equal(disaster))
+						})
+					})
+
+					Context("when the overrides cannot be satisfied", func() {
+						BeforeEach(func() {
+							fakeInputMapper.MapInputOverridesReturns(nil, false, nil)
+						})
+
+						It("errors the build", func() {
+							Expect(createdBuild.FinishWithErrorCallCount()).To(Equal(1))
+							Expect(createdBuild.FinishWithErrorArgsForCall(0)).To(Equal(scheduler.ErrInputOverridesNotSatisfied))
+						})
+
+						It("does not start the build", func() {
+							Expect(fakeEngine.CreateBuildCallCount()).To(BeZero())
+						})
+
+						It("returns without error", func() {
+							Expect(tryStartErr).NotTo(HaveOccurred())
+						})
+					})
+
+					Context("when the overrides are satisfied", func() {
+						var inputMapping algorithm.InputMapping
+
+						BeforeEach(func() {
+							inputMapping = algorithm.InputMapping{
+								"input-1": algorithm.InputVersion{ResourceID: 1, VersionID: 2},
+								"input-2": algorithm.InputVersion{ResourceID: 3, VersionID: 4},
+							}
+
+							fakeInputMapper.MapInputOverridesReturns(inputMapping, true, nil)
+
+							job.GetBuildInputsForMappingReturns([]db.BuildInput{
+								{Name: "input-1", ResourceID: 1, Version: atc.Version{"ref": "old"}},
+								{Name: "input-2", ResourceID: 3, Version: atc.Version{"ref": "latest"}},
+							}, nil)
+
+							fakePipeline.CheckPausedReturns(false, nil)
+							createdBuild.ScheduleReturns(true, nil)
+							fakeEngine.CreateBuildReturns(new(enginefakes.FakeBuild), nil)
+						})
+
+						It("uses the mapped inputs, marking the overridden ones", func() {
+							Expect(job.GetBuildInputsForMappingCallCount()).To(Equal(1))
+							Expect(job.GetBuildInputsForMappingArgsForCall(0)).To(Equal(inputMapping))
+
+							Expect(createdBuild.UseInputsCallCount()).To(Equal(1))
+							Expect(createdBuild.UseInputsArgsForCall(0)).To(ConsistOf(
+								db.BuildInput{Name: "input-1", ResourceID: 1, Version: atc.Version{"ref": "old"}, Override: true},
+								db.BuildInput{Name: "input-2", ResourceID: 3, Version: atc.Version{"ref": "latest"}},
+							))
+						})
+
+						It("does not use the job's next build inputs", func() {
+							Expect(job.GetNextBuildInputsCallCount()).To(BeZero())
+						})
+
+						It("starts the build", func() {
+							Expect(fakeEngine.CreateBuildCallCount()).To(Equal(1))
+						})
+					})
+				})
 			})
 		})
 
@@ -404,6 +515,20 @@ var _ = Describe("BuildStarter", func() {
 						})
 					})
 
+					Context("when the team has reached its running builds quota", func() {
+						BeforeEach(func() {
+							pendingBuild1.ScheduleReturns(false, db.ErrRunningBuildsQuotaReached)
+						})
+
+						It("doesn't return an error", func() {
+							Expect(tryStartErr).NotTo(HaveOccurred())
+						})
+
+						It("doesn't try to use inputs for build", func() {
+							Expect(pendingBuild1.UseInputsCallCount()).To(BeZero())
+						})
+					})
+
 					Context("when marking the build as scheduled succeeds", func() {
 						BeforeEach(func() {
 							pendingBuild1.ScheduleReturns(true, nil)
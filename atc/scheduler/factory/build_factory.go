@@ -38,13 +38,20 @@ func (factory *buildFactory) Create(
 		return atc.Plan{}, err
 	}
 
-	return factory.applyHooks(constructionParams{
+	plan, err = factory.applyHooks(constructionParams{
 		plan:          plan,
 		hooks:         job.Hooks(),
 		resources:     resources,
 		resourceTypes: resourceTypes,
 		inputs:        inputs,
 	})
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	inheritPlacement(&plan, job.Placement)
+
+	return plan, nil
 }
 
 func (factory *buildFactory) constructPlanFromJob(
@@ -99,6 +106,10 @@ func (factory *buildFactory) constructPlanFromConfig(
 	resourceTypes atc.VersionedResourceTypes,
 	inputs []db.BuildInput,
 ) (atc.Plan, error) {
+	if len(planConfig.Across) > 0 {
+		return factory.across(planConfig, resources, resourceTypes, inputs)
+	}
+
 	var plan atc.Plan
 	var err error
 
@@ -122,13 +133,92 @@ func (factory *buildFactory) constructPlanFromConfig(
 		plan = factory.planFactory.NewPlan(retryStep)
 	}
 
-	return factory.applyHooks(constructionParams{
+	plan, err = factory.applyHooks(constructionParams{
 		plan:          plan,
 		hooks:         planConfig.Hooks(),
 		resources:     resources,
 		resourceTypes: resourceTypes,
 		inputs:        inputs,
 	})
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	inheritPlacement(&plan, planConfig.Placement)
+
+	return plan, nil
+}
+
+func (factory *buildFactory) across(
+	planConfig atc.PlanConfig,
+	resources atc.ResourceConfigs,
+	resourceTypes atc.VersionedResourceTypes,
+	inputs []db.BuildInput,
+) (atc.Plan, error) {
+	acrossVars := planConfig.Across
+
+	// each combination is constructed from the step without the across
+	// modifier, so that any other modifiers and hooks apply to every
+	// combination individually
+	planConfig.Across = nil
+
+	vars := make([]atc.AcrossVar, len(acrossVars))
+	for i, acrossVar := range acrossVars {
+		vars[i] = atc.AcrossVar{
+			Var:         acrossVar.Var,
+			Values:      acrossVar.Values,
+			MaxInFlight: acrossVar.MaxInFlight,
+			FailFast:    acrossVar.FailFast,
+		}
+	}
+
+	// the values are not interpolated into the step's config here; they are
+	// set as vars local to each combination when the build runs, so that they
+	// also reach config which is only loaded then, e.g. task files
+	steps := []atc.VarScopedPlan{}
+	for _, values := range acrossCombinations(acrossVars) {
+		step, err := factory.constructPlanFromConfig(
+			planConfig,
+			resources,
+			resourceTypes,
+			inputs,
+		)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		steps = append(steps, atc.VarScopedPlan{
+			Step:   step,
+			Values: values,
+		})
+	}
+
+	return factory.planFactory.NewPlan(atc.AcrossPlan{
+		Vars:  vars,
+		Steps: steps,
+	}), nil
+}
+
+// acrossCombinations returns every combination of the vars' values, varying
+// the last var the fastest.
+func acrossCombinations(acrossVars []atc.AcrossVarConfig) [][]interface{} {
+	combinations := [][]interface{}{{}}
+
+	for _, acrossVar := range acrossVars {
+		var next [][]interface{}
+
+		for _, combination := range combinations {
+			for _, value := range acrossVar.Values {
+				values := make([]interface{}, len(combination), len(combination)+1)
+				copy(values, combination)
+				next = append(next, append(values, value))
+			}
+		}
+
+		combinations = next
+	}
+
+	return combinations
 }
 
 func (factory *buildFactory) constructUnhookedPlan(
@@ -166,13 +256,14 @@ func (factory *buildFactory) constructUnhookedPlan(
 		}
 
 		atcPutPlan := atc.PutPlan{
-			Type:     resource.Type,
-			Name:     logicalName,
-			Resource: resourceName,
-			Source:   resource.Source,
-			Params:   planConfig.Params,
-			Tags:     planConfig.Tags,
-			Inputs:   planConfig.Inputs,
+			Type:      resource.Type,
+			Name:      logicalName,
+			Resource:  resourceName,
+			Source:    resource.Source,
+			Params:    planConfig.Params,
+			Tags:      planConfig.Tags,
+			Placement: planConfig.Placement,
+			Inputs:    planConfig.Inputs,
 
 			VersionedResourceTypes: resourceTypes,
 		}
@@ -185,9 +276,10 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Resource:    resourceName,
 			VersionFrom: &putPlan.ID,
 
-			Params: planConfig.GetParams,
-			Tags:   planConfig.Tags,
-			Source: resource.Source,
+			Params:    planConfig.GetParams,
+			Tags:      planConfig.Tags,
+			Placement: planConfig.Placement,
+			Source:    resource.Source,
 
 			VersionedResourceTypes: resourceTypes,
 		})
@@ -218,13 +310,14 @@ func (factory *buildFactory) constructUnhookedPlan(
 		}
 
 		plan = factory.planFactory.NewPlan(atc.GetPlan{
-			Type:     resource.Type,
-			Name:     name,
-			Resource: resourceName,
-			Source:   resource.Source,
-			Params:   planConfig.Params,
-			Version:  &version,
-			Tags:     planConfig.Tags,
+			Type:      resource.Type,
+			Name:      name,
+			Resource:  resourceName,
+			Source:    resource.Source,
+			Params:    planConfig.Params,
+			Version:   &version,
+			Tags:      planConfig.Tags,
+			Placement: planConfig.Placement,
 
 			VersionedResourceTypes: resourceTypes,
 		})
@@ -237,6 +330,7 @@ func (factory *buildFactory) constructUnhookedPlan(
 			ConfigPath:        planConfig.TaskConfigPath,
 			Vars:              planConfig.TaskVars,
 			Tags:              planConfig.Tags,
+			Placement:         planConfig.Placement,
 			Params:            planConfig.Params,
 			InputMapping:      planConfig.InputMapping,
 			OutputMapping:     planConfig.OutputMapping,
@@ -244,6 +338,22 @@ func (factory *buildFactory) constructUnhookedPlan(
 
 			VersionedResourceTypes: resourceTypes,
 		})
+
+	case planConfig.SetPipeline != "":
+		plan = factory.planFactory.NewPlan(atc.SetPipelinePlan{
+			Name:     planConfig.SetPipeline,
+			File:     planConfig.TaskConfigPath,
+			Vars:     planConfig.TaskVars,
+			VarFiles: planConfig.VarFiles,
+		})
+
+	case planConfig.LoadVar != "":
+		plan = factory.planFactory.NewPlan(atc.LoadVarPlan{
+			Name:   planConfig.LoadVar,
+			File:   planConfig.TaskConfigPath,
+			Format: planConfig.Format,
+		})
+
 	case planConfig.Try != nil:
 		nextStep, err := factory.constructPlanFromConfig(
 			*planConfig.Try,
@@ -277,6 +387,29 @@ func (factory *buildFactory) constructUnhookedPlan(
 		}
 
 		plan = factory.planFactory.NewPlan(aggregate)
+
+	case planConfig.InParallel != nil:
+		var steps []atc.Plan
+
+		for _, planConfig := range planConfig.InParallel.Steps {
+			nextStep, err := factory.constructPlanFromConfig(
+				planConfig,
+				resources,
+				resourceTypes,
+				inputs,
+			)
+			if err != nil {
+				return atc.Plan{}, err
+			}
+
+			steps = append(steps, nextStep)
+		}
+
+		plan = factory.planFactory.NewPlan(atc.InParallelPlan{
+			Steps:    steps,
+			Limit:    planConfig.InParallel.Limit,
+			FailFast: planConfig.InParallel.FailFast,
+		})
 	}
 
 	if planConfig.Timeout != "" {
@@ -405,3 +538,75 @@ func (factory *buildFactory) abortIfPresent(cp constructionParams) (construction
 
 	return cp, nil
 }
+
+// inheritPlacement sets the placement of the steps within the plan which do
+// not specify their own.
+func inheritPlacement(plan *atc.Plan, placement []string) {
+	if len(placement) == 0 {
+		return
+	}
+
+	switch {
+	case plan.Get != nil:
+		if len(plan.Get.Placement) == 0 {
+			plan.Get.Placement = placement
+		}
+
+	case plan.Put != nil:
+		if len(plan.Put.Placement) == 0 {
+			plan.Put.Placement = placement
+		}
+
+	case plan.Task != nil:
+		if len(plan.Task.Placement) == 0 {
+			plan.Task.Placement = placement
+		}
+
+	case plan.Do != nil:
+		for i := range *plan.Do {
+			inheritPlacement(&(*plan.Do)[i], placement)
+		}
+
+	case plan.Aggregate != nil:
+		for i := range *plan.Aggregate {
+			inheritPlacement(&(*plan.Aggregate)[i], placement)
+		}
+
+	case plan.InParallel != nil:
+		for i := range plan.InParallel.Steps {
+			inheritPlacement(&plan.InParallel.Steps[i], placement)
+		}
+
+	case plan.Across != nil:
+		for i := range plan.Across.Steps {
+			inheritPlacement(&plan.Across.Steps[i].Step, placement)
+		}
+
+	case plan.Retry != nil:
+		for i := range *plan.Retry {
+			inheritPlacement(&(*plan.Retry)[i], placement)
+		}
+
+	case plan.Try != nil:
+		inheritPlacement(&plan.Try.Step, placement)
+
+	case plan.Timeout != nil:
+		inheritPlacement(&plan.Timeout.Step, placement)
+
+	case plan.OnAbort != nil:
+		inheritPlacement(&plan.OnAbort.Step, placement)
+		inheritPlacement(&plan.OnAbort.Next, placement)
+
+	case plan.OnFailure != nil:
+		inheritPlacement(&plan.OnFailure.Step, placement)
+		inheritPlacement(&plan.OnFailure.Next, placement)
+
+	case plan.OnSuccess != nil:
+		inheritPlacement(&plan.OnSuccess.Step, placement)
+		inheritPlacement(&plan.OnSuccess.Next, placement)
+
+	case plan.Ensure != nil:
+		inheritPlacement(&plan.Ensure.Step, placement)
+		inheritPlacement(&plan.Ensure.Next, placement)
+	}
+}
@@ -0,0 +1,217 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Across", func() {
+	var (
+		buildFactory factory.BuildFactory
+
+		resources           atc.ResourceConfigs
+		resourceTypes       atc.VersionedResourceTypes
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+
+		resources = atc.ResourceConfigs{
+			{
+				Name:   "some-resource",
+				Type:   "git",
+				Source: atc.Source{"uri": "git://some-resource"},
+			},
+		}
+	})
+
+	Context("when I have a step with a single across var", func() {
+		It("returns a plan with a step for each value, leaving the var to be set when it runs", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:           "some-task",
+						TaskConfigPath: "some-resource/task.yml",
+						TaskVars:       atc.Params{"go_version": "((go_version))"},
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:         "go_version",
+								Values:      []interface{}{"1.11", "1.12"},
+								MaxInFlight: 1,
+								FailFast:    true,
+							},
+						},
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:         "go_version",
+						Values:      []interface{}{"1.11", "1.12"},
+						MaxInFlight: 1,
+						FailFast:    true,
+					},
+				},
+				Steps: []atc.VarScopedPlan{
+					{
+						Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:       "some-task",
+							ConfigPath: "some-resource/task.yml",
+							Vars:       atc.Params{"go_version": "((go_version))"},
+						}),
+						Values: []interface{}{"1.11"},
+					},
+					{
+						Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:       "some-task",
+							ConfigPath: "some-resource/task.yml",
+							Vars:       atc.Params{"go_version": "((go_version))"},
+						}),
+						Values: []interface{}{"1.12"},
+					},
+				},
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when I have a step with multiple across vars", func() {
+		It("returns a plan with a step for each combination of values", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:           "some-task",
+						TaskConfigPath: "some-resource/task.yml",
+						TaskVars: atc.Params{
+							"go_version": "((go_version))",
+							"os":         "((os))",
+							"secret":     "((some-secret))",
+						},
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:    "go_version",
+								Values: []interface{}{"1.11", "1.12"},
+							},
+							{
+								Var:    "os",
+								Values: []interface{}{"linux", "windows"},
+							},
+						},
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			scopedTask := func(goVersion, os string) atc.VarScopedPlan {
+				return atc.VarScopedPlan{
+					Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:       "some-task",
+						ConfigPath: "some-resource/task.yml",
+						Vars: atc.Params{
+							"go_version": "((go_version))",
+							"os":         "((os))",
+							"secret":     "((some-secret))",
+						},
+					}),
+					Values: []interface{}{goVersion, os},
+				}
+			}
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:    "go_version",
+						Values: []interface{}{"1.11", "1.12"},
+					},
+					{
+						Var:    "os",
+						Values: []interface{}{"linux", "windows"},
+					},
+				},
+				Steps: []atc.VarScopedPlan{
+					scopedTask("1.11", "linux"),
+					scopedTask("1.11", "windows"),
+					scopedTask("1.12", "linux"),
+					scopedTask("1.12", "windows"),
+				},
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when I have hooks on a step with an across var", func() {
+		It("applies the hooks to each step", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Put:    "some-resource",
+						Params: atc.Params{"env": "((env))"},
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:    "env",
+								Values: []interface{}{"staging"},
+							},
+						},
+						Failure: &atc.PlanConfig{
+							Task:           "alert",
+							TaskConfigPath: "some-resource/alert.yml",
+							TaskVars:       atc.Params{"env": "((env))"},
+						},
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			putPlan := expectedPlanFactory.NewPlan(atc.PutPlan{
+				Name:     "some-resource",
+				Type:     "git",
+				Resource: "some-resource",
+				Source:   atc.Source{"uri": "git://some-resource"},
+				Params:   atc.Params{"env": "((env))"},
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:    "env",
+						Values: []interface{}{"staging"},
+					},
+				},
+				Steps: []atc.VarScopedPlan{
+					{
+						Step: expectedPlanFactory.NewPlan(atc.OnFailurePlan{
+							Step: expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
+								Step: putPlan,
+								Next: expectedPlanFactory.NewPlan(atc.GetPlan{
+									Name:        "some-resource",
+									Type:        "git",
+									Resource:    "some-resource",
+									Source:      atc.Source{"uri": "git://some-resource"},
+									VersionFrom: &putPlan.ID,
+								}),
+							}),
+							Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+								Name:       "alert",
+								ConfigPath: "some-resource/alert.yml",
+								Vars:       atc.Params{"env": "((env))"},
+							}),
+						}),
+						Values: []interface{}{"staging"},
+					},
+				},
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
@@ -0,0 +1,181 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Placement", func() {
+	var (
+		buildFactory factory.BuildFactory
+
+		resources           atc.ResourceConfigs
+		resourceTypes       atc.VersionedResourceTypes
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+
+		resources = atc.ResourceConfigs{
+			{
+				Name:   "some-resource",
+				Type:   "git",
+				Source: atc.Source{"uri": "git://some-resource"},
+			},
+		}
+
+		resourceTypes = atc.VersionedResourceTypes{}
+	})
+
+	Context("when a step specifies its placement", func() {
+		It("places the step accordingly", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:      "some-task",
+						Placement: []string{"volume-locality", "random"},
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:                   "some-task",
+				Placement:              []string{"volume-locality", "random"},
+				VersionedResourceTypes: resourceTypes,
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when a put step specifies its placement", func() {
+		It("places its dependent get the same way", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Put:       "some-resource",
+						Placement: []string{"least-loaded"},
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			putPlan := expectedPlanFactory.NewPlan(atc.PutPlan{
+				Type:                   "git",
+				Name:                   "some-resource",
+				Resource:               "some-resource",
+				Source:                 atc.Source{"uri": "git://some-resource"},
+				Placement:              []string{"least-loaded"},
+				VersionedResourceTypes: resourceTypes,
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
+				Step: putPlan,
+				Next: expectedPlanFactory.NewPlan(atc.GetPlan{
+					Type:                   "git",
+					Name:                   "some-resource",
+					Resource:               "some-resource",
+					Source:                 atc.Source{"uri": "git://some-resource"},
+					VersionFrom:            &putPlan.ID,
+					Placement:              []string{"least-loaded"},
+					VersionedResourceTypes: resourceTypes,
+				}),
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when the job specifies its placement", func() {
+		It("places the steps which do not specify their own", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Placement: []string{"fewest-build-containers"},
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+					},
+					{
+						Task:      "some-other-task",
+						Placement: []string{"random"},
+					},
+				},
+				Ensure: &atc.PlanConfig{
+					Task: "some-cleanup-task",
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.EnsurePlan{
+				Step: expectedPlanFactory.NewPlan(atc.DoPlan{
+					expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "some-task",
+						Placement:              []string{"fewest-build-containers"},
+						VersionedResourceTypes: resourceTypes,
+					}),
+					expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "some-other-task",
+						Placement:              []string{"random"},
+						VersionedResourceTypes: resourceTypes,
+					}),
+				}),
+				Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-cleanup-task",
+					Placement:              []string{"fewest-build-containers"},
+					VersionedResourceTypes: resourceTypes,
+				}),
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when a step containing other steps specifies its placement", func() {
+		It("places the steps within it", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Placement: []string{"least-loaded"},
+						InParallel: &atc.InParallelConfig{
+							Steps: atc.PlanSequence{
+								{
+									Task: "some-task",
+								},
+								{
+									Try: &atc.PlanConfig{
+										Task: "some-other-task",
+									},
+								},
+							},
+						},
+					},
+				},
+			}, resources, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.InParallelPlan{
+				Steps: []atc.Plan{
+					expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "some-task",
+						Placement:              []string{"least-loaded"},
+						VersionedResourceTypes: resourceTypes,
+					}),
+					expectedPlanFactory.NewPlan(atc.TryPlan{
+						Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+							Name:                   "some-other-task",
+							Placement:              []string{"least-loaded"},
+							VersionedResourceTypes: resourceTypes,
+						}),
+					}),
+				},
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
@@ -17,6 +17,30 @@ type InputMapper interface {
 		job db.Job,
 		resources db.Resources,
 	) (algorithm.InputMapping, error)
+
+	MapInputOverrides(
+		logger lager.Logger,
+		versions *algorithm.VersionsDB,
+		job db.Job,
+		resources db.Resources,
+		overrides atc.InputOverrides,
+	) (algorithm.InputMapping, bool, error)
+}
+
+//go:generate counterfeiter . InputMapperFactory
+
+type InputMapperFactory interface {
+	NewInputMapper(pipeline db.Pipeline) InputMapper
+}
+
+func NewInputMapperFactory() InputMapperFactory {
+	return inputMapperFactory{}
+}
+
+type inputMapperFactory struct{}
+
+func (inputMapperFactory) NewInputMapper(pipeline db.Pipeline) InputMapper {
+	return NewInputMapper(pipeline, inputconfig.NewTransformer(pipeline))
 }
 
 func NewInputMapper(pipeline db.Pipeline, transformer inputconfig.Transformer) InputMapper {
@@ -99,3 +123,70 @@ func (i *inputMapper) SaveNextInputMapping(
 
 	return resolvedMapping, nil
 }
+
+// MapInputOverrides resolves the inputs for a manually triggered build, using
+// the overridden versions in place of the latest ones. The overridden versions
+// must satisfy their inputs' passed constraints, unless they are ignored.
+//
+// Unlike SaveNextInputMapping, the mapping is not saved as the job's next
+// inputs, as it only applies to the one build.
+func (i *inputMapper) MapInputOverrides(
+	logger lager.Logger,
+	versions *algorithm.VersionsDB,
+	job db.Job,
+	resources db.Resources,
+	overrides atc.InputOverrides,
+) (algorithm.InputMapping, bool, error) {
+	logger = logger.Session("map-input-overrides")
+
+	inputConfigs := job.Config().Inputs()
+
+	for i, inputConfig := range inputConfigs {
+		version, overridden := overrides.Inputs[inputConfig.Name]
+		if overridden {
+			inputConfigs[i].Version = &atc.VersionConfig{Pinned: version}
+
+			if overrides.IgnorePassed {
+				inputConfigs[i].Passed = nil
+			}
+
+			continue
+		}
+
+		resource, found := resources.Lookup(inputConfig.Resource)
+		if !found {
+			logger.Debug("failed-to-find-resource")
+			continue
+		}
+
+		if resource.CurrentPinnedVersion() != nil {
+			inputConfigs[i].Version = &atc.VersionConfig{Pinned: resource.CurrentPinnedVersion()}
+		}
+	}
+
+	algorithmInputConfigs, err := i.transformer.TransformInputConfigs(versions, job.Name(), inputConfigs)
+	if err != nil {
+		logger.Error("failed-to-get-algorithm-input-configs", err)
+		return nil, false, err
+	}
+
+	// inputs are left out if their pinned version does not exist
+	if len(algorithmInputConfigs) < len(inputConfigs) {
+		return nil, false, nil
+	}
+
+	mapping, ok := algorithmInputConfigs.Resolve(versions)
+	if !ok {
+		return nil, false, nil
+	}
+
+	// an input with passed constraints which has only one candidate resolves
+	// to it, even if it is not the version the input is pinned to
+	for _, inputConfig := range algorithmInputConfigs {
+		if inputConfig.PinnedVersionID != 0 && mapping[inputConfig.Name].VersionID != inputConfig.PinnedVersionID {
+			return nil, false, nil
+		}
+	}
+
+	return mapping, true, nil
+}
@@ -467,4 +467,186 @@ var _ = Describe("Inputmapper", func() {
 			})
 		})
 	})
+
+	Describe("MapInputOverrides", func() {
+		var (
+			versionsDB   *algorithm.VersionsDB
+			fakeJob      *dbfakes.FakeJob
+			resources    db.Resources
+			overrides    atc.InputOverrides
+			inputMapping algorithm.InputMapping
+			mapped       bool
+			mappingErr   error
+		)
+
+		BeforeEach(func() {
+			versionsDB = &algorithm.VersionsDB{
+				JobIDs:      map[string]int{"some-job": 1, "upstream": 2},
+				ResourceIDs: map[string]int{"a": 11, "b": 12},
+				ResourceVersions: []algorithm.ResourceVersion{
+					{VersionID: 1, ResourceID: 11, CheckOrder: 1},
+					{VersionID: 2, ResourceID: 12, CheckOrder: 1},
+					{VersionID: 3, ResourceID: 11, CheckOrder: 2},
+				},
+				BuildOutputs: []algorithm.BuildOutput{
+					{
+						ResourceVersion: algorithm.ResourceVersion{VersionID: 1, ResourceID: 11, CheckOrder: 1},
+						BuildID:         98,
+						JobID:           2,
+					},
+				},
+			}
+
+			fakeJob = new(dbfakes.FakeJob)
+			fakeJob.NameReturns("some-job")
+			fakeJob.ConfigReturns(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{Get: "alias", Resource: "a", Passed: []string{"upstream"}},
+					{Get: "b"},
+				},
+			})
+
+			overrides = atc.InputOverrides{
+				Inputs: map[string]atc.Version{"alias": {"ref": "v3"}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			inputMapping, mapped, mappingErr = inputMapper.MapInputOverrides(
+				lagertest.NewTestLogger("test"),
+				versionsDB,
+				fakeJob,
+				resources,
+				overrides,
+			)
+		})
+
+		It("pins the overridden inputs to their versions", func() {
+			Expect(fakeTransformer.TransformInputConfigsCallCount()).To(Equal(1))
+			actualVersionsDB, actualJobName, actualJobInputs := fakeTransformer.TransformInputConfigsArgsForCall(0)
+			Expect(actualVersionsDB).To(Equal(versionsDB))
+			Expect(actualJobName).To(Equal("some-job"))
+			Expect(actualJobInputs).To(ConsistOf(
+				atc.JobInput{
+					Name:     "alias",
+					Resource: "a",
+					Passed:   []string{"upstream"},
+					Version:  &atc.VersionConfig{Pinned: atc.Version{"ref": "v3"}},
+				},
+				atc.JobInput{
+					Name:     "b",
+					Resource: "b",
+				},
+			))
+		})
+
+		It("does not save any input mapping for the job", func() {
+			Expect(fakeJob.SaveIndependentInputMappingCallCount()).To(BeZero())
+			Expect(fakeJob.SaveNextInputMappingCallCount()).To(BeZero())
+		})
+
+		Context("when passed constraints are ignored", func() {
+			BeforeEach(func() {
+				overrides.IgnorePassed = true
+			})
+
+			It("removes the passed constraints of the overridden inputs", func() {
+				Expect(fakeTransformer.TransformInputConfigsCallCount()).To(Equal(1))
+				_, _, actualJobInputs := fakeTransformer.TransformInputConfigsArgsForCall(0)
+				Expect(actualJobInputs).To(ConsistOf(
+					atc.JobInput{
+						Name:     "alias",
+						Resource: "a",
+						Version:  &atc.VersionConfig{Pinned: atc.Version{"ref": "v3"}},
+					},
+					atc.JobInput{
+						Name:     "b",
+						Resource: "b",
+					},
+				))
+			})
+		})
+
+		Context("when transforming the input configs fails", func() {
+			BeforeEach(func() {
+				fakeTransformer.TransformInputConfigsReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(mappingErr).To(Equal(disaster))
+			})
+		})
+
+		Context("when an overridden version does not exist", func() {
+			BeforeEach(func() {
+				fakeTransformer.TransformInputConfigsReturns(algorithm.InputConfigs{
+					{
+						Name:       "b",
+						ResourceID: 12,
+						Passed:     algorithm.JobSet{},
+						JobID:      1,
+					},
+				}, nil)
+			})
+
+			It("does not map the inputs", func() {
+				Expect(mappingErr).NotTo(HaveOccurred())
+				Expect(mapped).To(BeFalse())
+			})
+		})
+
+		Context("when the overridden version has not passed the upstream job", func() {
+			BeforeEach(func() {
+				fakeTransformer.TransformInputConfigsReturns(algorithm.InputConfigs{
+					{
+						Name:            "alias",
+						ResourceID:      11,
+						PinnedVersionID: 3,
+						Passed:          algorithm.JobSet{2: struct{}{}},
+						JobID:           1,
+					},
+					{
+						Name:       "b",
+						ResourceID: 12,
+						Passed:     algorithm.JobSet{},
+						JobID:      1,
+					},
+				}, nil)
+			})
+
+			It("does not map the inputs", func() {
+				Expect(mappingErr).NotTo(HaveOccurred())
+				Expect(mapped).To(BeFalse())
+			})
+		})
+
+		Context("when the overridden version satisfies the job's constraints", func() {
+			BeforeEach(func() {
+				fakeTransformer.TransformInputConfigsReturns(algorithm.InputConfigs{
+					{
+						Name:            "alias",
+						ResourceID:      11,
+						PinnedVersionID: 3,
+						Passed:          algorithm.JobSet{},
+						JobID:           1,
+					},
+					{
+						Name:       "b",
+						ResourceID: 12,
+						Passed:     algorithm.JobSet{},
+						JobID:      1,
+					},
+				}, nil)
+			})
+
+			It("returns the mapping", func() {
+				Expect(mappingErr).NotTo(HaveOccurred())
+				Expect(mapped).To(BeTrue())
+				Expect(inputMapping).To(Equal(algorithm.InputMapping{
+					"alias": algorithm.InputVersion{VersionID: 3, ResourceID: 11, FirstOccurrence: true},
+					"b":     algorithm.InputVersion{VersionID: 2, ResourceID: 12, FirstOccurrence: true},
+				}))
+			})
+		})
+	})
 })
@@ -5,12 +5,32 @@ import (
 	sync "sync"
 
 	lager "code.cloudfoundry.org/lager"
+	atc "github.com/concourse/concourse/atc"
 	db "github.com/concourse/concourse/atc/db"
 	algorithm "github.com/concourse/concourse/atc/db/algorithm"
 	inputmapper "github.com/concourse/concourse/atc/scheduler/inputmapper"
 )
 
 type FakeInputMapper struct {
+	MapInputOverridesStub        func(lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources, atc.InputOverrides) (algorithm.InputMapping, bool, error)
+	mapInputOverridesMutex       sync.RWMutex
+	mapInputOverridesArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 *algorithm.VersionsDB
+		arg3 db.Job
+		arg4 db.Resources
+		arg5 atc.InputOverrides
+	}
+	mapInputOverridesReturns struct {
+		result1 algorithm.InputMapping
+		result2 bool
+		result3 error
+	}
+	mapInputOverridesReturnsOnCall map[int]struct {
+		result1 algorithm.InputMapping
+		result2 bool
+		result3 error
+	}
 	SaveNextInputMappingStub        func(lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.InputMapping, error)
 	saveNextInputMappingMutex       sync.RWMutex
 	saveNextInputMappingArgsForCall []struct {
@@ -31,6 +51,76 @@ type FakeInputMapper struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeInputMapper) MapInputOverrides(arg1 lager.Logger, arg2 *algorithm.VersionsDB, arg3 db.Job, arg4 db.Resources, arg5 atc.InputOverrides) (algorithm.InputMapping, bool, error) {
+	fake.mapInputOverridesMutex.Lock()
+	ret, specificReturn := fake.mapInputOverridesReturnsOnCall[len(fake.mapInputOverridesArgsForCall)]
+	fake.mapInputOverridesArgsForCall = append(fake.mapInputOverridesArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 *algorithm.VersionsDB
+		arg3 db.Job
+		arg4 db.Resources
+		arg5 atc.InputOverrides
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("MapInputOverrides", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.mapInputOverridesMutex.Unlock()
+	if fake.MapInputOverridesStub != nil {
+		return fake.MapInputOverridesStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.mapInputOverridesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInputMapper) MapInputOverridesCallCount() int {
+	fake.mapInputOverridesMutex.RLock()
+	defer fake.mapInputOverridesMutex.RUnlock()
+	return len(fake.mapInputOverridesArgsForCall)
+}
+
+func (fake *FakeInputMapper) MapInputOverridesCalls(stub func(lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources, atc.InputOverrides) (algorithm.InputMapping, bool, error)) {
+	fake.mapInputOverridesMutex.Lock()
+	defer fake.mapInputOverridesMutex.Unlock()
+	fake.MapInputOverridesStub = stub
+}
+
+func (fake *FakeInputMapper) MapInputOverridesArgsForCall(i int) (lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources, atc.InputOverrides) {
+	fake.mapInputOverridesMutex.RLock()
+	defer fake.mapInputOverridesMutex.RUnlock()
+	argsForCall := fake.mapInputOverridesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInputMapper) MapInputOverridesReturns(result1 algorithm.InputMapping, result2 bool, result3 error) {
+	fake.mapInputOverridesMutex.Lock()
+	defer fake.mapInputOverridesMutex.Unlock()
+	fake.MapInputOverridesStub = nil
+	fake.mapInputOverridesReturns = struct {
+		result1 algorithm.InputMapping
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInputMapper) MapInputOverridesReturnsOnCall(i int, result1 algorithm.InputMapping, result2 bool, result3 error) {
+	fake.mapInputOverridesMutex.Lock()
+	defer fake.mapInputOverridesMutex.Unlock()
+	fake.MapInputOverridesStub = nil
+	if fake.mapInputOverridesReturnsOnCall == nil {
+		fake.mapInputOverridesReturnsOnCall = make(map[int]struct {
+			result1 algorithm.InputMapping
+			result2 bool
+			result3 error
+		})
+	}
+	fake.mapInputOverridesReturnsOnCall[i] = struct {
+		result1 algorithm.InputMapping
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInputMapper) SaveNextInputMapping(arg1 lager.Logger, arg2 *algorithm.VersionsDB, arg3 db.Job, arg4 db.Resources) (algorithm.InputMapping, error) {
 	fake.saveNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveNextInputMappingReturnsOnCall[len(fake.saveNextInputMappingArgsForCall)]
@@ -100,6 +190,8 @@ func (fake *FakeInputMapper) SaveNextInputMappingReturnsOnCall(i int, result1 al
 func (fake *FakeInputMapper) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.mapInputOverridesMutex.RLock()
+	defer fake.mapInputOverridesMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -1,9 +1,60 @@
 package atc
 
+import "fmt"
+
+// The roles a team's users and groups can be given, from the most to the
+// least privileged. Each role is permitted every action of the roles below
+// it.
+const (
+	OwnerRole    = "owner"
+	MemberRole   = "member"
+	OperatorRole = "operator"
+	ViewerRole   = "viewer"
+)
+
+var ValidRoles = []string{OwnerRole, MemberRole, OperatorRole, ViewerRole}
+
 type Team struct {
-	ID   int      `json:"id,omitempty"`
-	Name string   `json:"name,omitempty"`
-	Auth TeamAuth `json:"auth,omitempty"`
+	ID     int         `json:"id,omitempty"`
+	Name   string      `json:"name,omitempty"`
+	Auth   TeamAuth    `json:"auth,omitempty"`
+	Quotas *TeamQuotas `json:"quotas,omitempty"`
+
+	// CredentialManagers are the names of the credential managers the team's
+	// credentials are looked up in. If empty, all of them are used.
+	CredentialManagers []string `json:"credential_managers,omitempty"`
+}
+
+// TeamQuotas limit how much of the cluster a team can use at once. A zero
+// value means there is no limit.
+//
+// There is deliberately no quota on volume disk usage: baggageclaim does not
+// report the size of volumes, so the ATC has no way to measure it per team.
+type TeamQuotas struct {
+	MaxRunningBuilds int `json:"max_running_builds,omitempty"`
+	MaxContainers    int `json:"max_containers,omitempty"`
 }
 
 type TeamAuth map[string]map[string][]string
+
+// Validate returns an error if users or groups are given a role which does
+// not exist.
+func (auth TeamAuth) Validate() error {
+	for role := range auth {
+		if !IsValidRole(role) {
+			return fmt.Errorf("unknown role: %s", role)
+		}
+	}
+
+	return nil
+}
+
+func IsValidRole(role string) bool {
+	for _, validRole := range ValidRoles {
+		if role == validRole {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,80 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type Config struct {
+	ServiceName string `long:"tracing-service-name" default:"concourse-web" description:"Service name to attach to traces."`
+
+	OTLP struct {
+		Address string            `long:"tracing-otlp-address" description:"OTLP/HTTP collector address to export traces to (e.g. otel-collector:4318)."`
+		Headers map[string]string `long:"tracing-otlp-header"  description:"A header to send with each export request. Can be specified multiple times." value-name:"NAME:VALUE"`
+		UseTLS  bool              `long:"tracing-otlp-use-tls" description:"Connect to the OTLP/HTTP collector over TLS."`
+	}
+
+	Jaeger struct {
+		Endpoint string `long:"tracing-jaeger-endpoint" description:"Jaeger collector endpoint to export traces to (e.g. http://jaeger:14268/api/traces)."`
+	}
+}
+
+// Prepare configures the global tracer provider to export spans, if an
+// exporter has been configured.
+func (config Config) Prepare() error {
+	exporter, err := config.exporter()
+	if err != nil {
+		return err
+	}
+
+	if exporter == nil {
+		return nil
+	}
+
+	ConfigureTraceProvider(sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", config.ServiceName),
+		)),
+	))
+
+	return nil
+}
+
+// IsConfigured returns true if spans will be exported.
+func (config Config) IsConfigured() bool {
+	return config.OTLP.Address != "" || config.Jaeger.Endpoint != ""
+}
+
+func (config Config) exporter() (sdktrace.SpanExporter, error) {
+	if config.OTLP.Address != "" && config.Jaeger.Endpoint != "" {
+		return nil, errors.New("only one of --tracing-otlp-address or --tracing-jaeger-endpoint may be specified")
+	}
+
+	if config.OTLP.Address != "" {
+		options := []otlptracehttp.Option{
+			otlptracehttp.WithEndpoint(config.OTLP.Address),
+			otlptracehttp.WithHeaders(config.OTLP.Headers),
+		}
+
+		if !config.OTLP.UseTLS {
+			options = append(options, otlptracehttp.WithInsecure())
+		}
+
+		return otlptracehttp.New(context.Background(), options...)
+	}
+
+	if config.Jaeger.Endpoint != "" {
+		return jaeger.New(jaeger.WithCollectorEndpoint(
+			jaeger.WithEndpoint(config.Jaeger.Endpoint),
+		))
+	}
+
+	return nil, nil
+}
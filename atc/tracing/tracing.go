@@ -0,0 +1,88 @@
+// Package tracing emits OpenTelemetry spans for builds, steps, worker calls,
+// database queries and API requests.
+//
+// Spans are recorded by the global tracer provider, which records nothing
+// until Config.Prepare configures an exporter.
+package tracing
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/concourse/concourse/atc"
+
+// Attrs are attached to a span to describe what it covers.
+type Attrs map[string]string
+
+// Propagator carries trace context across process boundaries, e.g. in the
+// headers of an API request or the env of a task's container.
+var Propagator propagation.TextMapPropagator = propagation.TraceContext{}
+
+// ConfigureTraceProvider sets the provider used to record spans.
+func ConfigureTraceProvider(provider trace.TracerProvider) {
+	otel.SetTracerProvider(provider)
+}
+
+// StartSpan starts a span named after the given component as a child of the
+// span in ctx, if any. The span must be ended with End.
+func StartSpan(ctx context.Context, component string, attrs Attrs) (context.Context, trace.Span) {
+	return tracer().Start(ctx, component, trace.WithAttributes(attrs.keyValues()...))
+}
+
+// RecordSpan records a span which has already happened, such as the time a
+// build spent waiting to be scheduled.
+func RecordSpan(ctx context.Context, component string, attrs Attrs, start time.Time, end time.Time) {
+	_, span := tracer().Start(
+		ctx,
+		component,
+		trace.WithAttributes(attrs.keyValues()...),
+		trace.WithTimestamp(start),
+	)
+
+	span.End(trace.WithTimestamp(end))
+}
+
+// End ends the span, marking it as failed if err is not nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// Env returns the trace context of ctx as env vars (e.g. TRACEPARENT), so
+// that processes run in containers can continue the trace.
+func Env(ctx context.Context) []string {
+	carrier := propagation.MapCarrier{}
+	Propagator.Inject(ctx, carrier)
+
+	env := []string{}
+	for _, key := range carrier.Keys() {
+		env = append(env, strings.ToUpper(key)+"="+carrier.Get(key))
+	}
+
+	return env
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+func (attrs Attrs) keyValues() []attribute.KeyValue {
+	keyValues := []attribute.KeyValue{}
+	for key, value := range attrs {
+		keyValues = append(keyValues, attribute.String(key, value))
+	}
+
+	return keyValues
+}
@@ -0,0 +1,100 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc/tracing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ = Describe("Tracing", func() {
+	var recorder *tracetest.SpanRecorder
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		tracing.ConfigureTraceProvider(sdktrace.NewTracerProvider(
+			sdktrace.WithSpanProcessor(recorder),
+		))
+	})
+
+	AfterEach(func() {
+		tracing.ConfigureTraceProvider(trace.NewNoopTracerProvider())
+	})
+
+	Describe("StartSpan", func() {
+		It("starts a span with the attributes", func() {
+			_, span := tracing.StartSpan(context.Background(), "some-component", tracing.Attrs{
+				"some": "attr",
+			})
+			tracing.End(span, nil)
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name()).To(Equal("some-component"))
+			Expect(spans[0].Attributes()).To(ConsistOf(attribute.String("some", "attr")))
+			Expect(spans[0].Status().Code).To(Equal(codes.Unset))
+		})
+
+		It("starts the span as a child of the span in the context", func() {
+			ctx, parent := tracing.StartSpan(context.Background(), "parent", nil)
+			_, child := tracing.StartSpan(ctx, "child", nil)
+			tracing.End(child, nil)
+			tracing.End(parent, nil)
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(2))
+			Expect(spans[0].Parent().SpanID()).To(Equal(spans[1].SpanContext().SpanID()))
+		})
+	})
+
+	Describe("End", func() {
+		It("marks the span as failed when given an error", func() {
+			_, span := tracing.StartSpan(context.Background(), "some-component", nil)
+			tracing.End(span, errors.New("nope"))
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Status().Code).To(Equal(codes.Error))
+			Expect(spans[0].Status().Description).To(Equal("nope"))
+		})
+	})
+
+	Describe("RecordSpan", func() {
+		It("records a span with the given start and end", func() {
+			start := time.Now().Add(-time.Minute)
+			end := start.Add(30 * time.Second)
+
+			tracing.RecordSpan(context.Background(), "pending", nil, start, end)
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].StartTime()).To(BeTemporally("==", start))
+			Expect(spans[0].EndTime()).To(BeTemporally("==", end))
+		})
+	})
+
+	Describe("Env", func() {
+		It("returns the trace context as env vars", func() {
+			ctx, span := tracing.StartSpan(context.Background(), "some-component", nil)
+			defer tracing.End(span, nil)
+
+			env := tracing.Env(ctx)
+			Expect(env).To(HaveLen(1))
+			Expect(strings.HasPrefix(env[0], "TRACEPARENT=")).To(BeTrue())
+			Expect(env[0]).To(ContainSubstring(span.SpanContext().TraceID().String()))
+		})
+
+		It("returns nothing without a span", func() {
+			Expect(tracing.Env(context.Background())).To(BeEmpty())
+		})
+	})
+})
@@ -43,6 +43,11 @@ func (c Config) Validate() ([]ConfigWarning, []string) {
 		errorMessages = append(errorMessages, formatErr("resource types", resourceTypesErr))
 	}
 
+	varSourcesErr := validateVarSources(c)
+	if varSourcesErr != nil {
+		errorMessages = append(errorMessages, formatErr("var sources", varSourcesErr))
+	}
+
 	jobWarnings, jobsErr := validateJobs(c)
 	if jobsErr != nil {
 		errorMessages = append(errorMessages, formatErr("jobs", jobsErr))
@@ -120,6 +125,10 @@ func validateResources(c Config) error {
 		if resource.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		if resource.Webhook != nil {
+			errorMessages = append(errorMessages, validateWebhook(identifier, *resource.Webhook)...)
+		}
 	}
 
 	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
@@ -127,6 +136,32 @@ func validateResources(c Config) error {
 	return compositeErr(errorMessages)
 }
 
+func validateWebhook(identifier string, webhook WebhookConfig) []string {
+	errorMessages := []string{}
+
+	switch webhook.Provider {
+	case WebhookProviderGitHub, WebhookProviderGitLab, WebhookProviderBitbucket:
+	case WebhookProviderHMAC:
+		if len(webhook.Events) != 0 || len(webhook.Branches) != 0 {
+			errorMessages = append(errorMessages, identifier+".webhook cannot filter events or branches with the hmac provider")
+		}
+	case "":
+		errorMessages = append(errorMessages, identifier+".webhook has no provider")
+	default:
+		errorMessages = append(errorMessages, fmt.Sprintf("%s.webhook has unknown provider '%s'", identifier, webhook.Provider))
+	}
+
+	if webhook.Secret == "" {
+		errorMessages = append(errorMessages, identifier+".webhook has no secret")
+	}
+
+	if webhook.Header != "" && webhook.Provider != WebhookProviderHMAC {
+		errorMessages = append(errorMessages, identifier+".webhook can only set a header with the hmac provider")
+	}
+
+	return errorMessages
+}
+
 func validateResourceTypes(c Config) error {
 	errorMessages := []string{}
 
@@ -161,6 +196,42 @@ func validateResourceTypes(c Config) error {
 	return compositeErr(errorMessages)
 }
 
+func validateVarSources(c Config) error {
+	errorMessages := []string{}
+
+	names := map[string]int{}
+
+	for i, source := range c.VarSources {
+		var identifier string
+		if source.Name == "" {
+			identifier = fmt.Sprintf("var_sources[%d]", i)
+		} else {
+			identifier = fmt.Sprintf("var_sources.%s", source.Name)
+		}
+
+		if other, exists := names[source.Name]; exists {
+			errorMessages = append(errorMessages,
+				fmt.Sprintf(
+					"var_sources[%d] and var_sources[%d] have the same name ('%s')",
+					other, i, source.Name))
+		} else if source.Name != "" {
+			names[source.Name] = i
+		}
+
+		if source.Name == "" {
+			errorMessages = append(errorMessages, identifier+" has no name")
+		} else if strings.Contains(source.Name, ":") {
+			errorMessages = append(errorMessages, identifier+" has a name containing ':'")
+		}
+
+		if source.Type == "" {
+			errorMessages = append(errorMessages, identifier+" has no type")
+		}
+	}
+
+	return compositeErr(errorMessages)
+}
+
 func validateResourcesUnused(c Config) []string {
 	usedResources := usedResources(c)
 
@@ -224,6 +295,8 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 			)
 		}
 
+		errorMessages = append(errorMessages, validatePlacement(identifier, job.Placement)...)
+
 		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", PlanConfig{Do: &job.Plan})
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
@@ -319,6 +392,14 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		foundTypes.Find("task")
 	}
 
+	if plan.SetPipeline != "" {
+		foundTypes.Find("set_pipeline")
+	}
+
+	if plan.LoadVar != "" {
+		foundTypes.Find("load_var")
+	}
+
 	if plan.Do != nil {
 		foundTypes.Find("do")
 	}
@@ -327,6 +408,10 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		foundTypes.Find("aggregate")
 	}
 
+	if plan.InParallel != nil {
+		foundTypes.Find("in_parallel")
+	}
+
 	if plan.Try != nil {
 		foundTypes.Find("try")
 	}
@@ -355,6 +440,21 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 			errorMessages = append(errorMessages, planErrMessages...)
 		}
 
+	case plan.InParallel != nil:
+		if plan.InParallel.Limit < 0 {
+			errorMessages = append(
+				errorMessages,
+				fmt.Sprintf("%s.in_parallel.limit must be a non-negative integer", identifier),
+			)
+		}
+
+		for i, plan := range plan.InParallel.Steps {
+			subIdentifier := fmt.Sprintf("%s.in_parallel.steps[%d]", identifier, i)
+			planWarnings, planErrMessages := validatePlan(c, subIdentifier, plan)
+			warnings = append(warnings, planWarnings...)
+			errorMessages = append(errorMessages, planErrMessages...)
+		}
+
 	case plan.Get != "":
 		identifier = fmt.Sprintf("%s.get.%s", identifier, plan.Get)
 
@@ -495,6 +595,36 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 			plan, identifier)...,
 		)
 
+	case plan.SetPipeline != "":
+		identifier = fmt.Sprintf("%s.set_pipeline.%s", identifier, plan.SetPipeline)
+
+		if plan.TaskConfigPath == "" {
+			errorMessages = append(errorMessages, identifier+" does not specify any pipeline configuration file")
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config"},
+			plan, identifier)...,
+		)
+
+	case plan.LoadVar != "":
+		identifier = fmt.Sprintf("%s.load_var.%s", identifier, plan.LoadVar)
+
+		if plan.TaskConfigPath == "" {
+			errorMessages = append(errorMessages, identifier+" does not specify any file to load")
+		}
+
+		switch plan.Format {
+		case "", LoadVarFormatRaw, LoadVarFormatTrim, LoadVarFormatJSON, LoadVarFormatYAML:
+		default:
+			errorMessages = append(errorMessages, fmt.Sprintf("%s has an unknown format '%s'", identifier, plan.Format))
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config"},
+			plan, identifier)...,
+		)
+
 	case plan.Try != nil:
 		subIdentifier := fmt.Sprintf("%s.try", identifier)
 		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Try)
@@ -543,9 +673,49 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
 	}
 
+	errorMessages = append(errorMessages, validatePlacement(identifier, plan.Placement)...)
+
+	acrossVars := map[string]bool{}
+	for i, acrossVar := range plan.Across {
+		subIdentifier := fmt.Sprintf("%s.across[%d]", identifier, i)
+
+		if acrossVar.Var == "" {
+			errorMessages = append(errorMessages, subIdentifier+" has no var specified")
+		} else if acrossVars[acrossVar.Var] {
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" repeats var '%s'", acrossVar.Var))
+		}
+
+		acrossVars[acrossVar.Var] = true
+
+		if acrossVar.MaxInFlight < 0 {
+			errorMessages = append(errorMessages, subIdentifier+".max_in_flight must be a non-negative integer")
+		}
+	}
+
 	return warnings, errorMessages
 }
 
+func validatePlacement(identifier string, placement []string) []string {
+	errorMessages := []string{}
+
+	for i, strategy := range placement {
+		known := false
+		for _, name := range ContainerPlacementStrategies {
+			if strategy == name {
+				known = true
+				break
+			}
+		}
+
+		if !known {
+			subIdentifier := fmt.Sprintf("%s.placement[%d]", identifier, i)
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" refers to an unknown placement strategy ('%s')", strategy))
+		}
+	}
+
+	return errorMessages
+}
+
 func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, identifier string) []string {
 	errorMessages := []string{}
 	foundInapplicableFields := []string{}
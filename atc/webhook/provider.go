@@ -0,0 +1,242 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// DefaultHMACHeader carries the signature for the generic hmac provider when
+// the webhook does not configure a header.
+const DefaultHMACHeader = "X-Signature"
+
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// A Delivery is what a provider reveals about a request made to a webhook.
+type Delivery struct {
+	Event    string
+	Branches []string
+}
+
+// A Provider verifies that requests were made by a webhook provider, and
+// parses what they are for.
+type Provider interface {
+	// Verify returns ErrInvalidSignature if the request was not signed with
+	// the secret.
+	Verify(header http.Header, body []byte, secret string) error
+
+	Parse(header http.Header, body []byte) (Delivery, error)
+}
+
+func NewProvider(config atc.WebhookConfig) (Provider, error) {
+	switch config.Provider {
+	case atc.WebhookProviderGitHub:
+		return gitHubProvider{}, nil
+	case atc.WebhookProviderGitLab:
+		return gitLabProvider{}, nil
+	case atc.WebhookProviderBitbucket:
+		return bitbucketProvider{}, nil
+	case atc.WebhookProviderHMAC:
+		header := config.Header
+		if header == "" {
+			header = DefaultHMACHeader
+		}
+
+		return hmacProvider{header: header}, nil
+	}
+
+	return nil, fmt.Errorf("unknown webhook provider '%s'", config.Provider)
+}
+
+// Triggers returns whether the delivery passes the webhook's filters, and if
+// not, why.
+func Triggers(config atc.WebhookConfig, delivery Delivery) (bool, string) {
+	if len(config.Events) != 0 && !contains(config.Events, delivery.Event) {
+		return false, fmt.Sprintf("event '%s' is not one of %s", delivery.Event, strings.Join(config.Events, ", "))
+	}
+
+	if len(config.Branches) != 0 {
+		for _, branch := range delivery.Branches {
+			if contains(config.Branches, branch) {
+				return true, ""
+			}
+		}
+
+		return false, fmt.Sprintf("branch is not one of %s", strings.Join(config.Branches, ", "))
+	}
+
+	return true, ""
+}
+
+type gitHubProvider struct{}
+
+func (gitHubProvider) Verify(header http.Header, body []byte, secret string) error {
+	return verifyHMAC(header.Get("X-Hub-Signature-256"), body, secret)
+}
+
+func (gitHubProvider) Parse(header http.Header, body []byte) (Delivery, error) {
+	var payload struct {
+		Ref string `json:"ref"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	return Delivery{
+		Event:    header.Get("X-GitHub-Event"),
+		Branches: branchFromRef(payload.Ref),
+	}, nil
+}
+
+type gitLabProvider struct{}
+
+func (gitLabProvider) Verify(header http.Header, body []byte, secret string) error {
+	if subtle.ConstantTimeCompare([]byte(header.Get("X-Gitlab-Token")), []byte(secret)) != 1 {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func (gitLabProvider) Parse(header http.Header, body []byte) (Delivery, error) {
+	var payload struct {
+		Ref              string `json:"ref"`
+		ObjectAttributes struct {
+			TargetBranch string `json:"target_branch"`
+		} `json:"object_attributes"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	branches := branchFromRef(payload.Ref)
+	if payload.ObjectAttributes.TargetBranch != "" {
+		branches = append(branches, payload.ObjectAttributes.TargetBranch)
+	}
+
+	return Delivery{
+		Event:    header.Get("X-Gitlab-Event"),
+		Branches: branches,
+	}, nil
+}
+
+// bitbucketProvider handles both Bitbucket Cloud and Bitbucket Server, which
+// sign requests the same way but describe pushes differently.
+type bitbucketProvider struct{}
+
+func (bitbucketProvider) Verify(header http.Header, body []byte, secret string) error {
+	return verifyHMAC(header.Get("X-Hub-Signature"), body, secret)
+}
+
+func (bitbucketProvider) Parse(header http.Header, body []byte) (Delivery, error) {
+	var payload struct {
+		// Bitbucket Cloud
+		Push struct {
+			Changes []struct {
+				New *struct {
+					Type string `json:"type"`
+					Name string `json:"name"`
+				} `json:"new"`
+			} `json:"changes"`
+		} `json:"push"`
+
+		// Bitbucket Server
+		Changes []struct {
+			Ref struct {
+				Type      string `json:"type"`
+				DisplayID string `json:"displayId"`
+			} `json:"ref"`
+		} `json:"changes"`
+	}
+
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	var branches []string
+	for _, change := range payload.Push.Changes {
+		if change.New != nil && change.New.Type == "branch" {
+			branches = append(branches, change.New.Name)
+		}
+	}
+
+	for _, change := range payload.Changes {
+		if change.Ref.Type == "BRANCH" {
+			branches = append(branches, change.Ref.DisplayID)
+		}
+	}
+
+	return Delivery{
+		Event:    header.Get("X-Event-Key"),
+		Branches: branches,
+	}, nil
+}
+
+type hmacProvider struct {
+	header string
+}
+
+func (provider hmacProvider) Verify(header http.Header, body []byte, secret string) error {
+	signature := header.Get(provider.header)
+	if !strings.HasPrefix(signature, "sha256=") {
+		signature = "sha256=" + signature
+	}
+
+	return verifyHMAC(signature, body, secret)
+}
+
+func (hmacProvider) Parse(http.Header, []byte) (Delivery, error) {
+	return Delivery{}, nil
+}
+
+// verifyHMAC checks a signature of the form sha256=<hex digest>.
+func verifyHMAC(signature string, body []byte, secret string) error {
+	if !strings.HasPrefix(signature, "sha256=") {
+		return ErrInvalidSignature
+	}
+
+	actual, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	if !hmac.Equal(actual, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func branchFromRef(ref string) []string {
+	if !strings.HasPrefix(ref, "refs/heads/") {
+		return nil
+	}
+
+	return []string{strings.TrimPrefix(ref, "refs/heads/")}
+}
+
+func contains(list []string, item string) bool {
+	for _, i := range list {
+		if i == item {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,196 @@
+package webhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/webhook"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func sign(body []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+var _ = Describe("Provider", func() {
+	var (
+		config   atc.WebhookConfig
+		provider webhook.Provider
+		header   http.Header
+		body     []byte
+	)
+
+	BeforeEach(func() {
+		header = http.Header{}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		provider, err = webhook.NewProvider(config)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("github", func() {
+		BeforeEach(func() {
+			config = atc.WebhookConfig{Provider: "github"}
+			body = []byte(`{"ref":"refs/heads/master"}`)
+			header.Set("X-GitHub-Event", "push")
+		})
+
+		It("verifies the HMAC-SHA256 signature", func() {
+			header.Set("X-Hub-Signature-256", sign(body, "some-secret"))
+			Expect(provider.Verify(header, body, "some-secret")).To(Succeed())
+			Expect(provider.Verify(header, body, "wrong-secret")).To(Equal(webhook.ErrInvalidSignature))
+		})
+
+		It("rejects a request without a signature", func() {
+			Expect(provider.Verify(header, body, "some-secret")).To(Equal(webhook.ErrInvalidSignature))
+		})
+
+		It("parses the event and branch", func() {
+			delivery, err := provider.Parse(header, body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delivery).To(Equal(webhook.Delivery{
+				Event:    "push",
+				Branches: []string{"master"},
+			}))
+		})
+
+		It("does not treat tags as branches", func() {
+			delivery, err := provider.Parse(header, []byte(`{"ref":"refs/tags/v1.0.0"}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delivery.Branches).To(BeEmpty())
+		})
+	})
+
+	Describe("gitlab", func() {
+		BeforeEach(func() {
+			config = atc.WebhookConfig{Provider: "gitlab"}
+			body = []byte(`{"object_attributes":{"target_branch":"develop"}}`)
+			header.Set("X-Gitlab-Event", "Merge Request Hook")
+		})
+
+		It("verifies the token header", func() {
+			header.Set("X-Gitlab-Token", "some-secret")
+			Expect(provider.Verify(header, body, "some-secret")).To(Succeed())
+			Expect(provider.Verify(header, body, "wrong-secret")).To(Equal(webhook.ErrInvalidSignature))
+		})
+
+		It("parses the event and target branch", func() {
+			delivery, err := provider.Parse(header, body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delivery).To(Equal(webhook.Delivery{
+				Event:    "Merge Request Hook",
+				Branches: []string{"develop"},
+			}))
+		})
+	})
+
+	Describe("bitbucket", func() {
+		BeforeEach(func() {
+			config = atc.WebhookConfig{Provider: "bitbucket"}
+			header.Set("X-Event-Key", "repo:push")
+		})
+
+		It("verifies the HMAC-SHA256 signature", func() {
+			body = []byte(`{}`)
+			header.Set("X-Hub-Signature", sign(body, "some-secret"))
+			Expect(provider.Verify(header, body, "some-secret")).To(Succeed())
+			Expect(provider.Verify(header, body, "wrong-secret")).To(Equal(webhook.ErrInvalidSignature))
+		})
+
+		It("parses the branches pushed to Bitbucket Cloud", func() {
+			delivery, err := provider.Parse(header, []byte(`{"push":{"changes":[{"new":{"type":"branch","name":"master"}},{"new":{"type":"tag","name":"v1"}},{"new":null}]}}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delivery).To(Equal(webhook.Delivery{
+				Event:    "repo:push",
+				Branches: []string{"master"},
+			}))
+		})
+
+		It("parses the branches pushed to Bitbucket Server", func() {
+			delivery, err := provider.Parse(header, []byte(`{"changes":[{"ref":{"type":"BRANCH","displayId":"master"}},{"ref":{"type":"TAG","displayId":"v1"}}]}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(delivery.Branches).To(Equal([]string{"master"}))
+		})
+	})
+
+	Describe("hmac", func() {
+		BeforeEach(func() {
+			config = atc.WebhookConfig{Provider: "hmac"}
+			body = []byte("anything")
+		})
+
+		It("verifies the signature in the default header, with or without a prefix", func() {
+			header.Set("X-Signature", sign(body, "some-secret"))
+			Expect(provider.Verify(header, body, "some-secret")).To(Succeed())
+
+			header.Set("X-Signature", sign(body, "some-secret")[len("sha256="):])
+			Expect(provider.Verify(header, body, "some-secret")).To(Succeed())
+
+			Expect(provider.Verify(header, body, "wrong-secret")).To(Equal(webhook.ErrInvalidSignature))
+		})
+
+		Context("when a header is configured", func() {
+			BeforeEach(func() {
+				config.Header = "X-Custom-Signature"
+			})
+
+			It("verifies the signature in that header", func() {
+				header.Set("X-Custom-Signature", sign(body, "some-secret"))
+				Expect(provider.Verify(header, body, "some-secret")).To(Succeed())
+			})
+		})
+	})
+})
+
+var _ = Describe("Triggers", func() {
+	var config atc.WebhookConfig
+
+	BeforeEach(func() {
+		config = atc.WebhookConfig{Provider: "github"}
+	})
+
+	It("triggers every delivery without filters", func() {
+		triggers, _ := webhook.Triggers(config, webhook.Delivery{Event: "push"})
+		Expect(triggers).To(BeTrue())
+	})
+
+	Context("when filtering events", func() {
+		BeforeEach(func() {
+			config.Events = []string{"push", "create"}
+		})
+
+		It("only triggers the given events", func() {
+			triggers, _ := webhook.Triggers(config, webhook.Delivery{Event: "create"})
+			Expect(triggers).To(BeTrue())
+
+			triggers, reason := webhook.Triggers(config, webhook.Delivery{Event: "issues"})
+			Expect(triggers).To(BeFalse())
+			Expect(reason).To(Equal("event 'issues' is not one of push, create"))
+		})
+	})
+
+	Context("when filtering branches", func() {
+		BeforeEach(func() {
+			config.Branches = []string{"master"}
+		})
+
+		It("only triggers deliveries for the given branches", func() {
+			triggers, _ := webhook.Triggers(config, webhook.Delivery{Branches: []string{"feature", "master"}})
+			Expect(triggers).To(BeTrue())
+
+			triggers, _ = webhook.Triggers(config, webhook.Delivery{Branches: []string{"feature"}})
+			Expect(triggers).To(BeFalse())
+
+			triggers, _ = webhook.Triggers(config, webhook.Delivery{})
+			Expect(triggers).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,19 @@
+package atc
+
+const (
+	WebhookDeliveryAccepted = "accepted"
+	WebhookDeliveryIgnored  = "ignored"
+	WebhookDeliveryRejected = "rejected"
+)
+
+// WebhookDelivery records a request made to a resource's webhook, and
+// whether it triggered a check.
+type WebhookDelivery struct {
+	ID         int    `json:"id"`
+	ReceivedAt int64  `json:"received_at"`
+	Provider   string `json:"provider,omitempty"`
+	Event      string `json:"event,omitempty"`
+	Branch     string `json:"branch,omitempty"`
+	Status     string `json:"status"`
+	Message    string `json:"message,omitempty"`
+}
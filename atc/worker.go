@@ -2,6 +2,7 @@ package atc
 
 import (
 	"errors"
+	"math"
 	"regexp"
 )
 
@@ -19,6 +20,8 @@ type Worker struct {
 	ActiveContainers int `json:"active_containers"`
 	ActiveVolumes    int `json:"active_volumes"`
 
+	Resources *WorkerResources `json:"resources,omitempty"`
+
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
 	Platform  string   `json:"platform"`
@@ -29,6 +32,29 @@ type Worker struct {
 	StartTime int64    `json:"start_time"`
 	Ephemeral bool     `json:"ephemeral"`
 	State     string   `json:"state"`
+
+	DrainDeadline           int64 `json:"drain_deadline,omitempty"`
+	AbortAfterDrainDeadline bool  `json:"abort_after_drain_deadline,omitempty"`
+
+	Cordoned          bool               `json:"cordoned,omitempty"`
+	MaintenanceWindow *MaintenanceWindow `json:"maintenance_window,omitempty"`
+}
+
+// A MaintenanceWindow is a period, in Unix time, during which a worker is
+// cordoned.
+type MaintenanceWindow struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+}
+
+// ContainerPlacementStrategies are the strategies which may be chained to
+// choose the worker a container is placed on.
+var ContainerPlacementStrategies = []string{
+	"volume-locality",
+	"random",
+	"fewest-build-containers",
+	"limit-active-tasks",
+	"least-loaded",
 }
 
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
@@ -47,6 +73,52 @@ func (w Worker) Validate() error {
 	return nil
 }
 
+// WorkerResources describes a worker's capacity and how much of it is used by
+// its containers, as measured when it last heartbeated.
+type WorkerResources struct {
+	CPUs int `json:"cpus"`
+
+	// CPUUsage is the number of CPUs' worth of time used since the previous
+	// heartbeat.
+	CPUUsage float64 `json:"cpu_usage"`
+
+	MemoryInBytes     uint64 `json:"memory_in_bytes"`
+	MemoryUsedInBytes uint64 `json:"memory_used_in_bytes"`
+
+	DiskInBytes     uint64 `json:"disk_in_bytes"`
+	DiskUsedInBytes uint64 `json:"disk_used_in_bytes"`
+}
+
+func (r WorkerResources) CPUPressure() float64 {
+	if r.CPUs == 0 {
+		return 0
+	}
+
+	return r.CPUUsage / float64(r.CPUs)
+}
+
+func (r WorkerResources) MemoryPressure() float64 {
+	if r.MemoryInBytes == 0 {
+		return 0
+	}
+
+	return float64(r.MemoryUsedInBytes) / float64(r.MemoryInBytes)
+}
+
+func (r WorkerResources) DiskPressure() float64 {
+	if r.DiskInBytes == 0 {
+		return 0
+	}
+
+	return float64(r.DiskUsedInBytes) / float64(r.DiskInBytes)
+}
+
+// Pressure is the highest of the worker's CPU, memory and disk pressure, each
+// being the fraction of the resource in use.
+func (r WorkerResources) Pressure() float64 {
+	return math.Max(r.CPUPressure(), math.Max(r.MemoryPressure(), r.DiskPressure()))
+}
+
 type WorkerResourceType struct {
 	Type                 string `json:"type"`
 	Image                string `json:"image"`
@@ -13,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 const creatingContainerRetryDelay = 1 * time.Second
@@ -103,6 +104,8 @@ func (p *containerProvider) FindOrCreateContainer(
 		err               error
 	)
 
+	gardenClient := traceGardenClient(ctx, p.gardenClient)
+
 	for {
 		creatingContainer, createdContainer, err = p.worker.FindContainerOnWorker(
 			owner,
@@ -117,7 +120,7 @@ func (p *containerProvider) FindOrCreateContainer(
 
 			logger.Debug("found-created-container-in-db")
 
-			gardenContainer, err = p.gardenClient.Lookup(createdContainer.Handle())
+			gardenContainer, err = gardenClient.Lookup(createdContainer.Handle())
 			if err != nil {
 				logger.Error("failed-to-lookup-created-container-in-garden", err)
 				return nil, err
@@ -126,7 +129,7 @@ func (p *containerProvider) FindOrCreateContainer(
 			return p.constructGardenWorkerContainer(
 				logger,
 				createdContainer,
-				gardenContainer,
+				traceGardenContainer(ctx, gardenContainer),
 			)
 		}
 
@@ -137,6 +140,19 @@ func (p *containerProvider) FindOrCreateContainer(
 				owner,
 				metadata,
 			)
+			if err == db.ErrContainersQuotaReached {
+				// another container was created for the team since the
+				// quota was checked during placement
+				logger.Info("waiting-for-container-quota")
+
+				select {
+				case <-time.After(creatingContainerRetryDelay):
+					continue
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				}
+			}
+
 			if err != nil {
 				logger.Error("failed-to-create-container-in-db", err)
 				return nil, err
@@ -149,7 +165,7 @@ func (p *containerProvider) FindOrCreateContainer(
 			logger.Debug("found-creating-container-in-db")
 		}
 
-		gardenContainer, err = p.gardenClient.Lookup(creatingContainer.Handle())
+		gardenContainer, err = gardenClient.Lookup(creatingContainer.Handle())
 		if err != nil {
 			if _, ok := err.(garden.ContainerNotFoundError); !ok {
 				logger.Error("failed-to-lookup-creating-container-in-garden", err)
@@ -174,16 +190,26 @@ func (p *containerProvider) FindOrCreateContainer(
 
 			logger.Debug("fetching-image")
 
-			fetchedImage, err := image.FetchForContainer(ctx, logger, creatingContainer)
+			fetchCtx, fetchSpan := tracing.StartSpan(ctx, "fetch-image", tracing.Attrs{
+				"container": creatingContainer.Handle(),
+			})
+
+			fetchStart := time.Now()
+
+			fetchedImage, err := image.FetchForContainer(fetchCtx, logger, creatingContainer)
+			tracing.End(fetchSpan, err)
 			if err != nil {
 				creatingContainer.Failed()
 				logger.Error("failed-to-fetch-image-for-container", err)
 				return nil, err
 			}
 
+			delegate.ImageFetched(logger, time.Since(fetchStart))
+
 			logger.Debug("creating-container-in-garden")
 
 			gardenContainer, err = p.createGardenContainer(
+				ctx,
 				logger,
 				creatingContainer,
 				containerSpec,
@@ -211,7 +237,7 @@ func (p *containerProvider) FindOrCreateContainer(
 		if err != nil {
 			logger.Error("failed-to-mark-container-as-created", err)
 
-			_ = p.gardenClient.Destroy(creatingContainer.Handle())
+			_ = gardenClient.Destroy(creatingContainer.Handle())
 
 			return nil, err
 		}
@@ -221,7 +247,7 @@ func (p *containerProvider) FindOrCreateContainer(
 		return p.constructGardenWorkerContainer(
 			logger,
 			createdContainer,
-			gardenContainer,
+			traceGardenContainer(ctx, gardenContainer),
 		)
 	}
 }
@@ -298,15 +324,25 @@ func (p *containerProvider) constructGardenWorkerContainer(
 }
 
 func (p *containerProvider) createGardenContainer(
+	ctx context.Context,
 	logger lager.Logger,
 	creatingContainer db.CreatingContainer,
 	spec ContainerSpec,
 	fetchedImage FetchedImage,
-) (garden.Container, error) {
+) (gardenContainer garden.Container, err error) {
+	ctx, span := tracing.StartSpan(ctx, "create-container", tracing.Attrs{
+		"container": creatingContainer.Handle(),
+		"worker":    p.worker.Name(),
+	})
+	defer func() { tracing.End(span, err) }()
+
+	gardenClient := traceGardenClient(ctx, p.gardenClient)
+	volumeClient := traceVolumeClient(ctx, p.volumeClient)
+
 	var volumeMounts []VolumeMount
 	var ioVolumeMounts []VolumeMount
 
-	scratchVolume, err := p.volumeClient.FindOrCreateVolumeForContainer(
+	scratchVolume, err := volumeClient.FindOrCreateVolumeForContainer(
 		logger,
 		VolumeSpec{
 			Strategy:   baggageclaim.EmptyStrategy{},
@@ -329,7 +365,7 @@ func (p *containerProvider) createGardenContainer(
 	hasSpecDirInOutputs := anyMountTo(spec.Dir, getDestinationPathsFromOutputs(spec.Outputs))
 
 	if spec.Dir != "" && !hasSpecDirInOutputs && !hasSpecDirInInputs {
-		workdirVolume, volumeErr := p.volumeClient.FindOrCreateVolumeForContainer(
+		workdirVolume, volumeErr := volumeClient.FindOrCreateVolumeForContainer(
 			logger,
 			VolumeSpec{
 				Strategy:   baggageclaim.EmptyStrategy{},
@@ -371,7 +407,7 @@ func (p *containerProvider) createGardenContainer(
 		cleanedInputPath := filepath.Clean(inputSource.DestinationPath())
 
 		if found {
-			inputVolume, err = p.volumeClient.FindOrCreateCOWVolumeForContainer(
+			inputVolume, err = volumeClient.FindOrCreateCOWVolumeForContainer(
 				logger,
 				VolumeSpec{
 					Strategy:   localVolume.COWStrategy(),
@@ -386,7 +422,7 @@ func (p *containerProvider) createGardenContainer(
 				return nil, err
 			}
 		} else {
-			inputVolume, err = p.volumeClient.FindOrCreateVolumeForContainer(
+			inputVolume, err = volumeClient.FindOrCreateVolumeForContainer(
 				logger,
 				VolumeSpec{
 					Strategy:   baggageclaim.EmptyStrategy{},
@@ -404,7 +440,13 @@ func (p *containerProvider) createGardenContainer(
 				"dest-volume": inputVolume.Handle(),
 				"dest-worker": inputVolume.WorkerName(),
 			}
+			_, streamSpan := tracing.StartSpan(ctx, "stream-input", tracing.Attrs{
+				"dest-volume": inputVolume.Handle(),
+				"dest-worker": inputVolume.WorkerName(),
+			})
+
 			err = inputSource.Source().StreamTo(logger.Session("stream-to", destData), inputVolume)
+			tracing.End(streamSpan, err)
 			if err != nil {
 				return nil, err
 			}
@@ -426,7 +468,7 @@ func (p *containerProvider) createGardenContainer(
 			continue
 		}
 
-		outVolume, volumeErr := p.volumeClient.FindOrCreateVolumeForContainer(
+		outVolume, volumeErr := volumeClient.FindOrCreateVolumeForContainer(
 			logger,
 			VolumeSpec{
 				Strategy:   baggageclaim.EmptyStrategy{},
@@ -490,7 +532,7 @@ func (p *containerProvider) createGardenContainer(
 		env = append(env, fmt.Sprintf("no_proxy=%s", p.noProxy))
 	}
 
-	return p.gardenClient.Create(garden.ContainerSpec{
+	return gardenClient.Create(garden.ContainerSpec{
 		Handle:     creatingContainer.Handle(),
 		RootFSPath: fetchedImage.URL,
 		Privileged: fetchedImage.Privileged,
@@ -396,6 +396,33 @@ var _ = Describe("ContainerProvider", func() {
 				Expect(fakeDBWorker.CreateContainerCallCount()).To(Equal(1))
 			})
 
+			Context("when the team has reached its container quota", func() {
+				BeforeEach(func() {
+					fakeDBWorker.CreateContainerReturnsOnCall(0, nil, db.ErrContainersQuotaReached)
+					fakeDBWorker.CreateContainerReturnsOnCall(1, fakeCreatingContainer, nil)
+				})
+
+				It("waits to create the container until the quota allows", func() {
+					Expect(findOrCreateErr).ToNot(HaveOccurred())
+					Expect(fakeDBWorker.CreateContainerCallCount()).To(Equal(2))
+					Expect(fakeGardenClient.CreateCallCount()).To(Equal(1))
+				})
+
+				Context("when the context is done before the quota allows", func() {
+					BeforeEach(func() {
+						fakeDBWorker.CreateContainerReturnsOnCall(1, nil, db.ErrContainersQuotaReached)
+
+						var cancel context.CancelFunc
+						ctx, cancel = context.WithCancel(ctx)
+						cancel()
+					})
+
+					It("returns the context's error", func() {
+						Expect(findOrCreateErr).To(Equal(context.Canceled))
+					})
+				})
+			})
+
 			It("acquires lock", func() {
 				Expect(fakeLockFactory.AcquireCallCount()).To(Equal(1))
 			})
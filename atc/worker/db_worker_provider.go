@@ -1,38 +1,31 @@
 package worker
 
 import (
-	"net/http"
-	"time"
-
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
-	bclient "github.com/concourse/baggageclaim/client"
 	"github.com/concourse/concourse/atc/db/lock"
-	"github.com/concourse/concourse/atc/worker/transport"
-	"github.com/concourse/retryhttp"
 	"github.com/cppforlife/go-semi-semantic/version"
 
 	"github.com/concourse/concourse/atc/db"
 )
 
 type dbWorkerProvider struct {
-	lockFactory                       lock.LockFactory
-	retryBackOffFactory               retryhttp.BackOffFactory
-	imageFactory                      ImageFactory
-	dbResourceCacheFactory            db.ResourceCacheFactory
-	dbResourceConfigFactory           db.ResourceConfigFactory
-	dbWorkerBaseResourceTypeFactory   db.WorkerBaseResourceTypeFactory
-	dbWorkerTaskCacheFactory          db.WorkerTaskCacheFactory
-	dbVolumeRepository                db.VolumeRepository
-	dbTeamFactory                     db.TeamFactory
-	dbWorkerFactory                   db.WorkerFactory
-	workerVersion                     version.Version
-	baggageclaimResponseHeaderTimeout time.Duration
+	lockFactory                     lock.LockFactory
+	runtime                         Runtime
+	imageFactory                    ImageFactory
+	dbResourceCacheFactory          db.ResourceCacheFactory
+	dbResourceConfigFactory         db.ResourceConfigFactory
+	dbWorkerBaseResourceTypeFactory db.WorkerBaseResourceTypeFactory
+	dbWorkerTaskCacheFactory        db.WorkerTaskCacheFactory
+	dbVolumeRepository              db.VolumeRepository
+	dbTeamFactory                   db.TeamFactory
+	dbWorkerFactory                 db.WorkerFactory
+	workerVersion                   version.Version
 }
 
 func NewDBWorkerProvider(
 	lockFactory lock.LockFactory,
-	retryBackOffFactory retryhttp.BackOffFactory,
+	runtime Runtime,
 	imageFactory ImageFactory,
 	dbResourceCacheFactory db.ResourceCacheFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
@@ -42,21 +35,19 @@ func NewDBWorkerProvider(
 	dbTeamFactory db.TeamFactory,
 	workerFactory db.WorkerFactory,
 	workerVersion version.Version,
-	baggageclaimResponseHeaderTimeout time.Duration,
 ) WorkerProvider {
 	return &dbWorkerProvider{
-		lockFactory:                       lockFactory,
-		retryBackOffFactory:               retryBackOffFactory,
-		imageFactory:                      imageFactory,
-		dbResourceCacheFactory:            dbResourceCacheFactory,
-		dbResourceConfigFactory:           dbResourceConfigFactory,
-		dbWorkerBaseResourceTypeFactory:   dbWorkerBaseResourceTypeFactory,
-		dbWorkerTaskCacheFactory:          dbWorkerTaskCacheFactory,
-		dbVolumeRepository:                dbVolumeRepository,
-		dbTeamFactory:                     dbTeamFactory,
-		dbWorkerFactory:                   workerFactory,
-		workerVersion:                     workerVersion,
-		baggageclaimResponseHeaderTimeout: baggageclaimResponseHeaderTimeout,
+		lockFactory:                     lockFactory,
+		runtime:                         runtime,
+		imageFactory:                    imageFactory,
+		dbResourceCacheFactory:          dbResourceCacheFactory,
+		dbResourceConfigFactory:         dbResourceConfigFactory,
+		dbWorkerBaseResourceTypeFactory: dbWorkerBaseResourceTypeFactory,
+		dbWorkerTaskCacheFactory:        dbWorkerTaskCacheFactory,
+		dbVolumeRepository:              dbVolumeRepository,
+		dbTeamFactory:                   dbTeamFactory,
+		dbWorkerFactory:                 workerFactory,
+		workerVersion:                   workerVersion,
 	}
 }
 
@@ -76,7 +67,7 @@ func (provider *dbWorkerProvider) RunningWorkers(logger lager.Logger) ([]Worker,
 	workers := []Worker{}
 
 	for _, savedWorker := range savedWorkers {
-		if savedWorker.State() != db.WorkerStateRunning {
+		if savedWorker.State() != db.WorkerStateRunning || savedWorker.Cordoned() {
 			continue
 		}
 
@@ -167,25 +158,8 @@ func (provider *dbWorkerProvider) FindWorkerForVolume(
 }
 
 func (provider *dbWorkerProvider) NewGardenWorker(logger lager.Logger, tikTok clock.Clock, savedWorker db.Worker, buildContainersCount int) Worker {
-	gcf := NewGardenClientFactory(
-		provider.dbWorkerFactory,
-		logger.Session("garden-connection"),
-		savedWorker.Name(),
-		savedWorker.GardenAddr(),
-		provider.retryBackOffFactory,
-	)
-
-	gClient := gcf.NewClient()
-
-	bClient := bclient.New("", transport.NewBaggageclaimRoundTripper(
-		savedWorker.Name(),
-		savedWorker.BaggageclaimURL(),
-		provider.dbWorkerFactory,
-		&http.Transport{
-			DisableKeepAlives:     true,
-			ResponseHeaderTimeout: provider.baggageclaimResponseHeaderTimeout,
-		},
-	))
+	gClient := provider.runtime.GardenClient(logger, savedWorker)
+	bClient := provider.runtime.BaggageclaimClient(logger, savedWorker)
 
 	volumeClient := NewVolumeClient(
 		bClient,
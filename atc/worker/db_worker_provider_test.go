@@ -162,7 +162,11 @@ var _ = Describe("DBProvider", func() {
 
 		provider = NewDBWorkerProvider(
 			fakeLockFactory,
-			fakeBackOffFactory,
+			NewGardenRuntime(
+				fakeDBWorkerFactory,
+				fakeBackOffFactory,
+				baggageclaimResponseHeaderTimeout,
+			),
 			fakeImageFactory,
 			fakeDBResourceCacheFactory,
 			fakeDBResourceConfigFactory,
@@ -172,7 +176,6 @@ var _ = Describe("DBProvider", func() {
 			fakeDBTeamFactory,
 			fakeDBWorkerFactory,
 			wantWorkerVersion,
-			baggageclaimResponseHeaderTimeout,
 		)
 		baggageclaimURL = baggageclaimServer.URL()
 	})
@@ -218,7 +221,7 @@ var _ = Describe("DBProvider", func() {
 				Expect([]int{workers[0].BuildContainers(), workers[1].BuildContainers()}).To(ConsistOf(57, 68))
 			})
 
-			Context("when some of the workers returned are stalled or landing", func() {
+			Context("when some of the workers returned are stalled, landing or cordoned", func() {
 				BeforeEach(func() {
 					landingWorker := new(dbfakes.FakeWorker)
 					landingWorker.NameReturns("landing-worker")
@@ -238,11 +241,22 @@ var _ = Describe("DBProvider", func() {
 					stalledWorker.ResourceTypesReturns([]atc.WorkerResourceType{
 						{Type: "some-resource-b", Image: "some-image-b"}})
 
+					cordonedWorker := new(dbfakes.FakeWorker)
+					cordonedWorker.NameReturns("cordoned-worker")
+					cordonedWorker.GardenAddrReturns(&gardenAddr)
+					cordonedWorker.BaggageclaimURLReturns(&baggageclaimURL)
+					cordonedWorker.StateReturns(db.WorkerStateRunning)
+					cordonedWorker.CordonedReturns(true)
+					cordonedWorker.ActiveContainersReturns(3)
+					cordonedWorker.ResourceTypesReturns([]atc.WorkerResourceType{
+						{Type: "some-resource-b", Image: "some-image-b"}})
+
 					fakeDBWorkerFactory.WorkersReturns(
 						[]db.Worker{
 							fakeWorker1,
 							stalledWorker,
 							landingWorker,
+							cordonedWorker,
 						}, nil)
 				})
 
@@ -1,6 +1,7 @@
 package worker
 
 import (
+	"context"
 	"os"
 	"time"
 
@@ -58,3 +59,30 @@ func NewHardcoded(
 		return nil
 	}
 }
+
+// StaticWorkerHeartbeater is a lockrunner.Task which registers a worker that
+// is not registered through the TSA, e.g. the Kubernetes runtime's worker.
+// Running it under a lock means only one web node registers the worker at a
+// time.
+type StaticWorkerHeartbeater struct {
+	workerFactory db.WorkerFactory
+	workerInfo    atc.Worker
+	ttl           time.Duration
+}
+
+func NewStaticWorkerHeartbeater(
+	workerFactory db.WorkerFactory,
+	workerInfo atc.Worker,
+	ttl time.Duration,
+) *StaticWorkerHeartbeater {
+	return &StaticWorkerHeartbeater{
+		workerFactory: workerFactory,
+		workerInfo:    workerInfo,
+		ttl:           ttl,
+	}
+}
+
+func (heartbeater *StaticWorkerHeartbeater) Run(ctx context.Context) error {
+	_, err := heartbeater.workerFactory.SaveWorker(heartbeater.workerInfo, heartbeater.ttl)
+	return err
+}
@@ -1,6 +1,7 @@
 package worker_test
 
 import (
+	"context"
 	"errors"
 	"time"
 
@@ -118,4 +119,44 @@ var _ = Describe("Hardcoded", func() {
 			Expect(<-process.Wait()).To(Equal(disaster))
 		})
 	})
+
+	Describe("StaticWorkerHeartbeater", func() {
+		var (
+			workerInfo atc.Worker
+			runErr     error
+		)
+
+		BeforeEach(func() {
+			workerInfo = atc.Worker{
+				Name:     "some-worker",
+				Platform: "linux",
+				Tags:     []string{},
+			}
+		})
+
+		JustBeforeEach(func() {
+			runErr = worker.NewStaticWorkerHeartbeater(workerFactory, workerInfo, 30*time.Second).Run(context.Background())
+		})
+
+		It("registers the worker with the ttl", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+
+			Expect(workerFactory.SaveWorkerCallCount()).To(Equal(1))
+			savedInfo, ttl := workerFactory.SaveWorkerArgsForCall(0)
+			Expect(savedInfo).To(Equal(workerInfo))
+			Expect(ttl).To(Equal(30 * time.Second))
+		})
+
+		Context("if saving to the DB fails", func() {
+			disaster := errors.New("bad bad bad")
+
+			BeforeEach(func() {
+				workerFactory.SaveWorkerReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(Equal(disaster))
+			})
+		})
+	})
 })
@@ -258,7 +258,7 @@ func (i *imageResourceFetcher) ensureVersionOfType(
 	}
 
 	checkResourceType := i.resourceFactory.NewResourceForContainer(resourceTypeContainer)
-	versions, err := checkResourceType.Check(context.TODO(), source, nil)
+	versions, err := checkResourceType.Check(context.TODO(), resource.IOConfig{}, source, nil)
 	if err != nil {
 		return err
 	}
@@ -319,7 +319,7 @@ func (i *imageResourceFetcher) getLatestVersion(
 	}
 
 	checkingResource := i.resourceFactory.NewResourceForContainer(imageContainer)
-	versions, err := checkingResource.Check(context.TODO(), source, nil)
+	versions, err := checkingResource.Check(context.TODO(), resource.IOConfig{}, source, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,414 @@
+package k8s
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	v1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// volumes which are only ever used as a container's rootfs or scratch
+	// space are backed by an empty dir in the container's pod
+	emptyDirBacking = "empty-dir"
+
+	// volumes which have to outlive a container, e.g. resource caches and
+	// task outputs, are backed by a persistent volume claim
+	claimBacking = "claim"
+
+	backingKey    = "backing"
+	imageKey      = "image"
+	privilegedKey = "privileged"
+	propertiesKey = "properties"
+
+	helperVolumeName = "volume"
+	helperMountPath  = "/volume"
+	helperParentPath = "/parent"
+)
+
+// baggageclaimClient records each volume in a config map named after its
+// handle, alongside the persistent volume claim backing it, if any.
+type baggageclaimClient struct {
+	clientset kubernetes.Interface
+	executor  Executor
+	clock     clock.Clock
+	config    Config
+}
+
+type volumeRecord struct {
+	Handle     string
+	Backing    string
+	Image      string
+	Privileged bool
+	Properties baggageclaim.VolumeProperties
+}
+
+func (client *baggageclaimClient) CreateVolume(logger lager.Logger, handle string, spec baggageclaim.VolumeSpec) (baggageclaim.Volume, error) {
+	logger = logger.Session("create-volume", lager.Data{"handle": handle})
+
+	record := volumeRecord{
+		Handle:     handle,
+		Privileged: spec.Privileged,
+		Properties: spec.Properties,
+	}
+
+	var parent *volumeRecord
+
+	switch strategy := spec.Strategy.(type) {
+	case baggageclaim.ImportStrategy:
+		image, ok := ImageFromRootFSPath(strategy.Path)
+		if !ok {
+			return nil, UnsupportedImageError{URI: strategy.Path}
+		}
+
+		record.Backing = emptyDirBacking
+		record.Image = image
+
+	case baggageclaim.COWStrategy:
+		var found bool
+		var err error
+		parent, found, err = client.lookupRecord(strategy.Parent.Handle())
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return nil, fmt.Errorf("parent volume not found: %s", strategy.Parent.Handle())
+		}
+
+		if parent.Backing == emptyDirBacking {
+			record.Backing = emptyDirBacking
+			record.Image = parent.Image
+		} else {
+			record.Backing = claimBacking
+		}
+
+	case baggageclaim.EmptyStrategy:
+		record.Backing = claimBacking
+
+	default:
+		return nil, fmt.Errorf("unsupported volume strategy: %T", spec.Strategy)
+	}
+
+	if record.Backing == claimBacking {
+		err := client.createClaim(handle)
+		if err != nil {
+			logger.Error("failed-to-create-claim", err)
+			return nil, err
+		}
+
+		if parent != nil {
+			err = client.copyClaim(parent.Handle, handle)
+			if err != nil {
+				logger.Error("failed-to-copy-parent", err)
+				_ = client.deleteClaim(handle)
+				return nil, err
+			}
+		}
+	}
+
+	configMap, err := record.configMap()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.clientset.CoreV1().ConfigMaps(client.config.Namespace).Create(configMap)
+	if err != nil {
+		logger.Error("failed-to-create-config-map", err)
+
+		if record.Backing == claimBacking {
+			_ = client.deleteClaim(handle)
+		}
+
+		return nil, err
+	}
+
+	return &volume{client: client, handle: handle}, nil
+}
+
+func (client *baggageclaimClient) ListVolumes(logger lager.Logger, properties baggageclaim.VolumeProperties) (baggageclaim.Volumes, error) {
+	configMaps, err := client.clientset.CoreV1().ConfigMaps(client.config.Namespace).List(meta_v1.ListOptions{
+		LabelSelector: volumeLabel + "=true",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	volumes := baggageclaim.Volumes{}
+	for _, configMap := range configMaps.Items {
+		record, err := recordFromConfigMap(configMap)
+		if err != nil {
+			logger.Error("failed-to-decode-volume", err, lager.Data{"handle": configMap.Name})
+			continue
+		}
+
+		if matchesProperties(record.Properties, properties) {
+			volumes = append(volumes, &volume{client: client, handle: record.Handle})
+		}
+	}
+
+	return volumes, nil
+}
+
+func (client *baggageclaimClient) LookupVolume(logger lager.Logger, handle string) (baggageclaim.Volume, bool, error) {
+	_, found, err := client.lookupRecord(handle)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	return &volume{client: client, handle: handle}, true, nil
+}
+
+func (client *baggageclaimClient) DestroyVolumes(logger lager.Logger, handles []string) error {
+	for _, handle := range handles {
+		err := client.DestroyVolume(logger, handle)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (client *baggageclaimClient) DestroyVolume(logger lager.Logger, handle string) error {
+	return client.destroy(handle)
+}
+
+func (client *baggageclaimClient) destroy(handle string) error {
+	record, found, err := client.lookupRecord(handle)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return nil
+	}
+
+	if record.Backing == claimBacking {
+		err = client.deleteClaim(handle)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.clientset.CoreV1().ConfigMaps(client.config.Namespace).Delete(handle, &meta_v1.DeleteOptions{})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (client *baggageclaimClient) lookupRecord(handle string) (*volumeRecord, bool, error) {
+	configMap, err := client.clientset.CoreV1().ConfigMaps(client.config.Namespace).Get(handle, meta_v1.GetOptions{})
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	record, err := recordFromConfigMap(*configMap)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &record, true, nil
+}
+
+func (client *baggageclaimClient) updateRecord(handle string, update func(*volumeRecord)) error {
+	configMaps := client.clientset.CoreV1().ConfigMaps(client.config.Namespace)
+
+	configMap, err := configMaps.Get(handle, meta_v1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	record, err := recordFromConfigMap(*configMap)
+	if err != nil {
+		return err
+	}
+
+	update(&record)
+
+	updated, err := record.configMap()
+	if err != nil {
+		return err
+	}
+
+	configMap.Data = updated.Data
+
+	_, err = configMaps.Update(configMap)
+	return err
+}
+
+func (client *baggageclaimClient) createClaim(handle string) error {
+	claim := &v1.PersistentVolumeClaim{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:   handle,
+			Labels: map[string]string{volumeLabel: "true"},
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{
+					v1.ResourceStorage: client.config.VolumeSize,
+				},
+			},
+		},
+	}
+
+	if client.config.StorageClass != "" {
+		storageClass := client.config.StorageClass
+		claim.Spec.StorageClassName = &storageClass
+	}
+
+	_, err := client.clientset.CoreV1().PersistentVolumeClaims(client.config.Namespace).Create(claim)
+	return err
+}
+
+func (client *baggageclaimClient) deleteClaim(handle string) error {
+	err := client.clientset.CoreV1().PersistentVolumeClaims(client.config.Namespace).Delete(handle, &meta_v1.DeleteOptions{})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (client *baggageclaimClient) copyClaim(srcHandle string, dstHandle string) error {
+	pod, err := client.startHelper(dstHandle, "copy", map[string]string{
+		helperParentPath: srcHandle,
+		helperMountPath:  dstHandle,
+	})
+	if err != nil {
+		return err
+	}
+
+	defer client.deleteHelper(pod)
+
+	return client.exec(pod, []string{"cp", "-a", helperParentPath + "/.", helperMountPath + "/"}, nil, nil)
+}
+
+// startHelper runs a short-lived pod with the given claims mounted, for
+// running commands against volumes which aren't mounted in any container.
+func (client *baggageclaimClient) startHelper(handle string, purpose string, claims map[string]string) (string, error) {
+	pod := &v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			GenerateName: purpose + "-" + handle + "-",
+			Labels:       map[string]string{helperLabel: "true"},
+		},
+		Spec: v1.PodSpec{
+			RestartPolicy: v1.RestartPolicyNever,
+			Containers: []v1.Container{
+				{
+					Name:    mainContainerName,
+					Image:   client.config.HelperImage,
+					Command: idleCommand,
+				},
+			},
+		},
+	}
+
+	i := 0
+	for mountPath, claim := range claims {
+		name := helperVolumeName + strconv.Itoa(i)
+		i++
+
+		pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+			Name: name,
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					ClaimName: claim,
+				},
+			},
+		})
+
+		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, v1.VolumeMount{
+			Name:      name,
+			MountPath: mountPath,
+		})
+	}
+
+	created, err := client.clientset.CoreV1().Pods(client.config.Namespace).Create(pod)
+	if err != nil {
+		return "", err
+	}
+
+	err = waitForPod(client.clientset, client.clock, client.config, created.Name)
+	if err != nil {
+		client.deleteHelper(created.Name)
+		return "", err
+	}
+
+	return created.Name, nil
+}
+
+func (client *baggageclaimClient) deleteHelper(pod string) {
+	gracePeriod := int64(0)
+	_ = client.clientset.CoreV1().Pods(client.config.Namespace).Delete(pod, &meta_v1.DeleteOptions{
+		GracePeriodSeconds: &gracePeriod,
+	})
+}
+
+func (client *baggageclaimClient) exec(pod string, command []string, stdin io.Reader, stdout io.Writer) error {
+	status, err := client.executor.Exec(client.config.Namespace, pod, mainContainerName, command, stdin, stdout, nil, false)
+	if err != nil {
+		return err
+	}
+
+	if status != 0 {
+		return fmt.Errorf("%s exited with status %d", command[0], status)
+	}
+
+	return nil
+}
+
+func (record volumeRecord) configMap() (*v1.ConfigMap, error) {
+	properties, err := encodeProperties(record.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ConfigMap{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:   record.Handle,
+			Labels: map[string]string{volumeLabel: "true"},
+		},
+		Data: map[string]string{
+			backingKey:    record.Backing,
+			imageKey:      record.Image,
+			privilegedKey: strconv.FormatBool(record.Privileged),
+			propertiesKey: properties,
+		},
+	}, nil
+}
+
+func recordFromConfigMap(configMap v1.ConfigMap) (volumeRecord, error) {
+	properties, err := decodeProperties(configMap.Data[propertiesKey])
+	if err != nil {
+		return volumeRecord{}, err
+	}
+
+	privileged, _ := strconv.ParseBool(configMap.Data[privilegedKey])
+
+	return volumeRecord{
+		Handle:     configMap.Name,
+		Backing:    configMap.Data[backingKey],
+		Image:      configMap.Data[imageKey],
+		Privileged: privileged,
+		Properties: properties,
+	}, nil
+}
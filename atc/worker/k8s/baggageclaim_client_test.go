@@ -0,0 +1,258 @@
+package k8s_test
+
+import (
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/worker/k8s"
+	"github.com/concourse/concourse/atc/worker/k8s/k8sfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var _ = Describe("BaggageclaimClient", func() {
+	var (
+		clientset    *fake.Clientset
+		fakeExecutor *k8sfakes.FakeExecutor
+		logger       *lagertest.TestLogger
+
+		baggageclaimClient baggageclaim.Client
+	)
+
+	BeforeEach(func() {
+		clientset = fake.NewSimpleClientset()
+		startPods(clientset)
+
+		fakeExecutor = new(k8sfakes.FakeExecutor)
+		logger = lagertest.NewTestLogger("test")
+
+		runtime := k8s.NewRuntime(
+			clientset,
+			fakeExecutor,
+			fakeclock.NewFakeClock(time.Now()),
+			k8s.Config{
+				Namespace:       namespace,
+				StorageClass:    "some-storage-class",
+				VolumeSize:      resource.MustParse("1Gi"),
+				HelperImage:     "some-helper-image",
+				PodStartTimeout: time.Minute,
+			},
+		)
+
+		baggageclaimClient = runtime.BaggageclaimClient(logger, nil)
+	})
+
+	helperPods := func() int {
+		pods, err := clientset.CoreV1().Pods(namespace).List(meta_v1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return len(pods.Items)
+	}
+
+	Describe("CreateVolume", func() {
+		Context("with an empty strategy", func() {
+			var volume baggageclaim.Volume
+
+			BeforeEach(func() {
+				var err error
+				volume, err = baggageclaimClient.CreateVolume(logger, "some-handle", baggageclaim.VolumeSpec{
+					Strategy:   baggageclaim.EmptyStrategy{},
+					Properties: baggageclaim.VolumeProperties{"some": "property"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("creates a claim named after the handle", func() {
+				claim, err := clientset.CoreV1().PersistentVolumeClaims(namespace).Get("some-handle", meta_v1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(*claim.Spec.StorageClassName).To(Equal("some-storage-class"))
+
+				storage := claim.Spec.Resources.Requests[v1.ResourceStorage]
+				Expect(storage.String()).To(Equal("1Gi"))
+			})
+
+			It("returns a volume under the volumes path", func() {
+				Expect(volume.Handle()).To(Equal("some-handle"))
+				Expect(volume.Path()).To(Equal(k8s.VolumesPath + "/some-handle"))
+			})
+
+			It("can be looked up with its properties", func() {
+				found, ok, err := baggageclaimClient.LookupVolume(logger, "some-handle")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeTrue())
+
+				properties, err := found.Properties()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(properties).To(Equal(baggageclaim.VolumeProperties{"some": "property"}))
+			})
+
+			Context("when copied on write", func() {
+				BeforeEach(func() {
+					_, err := baggageclaimClient.CreateVolume(logger, "cow-handle", baggageclaim.VolumeSpec{
+						Strategy: baggageclaim.COWStrategy{Parent: volume},
+					})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("copies the parent's claim into a new claim", func() {
+					_, err := clientset.CoreV1().PersistentVolumeClaims(namespace).Get("cow-handle", meta_v1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(fakeExecutor.ExecCallCount()).To(Equal(1))
+					_, _, _, command, _, _, _, _ := fakeExecutor.ExecArgsForCall(0)
+					Expect(command[0]).To(Equal("cp"))
+				})
+
+				It("cleans up the helper pod", func() {
+					Expect(helperPods()).To(BeZero())
+				})
+			})
+		})
+
+		Context("with an import strategy", func() {
+			It("does not create a claim", func() {
+				_, err := baggageclaimClient.CreateVolume(logger, "some-handle", baggageclaim.VolumeSpec{
+					Strategy: baggageclaim.ImportStrategy{Path: "docker:///some/image"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = clientset.CoreV1().PersistentVolumeClaims(namespace).Get("some-handle", meta_v1.GetOptions{})
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("does not support paths on the worker", func() {
+				_, err := baggageclaimClient.CreateVolume(logger, "some-handle", baggageclaim.VolumeSpec{
+					Strategy: baggageclaim.ImportStrategy{Path: "/some/path"},
+				})
+				Expect(err).To(Equal(k8s.UnsupportedImageError{URI: "/some/path"}))
+			})
+		})
+	})
+
+	Describe("ListVolumes", func() {
+		BeforeEach(func() {
+			_, err := baggageclaimClient.CreateVolume(logger, "handle-a", baggageclaim.VolumeSpec{
+				Strategy:   baggageclaim.EmptyStrategy{},
+				Properties: baggageclaim.VolumeProperties{"a": "1"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = baggageclaimClient.CreateVolume(logger, "handle-b", baggageclaim.VolumeSpec{
+				Strategy:   baggageclaim.EmptyStrategy{},
+				Properties: baggageclaim.VolumeProperties{"a": "2"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the volumes matching the properties", func() {
+			volumes, err := baggageclaimClient.ListVolumes(logger, baggageclaim.VolumeProperties{"a": "2"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(volumes).To(HaveLen(1))
+			Expect(volumes[0].Handle()).To(Equal("handle-b"))
+		})
+	})
+
+	Describe("a volume", func() {
+		var volume baggageclaim.Volume
+
+		BeforeEach(func() {
+			var err error
+			volume, err = baggageclaimClient.CreateVolume(logger, "some-handle", baggageclaim.VolumeSpec{
+				Strategy: baggageclaim.EmptyStrategy{},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Describe("SetProperty", func() {
+			It("stores the property", func() {
+				Expect(volume.SetProperty("some", "value")).To(Succeed())
+
+				properties, err := volume.Properties()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(properties).To(Equal(baggageclaim.VolumeProperties{"some": "value"}))
+			})
+		})
+
+		Describe("StreamIn", func() {
+			It("extracts the stream from a helper pod", func() {
+				Expect(volume.StreamIn("some/path", strings.NewReader("some-tar"))).To(Succeed())
+
+				Expect(fakeExecutor.ExecCallCount()).To(Equal(1))
+				_, _, _, command, stdin, _, _, _ := fakeExecutor.ExecArgsForCall(0)
+				Expect(command[len(command)-1]).To(Equal("/volume/some/path"))
+
+				payload, err := ioutil.ReadAll(stdin)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(payload)).To(Equal("some-tar"))
+
+				Expect(helperPods()).To(BeZero())
+			})
+
+			Context("when the volume is not backed by a claim", func() {
+				It("returns ErrUnsupported", func() {
+					imported, err := baggageclaimClient.CreateVolume(logger, "imported-handle", baggageclaim.VolumeSpec{
+						Strategy: baggageclaim.ImportStrategy{Path: "docker:///some/image"},
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(imported.StreamIn(".", strings.NewReader("some-tar"))).To(Equal(k8s.ErrUnsupported))
+				})
+			})
+		})
+
+		Describe("StreamOut", func() {
+			It("streams the tar from a helper pod", func() {
+				fakeExecutor.ExecStub = func(_ string, _ string, _ string, _ []string, _ io.Reader, stdout io.Writer, _ io.Writer, _ bool) (int, error) {
+					if stdout != nil {
+						_, err := stdout.Write([]byte("some-tar"))
+						return 0, err
+					}
+
+					return 0, nil
+				}
+
+				stream, err := volume.StreamOut("some/path")
+				Expect(err).ToNot(HaveOccurred())
+
+				payload, err := ioutil.ReadAll(stream)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(payload)).To(Equal("some-tar"))
+
+				Eventually(helperPods).Should(BeZero())
+			})
+
+			Context("when the path does not exist", func() {
+				BeforeEach(func() {
+					fakeExecutor.ExecReturns(44, nil)
+				})
+
+				It("returns ErrFileNotFound", func() {
+					_, err := volume.StreamOut("bogus")
+					Expect(err).To(Equal(baggageclaim.ErrFileNotFound))
+					Expect(helperPods()).To(BeZero())
+				})
+			})
+		})
+
+		Describe("Destroy", func() {
+			It("deletes the claim and the volume", func() {
+				Expect(volume.Destroy()).To(Succeed())
+
+				_, err := clientset.CoreV1().PersistentVolumeClaims(namespace).Get("some-handle", meta_v1.GetOptions{})
+				Expect(err).To(HaveOccurred())
+
+				_, found, err := baggageclaimClient.LookupVolume(logger, "some-handle")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,246 @@
+package k8s
+
+import (
+	"io"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+// processCommand records the process's pid so that it can be signalled, and
+// runs its command in its working directory with its environment, as
+// exec'ing into a pod supports neither.
+const processCommand = `echo $$ > "$0"; cd "$1" || exit 1; shift; exec "$@"`
+
+// stopCommand signals every process which has been run in the container.
+const stopCommand = `for pidfile in /tmp/concourse-process-*.pid; do [ -e "$pidfile" ] && kill -s "$0" "$(cat "$pidfile")"; done; true`
+
+const signalCommand = `kill -s "$0" "$(cat "$1")"`
+
+type podContainer struct {
+	client *gardenClient
+	handle string
+}
+
+func (container *podContainer) Handle() string {
+	return container.handle
+}
+
+func (container *podContainer) Stop(kill bool) error {
+	signal := garden.SignalTerminate
+	if kill {
+		signal = garden.SignalKill
+	}
+
+	_, err := container.exec([]string{"sh", "-c", stopCommand, signalName(signal)}, nil, nil, nil, false)
+	return err
+}
+
+func (container *podContainer) Info() (garden.ContainerInfo, error) {
+	return container.client.info(container.handle)
+}
+
+func (container *podContainer) StreamIn(spec garden.StreamInSpec) error {
+	status, err := container.exec([]string{
+		"sh", "-c", `mkdir -p "$0" && exec tar -x -C "$0"`, spec.Path,
+	}, spec.TarStream, nil, nil, false)
+	if err != nil {
+		return err
+	}
+
+	if status != 0 {
+		return garden.NewError("failed to stream in: tar exited with status " + strconv.Itoa(status))
+	}
+
+	return nil
+}
+
+func (container *podContainer) StreamOut(spec garden.StreamOutSpec) (io.ReadCloser, error) {
+	err := container.waitForPod()
+	if err != nil {
+		return nil, err
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		status, err := container.exec([]string{"sh", "-c", streamOutCommand, spec.Path}, nil, writer, nil, false)
+		if err == nil && status != 0 {
+			err = garden.NewError("failed to stream out: tar exited with status " + strconv.Itoa(status))
+		}
+
+		writer.CloseWithError(err)
+	}()
+
+	return reader, nil
+}
+
+func (container *podContainer) CurrentBandwidthLimits() (garden.BandwidthLimits, error) {
+	return garden.BandwidthLimits{}, nil
+}
+
+func (container *podContainer) CurrentCPULimits() (garden.CPULimits, error) {
+	return garden.CPULimits{}, nil
+}
+
+func (container *podContainer) CurrentDiskLimits() (garden.DiskLimits, error) {
+	return garden.DiskLimits{}, nil
+}
+
+func (container *podContainer) CurrentMemoryLimits() (garden.MemoryLimits, error) {
+	return garden.MemoryLimits{}, nil
+}
+
+func (container *podContainer) NetIn(hostPort, containerPort uint32) (uint32, uint32, error) {
+	return 0, 0, ErrUnsupported
+}
+
+func (container *podContainer) NetOut(netOutRule garden.NetOutRule) error {
+	return ErrUnsupported
+}
+
+func (container *podContainer) BulkNetOut(netOutRules []garden.NetOutRule) error {
+	return ErrUnsupported
+}
+
+// Run waits for the container's pod to be running, and then execs the
+// process into it. The process's user is ignored; processes run as the user
+// of the pod's image.
+func (container *podContainer) Run(spec garden.ProcessSpec, processIO garden.ProcessIO) (garden.Process, error) {
+	err := container.waitForPod()
+	if err != nil {
+		return nil, err
+	}
+
+	id := spec.ID
+	if id == "" {
+		guid, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+
+		id = guid.String()
+	}
+
+	dir := spec.Dir
+	if dir == "" {
+		dir = "/"
+	}
+
+	process := newPodProcess(id, container)
+
+	command := []string{"sh", "-c", processCommand, process.pidFile(), dir, "env"}
+	command = append(command, spec.Env...)
+	command = append(command, spec.Path)
+	command = append(command, spec.Args...)
+
+	go func() {
+		process.exited(container.exec(command, processIO.Stdin, processIO.Stdout, processIO.Stderr, spec.TTY != nil))
+	}()
+
+	return process, nil
+}
+
+// Attach always fails, as processes exec'd into a pod cannot be reattached
+// to once the stream has been lost.
+func (container *podContainer) Attach(processID string, processIO garden.ProcessIO) (garden.Process, error) {
+	return nil, garden.ProcessNotFoundError{ProcessID: processID}
+}
+
+func (container *podContainer) Metrics() (garden.Metrics, error) {
+	return garden.Metrics{}, ErrUnsupported
+}
+
+func (container *podContainer) SetGraceTime(graceTime time.Duration) error {
+	return nil
+}
+
+func (container *podContainer) Properties() (garden.Properties, error) {
+	pod, err := container.client.pod(container.handle)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeProperties(pod.Annotations[propertiesAnnotation])
+}
+
+func (container *podContainer) Property(name string) (string, error) {
+	properties, err := container.Properties()
+	if err != nil {
+		return "", err
+	}
+
+	value, found := properties[name]
+	if !found {
+		return "", garden.NewError("property does not exist: " + name)
+	}
+
+	return value, nil
+}
+
+func (container *podContainer) SetProperty(name string, value string) error {
+	return container.updateProperties(func(properties garden.Properties) {
+		properties[name] = value
+	})
+}
+
+func (container *podContainer) RemoveProperty(name string) error {
+	return container.updateProperties(func(properties garden.Properties) {
+		delete(properties, name)
+	})
+}
+
+func (container *podContainer) updateProperties(update func(garden.Properties)) error {
+	pod, err := container.client.pod(container.handle)
+	if err != nil {
+		return err
+	}
+
+	properties, err := decodeProperties(pod.Annotations[propertiesAnnotation])
+	if err != nil {
+		return err
+	}
+
+	update(properties)
+
+	payload, err := encodeProperties(properties)
+	if err != nil {
+		return err
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+
+	pod.Annotations[propertiesAnnotation] = payload
+
+	_, err = container.client.clientset.CoreV1().Pods(container.client.config.Namespace).Update(pod)
+	return err
+}
+
+func (container *podContainer) waitForPod() error {
+	return waitForPod(container.client.clientset, container.client.clock, container.client.config, container.handle)
+}
+
+func (container *podContainer) exec(command []string, stdin io.Reader, stdout io.Writer, stderr io.Writer, tty bool) (int, error) {
+	return container.client.executor.Exec(
+		container.client.config.Namespace,
+		container.handle,
+		mainContainerName,
+		command,
+		stdin,
+		stdout,
+		stderr,
+		tty,
+	)
+}
+
+func signalName(signal garden.Signal) string {
+	if signal == garden.SignalKill {
+		return "KILL"
+	}
+
+	return "TERM"
+}
@@ -0,0 +1,89 @@
+package k8s
+
+import (
+	"io"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+//go:generate counterfeiter . Executor
+
+// Executor runs commands in the containers of running pods, returning the
+// command's exit status.
+type Executor interface {
+	Exec(
+		namespace string,
+		pod string,
+		container string,
+		command []string,
+		stdin io.Reader,
+		stdout io.Writer,
+		stderr io.Writer,
+		tty bool,
+	) (int, error)
+}
+
+type remoteExecutor struct {
+	config    *rest.Config
+	clientset kubernetes.Interface
+}
+
+// NewRemoteExecutor constructs an Executor which streams commands through the
+// Kubernetes API server's exec subresource.
+func NewRemoteExecutor(config *rest.Config, clientset kubernetes.Interface) Executor {
+	return &remoteExecutor{
+		config:    config,
+		clientset: clientset,
+	}
+}
+
+func (executor *remoteExecutor) Exec(
+	namespace string,
+	pod string,
+	container string,
+	command []string,
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+	tty bool,
+) (int, error) {
+	req := executor.clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&v1.PodExecOptions{
+			Container: container,
+			Command:   command,
+			Stdin:     stdin != nil,
+			Stdout:    stdout != nil,
+			Stderr:    stderr != nil,
+			TTY:       tty,
+		}, scheme.ParameterCodec)
+
+	exec, err := remotecommand.NewSPDYExecutor(executor.config, "POST", req.URL())
+	if err != nil {
+		return 0, err
+	}
+
+	err = exec.Stream(remotecommand.StreamOptions{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+		Tty:    tty,
+	})
+	if err != nil {
+		if exitErr, ok := err.(utilexec.ExitError); ok {
+			return exitErr.ExitStatus(), nil
+		}
+
+		return 0, err
+	}
+
+	return 0, nil
+}
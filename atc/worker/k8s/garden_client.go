@@ -0,0 +1,326 @@
+package k8s
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/garden"
+	v1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// UnsupportedImageError is returned when a container's rootfs is not an
+// image in a registry, either directly or through the volume it was imported
+// into, as pods cannot be started from an arbitrary filesystem.
+type UnsupportedImageError struct {
+	URI string
+}
+
+func (err UnsupportedImageError) Error() string {
+	return fmt.Sprintf("image cannot be run on a kubernetes worker: %s", err.URI)
+}
+
+// UnsupportedBindMountError is returned when a container is created with a
+// bind mount which is not a volume, as pods cannot mount paths of the ATC.
+type UnsupportedBindMountError struct {
+	SrcPath string
+}
+
+func (err UnsupportedBindMountError) Error() string {
+	return fmt.Sprintf("only volumes can be mounted on a kubernetes worker: %s", err.SrcPath)
+}
+
+// gardenClient runs each container as a pod with a single container, whose
+// name is the container's handle.
+type gardenClient struct {
+	clientset kubernetes.Interface
+	executor  Executor
+	clock     clock.Clock
+	config    Config
+	volumes   *baggageclaimClient
+}
+
+func (client *gardenClient) Ping() error {
+	_, err := client.clientset.CoreV1().Namespaces().Get(client.config.Namespace, meta_v1.GetOptions{})
+	return err
+}
+
+func (client *gardenClient) Capacity() (garden.Capacity, error) {
+	return garden.Capacity{}, nil
+}
+
+func (client *gardenClient) Create(spec garden.ContainerSpec) (garden.Container, error) {
+	if spec.Handle == "" {
+		return nil, errors.New("container handle must be specified")
+	}
+
+	rootFSPath := spec.RootFSPath
+	if spec.Image.URI != "" {
+		rootFSPath = spec.Image.URI
+	}
+
+	image, err := client.image(rootFSPath)
+	if err != nil {
+		return nil, err
+	}
+
+	properties, err := encodeProperties(spec.Properties)
+	if err != nil {
+		return nil, err
+	}
+
+	container := v1.Container{
+		Name:    mainContainerName,
+		Image:   image,
+		Command: idleCommand,
+	}
+
+	for _, env := range spec.Env {
+		segs := strings.SplitN(env, "=", 2)
+		if len(segs) != 2 {
+			continue
+		}
+
+		container.Env = append(container.Env, v1.EnvVar{
+			Name:  segs[0],
+			Value: segs[1],
+		})
+	}
+
+	if spec.Privileged {
+		privileged := true
+		container.SecurityContext = &v1.SecurityContext{
+			Privileged: &privileged,
+		}
+	}
+
+	limits := v1.ResourceList{}
+	if spec.Limits.Memory.LimitInBytes != 0 {
+		limits[v1.ResourceMemory] = *resource.NewQuantity(int64(spec.Limits.Memory.LimitInBytes), resource.BinarySI)
+	}
+
+	if spec.Limits.CPU.LimitInShares != 0 {
+		// garden's cpu shares are relative, like docker's, where 1024
+		// shares are the equivalent of a single cpu
+		limits[v1.ResourceCPU] = *resource.NewMilliQuantity(int64(spec.Limits.CPU.LimitInShares)*1000/1024, resource.DecimalSI)
+	}
+
+	if len(limits) > 0 {
+		container.Resources.Limits = limits
+	}
+
+	pod := &v1.Pod{
+		ObjectMeta: meta_v1.ObjectMeta{
+			Name:        spec.Handle,
+			Labels:      map[string]string{containerLabel: "true"},
+			Annotations: map[string]string{propertiesAnnotation: properties},
+		},
+		Spec: v1.PodSpec{
+			RestartPolicy: v1.RestartPolicyNever,
+		},
+	}
+
+	for i, bindMount := range spec.BindMounts {
+		podVolume, err := client.podVolume("volume"+strconv.Itoa(i), bindMount)
+		if err != nil {
+			return nil, err
+		}
+
+		pod.Spec.Volumes = append(pod.Spec.Volumes, podVolume)
+
+		container.VolumeMounts = append(container.VolumeMounts, v1.VolumeMount{
+			Name:      podVolume.Name,
+			MountPath: bindMount.DstPath,
+			ReadOnly:  bindMount.Mode == garden.BindMountModeRO,
+		})
+	}
+
+	pod.Spec.Containers = []v1.Container{container}
+
+	_, err = client.clientset.CoreV1().Pods(client.config.Namespace).Create(pod)
+	if err != nil {
+		return nil, err
+	}
+
+	return &podContainer{client: client, handle: spec.Handle}, nil
+}
+
+func (client *gardenClient) Destroy(handle string) error {
+	err := client.clientset.CoreV1().Pods(client.config.Namespace).Delete(handle, &meta_v1.DeleteOptions{})
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			return garden.ContainerNotFoundError{Handle: handle}
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (client *gardenClient) Containers(properties garden.Properties) ([]garden.Container, error) {
+	pods, err := client.clientset.CoreV1().Pods(client.config.Namespace).List(meta_v1.ListOptions{
+		LabelSelector: containerLabel + "=true",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	containers := []garden.Container{}
+	for _, pod := range pods.Items {
+		podProperties, err := decodeProperties(pod.Annotations[propertiesAnnotation])
+		if err != nil {
+			continue
+		}
+
+		if matchesProperties(podProperties, properties) {
+			containers = append(containers, &podContainer{client: client, handle: pod.Name})
+		}
+	}
+
+	return containers, nil
+}
+
+func (client *gardenClient) BulkInfo(handles []string) (map[string]garden.ContainerInfoEntry, error) {
+	entries := map[string]garden.ContainerInfoEntry{}
+	for _, handle := range handles {
+		info, err := client.info(handle)
+		if err != nil {
+			entries[handle] = garden.ContainerInfoEntry{Err: garden.NewError(err.Error())}
+		} else {
+			entries[handle] = garden.ContainerInfoEntry{Info: info}
+		}
+	}
+
+	return entries, nil
+}
+
+func (client *gardenClient) BulkMetrics(handles []string) (map[string]garden.ContainerMetricsEntry, error) {
+	entries := map[string]garden.ContainerMetricsEntry{}
+	for _, handle := range handles {
+		entries[handle] = garden.ContainerMetricsEntry{Err: garden.NewError(ErrUnsupported.Error())}
+	}
+
+	return entries, nil
+}
+
+func (client *gardenClient) Lookup(handle string) (garden.Container, error) {
+	_, err := client.pod(handle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &podContainer{client: client, handle: handle}, nil
+}
+
+func (client *gardenClient) pod(handle string) (*v1.Pod, error) {
+	pod, err := client.clientset.CoreV1().Pods(client.config.Namespace).Get(handle, meta_v1.GetOptions{})
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			return nil, garden.ContainerNotFoundError{Handle: handle}
+		}
+
+		return nil, err
+	}
+
+	return pod, nil
+}
+
+func (client *gardenClient) info(handle string) (garden.ContainerInfo, error) {
+	pod, err := client.pod(handle)
+	if err != nil {
+		return garden.ContainerInfo{}, err
+	}
+
+	properties, err := decodeProperties(pod.Annotations[propertiesAnnotation])
+	if err != nil {
+		return garden.ContainerInfo{}, err
+	}
+
+	state := "active"
+	switch pod.Status.Phase {
+	case v1.PodPending:
+		state = "pending"
+	case v1.PodSucceeded, v1.PodFailed:
+		state = "stopped"
+	}
+
+	return garden.ContainerInfo{
+		State:       state,
+		HostIP:      pod.Status.HostIP,
+		ContainerIP: pod.Status.PodIP,
+		Properties:  properties,
+	}, nil
+}
+
+// image determines the image of a container's pod. Images in a registry
+// are used directly, and images imported into volumes are resolved to the
+// image they were imported from.
+func (client *gardenClient) image(rootFSPath string) (string, error) {
+	if image, ok := ImageFromRootFSPath(rootFSPath); ok {
+		return image, nil
+	}
+
+	uri, err := url.Parse(rootFSPath)
+	if err != nil || uri.Scheme != "raw" {
+		return "", UnsupportedImageError{URI: rootFSPath}
+	}
+
+	handle, ok := handleFromVolumePath(uri.Path)
+	if !ok {
+		return "", UnsupportedImageError{URI: rootFSPath}
+	}
+
+	record, found, err := client.volumes.lookupRecord(handle)
+	if err != nil {
+		return "", err
+	}
+
+	if !found || record.Image == "" {
+		return "", UnsupportedImageError{URI: rootFSPath}
+	}
+
+	return record.Image, nil
+}
+
+func (client *gardenClient) podVolume(name string, bindMount garden.BindMount) (v1.Volume, error) {
+	handle, ok := handleFromVolumePath(bindMount.SrcPath)
+	if !ok {
+		return v1.Volume{}, UnsupportedBindMountError{SrcPath: bindMount.SrcPath}
+	}
+
+	record, found, err := client.volumes.lookupRecord(handle)
+	if err != nil {
+		return v1.Volume{}, err
+	}
+
+	if !found {
+		return v1.Volume{}, fmt.Errorf("volume not found: %s", handle)
+	}
+
+	if record.Backing == emptyDirBacking {
+		return v1.Volume{
+			Name: name,
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		}, nil
+	}
+
+	return v1.Volume{
+		Name: name,
+		VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+				ClaimName: handle,
+				ReadOnly:  bindMount.Mode == garden.BindMountModeRO,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,344 @@
+package k8s_test
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/worker/k8s"
+	"github.com/concourse/concourse/atc/worker/k8s/k8sfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const namespace = "some-namespace"
+
+func startPods(clientset *fake.Clientset) {
+	clientset.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		pod := action.(k8stesting.CreateAction).GetObject().(*v1.Pod)
+		if pod.Name == "" {
+			pod.Name = pod.GenerateName + "generated"
+		}
+
+		pod.Status.Phase = v1.PodRunning
+
+		return false, nil, nil
+	})
+}
+
+var _ = Describe("GardenClient", func() {
+	var (
+		clientset    *fake.Clientset
+		fakeExecutor *k8sfakes.FakeExecutor
+		logger       *lagertest.TestLogger
+
+		gardenClient       garden.Client
+		baggageclaimClient baggageclaim.Client
+	)
+
+	BeforeEach(func() {
+		clientset = fake.NewSimpleClientset()
+		startPods(clientset)
+
+		fakeExecutor = new(k8sfakes.FakeExecutor)
+		logger = lagertest.NewTestLogger("test")
+
+		runtime := k8s.NewRuntime(
+			clientset,
+			fakeExecutor,
+			fakeclock.NewFakeClock(time.Now()),
+			k8s.Config{
+				Namespace:       namespace,
+				VolumeSize:      resource.MustParse("1Gi"),
+				HelperImage:     "some-helper-image",
+				PodStartTimeout: time.Minute,
+			},
+		)
+
+		gardenClient = runtime.GardenClient(logger, nil)
+		baggageclaimClient = runtime.BaggageclaimClient(logger, nil)
+	})
+
+	getPod := func(name string) *v1.Pod {
+		pod, err := clientset.CoreV1().Pods(namespace).Get(name, meta_v1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return pod
+	}
+
+	Describe("Create", func() {
+		var (
+			spec      garden.ContainerSpec
+			container garden.Container
+			createErr error
+		)
+
+		BeforeEach(func() {
+			spec = garden.ContainerSpec{
+				Handle:     "some-handle",
+				RootFSPath: "docker:///some/image#some-tag",
+				Env:        []string{"FOO=bar", "BAZ=a=b"},
+				Properties: garden.Properties{"some": "property"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			container, createErr = gardenClient.Create(spec)
+		})
+
+		It("creates a pod named after the handle", func() {
+			Expect(createErr).ToNot(HaveOccurred())
+			Expect(container.Handle()).To(Equal("some-handle"))
+
+			pod := getPod("some-handle")
+			Expect(pod.Spec.Containers).To(HaveLen(1))
+			Expect(pod.Spec.Containers[0].Image).To(Equal("some/image:some-tag"))
+			Expect(pod.Spec.Containers[0].Env).To(Equal([]v1.EnvVar{
+				{Name: "FOO", Value: "bar"},
+				{Name: "BAZ", Value: "a=b"},
+			}))
+			Expect(pod.Spec.RestartPolicy).To(Equal(v1.RestartPolicyNever))
+		})
+
+		It("stores the properties on the pod", func() {
+			Expect(createErr).ToNot(HaveOccurred())
+
+			properties, err := container.Properties()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(properties).To(Equal(garden.Properties{"some": "property"}))
+		})
+
+		Context("when the container is privileged", func() {
+			BeforeEach(func() {
+				spec.Privileged = true
+			})
+
+			It("runs the pod's container privileged", func() {
+				Expect(createErr).ToNot(HaveOccurred())
+
+				securityContext := getPod("some-handle").Spec.Containers[0].SecurityContext
+				Expect(securityContext).ToNot(BeNil())
+				Expect(*securityContext.Privileged).To(BeTrue())
+			})
+		})
+
+		Context("when the container has a memory limit", func() {
+			BeforeEach(func() {
+				spec.Limits.Memory.LimitInBytes = 1024 * 1024
+			})
+
+			It("limits the pod's container", func() {
+				Expect(createErr).ToNot(HaveOccurred())
+
+				limits := getPod("some-handle").Spec.Containers[0].Resources.Limits
+				Expect(limits.Memory().Value()).To(Equal(int64(1024 * 1024)))
+			})
+		})
+
+		Context("when the rootfs is an imported volume", func() {
+			BeforeEach(func() {
+				imported, err := baggageclaimClient.CreateVolume(logger, "imported-volume", baggageclaim.VolumeSpec{
+					Strategy: baggageclaim.ImportStrategy{Path: "docker:///some/resource-type"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				cow, err := baggageclaimClient.CreateVolume(logger, "cow-volume", baggageclaim.VolumeSpec{
+					Strategy: baggageclaim.COWStrategy{Parent: imported},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				spec.RootFSPath = "raw://" + cow.Path() + "/rootfs"
+			})
+
+			It("runs the image the volume was imported from", func() {
+				Expect(createErr).ToNot(HaveOccurred())
+				Expect(getPod("some-handle").Spec.Containers[0].Image).To(Equal("some/resource-type"))
+			})
+		})
+
+		Context("when the rootfs is a volume with no image", func() {
+			BeforeEach(func() {
+				volume, err := baggageclaimClient.CreateVolume(logger, "some-volume", baggageclaim.VolumeSpec{
+					Strategy: baggageclaim.EmptyStrategy{},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				spec.RootFSPath = "raw://" + volume.Path() + "/rootfs"
+			})
+
+			It("returns an error", func() {
+				Expect(createErr).To(Equal(k8s.UnsupportedImageError{URI: spec.RootFSPath}))
+			})
+		})
+
+		Context("when volumes are bind mounted", func() {
+			BeforeEach(func() {
+				volume, err := baggageclaimClient.CreateVolume(logger, "some-volume", baggageclaim.VolumeSpec{
+					Strategy: baggageclaim.EmptyStrategy{},
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				spec.BindMounts = []garden.BindMount{
+					{
+						SrcPath: volume.Path(),
+						DstPath: "/some/dst",
+						Mode:    garden.BindMountModeRO,
+					},
+				}
+			})
+
+			It("mounts the volume's claim", func() {
+				Expect(createErr).ToNot(HaveOccurred())
+
+				pod := getPod("some-handle")
+				Expect(pod.Spec.Volumes).To(HaveLen(1))
+				Expect(pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("some-volume"))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(Equal([]v1.VolumeMount{
+					{
+						Name:      pod.Spec.Volumes[0].Name,
+						MountPath: "/some/dst",
+						ReadOnly:  true,
+					},
+				}))
+			})
+		})
+
+		Context("when a path which is not a volume is bind mounted", func() {
+			BeforeEach(func() {
+				spec.BindMounts = []garden.BindMount{
+					{SrcPath: "/etc/ssl/certs", DstPath: "/etc/ssl/certs"},
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(createErr).To(Equal(k8s.UnsupportedBindMountError{SrcPath: "/etc/ssl/certs"}))
+			})
+		})
+	})
+
+	Describe("Lookup", func() {
+		Context("when the pod does not exist", func() {
+			It("returns ContainerNotFoundError", func() {
+				_, err := gardenClient.Lookup("bogus")
+				Expect(err).To(Equal(garden.ContainerNotFoundError{Handle: "bogus"}))
+			})
+		})
+	})
+
+	Describe("Containers", func() {
+		BeforeEach(func() {
+			_, err := gardenClient.Create(garden.ContainerSpec{
+				Handle:     "handle-a",
+				RootFSPath: "docker:///some/image",
+				Properties: garden.Properties{"a": "1", "b": "2"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = gardenClient.Create(garden.ContainerSpec{
+				Handle:     "handle-b",
+				RootFSPath: "docker:///some/image",
+				Properties: garden.Properties{"a": "1"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the containers matching the properties", func() {
+			containers, err := gardenClient.Containers(garden.Properties{"b": "2"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(containers).To(HaveLen(1))
+			Expect(containers[0].Handle()).To(Equal("handle-a"))
+
+			containers, err = gardenClient.Containers(garden.Properties{"a": "1"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(containers).To(HaveLen(2))
+		})
+	})
+
+	Describe("Destroy", func() {
+		BeforeEach(func() {
+			_, err := gardenClient.Create(garden.ContainerSpec{
+				Handle:     "some-handle",
+				RootFSPath: "docker:///some/image",
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("deletes the pod", func() {
+			Expect(gardenClient.Destroy("some-handle")).To(Succeed())
+
+			_, err := gardenClient.Lookup("some-handle")
+			Expect(err).To(Equal(garden.ContainerNotFoundError{Handle: "some-handle"}))
+		})
+	})
+
+	Describe("a container", func() {
+		var container garden.Container
+
+		BeforeEach(func() {
+			var err error
+			container, err = gardenClient.Create(garden.ContainerSpec{
+				Handle:     "some-handle",
+				RootFSPath: "docker:///some/image",
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Describe("Run", func() {
+			var stdout *bytes.Buffer
+
+			BeforeEach(func() {
+				stdout = new(bytes.Buffer)
+
+				fakeExecutor.ExecStub = func(_ string, _ string, _ string, _ []string, _ io.Reader, stdout io.Writer, _ io.Writer, _ bool) (int, error) {
+					_, err := stdout.Write([]byte("hello"))
+					return 42, err
+				}
+			})
+
+			It("execs the process in the pod", func() {
+				process, err := container.Run(garden.ProcessSpec{
+					ID:   "some-process",
+					Path: "/some/path",
+					Args: []string{"some", "args"},
+					Dir:  "/some/dir",
+					Env:  []string{"FOO=bar"},
+				}, garden.ProcessIO{Stdout: stdout})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(process.ID()).To(Equal("some-process"))
+
+				status, err := process.Wait()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(status).To(Equal(42))
+				Expect(stdout.String()).To(Equal("hello"))
+
+				Expect(fakeExecutor.ExecCallCount()).To(Equal(1))
+				ns, pod, _, command, _, _, _, tty := fakeExecutor.ExecArgsForCall(0)
+				Expect(ns).To(Equal(namespace))
+				Expect(pod).To(Equal("some-handle"))
+				Expect(command[len(command)-6:]).To(Equal([]string{
+					"/some/dir", "env", "FOO=bar", "/some/path", "some", "args",
+				}))
+				Expect(tty).To(BeFalse())
+			})
+		})
+
+		Describe("SetProperty", func() {
+			It("updates the pod's properties", func() {
+				Expect(container.SetProperty("some", "value")).To(Succeed())
+
+				value, err := container.Property("some")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(Equal("value"))
+			})
+		})
+	})
+})
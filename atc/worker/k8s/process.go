@@ -0,0 +1,55 @@
+package k8s
+
+import (
+	"code.cloudfoundry.org/garden"
+)
+
+// podProcess is a process exec'd into a container's pod.
+type podProcess struct {
+	id        string
+	container *podContainer
+
+	exitedCh   chan struct{}
+	exitStatus int
+	exitErr    error
+}
+
+func newPodProcess(id string, container *podContainer) *podProcess {
+	return &podProcess{
+		id:        id,
+		container: container,
+		exitedCh:  make(chan struct{}),
+	}
+}
+
+func (process *podProcess) ID() string {
+	return process.id
+}
+
+func (process *podProcess) Wait() (int, error) {
+	<-process.exitedCh
+	return process.exitStatus, process.exitErr
+}
+
+// SetTTY does nothing, as the terminal of a process exec'd into a pod cannot
+// be resized through the Executor.
+func (process *podProcess) SetTTY(garden.TTYSpec) error {
+	return nil
+}
+
+func (process *podProcess) Signal(signal garden.Signal) error {
+	_, err := process.container.exec([]string{
+		"sh", "-c", signalCommand, signalName(signal), process.pidFile(),
+	}, nil, nil, nil, false)
+	return err
+}
+
+func (process *podProcess) exited(status int, err error) {
+	process.exitStatus = status
+	process.exitErr = err
+	close(process.exitedCh)
+}
+
+func (process *podProcess) pidFile() string {
+	return "/tmp/concourse-process-" + process.id + ".pid"
+}
@@ -0,0 +1,210 @@
+// Package k8s implements a worker runtime which runs containers as pods and
+// volumes as persistent volume claims or empty dirs in a Kubernetes
+// namespace.
+//
+// The runtime adapts Kubernetes to the Garden and Baggageclaim clients used
+// by the rest of the worker package, so containers and volumes are tracked
+// in the database and garbage collected the same way as on any other worker.
+package k8s
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// VolumesPath is the directory volumes appear to be in, so that they can be
+// referred to by path in bind mounts and raw rootfs URIs.
+const VolumesPath = "/concourse/volumes"
+
+const (
+	containerLabel       = "concourse.ci/container"
+	volumeLabel          = "concourse.ci/volume"
+	helperLabel          = "concourse.ci/helper"
+	propertiesAnnotation = "concourse.ci/properties"
+
+	mainContainerName = "main"
+
+	podPollInterval = time.Second
+)
+
+// ErrUnsupported is returned for operations which have no equivalent on a
+// Kubernetes worker.
+var ErrUnsupported = errors.New("not supported by the kubernetes runtime")
+
+// ErrPodStartTimedOut is returned when a pod does not start running within
+// the configured timeout, e.g. because its image could not be pulled.
+var ErrPodStartTimedOut = errors.New("timed out waiting for pod to start")
+
+// idleCommand keeps a pod's main container running so that processes can
+// be run in it later, like an empty Garden container.
+var idleCommand = []string{"/bin/sh", "-c", "trap 'exit 0' TERM; while true; do sleep 1; done"}
+
+type Config struct {
+	Namespace string
+
+	// StorageClass is the storage class of the claims backing volumes. The
+	// cluster's default storage class is used if it's empty.
+	StorageClass string
+
+	// VolumeSize is the storage requested for each volume's claim.
+	VolumeSize resource.Quantity
+
+	// HelperImage is the image of the pods which stream data in and out of
+	// volumes. It must have sh, tar and cp.
+	HelperImage string
+
+	PodStartTimeout time.Duration
+}
+
+// Runtime is a worker.Runtime for a single worker backed by a namespace.
+// The worker is not registered through the TSA, so it must be registered and
+// swept by the ATC itself.
+type Runtime struct {
+	gardenClient       *gardenClient
+	baggageclaimClient *baggageclaimClient
+}
+
+var _ worker.Runtime = &Runtime{}
+
+func NewRuntime(
+	clientset kubernetes.Interface,
+	executor Executor,
+	clock clock.Clock,
+	config Config,
+) *Runtime {
+	volumes := &baggageclaimClient{
+		clientset: clientset,
+		executor:  executor,
+		clock:     clock,
+		config:    config,
+	}
+
+	return &Runtime{
+		gardenClient: &gardenClient{
+			clientset: clientset,
+			executor:  executor,
+			clock:     clock,
+			config:    config,
+			volumes:   volumes,
+		},
+		baggageclaimClient: volumes,
+	}
+}
+
+func (runtime *Runtime) GardenClient(lager.Logger, db.Worker) garden.Client {
+	return runtime.gardenClient
+}
+
+func (runtime *Runtime) BaggageclaimClient(lager.Logger, db.Worker) baggageclaim.Client {
+	return runtime.baggageclaimClient
+}
+
+// ImageFromRootFSPath converts a Garden rootfs URI for an image in a
+// registry, e.g. docker:///concourse/git-resource#latest, to the image
+// reference used in a pod spec.
+func ImageFromRootFSPath(rootFSPath string) (string, bool) {
+	if !strings.HasPrefix(rootFSPath, "docker://") {
+		return "", false
+	}
+
+	ref := strings.TrimPrefix(rootFSPath, "docker://")
+	ref = strings.TrimPrefix(ref, "/")
+
+	if i := strings.Index(ref, "#"); i != -1 {
+		ref = ref[:i] + ":" + ref[i+1:]
+	}
+
+	return ref, ref != ""
+}
+
+func volumePath(handle string) string {
+	return path.Join(VolumesPath, handle)
+}
+
+// handleFromVolumePath returns the handle of the volume a path is in, e.g.
+// for a volume's path or the rootfs of an image in a volume.
+func handleFromVolumePath(p string) (string, bool) {
+	p = path.Clean(p)
+	if !strings.HasPrefix(p, VolumesPath+"/") {
+		return "", false
+	}
+
+	return strings.SplitN(strings.TrimPrefix(p, VolumesPath+"/"), "/", 2)[0], true
+}
+
+func waitForPod(clientset kubernetes.Interface, clock clock.Clock, config Config, name string) error {
+	timeout := clock.NewTimer(config.PodStartTimeout)
+	defer timeout.Stop()
+
+	for {
+		pod, err := clientset.CoreV1().Pods(config.Namespace).Get(name, meta_v1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		switch pod.Status.Phase {
+		case v1.PodRunning:
+			return nil
+		case v1.PodSucceeded, v1.PodFailed:
+			return fmt.Errorf("pod %s exited before it could be used: %s", name, pod.Status.Phase)
+		}
+
+		select {
+		case <-timeout.C():
+			return ErrPodStartTimedOut
+		case <-clock.After(podPollInterval):
+		}
+	}
+}
+
+func encodeProperties(properties map[string]string) (string, error) {
+	if properties == nil {
+		properties = map[string]string{}
+	}
+
+	payload, err := json.Marshal(properties)
+	if err != nil {
+		return "", err
+	}
+
+	return string(payload), nil
+}
+
+func decodeProperties(payload string) (map[string]string, error) {
+	properties := map[string]string{}
+	if payload == "" {
+		return properties, nil
+	}
+
+	err := json.Unmarshal([]byte(payload), &properties)
+	if err != nil {
+		return nil, err
+	}
+
+	return properties, nil
+}
+
+func matchesProperties(properties map[string]string, filter map[string]string) bool {
+	for k, v := range filter {
+		if properties[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
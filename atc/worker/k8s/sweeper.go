@@ -0,0 +1,120 @@
+package k8s
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/gc"
+)
+
+// Sweeper is a lockrunner.Task which does for a Kubernetes worker what the
+// beacon's sweepers do for a worker registered through the TSA: it reports
+// the containers and volumes which exist, and destroys the ones the ATC has
+// marked as destroying.
+type Sweeper struct {
+	workerName string
+	runtime    *Runtime
+
+	destroyer           gc.Destroyer
+	containerRepository db.ContainerRepository
+	volumeRepository    db.VolumeRepository
+}
+
+func NewSweeper(
+	workerName string,
+	runtime *Runtime,
+	destroyer gc.Destroyer,
+	containerRepository db.ContainerRepository,
+	volumeRepository db.VolumeRepository,
+) *Sweeper {
+	return &Sweeper{
+		workerName: workerName,
+		runtime:    runtime,
+
+		destroyer:           destroyer,
+		containerRepository: containerRepository,
+		volumeRepository:    volumeRepository,
+	}
+}
+
+func (sweeper *Sweeper) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("sweep")
+
+	sweeper.sweepContainers(logger)
+	sweeper.sweepVolumes(logger)
+
+	return nil
+}
+
+func (sweeper *Sweeper) sweepContainers(logger lager.Logger) {
+	containers, err := sweeper.runtime.gardenClient.Containers(garden.Properties{})
+	if err != nil {
+		logger.Error("failed-to-list-containers", err)
+	} else {
+		handles := []string{}
+		for _, container := range containers {
+			handles = append(handles, container.Handle())
+		}
+
+		err = sweeper.containerRepository.UpdateContainersMissingSince(sweeper.workerName, handles)
+		if err != nil {
+			logger.Error("failed-to-update-containers-missing-since", err)
+		}
+
+		err = sweeper.destroyer.DestroyContainers(sweeper.workerName, handles)
+		if err != nil {
+			logger.Error("failed-to-report-containers", err)
+		}
+	}
+
+	handles, err := sweeper.containerRepository.FindDestroyingContainers(sweeper.workerName)
+	if err != nil {
+		logger.Error("failed-to-find-destroying-containers", err)
+		return
+	}
+
+	for _, handle := range handles {
+		err := sweeper.runtime.gardenClient.Destroy(handle)
+		if err != nil {
+			if _, ok := err.(garden.ContainerNotFoundError); !ok {
+				logger.Error("failed-to-destroy-container", err, lager.Data{"handle": handle})
+			}
+		}
+	}
+}
+
+func (sweeper *Sweeper) sweepVolumes(logger lager.Logger) {
+	volumes, err := sweeper.runtime.baggageclaimClient.ListVolumes(logger, nil)
+	if err != nil {
+		logger.Error("failed-to-list-volumes", err)
+	} else {
+		handles := []string{}
+		for _, volume := range volumes {
+			handles = append(handles, volume.Handle())
+		}
+
+		err = sweeper.volumeRepository.UpdateVolumesMissingSince(sweeper.workerName, handles)
+		if err != nil {
+			logger.Error("failed-to-update-volumes-missing-since", err)
+		}
+
+		err = sweeper.destroyer.DestroyVolumes(sweeper.workerName, handles)
+		if err != nil {
+			logger.Error("failed-to-report-volumes", err)
+		}
+	}
+
+	handles, err := sweeper.destroyer.FindDestroyingVolumesForGc(sweeper.workerName)
+	if err != nil {
+		logger.Error("failed-to-find-destroying-volumes", err)
+		return
+	}
+
+	err = sweeper.runtime.baggageclaimClient.DestroyVolumes(logger, handles)
+	if err != nil {
+		logger.Error("failed-to-destroy-volumes", err)
+	}
+}
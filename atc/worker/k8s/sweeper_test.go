@@ -0,0 +1,111 @@
+package k8s_test
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc/gcfakes"
+	"github.com/concourse/concourse/atc/worker/k8s"
+	"github.com/concourse/concourse/atc/worker/k8s/k8sfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+var _ = Describe("Sweeper", func() {
+	var (
+		fakeClock               *fakeclock.FakeClock
+		fakeDestroyer           *gcfakes.FakeDestroyer
+		fakeContainerRepository *dbfakes.FakeContainerRepository
+		fakeVolumeRepository    *dbfakes.FakeVolumeRepository
+
+		gardenClient       garden.Client
+		baggageclaimClient baggageclaim.Client
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		clientset := fake.NewSimpleClientset()
+		startPods(clientset)
+
+		logger := lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+
+		fakeDestroyer = new(gcfakes.FakeDestroyer)
+		fakeContainerRepository = new(dbfakes.FakeContainerRepository)
+		fakeVolumeRepository = new(dbfakes.FakeVolumeRepository)
+
+		runtime := k8s.NewRuntime(
+			clientset,
+			new(k8sfakes.FakeExecutor),
+			fakeClock,
+			k8s.Config{
+				Namespace:       namespace,
+				VolumeSize:      resource.MustParse("1Gi"),
+				PodStartTimeout: time.Minute,
+			},
+		)
+
+		gardenClient = runtime.GardenClient(logger, nil)
+		baggageclaimClient = runtime.BaggageclaimClient(logger, nil)
+
+		_, err := gardenClient.Create(garden.ContainerSpec{
+			Handle:     "some-container",
+			RootFSPath: "docker:///some/image",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = baggageclaimClient.CreateVolume(logger, "some-volume", baggageclaim.VolumeSpec{
+			Strategy: baggageclaim.EmptyStrategy{},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeContainerRepository.FindDestroyingContainersReturns([]string{"some-container"}, nil)
+		fakeDestroyer.FindDestroyingVolumesForGcReturns([]string{"some-volume"}, nil)
+
+		runErr = k8s.NewSweeper(
+			"some-worker",
+			runtime,
+			fakeDestroyer,
+			fakeContainerRepository,
+			fakeVolumeRepository,
+		).Run(lagerctx.NewContext(context.Background(), logger))
+	})
+
+	It("succeeds", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+	})
+
+	It("reports the existing containers", func() {
+		Expect(fakeDestroyer.DestroyContainersCallCount()).To(Equal(1))
+
+		workerName, handles := fakeDestroyer.DestroyContainersArgsForCall(0)
+		Expect(workerName).To(Equal("some-worker"))
+		Expect(handles).To(Equal([]string{"some-container"}))
+	})
+
+	It("reports the existing volumes", func() {
+		Expect(fakeDestroyer.DestroyVolumesCallCount()).To(Equal(1))
+
+		workerName, handles := fakeDestroyer.DestroyVolumesArgsForCall(0)
+		Expect(workerName).To(Equal("some-worker"))
+		Expect(handles).To(Equal([]string{"some-volume"}))
+	})
+
+	It("destroys the containers and volumes which are destroying", func() {
+		_, err := gardenClient.Lookup("some-container")
+		Expect(err).To(Equal(garden.ContainerNotFoundError{Handle: "some-container"}))
+
+		_, found, err := baggageclaimClient.LookupVolume(lagertest.NewTestLogger("test"), "some-volume")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+})
@@ -0,0 +1,150 @@
+package k8s
+
+import (
+	"io"
+	"path"
+	"strconv"
+
+	"github.com/concourse/baggageclaim"
+)
+
+// fileNotFoundStatus is the exit status of the stream out helper command
+// when the path to stream does not exist.
+const fileNotFoundStatus = 44
+
+// streamOutCommand tars up the contents of a directory, or a single file
+// relative to its parent, like baggageclaim's stream out endpoint.
+const streamOutCommand = `if [ -d "$0" ]; then exec tar -c -C "$0" .; else exec tar -c -C "$(dirname "$0")" "$(basename "$0")"; fi`
+
+type volume struct {
+	client *baggageclaimClient
+	handle string
+}
+
+func (volume *volume) Handle() string {
+	return volume.handle
+}
+
+func (volume *volume) Path() string {
+	return volumePath(volume.handle)
+}
+
+func (volume *volume) SetProperty(key string, value string) error {
+	return volume.client.updateRecord(volume.handle, func(record *volumeRecord) {
+		if record.Properties == nil {
+			record.Properties = baggageclaim.VolumeProperties{}
+		}
+
+		record.Properties[key] = value
+	})
+}
+
+func (volume *volume) SetPrivileged(privileged bool) error {
+	return volume.client.updateRecord(volume.handle, func(record *volumeRecord) {
+		record.Privileged = privileged
+	})
+}
+
+func (volume *volume) Properties() (baggageclaim.VolumeProperties, error) {
+	record, found, err := volume.client.lookupRecord(volume.handle)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, baggageclaim.ErrVolumeNotFound
+	}
+
+	return record.Properties, nil
+}
+
+// StreamIn extracts the tar stream into the volume from a helper pod. Only
+// volumes backed by a persistent volume claim can be streamed to, as empty
+// dirs are only reachable from within the pod they belong to.
+func (volume *volume) StreamIn(dest string, tarStream io.Reader) error {
+	err := volume.ensureClaim()
+	if err != nil {
+		return err
+	}
+
+	pod, err := volume.client.startHelper(volume.handle, "stream-in", map[string]string{
+		helperMountPath: volume.handle,
+	})
+	if err != nil {
+		return err
+	}
+
+	defer volume.client.deleteHelper(pod)
+
+	return volume.client.exec(pod, []string{
+		"sh", "-c", `mkdir -p "$0" && exec tar -x -C "$0"`,
+		path.Join(helperMountPath, dest),
+	}, tarStream, nil)
+}
+
+// StreamOut tars up the given path of the volume from a helper pod, which
+// is deleted once the stream has been read.
+func (volume *volume) StreamOut(src string) (io.ReadCloser, error) {
+	err := volume.ensureClaim()
+	if err != nil {
+		return nil, err
+	}
+
+	pod, err := volume.client.startHelper(volume.handle, "stream-out", map[string]string{
+		helperMountPath: volume.handle,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	srcPath := path.Join(helperMountPath, src)
+
+	status, err := volume.client.executor.Exec(
+		volume.client.config.Namespace,
+		pod,
+		mainContainerName,
+		[]string{"sh", "-c", `[ -e "$0" ] || exit ` + strconv.Itoa(fileNotFoundStatus), srcPath},
+		nil, nil, nil,
+		false,
+	)
+	if err != nil {
+		volume.client.deleteHelper(pod)
+		return nil, err
+	}
+
+	if status == fileNotFoundStatus {
+		volume.client.deleteHelper(pod)
+		return nil, baggageclaim.ErrFileNotFound
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		defer volume.client.deleteHelper(pod)
+
+		writer.CloseWithError(volume.client.exec(pod, []string{"sh", "-c", streamOutCommand, srcPath}, nil, writer))
+	}()
+
+	return reader, nil
+}
+
+func (volume *volume) Destroy() error {
+	return volume.client.destroy(volume.handle)
+}
+
+func (volume *volume) ensureClaim() error {
+	record, found, err := volume.client.lookupRecord(volume.handle)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return baggageclaim.ErrVolumeNotFound
+	}
+
+	if record.Backing != claimBacking {
+		return ErrUnsupported
+	}
+
+	return nil
+}
@@ -1,29 +1,176 @@
 package worker
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
 	"code.cloudfoundry.org/lager"
 )
 
+// ErrNoWorkerFits is returned by placement strategies which found no worker
+// with the capacity to run the container. Placement may be retried once load
+// on the workers has gone down.
+var ErrNoWorkerFits = errors.New("no worker has the capacity to run the container")
+
 type ContainerPlacementStrategy interface {
 	//TODO: Don't pass around container metadata since it's not guaranteed to be deterministic.
 	// Change this after check containers stop being reused
 	Choose(lager.Logger, []Worker, ContainerSpec) (Worker, error)
 }
 
-type VolumeLocalityPlacementStrategy struct {
-	rand *rand.Rand
+//go:generate counterfeiter . PlacementFilter
+
+// A PlacementFilter narrows down the workers a container may be placed on to
+// the ones it prefers. Filters are chained to make up a placement strategy.
+type PlacementFilter interface {
+	Name() string
+	Filter(lager.Logger, []Worker, ContainerSpec) ([]Worker, error)
 }
 
-func NewVolumeLocalityPlacementStrategy() ContainerPlacementStrategy {
-	return &VolumeLocalityPlacementStrategy{
-		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+// A PlacementLimit refuses workers which may not run the container at all,
+// such as those without the capacity for it. Placement filters which are also
+// limits refuse workers regardless of where they appear in a chain.
+type PlacementLimit interface {
+	Allows(Worker, ContainerSpec) bool
+}
+
+// PlacementOptions configures the placement filters which limit the load on
+// workers.
+type PlacementOptions struct {
+	MaxActiveTasksPerWorker int
+	MaxWorkerPressure       float64
+}
+
+// NewPlacementStrategy chains the placement filters with the given names, in
+// order.
+func NewPlacementStrategy(names []string, options PlacementOptions) (ContainerPlacementStrategy, error) {
+	filters := make([]PlacementFilter, len(names))
+	for i, name := range names {
+		switch name {
+		case "volume-locality":
+			filters[i] = VolumeLocalityPlacementFilter{}
+		case "fewest-build-containers":
+			filters[i] = FewestBuildContainersPlacementFilter{}
+		case "limit-active-tasks":
+			filters[i] = LimitActiveTasksPlacementFilter{
+				MaxTasks:    options.MaxActiveTasksPerWorker,
+				MaxPressure: options.MaxWorkerPressure,
+			}
+		case "least-loaded":
+			filters[i] = LeastLoadedPlacementFilter{
+				MaxPressure: options.MaxWorkerPressure,
+			}
+		case "random":
+			filters[i] = NewRandomPlacementFilter()
+		default:
+			return nil, fmt.Errorf("unknown container placement strategy '%s'", name)
+		}
+	}
+
+	return NewChainPlacementStrategy(filters...), nil
+}
+
+// ChainPlacementStrategy first refuses the workers which any of its filters
+// limit, then applies each of its filters in turn to the workers preferred by
+// the previous ones, and chooses randomly among the workers preferred by all of
+// them. Limits are applied up front so that the workers preferred by an early
+// filter cannot all be refused by a later one while others are allowed.
+type ChainPlacementStrategy struct {
+	filters []PlacementFilter
+	rand    *rand.Rand
+}
+
+func NewChainPlacementStrategy(filters ...PlacementFilter) ContainerPlacementStrategy {
+	return &ChainPlacementStrategy{
+		filters: filters,
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (strategy *ChainPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	candidates := strategy.allowed(workers, spec)
+	if len(candidates) == 0 {
+		logger.Debug("no-worker-allowed", lager.Data{
+			"candidates": workerNames(workers),
+		})
+
+		return nil, ErrNoWorkerFits
+	}
+
+	for _, filter := range strategy.filters {
+		preferred, err := filter.Filter(logger, candidates, spec)
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Debug("filtered-workers", lager.Data{
+			"strategy":   filter.Name(),
+			"candidates": workerNames(candidates),
+			"preferred":  workerNames(preferred),
+		})
+
+		if len(preferred) == 0 {
+			return nil, ErrNoWorkerFits
+		}
+
+		candidates = preferred
 	}
+
+	chosen := candidates[strategy.rand.Intn(len(candidates))]
+
+	logger.Debug("chose-worker", lager.Data{
+		"worker": chosen.Name(),
+	})
+
+	return chosen, nil
 }
 
-func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+func (strategy *ChainPlacementStrategy) allowed(workers []Worker, spec ContainerSpec) []Worker {
+	allowed := []Worker{}
+	for _, w := range workers {
+		if strategy.allows(w, spec) {
+			allowed = append(allowed, w)
+		}
+	}
+
+	return allowed
+}
+
+func (strategy *ChainPlacementStrategy) allows(w Worker, spec ContainerSpec) bool {
+	for _, filter := range strategy.filters {
+		limit, ok := filter.(PlacementLimit)
+		if ok && !limit.Allows(w, spec) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func workerNames(workers []Worker) []string {
+	names := make([]string, len(workers))
+	for i, w := range workers {
+		names[i] = w.Name()
+	}
+
+	return names
+}
+
+func NewVolumeLocalityPlacementStrategy() ContainerPlacementStrategy {
+	return NewChainPlacementStrategy(VolumeLocalityPlacementFilter{})
+}
+
+// VolumeLocalityPlacementFilter prefers the workers which already have the
+// most of the container's inputs.
+type VolumeLocalityPlacementFilter struct{}
+
+func (VolumeLocalityPlacementFilter) Name() string {
+	return "volume-locality"
+}
+
+func (VolumeLocalityPlacementFilter) Filter(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByCount := map[int][]Worker{}
 	var highestCount int
 	for _, w := range workers {
@@ -47,22 +194,22 @@ func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, wor
 		}
 	}
 
-	highestLocalityWorkers := workersByCount[highestCount]
-
-	return highestLocalityWorkers[strategy.rand.Intn(len(highestLocalityWorkers))], nil
+	return workersByCount[highestCount], nil
 }
 
-type FewestBuildContainersPlacementStrategy struct {
-	rand *rand.Rand
+func NewFewestBuildContainersPlacementStrategy() ContainerPlacementStrategy {
+	return NewChainPlacementStrategy(FewestBuildContainersPlacementFilter{})
 }
 
-func NewFewestBuildContainersPlacementStrategy() ContainerPlacementStrategy {
-	return &FewestBuildContainersPlacementStrategy{
-		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
-	}
+// FewestBuildContainersPlacementFilter prefers the workers with the fewest
+// build containers.
+type FewestBuildContainersPlacementFilter struct{}
+
+func (FewestBuildContainersPlacementFilter) Name() string {
+	return "fewest-build-containers"
 }
 
-func (strategy *FewestBuildContainersPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+func (FewestBuildContainersPlacementFilter) Filter(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByWork := map[int][]Worker{}
 	var minWork int
 
@@ -74,20 +221,149 @@ func (strategy *FewestBuildContainersPlacementStrategy) Choose(logger lager.Logg
 		}
 	}
 
-	leastBusyWorkers := workersByWork[minWork]
-	return leastBusyWorkers[strategy.rand.Intn(len(leastBusyWorkers))], nil
+	return workersByWork[minWork], nil
+}
+
+func NewRandomPlacementStrategy() ContainerPlacementStrategy {
+	return NewChainPlacementStrategy(NewRandomPlacementFilter())
 }
 
-type RandomPlacementStrategy struct {
+// RandomPlacementFilter prefers one of the workers at random, so any filters
+// chained after it have no effect.
+type RandomPlacementFilter struct {
 	rand *rand.Rand
 }
 
-func NewRandomPlacementStrategy() ContainerPlacementStrategy {
-	return &RandomPlacementStrategy{
+func NewRandomPlacementFilter() PlacementFilter {
+	return &RandomPlacementFilter{
 		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
-func (strategy *RandomPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
-	return workers[strategy.rand.Intn(len(workers))], nil
+func (filter *RandomPlacementFilter) Name() string {
+	return "random"
+}
+
+func (filter *RandomPlacementFilter) Filter(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	if len(workers) == 0 {
+		return workers, nil
+	}
+
+	return []Worker{workers[filter.rand.Intn(len(workers))]}, nil
+}
+
+// LimitActiveTasksPlacementFilter refuses workers which have reached the
+// maximum number of build containers or which do not have the capacity to run
+// the container, and prefers the workers with the fewest build containers
+// among the rest. A MaxTasks of 0 means no limit.
+type LimitActiveTasksPlacementFilter struct {
+	MaxTasks    int
+	MaxPressure float64
+}
+
+func (LimitActiveTasksPlacementFilter) Name() string {
+	return "limit-active-tasks"
+}
+
+func (filter LimitActiveTasksPlacementFilter) Allows(w Worker, spec ContainerSpec) bool {
+	if filter.MaxTasks != 0 && w.BuildContainers() >= filter.MaxTasks {
+		return false
+	}
+
+	return hasCapacity(w, spec.Limits, filter.MaxPressure)
+}
+
+func (filter LimitActiveTasksPlacementFilter) Filter(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	available := []Worker{}
+	for _, w := range workers {
+		if filter.Allows(w, spec) {
+			available = append(available, w)
+		}
+	}
+
+	return FewestBuildContainersPlacementFilter{}.Filter(logger, available, spec)
+}
+
+func NewLeastLoadedPlacementStrategy(maxPressure float64) ContainerPlacementStrategy {
+	return NewChainPlacementStrategy(LeastLoadedPlacementFilter{
+		MaxPressure: maxPressure,
+	})
+}
+
+// LeastLoadedPlacementFilter prefers the workers under the least pressure,
+// refusing workers which do not have the capacity to run the container.
+// Workers which do not report their resources are only preferred when no
+// other worker has the capacity.
+type LeastLoadedPlacementFilter struct {
+	MaxPressure float64
+}
+
+func (LeastLoadedPlacementFilter) Name() string {
+	return "least-loaded"
+}
+
+func (filter LeastLoadedPlacementFilter) Allows(w Worker, spec ContainerSpec) bool {
+	return hasCapacity(w, spec.Limits, filter.MaxPressure)
+}
+
+func (filter LeastLoadedPlacementFilter) Filter(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	var leastLoadedWorkers, unreportedWorkers []Worker
+	var minPressure float64
+
+	for _, w := range workers {
+		if !filter.Allows(w, spec) {
+			continue
+		}
+
+		resources := w.Resources()
+		if resources == nil {
+			unreportedWorkers = append(unreportedWorkers, w)
+			continue
+		}
+
+		pressure := resources.Pressure()
+		if len(leastLoadedWorkers) == 0 || pressure < minPressure {
+			leastLoadedWorkers = []Worker{w}
+			minPressure = pressure
+		} else if pressure == minPressure {
+			leastLoadedWorkers = append(leastLoadedWorkers, w)
+		}
+	}
+
+	if len(leastLoadedWorkers) == 0 {
+		return unreportedWorkers, nil
+	}
+
+	return leastLoadedWorkers, nil
+}
+
+// cpuSharesPerCPU is the number of CPU shares taken to be worth a whole CPU
+// when estimating the CPU a container will use from its limits.
+const cpuSharesPerCPU = 1024
+
+// hasCapacity returns whether the worker would be under no more than
+// maxPressure once running a container using all of its limits. A maxPressure
+// of 0 only requires the container to fit on the worker. Workers which do not
+// report their resources are assumed to have capacity.
+func hasCapacity(w Worker, limits ContainerLimits, maxPressure float64) bool {
+	resources := w.Resources()
+	if resources == nil {
+		return true
+	}
+
+	if maxPressure == 0 {
+		maxPressure = 1
+	}
+
+	projected := *resources
+
+	if limits.CPU != nil && projected.CPUs != 0 {
+		projected.CPUUsage += float64(*limits.CPU) / cpuSharesPerCPU
+	}
+
+	if limits.Memory != nil && projected.MemoryInBytes != 0 {
+		projected.MemoryUsedInBytes += *limits.Memory
+	}
+
+	return projected.Pressure() <= maxPressure
 }
@@ -1,8 +1,11 @@
 package worker_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
@@ -305,3 +308,427 @@ var _ = Describe("RandomPlacementStrategy", func() {
 		})
 	})
 })
+
+var _ = Describe("LimitActiveTasksPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var compatibleWorker1 *workerfakes.FakeWorker
+		var compatibleWorker2 *workerfakes.FakeWorker
+		var compatibleWorker3 *workerfakes.FakeWorker
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("limit-active-tasks-placement-test")
+
+			var err error
+			strategy, err = NewPlacementStrategy([]string{"limit-active-tasks"}, PlacementOptions{
+				MaxActiveTasksPerWorker: 20,
+				MaxWorkerPressure:       0.9,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			compatibleWorker1 = new(workerfakes.FakeWorker)
+			compatibleWorker1.BuildContainersReturns(10)
+
+			compatibleWorker2 = new(workerfakes.FakeWorker)
+			compatibleWorker2.BuildContainersReturns(5)
+
+			compatibleWorker3 = new(workerfakes.FakeWorker)
+			compatibleWorker3.BuildContainersReturns(15)
+
+			workers = []Worker{compatibleWorker1, compatibleWorker2, compatibleWorker3}
+
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+
+				TeamID: 4567,
+			}
+		})
+
+		JustBeforeEach(func() {
+			chosenWorker, chooseErr = strategy.Choose(
+				logger,
+				workers,
+				spec,
+			)
+		})
+
+		It("picks the worker with the fewest build containers", func() {
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(compatibleWorker2))
+		})
+
+		Context("when the least busy worker is overloaded", func() {
+			BeforeEach(func() {
+				compatibleWorker2.ResourcesReturns(&atc.WorkerResources{
+					MemoryInBytes:     1000,
+					MemoryUsedInBytes: 950,
+				})
+			})
+
+			It("picks the next least busy worker", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker1))
+			})
+		})
+
+		Context("when the container's limits do not fit on the least busy worker", func() {
+			BeforeEach(func() {
+				memory := uint64(500)
+				spec.Limits = ContainerLimits{Memory: &memory}
+
+				compatibleWorker2.ResourcesReturns(&atc.WorkerResources{
+					MemoryInBytes:     1000,
+					MemoryUsedInBytes: 600,
+				})
+
+				compatibleWorker1.ResourcesReturns(&atc.WorkerResources{
+					MemoryInBytes:     1000,
+					MemoryUsedInBytes: 100,
+				})
+			})
+
+			It("picks a worker which fits them", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker1))
+			})
+		})
+
+		Context("when workers have reached the maximum number of tasks", func() {
+			BeforeEach(func() {
+				compatibleWorker1.BuildContainersReturns(20)
+				compatibleWorker2.BuildContainersReturns(25)
+			})
+
+			It("picks a worker which has not", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker3))
+			})
+		})
+
+		Context("when no worker fits", func() {
+			BeforeEach(func() {
+				for _, w := range []*workerfakes.FakeWorker{compatibleWorker1, compatibleWorker2, compatibleWorker3} {
+					w.BuildContainersReturns(20)
+				}
+			})
+
+			It("returns ErrNoWorkerFits", func() {
+				Expect(chooseErr).To(Equal(ErrNoWorkerFits))
+			})
+		})
+	})
+})
+
+var _ = Describe("LeastLoadedPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var compatibleWorker1 *workerfakes.FakeWorker
+		var compatibleWorker2 *workerfakes.FakeWorker
+		var compatibleWorker3 *workerfakes.FakeWorker
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("least-loaded-placement-test")
+			strategy = NewLeastLoadedPlacementStrategy(0.9)
+
+			compatibleWorker1 = new(workerfakes.FakeWorker)
+			compatibleWorker1.ResourcesReturns(&atc.WorkerResources{
+				CPUs:          4,
+				CPUUsage:      3,
+				MemoryInBytes: 1000,
+			})
+
+			compatibleWorker2 = new(workerfakes.FakeWorker)
+			compatibleWorker2.ResourcesReturns(&atc.WorkerResources{
+				CPUs:              4,
+				CPUUsage:          1,
+				MemoryInBytes:     1000,
+				MemoryUsedInBytes: 500,
+			})
+
+			compatibleWorker3 = new(workerfakes.FakeWorker)
+
+			workers = []Worker{compatibleWorker1, compatibleWorker2, compatibleWorker3}
+
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+
+				TeamID: 4567,
+			}
+		})
+
+		JustBeforeEach(func() {
+			chosenWorker, chooseErr = strategy.Choose(
+				logger,
+				workers,
+				spec,
+			)
+		})
+
+		It("picks the worker under the least pressure", func() {
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(compatibleWorker2))
+		})
+
+		Context("when the container's CPU limit does not fit on the least loaded worker", func() {
+			BeforeEach(func() {
+				compatibleWorker1.ResourcesReturns(&atc.WorkerResources{
+					CPUs:          4,
+					CPUUsage:      0.5,
+					MemoryInBytes: 1000,
+				})
+
+				compatibleWorker2.ResourcesReturns(&atc.WorkerResources{
+					CPUs:          4,
+					CPUUsage:      0.25,
+					MemoryInBytes: 1000,
+				})
+
+				cpu := uint64(4 * 1024)
+				spec.Limits = ContainerLimits{CPU: &cpu}
+			})
+
+			It("falls back to a worker which does not report its resources", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker3))
+			})
+		})
+
+		Context("when every worker is overloaded", func() {
+			BeforeEach(func() {
+				overloaded := &atc.WorkerResources{
+					DiskInBytes:     1000,
+					DiskUsedInBytes: 990,
+				}
+
+				compatibleWorker1.ResourcesReturns(overloaded)
+				compatibleWorker2.ResourcesReturns(overloaded)
+
+				workers = []Worker{compatibleWorker1, compatibleWorker2}
+			})
+
+			It("returns ErrNoWorkerFits", func() {
+				Expect(chooseErr).To(Equal(ErrNoWorkerFits))
+			})
+		})
+	})
+})
+
+var _ = Describe("ChainPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var (
+			fakeFilter1 *workerfakes.FakePlacementFilter
+			fakeFilter2 *workerfakes.FakePlacementFilter
+
+			worker1 *workerfakes.FakeWorker
+			worker2 *workerfakes.FakeWorker
+			worker3 *workerfakes.FakeWorker
+		)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("chain-placement-test")
+
+			worker1 = new(workerfakes.FakeWorker)
+			worker1.NameReturns("worker-1")
+			worker2 = new(workerfakes.FakeWorker)
+			worker2.NameReturns("worker-2")
+			worker3 = new(workerfakes.FakeWorker)
+			worker3.NameReturns("worker-3")
+
+			workers = []Worker{worker1, worker2, worker3}
+
+			fakeFilter1 = new(workerfakes.FakePlacementFilter)
+			fakeFilter1.NameReturns("filter-1")
+			fakeFilter1.FilterReturns([]Worker{worker1, worker2}, nil)
+
+			fakeFilter2 = new(workerfakes.FakePlacementFilter)
+			fakeFilter2.NameReturns("filter-2")
+			fakeFilter2.FilterReturns([]Worker{worker2}, nil)
+
+			strategy = NewChainPlacementStrategy(fakeFilter1, fakeFilter2)
+
+			spec = ContainerSpec{TeamID: 4567}
+		})
+
+		JustBeforeEach(func() {
+			chosenWorker, chooseErr = strategy.Choose(
+				logger,
+				workers,
+				spec,
+			)
+		})
+
+		It("applies each filter to the workers preferred by the previous one", func() {
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(worker2))
+
+			_, filteredWorkers, filteredSpec := fakeFilter1.FilterArgsForCall(0)
+			Expect(filteredWorkers).To(Equal(workers))
+			Expect(filteredSpec).To(Equal(spec))
+
+			_, filteredWorkers, _ = fakeFilter2.FilterArgsForCall(0)
+			Expect(filteredWorkers).To(Equal([]Worker{worker1, worker2}))
+		})
+
+		It("explains its decisions", func() {
+			Expect(logger.LogMessages()).To(ContainElement("chain-placement-test.filtered-workers"))
+			Expect(logger.Logs()[0].Data).To(Equal(lager.Data{
+				"strategy":   "filter-1",
+				"candidates": []interface{}{"worker-1", "worker-2", "worker-3"},
+				"preferred":  []interface{}{"worker-1", "worker-2"},
+			}))
+		})
+
+		Context("when a filter prefers no workers", func() {
+			BeforeEach(func() {
+				fakeFilter1.FilterReturns([]Worker{}, nil)
+			})
+
+			It("returns ErrNoWorkerFits without applying the rest", func() {
+				Expect(chooseErr).To(Equal(ErrNoWorkerFits))
+				Expect(fakeFilter2.FilterCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when a filter fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeFilter1.FilterReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(chooseErr).To(Equal(disaster))
+			})
+		})
+
+		Context("when there are no filters", func() {
+			BeforeEach(func() {
+				strategy = NewChainPlacementStrategy()
+			})
+
+			It("chooses any of the workers", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(workers).To(ContainElement(chosenWorker))
+			})
+		})
+	})
+})
+
+var _ = Describe("NewPlacementStrategy", func() {
+	var (
+		names    []string
+		options  PlacementOptions
+		buildErr error
+
+		busyWorkerWithCache  *workerfakes.FakeWorker
+		idleWorkerWithCache  *workerfakes.FakeWorker
+		idleWorkerNoCache    *workerfakes.FakeWorker
+		overloadedIdleWorker *workerfakes.FakeWorker
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("placement-strategy-test")
+
+		busyWorkerWithCache = new(workerfakes.FakeWorker)
+		busyWorkerWithCache.BuildContainersReturns(10)
+
+		idleWorkerWithCache = new(workerfakes.FakeWorker)
+		idleWorkerWithCache.BuildContainersReturns(1)
+
+		idleWorkerNoCache = new(workerfakes.FakeWorker)
+		idleWorkerNoCache.BuildContainersReturns(0)
+
+		overloadedIdleWorker = new(workerfakes.FakeWorker)
+		overloadedIdleWorker.BuildContainersReturns(0)
+		overloadedIdleWorker.ResourcesReturns(&atc.WorkerResources{
+			MemoryInBytes:     100,
+			MemoryUsedInBytes: 100,
+		})
+
+		fakeInput := new(workerfakes.FakeInputSource)
+		fakeInputAS := new(workerfakes.FakeArtifactSource)
+		fakeInputAS.VolumeOnStub = func(logger lager.Logger, worker Worker) (Volume, bool, error) {
+			switch worker {
+			case busyWorkerWithCache, idleWorkerWithCache:
+				return new(workerfakes.FakeVolume), true, nil
+			default:
+				return nil, false, nil
+			}
+		}
+		fakeInput.SourceReturns(fakeInputAS)
+
+		spec = ContainerSpec{
+			TeamID: 4567,
+			Inputs: []InputSource{fakeInput},
+		}
+
+		workers = []Worker{busyWorkerWithCache, idleWorkerWithCache, idleWorkerNoCache, overloadedIdleWorker}
+
+		options = PlacementOptions{
+			MaxWorkerPressure: 0.9,
+		}
+	})
+
+	JustBeforeEach(func() {
+		strategy, buildErr = NewPlacementStrategy(names, options)
+		if buildErr == nil {
+			chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+		}
+	})
+
+	Context("with volume-locality then fewest-build-containers", func() {
+		BeforeEach(func() {
+			names = []string{"volume-locality", "fewest-build-containers"}
+		})
+
+		It("picks the least busy of the workers with the most inputs", func() {
+			Expect(buildErr).ToNot(HaveOccurred())
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(idleWorkerWithCache))
+		})
+	})
+
+	Context("with limit-active-tasks then fewest-build-containers", func() {
+		BeforeEach(func() {
+			names = []string{"limit-active-tasks", "fewest-build-containers"}
+		})
+
+		It("picks the least busy worker which is not overloaded", func() {
+			Expect(buildErr).ToNot(HaveOccurred())
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(idleWorkerNoCache))
+		})
+	})
+
+	Context("with volume-locality then limit-active-tasks", func() {
+		BeforeEach(func() {
+			names = []string{"volume-locality", "limit-active-tasks"}
+		})
+
+		It("picks the least busy of the workers with the most inputs", func() {
+			Expect(buildErr).ToNot(HaveOccurred())
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(idleWorkerWithCache))
+		})
+
+		Context("when the workers with the most inputs have reached the maximum number of tasks", func() {
+			BeforeEach(func() {
+				options.MaxActiveTasksPerWorker = 1
+			})
+
+			It("picks a worker which has not, rather than waiting for them", func() {
+				Expect(buildErr).ToNot(HaveOccurred())
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(idleWorkerNoCache))
+			})
+		})
+	})
+
+	Context("with an unknown strategy", func() {
+		BeforeEach(func() {
+			names = []string{"volume-locality", "bogus"}
+		})
+
+		It("returns an error", func() {
+			Expect(buildErr).To(MatchError("unknown container placement strategy 'bogus'"))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package worker
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -8,6 +9,7 @@ import (
 
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
+	"github.com/cenkalti/backoff"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -42,7 +44,8 @@ type WorkerProvider interface {
 }
 
 var (
-	ErrNoWorkers = errors.New("no workers")
+	ErrNoWorkers             = errors.New("no workers")
+	ErrContainerQuotaReached = db.ErrContainersQuotaReached
 )
 
 type NoCompatibleWorkersError struct {
@@ -57,6 +60,7 @@ func (err NoCompatibleWorkersError) Error() string {
 
 type Pool interface {
 	FindOrChooseWorkerForContainer(
+		context.Context,
 		lager.Logger,
 		db.ContainerOwner,
 		ContainerSpec,
@@ -71,15 +75,19 @@ type Pool interface {
 }
 
 type pool struct {
-	provider WorkerProvider
+	clock       clock.Clock
+	provider    WorkerProvider
+	teamFactory db.TeamFactory
 
 	rand *rand.Rand
 }
 
-func NewPool(provider WorkerProvider) Pool {
+func NewPool(clock clock.Clock, provider WorkerProvider, teamFactory db.TeamFactory) Pool {
 	return &pool{
-		provider: provider,
-		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
+		clock:       clock,
+		provider:    provider,
+		teamFactory: teamFactory,
+		rand:        rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
@@ -120,6 +128,7 @@ func (pool *pool) allSatisfying(logger lager.Logger, spec WorkerSpec) ([]Worker,
 }
 
 func (pool *pool) FindOrChooseWorkerForContainer(
+	ctx context.Context,
 	logger lager.Logger,
 	owner db.ContainerOwner,
 	containerSpec ContainerSpec,
@@ -151,7 +160,7 @@ dance:
 	}
 
 	if worker == nil {
-		worker, err = strategy.Choose(logger, compatibleWorkers, containerSpec)
+		worker, err = pool.chooseWorker(ctx, logger, compatibleWorkers, containerSpec, workerSpec, strategy)
 		if err != nil {
 			return nil, err
 		}
@@ -160,6 +169,74 @@ dance:
 	return worker, nil
 }
 
+// chooseWorker places the container using the strategy. If no worker has the
+// capacity to run it, or its team has reached its container quota, placement
+// is retried with backoff against the latest state of the workers until it
+// succeeds or the context is done.
+func (pool *pool) chooseWorker(
+	ctx context.Context,
+	logger lager.Logger,
+	compatibleWorkers []Worker,
+	containerSpec ContainerSpec,
+	workerSpec WorkerSpec,
+	strategy ContainerPlacementStrategy,
+) (Worker, error) {
+	exp := backoff.NewExponentialBackOff()
+	exp.InitialInterval = time.Second
+	exp.MaxInterval = 30 * time.Second
+	exp.MaxElapsedTime = 0
+	exp.Reset()
+
+	team := pool.teamFactory.GetByID(containerSpec.TeamID)
+
+	for {
+		worker, err := pool.place(logger, team, compatibleWorkers, containerSpec, strategy)
+		if err != ErrNoWorkerFits && err != ErrContainerQuotaReached {
+			return worker, err
+		}
+
+		interval := exp.NextBackOff()
+
+		logger.Info("waiting-to-place-container", lager.Data{
+			"reason":      err.Error(),
+			"retrying-in": interval.String(),
+		})
+
+		select {
+		case <-pool.clock.NewTimer(interval).C():
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		compatibleWorkers, err = pool.allSatisfying(logger, workerSpec)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// place checks the team's container quota before choosing a worker. The
+// quota is enforced when the container is created, so this only saves placing
+// a container which could not be created yet.
+func (pool *pool) place(
+	logger lager.Logger,
+	team db.Team,
+	compatibleWorkers []Worker,
+	containerSpec ContainerSpec,
+	strategy ContainerPlacementStrategy,
+) (Worker, error) {
+	reachedQuota, err := team.ContainersQuotaReached()
+	if err != nil {
+		return nil, err
+	}
+
+	if reachedQuota {
+		return nil, ErrContainerQuotaReached
+	}
+
+	return strategy.Choose(logger, compatibleWorkers, containerSpec)
+}
+
 func (pool *pool) FindOrChooseWorker(
 	logger lager.Logger,
 	workerSpec WorkerSpec,
@@ -1,8 +1,11 @@
 package worker_test
 
 import (
+	"context"
 	"errors"
+	"time"
 
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/cloudfoundry/bosh-cli/director/template"
@@ -17,20 +20,28 @@ import (
 
 var _ = Describe("Pool", func() {
 	var (
-		logger       *lagertest.TestLogger
-		fakeProvider *workerfakes.FakeWorkerProvider
-		pool         Pool
+		logger          *lagertest.TestLogger
+		fakeClock       *fakeclock.FakeClock
+		fakeProvider    *workerfakes.FakeWorkerProvider
+		fakeTeamFactory *dbfakes.FakeTeamFactory
+		fakeTeam        *dbfakes.FakeTeam
+		pool            Pool
 	)
 
 	BeforeEach(func() {
 		logger = lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
 		fakeProvider = new(workerfakes.FakeWorkerProvider)
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeamFactory.GetByIDReturns(fakeTeam)
 
-		pool = NewPool(fakeProvider)
+		pool = NewPool(fakeClock, fakeProvider, fakeTeamFactory)
 	})
 
 	Describe("FindOrChooseWorkerForContainer", func() {
 		var (
+			ctx           context.Context
 			spec          ContainerSpec
 			workerSpec    WorkerSpec
 			resourceTypes creds.VersionedResourceTypes
@@ -45,6 +56,8 @@ var _ = Describe("Pool", func() {
 		)
 
 		BeforeEach(func() {
+			ctx = context.Background()
+
 			fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
 
 			fakeOwner = new(dbfakes.FakeContainerOwner)
@@ -115,6 +128,7 @@ var _ = Describe("Pool", func() {
 
 		JustBeforeEach(func() {
 			chosenWorker, chooseErr = pool.FindOrChooseWorkerForContainer(
+				ctx,
 				logger,
 				fakeOwner,
 				spec,
@@ -170,6 +184,10 @@ var _ = Describe("Pool", func() {
 					Expect(chooseErr).NotTo(HaveOccurred())
 					Expect(chosenWorker.Name()).To(Equal(workerA.Name()))
 				})
+
+				It("does not check the team's container quota", func() {
+					Expect(fakeTeam.ContainersQuotaReachedCallCount()).To(BeZero())
+				})
 			})
 
 			Context("when multiple workers satisfy the spec", func() {
@@ -263,6 +281,42 @@ var _ = Describe("Pool", func() {
 					Expect(satisfyingWorkers).To(ConsistOf(workerA, workerB))
 				})
 
+				Context("when no worker fits the container", func() {
+					BeforeEach(func() {
+						fakeStrategy.ChooseStub = func(lager.Logger, []Worker, ContainerSpec) (Worker, error) {
+							if fakeStrategy.ChooseCallCount() == 1 {
+								return nil, ErrNoWorkerFits
+							}
+
+							return workerB, nil
+						}
+
+						go fakeClock.WaitForWatcherAndIncrement(time.Minute)
+					})
+
+					It("retries placement against the latest workers", func() {
+						Expect(chooseErr).NotTo(HaveOccurred())
+						Expect(chosenWorker).To(Equal(workerB))
+
+						Expect(fakeStrategy.ChooseCallCount()).To(Equal(2))
+						Expect(fakeProvider.RunningWorkersCallCount()).To(Equal(2))
+					})
+				})
+
+				Context("when no worker fits the container before the context is done", func() {
+					BeforeEach(func() {
+						fakeStrategy.ChooseReturns(nil, ErrNoWorkerFits)
+
+						var cancel context.CancelFunc
+						ctx, cancel = context.WithCancel(ctx)
+						cancel()
+					})
+
+					It("returns the context's error", func() {
+						Expect(chooseErr).To(Equal(context.Canceled))
+					})
+				})
+
 				Context("when no workers satisfy the spec", func() {
 					BeforeEach(func() {
 						workerA.SatisfiesReturns(false)
@@ -411,6 +465,58 @@ var _ = Describe("Pool", func() {
 						Expect(chooseErr).To(Equal(strategyError))
 					})
 				})
+
+				Context("when the team has reached its container quota", func() {
+					BeforeEach(func() {
+						fakeTeam.ContainersQuotaReachedReturns(true, nil)
+					})
+
+					It("checks the quota of the container's team", func() {
+						Expect(fakeTeamFactory.GetByIDArgsForCall(0)).To(Equal(4567))
+					})
+
+					Context("until a container is destroyed", func() {
+						BeforeEach(func() {
+							fakeTeam.ContainersQuotaReachedReturnsOnCall(1, false, nil)
+							fakeStrategy.ChooseReturns(compatibleWorker, nil)
+
+							go fakeClock.WaitForWatcherAndIncrement(time.Minute)
+						})
+
+						It("waits to choose a worker", func() {
+							Expect(chooseErr).NotTo(HaveOccurred())
+							Expect(chosenWorker).To(Equal(compatibleWorker))
+
+							Expect(fakeTeam.ContainersQuotaReachedCallCount()).To(Equal(2))
+							Expect(fakeStrategy.ChooseCallCount()).To(Equal(1))
+						})
+					})
+
+					Context("until the context is done", func() {
+						BeforeEach(func() {
+							var cancel context.CancelFunc
+							ctx, cancel = context.WithCancel(ctx)
+							cancel()
+						})
+
+						It("returns the context's error without choosing a worker", func() {
+							Expect(chooseErr).To(Equal(context.Canceled))
+							Expect(fakeStrategy.ChooseCallCount()).To(BeZero())
+						})
+					})
+				})
+
+				Context("when checking the team's container quota fails", func() {
+					disaster := errors.New("nope")
+
+					BeforeEach(func() {
+						fakeTeam.ContainersQuotaReachedReturns(false, disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(chooseErr).To(Equal(disaster))
+					})
+				})
 			})
 		})
 	})
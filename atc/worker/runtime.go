@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	bclient "github.com/concourse/baggageclaim/client"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/worker/transport"
+	"github.com/concourse/retryhttp"
+)
+
+//go:generate counterfeiter . Runtime
+
+// Runtime constructs the clients for managing the containers and volumes of
+// a worker.
+type Runtime interface {
+	GardenClient(lager.Logger, db.Worker) garden.Client
+	BaggageclaimClient(lager.Logger, db.Worker) baggageclaim.Client
+}
+
+type gardenRuntime struct {
+	db                                transport.TransportDB
+	retryBackOffFactory               retryhttp.BackOffFactory
+	baggageclaimResponseHeaderTimeout time.Duration
+}
+
+// NewGardenRuntime constructs a Runtime which talks to the Garden and
+// Baggageclaim servers registered by each worker.
+func NewGardenRuntime(
+	db transport.TransportDB,
+	retryBackOffFactory retryhttp.BackOffFactory,
+	baggageclaimResponseHeaderTimeout time.Duration,
+) Runtime {
+	return &gardenRuntime{
+		db:                                db,
+		retryBackOffFactory:               retryBackOffFactory,
+		baggageclaimResponseHeaderTimeout: baggageclaimResponseHeaderTimeout,
+	}
+}
+
+func (runtime *gardenRuntime) GardenClient(logger lager.Logger, savedWorker db.Worker) garden.Client {
+	gcf := NewGardenClientFactory(
+		runtime.db,
+		logger.Session("garden-connection"),
+		savedWorker.Name(),
+		savedWorker.GardenAddr(),
+		runtime.retryBackOffFactory,
+	)
+
+	return gcf.NewClient()
+}
+
+func (runtime *gardenRuntime) BaggageclaimClient(logger lager.Logger, savedWorker db.Worker) baggageclaim.Client {
+	return bclient.New("", transport.NewBaggageclaimRoundTripper(
+		savedWorker.Name(),
+		savedWorker.BaggageclaimURL(),
+		runtime.db,
+		&http.Transport{
+			DisableKeepAlives:     true,
+			ResponseHeaderTimeout: runtime.baggageclaimResponseHeaderTimeout,
+		},
+	))
+}
+
+type runtimeByWorker struct {
+	defaultRuntime Runtime
+	workerRuntimes map[string]Runtime
+}
+
+// NewRuntimeByWorker constructs a Runtime which uses the runtime given for
+// each worker's name, falling back to the default runtime for any other
+// worker, e.g. those registered through the TSA.
+func NewRuntimeByWorker(defaultRuntime Runtime, workerRuntimes map[string]Runtime) Runtime {
+	return &runtimeByWorker{
+		defaultRuntime: defaultRuntime,
+		workerRuntimes: workerRuntimes,
+	}
+}
+
+func (runtime *runtimeByWorker) GardenClient(logger lager.Logger, savedWorker db.Worker) garden.Client {
+	return runtime.runtimeFor(savedWorker).GardenClient(logger, savedWorker)
+}
+
+func (runtime *runtimeByWorker) BaggageclaimClient(logger lager.Logger, savedWorker db.Worker) baggageclaim.Client {
+	return runtime.runtimeFor(savedWorker).BaggageclaimClient(logger, savedWorker)
+}
+
+func (runtime *runtimeByWorker) runtimeFor(savedWorker db.Worker) Runtime {
+	workerRuntime, found := runtime.workerRuntimes[savedWorker.Name()]
+	if !found {
+		return runtime.defaultRuntime
+	}
+
+	return workerRuntime
+}
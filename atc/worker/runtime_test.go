@@ -0,0 +1,83 @@
+package worker_test
+
+import (
+	"code.cloudfoundry.org/garden/gardenfakes"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim/baggageclaimfakes"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RuntimeByWorker", func() {
+	var (
+		logger *lagertest.TestLogger
+
+		fakeDefaultRuntime *workerfakes.FakeRuntime
+		fakeWorkerRuntime  *workerfakes.FakeRuntime
+
+		defaultGardenClient       *gardenfakes.FakeClient
+		defaultBaggageclaimClient *baggageclaimfakes.FakeClient
+		workerGardenClient        *gardenfakes.FakeClient
+		workerBaggageclaimClient  *baggageclaimfakes.FakeClient
+
+		fakeWorker *dbfakes.FakeWorker
+
+		runtime worker.Runtime
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		defaultGardenClient = new(gardenfakes.FakeClient)
+		defaultBaggageclaimClient = new(baggageclaimfakes.FakeClient)
+		fakeDefaultRuntime = new(workerfakes.FakeRuntime)
+		fakeDefaultRuntime.GardenClientReturns(defaultGardenClient)
+		fakeDefaultRuntime.BaggageclaimClientReturns(defaultBaggageclaimClient)
+
+		workerGardenClient = new(gardenfakes.FakeClient)
+		workerBaggageclaimClient = new(baggageclaimfakes.FakeClient)
+		fakeWorkerRuntime = new(workerfakes.FakeRuntime)
+		fakeWorkerRuntime.GardenClientReturns(workerGardenClient)
+		fakeWorkerRuntime.BaggageclaimClientReturns(workerBaggageclaimClient)
+
+		fakeWorker = new(dbfakes.FakeWorker)
+
+		runtime = worker.NewRuntimeByWorker(fakeDefaultRuntime, map[string]worker.Runtime{
+			"kubernetes": fakeWorkerRuntime,
+		})
+	})
+
+	Context("when the worker has its own runtime", func() {
+		BeforeEach(func() {
+			fakeWorker.NameReturns("kubernetes")
+		})
+
+		It("uses the worker's runtime", func() {
+			Expect(runtime.GardenClient(logger, fakeWorker)).To(BeIdenticalTo(workerGardenClient))
+			Expect(runtime.BaggageclaimClient(logger, fakeWorker)).To(BeIdenticalTo(workerBaggageclaimClient))
+
+			_, savedWorker := fakeWorkerRuntime.GardenClientArgsForCall(0)
+			Expect(savedWorker).To(Equal(fakeWorker))
+
+			Expect(fakeDefaultRuntime.GardenClientCallCount()).To(BeZero())
+			Expect(fakeDefaultRuntime.BaggageclaimClientCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the worker does not have its own runtime", func() {
+		BeforeEach(func() {
+			fakeWorker.NameReturns("some-garden-worker")
+		})
+
+		It("uses the default runtime", func() {
+			Expect(runtime.GardenClient(logger, fakeWorker)).To(BeIdenticalTo(defaultGardenClient))
+			Expect(runtime.BaggageclaimClient(logger, fakeWorker)).To(BeIdenticalTo(defaultBaggageclaimClient))
+
+			Expect(fakeWorkerRuntime.GardenClientCallCount()).To(BeZero())
+			Expect(fakeWorkerRuntime.BaggageclaimClientCallCount()).To(BeZero())
+		})
+	})
+})
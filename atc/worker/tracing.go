@@ -0,0 +1,176 @@
+package worker
+
+import (
+	"context"
+	"io"
+
+	"code.cloudfoundry.org/garden"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/tracing"
+)
+
+// traceGardenClient records a span for each call made to Garden on behalf of
+// the operation in ctx.
+func traceGardenClient(ctx context.Context, client garden.Client) garden.Client {
+	return &tracedGardenClient{
+		Client: client,
+		ctx:    ctx,
+	}
+}
+
+type tracedGardenClient struct {
+	garden.Client
+
+	ctx context.Context
+}
+
+func (client *tracedGardenClient) Create(spec garden.ContainerSpec) (garden.Container, error) {
+	_, span := tracing.StartSpan(client.ctx, "garden.create", tracing.Attrs{
+		"container": spec.Handle,
+	})
+
+	container, err := client.Client.Create(spec)
+	tracing.End(span, err)
+
+	return container, err
+}
+
+func (client *tracedGardenClient) Lookup(handle string) (garden.Container, error) {
+	_, span := tracing.StartSpan(client.ctx, "garden.lookup", tracing.Attrs{
+		"container": handle,
+	})
+
+	container, err := client.Client.Lookup(handle)
+	tracing.End(span, err)
+
+	return container, err
+}
+
+func (client *tracedGardenClient) Destroy(handle string) error {
+	_, span := tracing.StartSpan(client.ctx, "garden.destroy", tracing.Attrs{
+		"container": handle,
+	})
+
+	err := client.Client.Destroy(handle)
+	tracing.End(span, err)
+
+	return err
+}
+
+// traceGardenContainer records a span for each process run or attached to in
+// the container, and each stream in or out of it, on behalf of the operation
+// in ctx.
+func traceGardenContainer(ctx context.Context, container garden.Container) garden.Container {
+	return &tracedGardenContainer{
+		Container: container,
+		ctx:       ctx,
+	}
+}
+
+type tracedGardenContainer struct {
+	garden.Container
+
+	ctx context.Context
+}
+
+func (container *tracedGardenContainer) Run(spec garden.ProcessSpec, io garden.ProcessIO) (garden.Process, error) {
+	_, span := tracing.StartSpan(container.ctx, "garden.run", tracing.Attrs{
+		"container": container.Container.Handle(),
+		"path":      spec.Path,
+	})
+
+	process, err := container.Container.Run(spec, io)
+	tracing.End(span, err)
+
+	return process, err
+}
+
+func (container *tracedGardenContainer) Attach(processID string, io garden.ProcessIO) (garden.Process, error) {
+	_, span := tracing.StartSpan(container.ctx, "garden.attach", tracing.Attrs{
+		"container": container.Container.Handle(),
+		"process":   processID,
+	})
+
+	process, err := container.Container.Attach(processID, io)
+	tracing.End(span, err)
+
+	return process, err
+}
+
+func (container *tracedGardenContainer) StreamIn(spec garden.StreamInSpec) error {
+	_, span := tracing.StartSpan(container.ctx, "garden.stream-in", tracing.Attrs{
+		"container": container.Container.Handle(),
+		"path":      spec.Path,
+	})
+
+	err := container.Container.StreamIn(spec)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (container *tracedGardenContainer) StreamOut(spec garden.StreamOutSpec) (io.ReadCloser, error) {
+	_, span := tracing.StartSpan(container.ctx, "garden.stream-out", tracing.Attrs{
+		"container": container.Container.Handle(),
+		"path":      spec.Path,
+	})
+
+	stream, err := container.Container.StreamOut(spec)
+	tracing.End(span, err)
+
+	return stream, err
+}
+
+// traceVolumeClient records a span for each volume found or created in
+// Baggageclaim for a container on behalf of the operation in ctx.
+func traceVolumeClient(ctx context.Context, client VolumeClient) VolumeClient {
+	return &tracedVolumeClient{
+		VolumeClient: client,
+		ctx:          ctx,
+	}
+}
+
+type tracedVolumeClient struct {
+	VolumeClient
+
+	ctx context.Context
+}
+
+func (client *tracedVolumeClient) FindOrCreateVolumeForContainer(
+	logger lager.Logger,
+	spec VolumeSpec,
+	container db.CreatingContainer,
+	teamID int,
+	mountPath string,
+) (Volume, error) {
+	_, span := tracing.StartSpan(client.ctx, "baggageclaim.find-or-create-volume", tracing.Attrs{
+		"container":  container.Handle(),
+		"mount-path": mountPath,
+	})
+
+	volume, err := client.VolumeClient.FindOrCreateVolumeForContainer(logger, spec, container, teamID, mountPath)
+	tracing.End(span, err)
+
+	return volume, err
+}
+
+func (client *tracedVolumeClient) FindOrCreateCOWVolumeForContainer(
+	logger lager.Logger,
+	spec VolumeSpec,
+	container db.CreatingContainer,
+	parent Volume,
+	teamID int,
+	mountPath string,
+) (Volume, error) {
+	_, span := tracing.StartSpan(client.ctx, "baggageclaim.find-or-create-cow-volume", tracing.Attrs{
+		"container":  container.Handle(),
+		"mount-path": mountPath,
+		"parent":     parent.Handle(),
+	})
+
+	volume, err := client.VolumeClient.FindOrCreateCOWVolumeForContainer(logger, spec, container, parent, teamID, mountPath)
+	tracing.End(span, err)
+
+	return volume, err
+}
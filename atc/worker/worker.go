@@ -22,6 +22,7 @@ type Worker interface {
 	ActiveContainers() int
 	ActiveVolumes() int
 	BuildContainers() int
+	Resources() *atc.WorkerResources
 
 	Description() string
 	Name() string
@@ -200,6 +201,10 @@ func (worker *gardenWorker) ActiveVolumes() int {
 	return worker.dbWorker.ActiveVolumes()
 }
 
+func (worker *gardenWorker) Resources() *atc.WorkerResources {
+	return worker.dbWorker.Resources()
+}
+
 func (worker *gardenWorker) Name() string {
 	return worker.dbWorker.Name()
 }
@@ -61,4 +61,41 @@ var _ = Describe("Worker", func() {
 			})
 		})
 	})
+
+	Describe("WorkerResources", func() {
+		var resources atc.WorkerResources
+
+		BeforeEach(func() {
+			resources = atc.WorkerResources{
+				CPUs:     4,
+				CPUUsage: 1,
+
+				MemoryInBytes:     1000,
+				MemoryUsedInBytes: 750,
+
+				DiskInBytes:     1000,
+				DiskUsedInBytes: 500,
+			}
+		})
+
+		It("computes the pressure on each resource", func() {
+			Expect(resources.CPUPressure()).To(Equal(0.25))
+			Expect(resources.MemoryPressure()).To(Equal(0.75))
+			Expect(resources.DiskPressure()).To(Equal(0.5))
+		})
+
+		It("is under the pressure of its most used resource", func() {
+			Expect(resources.Pressure()).To(Equal(0.75))
+		})
+
+		Context("when the capacity is unknown", func() {
+			BeforeEach(func() {
+				resources = atc.WorkerResources{}
+			})
+
+			It("is under no pressure", func() {
+				Expect(resources.Pressure()).To(BeZero())
+			})
+		})
+	})
 })
@@ -68,6 +68,10 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.PruneWorker,
 			atc.LandWorker,
 			atc.RetireWorker,
+			atc.ListBlockingBuilds,
+			atc.CordonWorker,
+			atc.UncordonWorker,
+			atc.ScheduleWorkerMaintenance,
 			atc.ListDestroyingVolumes,
 			atc.ListDestroyingContainers,
 			atc.ReportWorkerContainers,
@@ -111,7 +115,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		case atc.GetLogLevel,
 			atc.SetLogLevel,
-			atc.GetInfoCreds:
+			atc.GetInfoCreds,
+			atc.SetTeamQuotas:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
 
 		// authorized (requested team matches resource team)
@@ -127,6 +132,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetPinCommentOnResource,
 			atc.GetConfig,
 			atc.GetCC,
+			atc.ListResourceWebhookDeliveries,
+			atc.ListResourceChecks,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
 			atc.OrderPipelines,
@@ -165,13 +165,17 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.AbortBuild: checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
 
 				// resource belongs to authorized team
-				atc.PruneWorker:              checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
-				atc.LandWorker:               checkTeamAccessForWorker(inputHandlers[atc.LandWorker]),
-				atc.ReportWorkerContainers:   checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerContainers]),
-				atc.ReportWorkerVolumes:      checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerVolumes]),
-				atc.RetireWorker:             checkTeamAccessForWorker(inputHandlers[atc.RetireWorker]),
-				atc.ListDestroyingContainers: checkTeamAccessForWorker(inputHandlers[atc.ListDestroyingContainers]),
-				atc.ListDestroyingVolumes:    checkTeamAccessForWorker(inputHandlers[atc.ListDestroyingVolumes]),
+				atc.PruneWorker:               checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
+				atc.LandWorker:                checkTeamAccessForWorker(inputHandlers[atc.LandWorker]),
+				atc.ReportWorkerContainers:    checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerContainers]),
+				atc.ReportWorkerVolumes:       checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerVolumes]),
+				atc.RetireWorker:              checkTeamAccessForWorker(inputHandlers[atc.RetireWorker]),
+				atc.ListBlockingBuilds:        checkTeamAccessForWorker(inputHandlers[atc.ListBlockingBuilds]),
+				atc.CordonWorker:              checkTeamAccessForWorker(inputHandlers[atc.CordonWorker]),
+				atc.UncordonWorker:            checkTeamAccessForWorker(inputHandlers[atc.UncordonWorker]),
+				atc.ScheduleWorkerMaintenance: checkTeamAccessForWorker(inputHandlers[atc.ScheduleWorkerMaintenance]),
+				atc.ListDestroyingContainers:  checkTeamAccessForWorker(inputHandlers[atc.ListDestroyingContainers]),
+				atc.ListDestroyingVolumes:     checkTeamAccessForWorker(inputHandlers[atc.ListDestroyingVolumes]),
 
 				// belongs to public pipeline or authorized
 				atc.GetPipeline:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetPipeline]),
@@ -207,37 +211,40 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.DestroyTeam:     authenticated(inputHandlers[atc.DestroyTeam]),
 
 				// authenticated and is admin
-				atc.GetLogLevel:  authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
-				atc.SetLogLevel:  authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
-				atc.GetInfoCreds: authenticatedAndAdmin(inputHandlers[atc.GetInfoCreds]),
+				atc.GetLogLevel:   authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
+				atc.SetLogLevel:   authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
+				atc.GetInfoCreds:  authenticatedAndAdmin(inputHandlers[atc.GetInfoCreds]),
+				atc.SetTeamQuotas: authenticatedAndAdmin(inputHandlers[atc.SetTeamQuotas]),
 
 				// authorized (requested team matches resource team)
-				atc.CheckResource:           authorized(inputHandlers[atc.CheckResource]),
-				atc.CheckResourceType:       authorized(inputHandlers[atc.CheckResourceType]),
-				atc.CreateJobBuild:          authorized(inputHandlers[atc.CreateJobBuild]),
-				atc.DeletePipeline:          authorized(inputHandlers[atc.DeletePipeline]),
-				atc.DisableResourceVersion:  authorized(inputHandlers[atc.DisableResourceVersion]),
-				atc.EnableResourceVersion:   authorized(inputHandlers[atc.EnableResourceVersion]),
-				atc.PinResourceVersion:      authorized(inputHandlers[atc.PinResourceVersion]),
-				atc.UnpinResource:           authorized(inputHandlers[atc.UnpinResource]),
-				atc.SetPinCommentOnResource: authorized(inputHandlers[atc.SetPinCommentOnResource]),
-				atc.GetConfig:               authorized(inputHandlers[atc.GetConfig]),
-				atc.GetCC:                   authorized(inputHandlers[atc.GetCC]),
-				atc.GetVersionsDB:           authorized(inputHandlers[atc.GetVersionsDB]),
-				atc.ListJobInputs:           authorized(inputHandlers[atc.ListJobInputs]),
-				atc.OrderPipelines:          authorized(inputHandlers[atc.OrderPipelines]),
-				atc.PauseJob:                authorized(inputHandlers[atc.PauseJob]),
-				atc.PausePipeline:           authorized(inputHandlers[atc.PausePipeline]),
-				atc.RenamePipeline:          authorized(inputHandlers[atc.RenamePipeline]),
-				atc.SaveConfig:              authorized(inputHandlers[atc.SaveConfig]),
-				atc.UnpauseJob:              authorized(inputHandlers[atc.UnpauseJob]),
-				atc.UnpausePipeline:         authorized(inputHandlers[atc.UnpausePipeline]),
-				atc.ExposePipeline:          authorized(inputHandlers[atc.ExposePipeline]),
-				atc.HidePipeline:            authorized(inputHandlers[atc.HidePipeline]),
-				atc.CreatePipelineBuild:     authorized(inputHandlers[atc.CreatePipelineBuild]),
-				atc.ClearTaskCache:          authorized(inputHandlers[atc.ClearTaskCache]),
-				atc.CreateArtifact:          authorized(inputHandlers[atc.CreateArtifact]),
-				atc.GetArtifact:             authorized(inputHandlers[atc.GetArtifact]),
+				atc.CheckResource:                 authorized(inputHandlers[atc.CheckResource]),
+				atc.CheckResourceType:             authorized(inputHandlers[atc.CheckResourceType]),
+				atc.CreateJobBuild:                authorized(inputHandlers[atc.CreateJobBuild]),
+				atc.DeletePipeline:                authorized(inputHandlers[atc.DeletePipeline]),
+				atc.DisableResourceVersion:        authorized(inputHandlers[atc.DisableResourceVersion]),
+				atc.EnableResourceVersion:         authorized(inputHandlers[atc.EnableResourceVersion]),
+				atc.PinResourceVersion:            authorized(inputHandlers[atc.PinResourceVersion]),
+				atc.UnpinResource:                 authorized(inputHandlers[atc.UnpinResource]),
+				atc.SetPinCommentOnResource:       authorized(inputHandlers[atc.SetPinCommentOnResource]),
+				atc.GetConfig:                     authorized(inputHandlers[atc.GetConfig]),
+				atc.GetCC:                         authorized(inputHandlers[atc.GetCC]),
+				atc.ListResourceWebhookDeliveries: authorized(inputHandlers[atc.ListResourceWebhookDeliveries]),
+				atc.ListResourceChecks:            authorized(inputHandlers[atc.ListResourceChecks]),
+				atc.GetVersionsDB:                 authorized(inputHandlers[atc.GetVersionsDB]),
+				atc.ListJobInputs:                 authorized(inputHandlers[atc.ListJobInputs]),
+				atc.OrderPipelines:                authorized(inputHandlers[atc.OrderPipelines]),
+				atc.PauseJob:                      authorized(inputHandlers[atc.PauseJob]),
+				atc.PausePipeline:                 authorized(inputHandlers[atc.PausePipeline]),
+				atc.RenamePipeline:                authorized(inputHandlers[atc.RenamePipeline]),
+				atc.SaveConfig:                    authorized(inputHandlers[atc.SaveConfig]),
+				atc.UnpauseJob:                    authorized(inputHandlers[atc.UnpauseJob]),
+				atc.UnpausePipeline:               authorized(inputHandlers[atc.UnpausePipeline]),
+				atc.ExposePipeline:                authorized(inputHandlers[atc.ExposePipeline]),
+				atc.HidePipeline:                  authorized(inputHandlers[atc.HidePipeline]),
+				atc.CreatePipelineBuild:           authorized(inputHandlers[atc.CreatePipelineBuild]),
+				atc.ClearTaskCache:                authorized(inputHandlers[atc.ClearTaskCache]),
+				atc.CreateArtifact:                authorized(inputHandlers[atc.CreateArtifact]),
+				atc.GetArtifact:                   authorized(inputHandlers[atc.GetArtifact]),
 			}
 		})
 
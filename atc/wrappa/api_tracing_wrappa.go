@@ -0,0 +1,58 @@
+package wrappa
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/felixge/httpsnoop"
+	"github.com/tedsuo/rata"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+type APITracingWrappa struct{}
+
+func NewAPITracingWrappa() Wrappa {
+	return APITracingWrappa{}
+}
+
+func (wrappa APITracingWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
+	wrapped := rata.Handlers{}
+
+	for name, handler := range handlers {
+		switch name {
+		case atc.BuildEvents, atc.DownloadCLI, atc.HijackContainer:
+			wrapped[name] = handler
+		default:
+			wrapped[name] = TracingHandler{
+				Route:   name,
+				Handler: handler,
+			}
+		}
+	}
+
+	return wrapped
+}
+
+// TracingHandler records a span for each request, continuing the trace given
+// in the request's headers, if any.
+type TracingHandler struct {
+	Route   string
+	Handler http.Handler
+}
+
+func (handler TracingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := tracing.Propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+	ctx, span := tracing.StartSpan(ctx, handler.Route, tracing.Attrs{
+		"http.method": r.Method,
+		"http.path":   r.URL.Path,
+	})
+
+	metrics := httpsnoop.CaptureMetrics(handler.Handler, w, r.WithContext(ctx))
+
+	span.SetAttributes(attribute.Int("http.status_code", metrics.Code))
+
+	tracing.End(span, nil)
+}
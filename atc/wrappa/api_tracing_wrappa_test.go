@@ -0,0 +1,82 @@
+package wrappa_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/tracing"
+	"github.com/concourse/concourse/atc/wrappa"
+	"github.com/tedsuo/rata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("APITracingWrappa", func() {
+	var (
+		recorder *tracetest.SpanRecorder
+
+		inputHandlers   rata.Handlers
+		wrappedHandlers rata.Handlers
+	)
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		tracing.ConfigureTraceProvider(sdktrace.NewTracerProvider(
+			sdktrace.WithSpanProcessor(recorder),
+		))
+
+		inputHandlers = rata.Handlers{}
+		for _, route := range atc.Routes {
+			inputHandlers[route.Name] = &stupidHandler{}
+		}
+
+		wrappedHandlers = wrappa.NewAPITracingWrappa().Wrap(inputHandlers)
+	})
+
+	AfterEach(func() {
+		tracing.ConfigureTraceProvider(trace.NewNoopTracerProvider())
+	})
+
+	It("wraps handlers with a tracing handler", func() {
+		Expect(wrappedHandlers[atc.ListPipelines]).To(Equal(wrappa.TracingHandler{
+			Route:   atc.ListPipelines,
+			Handler: inputHandlers[atc.ListPipelines],
+		}))
+	})
+
+	It("does not wrap streaming handlers", func() {
+		Expect(wrappedHandlers[atc.BuildEvents]).To(Equal(inputHandlers[atc.BuildEvents]))
+		Expect(wrappedHandlers[atc.DownloadCLI]).To(Equal(inputHandlers[atc.DownloadCLI]))
+		Expect(wrappedHandlers[atc.HijackContainer]).To(Equal(inputHandlers[atc.HijackContainer]))
+	})
+
+	Describe("TracingHandler", func() {
+		It("records a span named after the route", func() {
+			request, err := http.NewRequest("GET", "/api/v1/pipelines", nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			wrappedHandlers[atc.ListPipelines].ServeHTTP(httptest.NewRecorder(), request)
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name()).To(Equal(atc.ListPipelines))
+		})
+
+		It("continues the trace given in the request's headers", func() {
+			request, err := http.NewRequest("GET", "/api/v1/pipelines", nil)
+			Expect(err).ToNot(HaveOccurred())
+			request.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+			wrappedHandlers[atc.ListPipelines].ServeHTTP(httptest.NewRecorder(), request)
+
+			spans := recorder.Ended()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].SpanContext().TraceID().String()).To(Equal("4bf92f3577b34da6a3ce929d0e0e4736"))
+		})
+	})
+})
@@ -92,7 +92,10 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		cmd.HealthCheckTimeout,
 	)
 
-	tsaClient := cmd.TSA.Client(atcWorker)
+	tsaClient, err := cmd.TSA.Client(atcWorker)
+	if err != nil {
+		return nil, err
+	}
 
 	beaconRunner := worker.NewBeaconRunner(
 		logger.Session("beacon-runner"),
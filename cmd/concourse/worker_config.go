@@ -1,6 +1,7 @@
 package main
 
 import (
+	"runtime"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -31,5 +32,10 @@ func (c WorkerConfig) Worker() atc.Worker {
 		HTTPSProxyURL: c.HTTPSProxy,
 		NoProxy:       c.NoProxy,
 		Ephemeral:     c.Ephemeral,
+
+		// usage is measured by the TSA each time the worker heartbeats
+		Resources: &atc.WorkerResources{
+			CPUs: runtime.NumCPU(),
+		},
 	}
 }
@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type CheckHistoryCommand struct {
+	Count    int                      `short:"c" long:"count" default:"50" description:"Number of checks you want to limit the return to"`
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to get the check history for"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *CheckHistoryCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	checks, found, err := target.Team().ResourceCheckHistory(command.Resource.PipelineName, command.Resource.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineName, command.Resource.ResourceName)
+	}
+
+	if command.Count < len(checks) {
+		checks = checks[:command.Count]
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(checks)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "start", Color: color.New(color.Bold)},
+			{Contents: "end", Color: color.New(color.Bold)},
+			{Contents: "duration", Color: color.New(color.Bold)},
+			{Contents: "worker", Color: color.New(color.Bold)},
+			{Contents: "versions", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "error", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, check := range checks {
+		startTimeCell, endTimeCell, durationCell := populateTimeCells(time.Unix(check.StartTime, 0), time.Unix(check.EndTime, 0))
+
+		var workerCell ui.TableCell
+		if check.WorkerName == "" {
+			workerCell.Contents = "none"
+			workerCell.Color = color.New(color.Faint)
+		} else {
+			workerCell.Contents = check.WorkerName
+		}
+
+		var statusCell, errorCell ui.TableCell
+		if check.Error == "" {
+			statusCell.Contents = "succeeded"
+			statusCell.Color = ui.SucceededColor
+		} else {
+			statusCell.Contents = "errored"
+			statusCell.Color = ui.ErroredColor
+
+			// only the first line fits in the table; the full error and stderr
+			// are included with --json
+			errorCell.Contents = strings.SplitN(check.Error, "\n", 2)[0]
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: strconv.Itoa(check.ID)},
+			startTimeCell,
+			endTimeCell,
+			durationCell,
+			workerCell,
+			{Contents: strconv.Itoa(len(check.Versions))},
+			statusCell,
+			errorCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -2,15 +2,22 @@ package commands
 
 import (
 	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
 )
 
 type CheckResourceCommand struct {
 	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to check version for"`
 	Version  *atc.Version             `short:"f" long:"from"                     value-name:"VERSION"           description:"Version of the resource to check from, e.g. ref:abcd or path:thing-1.2.3.tgz"`
+	Watch    bool                     `short:"w" long:"watch"                                                   description:"Stream the output of the check as it runs"`
 }
 
 func (command *CheckResourceCommand) Execute(args []string) error {
@@ -29,6 +36,10 @@ func (command *CheckResourceCommand) Execute(args []string) error {
 		version = *command.Version
 	}
 
+	if command.Watch {
+		return command.watchCheck(target, version)
+	}
+
 	found, err := target.Team().CheckResource(command.Resource.PipelineName, command.Resource.ResourceName, version)
 	if err != nil {
 		return err
@@ -41,3 +52,41 @@ func (command *CheckResourceCommand) Execute(args []string) error {
 	fmt.Printf("checked '%s'\n", command.Resource.ResourceName)
 	return nil
 }
+
+func (command *CheckResourceCommand) watchCheck(target rc.Target, version atc.Version) error {
+	build, found, err := target.Team().CheckResourceInBuild(command.Resource.PipelineName, command.Resource.ResourceName, version)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineName, command.Resource.ResourceName)
+	}
+
+	fmt.Printf("checking '%s' in build %d\n\n", command.Resource.ResourceName, build.ID)
+
+	terminate := make(chan os.Signal, 1)
+
+	go func(terminate <-chan os.Signal) {
+		<-terminate
+		fmt.Fprintf(ui.Stderr, "\ndetached, check is still running...\n")
+		fmt.Fprintf(ui.Stderr, "re-attach to it with:\n\n")
+		fmt.Fprintf(ui.Stderr, "    "+ui.Embolden(fmt.Sprintf("fly -t %s watch -b %d\n\n", Fly.Target, build.ID)))
+		os.Exit(2)
+	}(terminate)
+
+	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+
+	eventSource, err := target.Client().BuildEvents(strconv.Itoa(build.ID))
+	if err != nil {
+		return err
+	}
+
+	exitCode := eventstream.Render(os.Stdout, eventSource, eventstream.RenderOptions{})
+
+	eventSource.Close()
+
+	os.Exit(exitCode)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type CordonWorkerCommand struct {
+	Worker string               `short:"w" long:"worker" required:"true" description:"Worker to cordon"`
+	Start  flaghelpers.TimeFlag `long:"start"                            description:"Start of the maintenance window, in RFC3339 format (default: now)"`
+	End    flaghelpers.TimeFlag `long:"end"                              description:"End of the maintenance window, in RFC3339 format, after which the worker is uncordoned"`
+}
+
+func (command *CordonWorkerCommand) Execute(args []string) error {
+	workerName := command.Worker
+
+	if !command.Start.IsZero() && command.End.IsZero() {
+		return errors.New("--start requires --end")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	if command.End.IsZero() {
+		err = target.Client().CordonWorker(workerName)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("cordoned '%s'\n", workerName)
+		return nil
+	}
+
+	start := command.Start.Time
+	if start.IsZero() {
+		start = time.Now()
+	}
+
+	if !command.End.After(start) {
+		return errors.New("the maintenance window must end after it starts")
+	}
+
+	err = target.Client().ScheduleWorkerMaintenance(workerName, atc.MaintenanceWindow{
+		Start: start.Unix(),
+		End:   command.End.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("scheduled maintenance of '%s' from %s until %s\n", workerName, start.Format(time.RFC3339), command.End.Format(time.RFC3339))
+
+	return nil
+}
@@ -23,10 +23,11 @@ type FlyCommand struct {
 
 	Userinfo UserinfoCommand `command:"userinfo" description:"User information"`
 
-	Teams       TeamsCommand       `command:"teams" alias:"t" description:"List the configured teams"`
-	SetTeam     SetTeamCommand     `command:"set-team"  alias:"st" description:"Create or modify a team to have the given credentials"`
-	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
-	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
+	Teams         TeamsCommand         `command:"teams" alias:"t" description:"List the configured teams"`
+	SetTeam       SetTeamCommand       `command:"set-team"  alias:"st" description:"Create or modify a team to have the given credentials"`
+	RenameTeam    RenameTeamCommand    `command:"rename-team"   alias:"rt" description:"Rename a team"`
+	DestroyTeam   DestroyTeamCommand   `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
+	SetTeamQuotas SetTeamQuotasCommand `command:"set-team-quotas" alias:"stq" description:"Limit the builds and containers a team can have at once"`
 
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
@@ -59,6 +60,8 @@ type FlyCommand struct {
 
 	CheckResourceType CheckResourceTypeCommand `command:"check-resource-type" alias:"crt"  description:"Check a resource-type"`
 
+	CheckHistory CheckHistoryCommand `command:"check-history" alias:"ch" description:"List the recent checks of a resource"`
+
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
 	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
@@ -68,9 +71,11 @@ type FlyCommand struct {
 
 	Volumes VolumesCommand `command:"volumes" alias:"vs" description:"List the active volumes"`
 
-	Workers     WorkersCommand     `command:"workers" alias:"ws" description:"List the registered workers"`
-	LandWorker  LandWorkerCommand  `command:"land-worker" alias:"lw" description:"Land a worker"`
-	PruneWorker PruneWorkerCommand `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
+	Workers        WorkersCommand        `command:"workers" alias:"ws" description:"List the registered workers"`
+	LandWorker     LandWorkerCommand     `command:"land-worker" alias:"lw" description:"Land a worker"`
+	PruneWorker    PruneWorkerCommand    `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
+	CordonWorker   CordonWorkerCommand   `command:"cordon-worker" alias:"cw" description:"Stop placing new containers on a worker, now or during a maintenance window"`
+	UncordonWorker UncordonWorkerCommand `command:"uncordon-worker" alias:"ucw" description:"Resume placing new containers on a cordoned worker"`
 
 	Curl CurlCommand `command:"curl" alias:"c" description:"curl the api"`
 }
@@ -0,0 +1,32 @@
+package flaghelpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type InputVersionFlag struct {
+	Name    string
+	Version atc.Version
+}
+
+func (flag *InputVersionFlag) UnmarshalFlag(value string) error {
+	vs := strings.SplitN(value, ":", 2)
+	if len(vs) != 2 || vs[0] == "" {
+		return fmt.Errorf("invalid input version '%s' (must be name:version-json)", value)
+	}
+
+	var version atc.Version
+	err := json.Unmarshal([]byte(vs[1]), &version)
+	if err != nil {
+		return fmt.Errorf("invalid version for input '%s' (must be a JSON object of strings): %s", vs[0], err)
+	}
+
+	flag.Name = vs[0]
+	flag.Version = version
+
+	return nil
+}
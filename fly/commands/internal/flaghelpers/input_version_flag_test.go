@@ -0,0 +1,39 @@
+package flaghelpers_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InputVersionFlag", func() {
+	It("parses the input name and its version", func() {
+		flag := &InputVersionFlag{}
+
+		err := flag.UnmarshalFlag(`some-input:{"ref":"abc:def"}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(flag.Name).To(Equal("some-input"))
+		Expect(flag.Version).To(Equal(atc.Version{"ref": "abc:def"}))
+	})
+
+	Context("when there is no version", func() {
+		It("displays an error message", func() {
+			flag := &InputVersionFlag{}
+
+			err := flag.UnmarshalFlag("some-input")
+			Expect(err).To(MatchError("invalid input version 'some-input' (must be name:version-json)"))
+		})
+	})
+
+	Context("when the version is not a JSON object", func() {
+		It("displays an error message", func() {
+			flag := &InputVersionFlag{}
+
+			err := flag.UnmarshalFlag("some-input:abc")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid version for input 'some-input'"))
+		})
+	})
+})
@@ -0,0 +1,23 @@
+package flaghelpers
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeFlag is a point in time given in RFC3339 format, e.g.
+// 2019-04-05T18:00:00Z.
+type TimeFlag struct {
+	time.Time
+}
+
+func (flag *TimeFlag) UnmarshalFlag(value string) error {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return fmt.Errorf("invalid time '%s' (must be RFC3339, e.g. 2019-04-05T18:00:00Z)", value)
+	}
+
+	flag.Time = t
+
+	return nil
+}
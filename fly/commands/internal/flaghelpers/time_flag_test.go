@@ -0,0 +1,29 @@
+package flaghelpers_test
+
+import (
+	"time"
+
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TimeFlag", func() {
+	It("parses the time", func() {
+		flag := &TimeFlag{}
+
+		err := flag.UnmarshalFlag("2019-04-05T18:00:00+02:00")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(flag.Time).To(BeTemporally("==", time.Date(2019, 4, 5, 16, 0, 0, 0, time.UTC)))
+	})
+
+	Context("when the time is not in RFC3339 format", func() {
+		It("displays an error message", func() {
+			flag := &TimeFlag{}
+
+			err := flag.UnmarshalFlag("tomorrow")
+			Expect(err).To(MatchError("invalid time 'tomorrow' (must be RFC3339, e.g. 2019-04-05T18:00:00Z)"))
+		})
+	})
+})
@@ -13,7 +13,6 @@ import (
 	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse"
-	"github.com/onsi/gomega/gexec"
 	"github.com/vito/go-interact/interact"
 )
 
@@ -57,7 +56,9 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return err
 	}
 
-	diffExists := diff(existingConfig, newConfig)
+	stdout, _ := ui.ForTTY(os.Stdout)
+
+	diffExists := existingConfig.Diff(stdout, newConfig)
 
 	if !diffExists {
 		fmt.Println("no changes to apply")
@@ -117,52 +118,3 @@ func (atcConfig ATCConfig) showPipelineUpdateResult(created bool, updated bool)
 		panic("Something really went wrong!")
 	}
 }
-
-func diff(existingConfig atc.Config, newConfig atc.Config) bool {
-	var diffExists bool
-
-	stdout, _ := ui.ForTTY(os.Stdout)
-
-	indent := gexec.NewPrefixedWriter("  ", stdout)
-
-	groupDiffs := groupDiffIndices(GroupIndex(existingConfig.Groups), GroupIndex(newConfig.Groups))
-	if len(groupDiffs) > 0 {
-		diffExists = true
-		fmt.Println("groups:")
-
-		for _, diff := range groupDiffs {
-			diff.Render(indent, "group")
-		}
-	}
-
-	resourceDiffs := diffIndices(ResourceIndex(existingConfig.Resources), ResourceIndex(newConfig.Resources))
-	if len(resourceDiffs) > 0 {
-		diffExists = true
-		fmt.Println("resources:")
-
-		for _, diff := range resourceDiffs {
-			diff.Render(indent, "resource")
-		}
-	}
-
-	resourceTypeDiffs := diffIndices(ResourceTypeIndex(existingConfig.ResourceTypes), ResourceTypeIndex(newConfig.ResourceTypes))
-	if len(resourceTypeDiffs) > 0 {
-		diffExists = true
-		fmt.Println("resource types:")
-
-		for _, diff := range resourceTypeDiffs {
-			diff.Render(indent, "resource type")
-		}
-	}
-
-	jobDiffs := diffIndices(JobIndex(existingConfig.Jobs), JobIndex(newConfig.Jobs))
-	if len(jobDiffs) > 0 {
-		diffExists = true
-		fmt.Println("jobs:")
-
-		for _, diff := range jobDiffs {
-			diff.Render(indent, "job")
-		}
-	}
-	return diffExists
-}
@@ -1,18 +1,32 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
+const landWorkerPollInterval = 5 * time.Second
+
 type LandWorkerCommand struct {
-	Worker string `short:"w"  long:"worker" required:"true" description:"Worker to land"`
+	Worker     string        `short:"w"  long:"worker" required:"true" description:"Worker to land"`
+	Wait       bool          `long:"wait"                              description:"Wait for the worker to land, listing the builds preventing it from doing so"`
+	Deadline   time.Duration `long:"deadline"                          description:"Time the builds preventing the worker from landing are given to finish, e.g. 30m"`
+	AbortAfter bool          `long:"abort-after"                       description:"Abort the builds preventing the worker from landing once the deadline has passed"`
 }
 
 func (command *LandWorkerCommand) Execute(args []string) error {
 	workerName := command.Worker
 
+	if command.AbortAfter && command.Deadline == 0 {
+		return errors.New("--abort-after requires --deadline")
+	}
+
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
 		return err
@@ -23,12 +37,93 @@ func (command *LandWorkerCommand) Execute(args []string) error {
 		return err
 	}
 
-	err = target.Client().LandWorker(workerName)
+	if command.Deadline != 0 {
+		err = target.Client().LandWorkerWithDeadline(workerName, command.Deadline, command.AbortAfter)
+	} else {
+		err = target.Client().LandWorker(workerName)
+	}
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("landed '%s'\n", workerName)
+	if !command.Wait {
+		fmt.Printf("landed '%s'\n", workerName)
+		return nil
+	}
+
+	return command.waitForLanding(target.Client(), workerName)
+}
+
+func (command *LandWorkerCommand) waitForLanding(client concourse.Client, workerName string) error {
+	var deadline time.Time
+	if command.Deadline != 0 {
+		deadline = time.Now().Add(command.Deadline)
+	}
+
+	var reportedBuilds string
+	for {
+		landed, err := workerLanded(client, workerName)
+		if err != nil {
+			return err
+		}
+
+		if landed {
+			fmt.Printf("landed '%s'\n", workerName)
+			return nil
+		}
+
+		builds, err := client.ListBlockingBuilds(workerName)
+		if err != nil {
+			return err
+		}
+
+		description := describeBlockingBuilds(builds)
+		if description != reportedBuilds {
+			fmt.Print(description)
+			reportedBuilds = description
+		}
+
+		if !deadline.IsZero() && time.Now().After(deadline) && !command.AbortAfter {
+			return fmt.Errorf("worker '%s' did not land before the deadline", workerName)
+		}
+
+		time.Sleep(landWorkerPollInterval)
+	}
+}
+
+// workerLanded returns whether the worker has landed. A worker which is gone
+// is as good as landed.
+func workerLanded(client concourse.Client, workerName string) (bool, error) {
+	workers, err := client.ListWorkers()
+	if err != nil {
+		return false, err
+	}
+
+	for _, worker := range workers {
+		if worker.Name == workerName {
+			return worker.State == "landed", nil
+		}
+	}
+
+	return true, nil
+}
+
+func describeBlockingBuilds(builds []atc.Build) string {
+	if len(builds) == 0 {
+		return "waiting for the worker to land...\n"
+	}
+
+	description := fmt.Sprintf("waiting for %d build(s) to finish:\n", len(builds))
+	for _, build := range builds {
+		var name string
+		if build.JobName == "" {
+			name = fmt.Sprintf("one-off build %d", build.ID)
+		} else {
+			name = fmt.Sprintf("%s/%s #%s (build %d)", build.PipelineName, build.JobName, build.Name, build.ID)
+		}
+
+		description += fmt.Sprintf("  %s %s\n", ui.Embolden("%s", name), build.Status)
+	}
 
-	return nil
+	return description
 }
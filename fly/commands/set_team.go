@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -27,6 +28,8 @@ type SetTeamCommand struct {
 	TeamName        string               `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
 	SkipInteractive bool                 `long:"non-interactive" description:"Force apply configuration"`
 	AuthFlags       skycmd.AuthTeamFlags `group:"Authentication"`
+
+	CredentialManagers []string `long:"credential-manager" value-name:"NAME" description:"Credential manager to look up the team's credentials in. Can be specified multiple times. Defaults to all configured credential managers."`
 }
 
 func (command *SetTeamCommand) Execute([]string) error {
@@ -46,6 +49,12 @@ func (command *SetTeamCommand) Execute([]string) error {
 		os.Exit(1)
 	}
 
+	err = atc.TeamAuth(authRoles).Validate()
+	if err != nil {
+		fmt.Fprintf(ui.Stderr, "%s, expected one of: %s\n", err, strings.Join(atc.ValidRoles, ", "))
+		os.Exit(1)
+	}
+
 	roles := []string{}
 	for role := range authRoles {
 		roles = append(roles, role)
@@ -80,6 +89,14 @@ func (command *SetTeamCommand) Execute([]string) error {
 		}
 	}
 
+	if len(command.CredentialManagers) > 0 {
+		fmt.Println()
+		fmt.Printf("credential managers:\n")
+		for _, name := range command.CredentialManagers {
+			fmt.Printf("- %s\n", name)
+		}
+	}
+
 	confirm := true
 	if !command.SkipInteractive {
 		confirm = false
@@ -93,7 +110,10 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: atc.TeamAuth(authRoles)}
+	team := atc.Team{
+		Auth:               atc.TeamAuth(authRoles),
+		CredentialManagers: command.CredentialManagers,
+	}
 
 	_, created, updated, err := target.Client().Team(command.TeamName).CreateOrUpdate(team)
 	if err != nil {
@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type SetTeamQuotasCommand struct {
+	TeamName         string `short:"n" long:"team-name" required:"true" description:"The team to set the quotas of"`
+	MaxRunningBuilds int    `long:"max-running-builds" description:"Maximum number of builds the team can have running at once (0 for no limit)"`
+	MaxContainers    int    `long:"max-containers" description:"Maximum number of containers the team can have at once (0 for no limit)"`
+}
+
+func (command *SetTeamQuotasCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	if command.MaxRunningBuilds < 0 || command.MaxContainers < 0 {
+		displayhelpers.Failf("quotas cannot be negative")
+		return nil
+	}
+
+	_, found, err := target.Team().SetTeamQuotas(command.TeamName, atc.TeamQuotas{
+		MaxRunningBuilds: command.MaxRunningBuilds,
+		MaxContainers:    command.MaxContainers,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("Team '%s' not found\n", command.TeamName)
+		return nil
+	}
+
+	fmt.Printf("Quotas for team '%s' updated\n", command.TeamName)
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
@@ -15,7 +16,7 @@ import (
 
 type TeamsCommand struct {
 	Json    bool `long:"json" description:"Print command result as JSON"`
-	Details bool `short:"d" long:"details" description:"Print authentication configuration"`
+	Details bool `short:"d" long:"details" description:"Print authentication configuration and quotas"`
 }
 
 func (command *TeamsCommand) Execute([]string) error {
@@ -48,6 +49,7 @@ func (command *TeamsCommand) Execute([]string) error {
 			{Contents: "name/role", Color: color.New(color.Bold)},
 			{Contents: "users", Color: color.New(color.Bold)},
 			{Contents: "groups", Color: color.New(color.Bold)},
+			{Contents: "quotas", Color: color.New(color.Bold)},
 		}
 	} else {
 		headers = ui.TableRow{
@@ -88,6 +90,7 @@ func (command *TeamsCommand) Execute([]string) error {
 
 				row = append(row, usersCell)
 				row = append(row, groupsCell)
+				row = append(row, quotasCell(t.Quotas))
 				table.Data = append(table.Data, row)
 			}
 
@@ -103,3 +106,22 @@ func (command *TeamsCommand) Execute([]string) error {
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
+
+func quotasCell(quotas *atc.TeamQuotas) ui.TableCell {
+	var limits []string
+	if quotas != nil {
+		if quotas.MaxRunningBuilds != 0 {
+			limits = append(limits, fmt.Sprintf("builds=%d", quotas.MaxRunningBuilds))
+		}
+
+		if quotas.MaxContainers != 0 {
+			limits = append(limits, fmt.Sprintf("containers=%d", quotas.MaxContainers))
+		}
+	}
+
+	if len(limits) == 0 {
+		return ui.TableCell{Contents: "none", Color: color.New(color.Faint)}
+	}
+
+	return ui.TableCell{Contents: strings.Join(limits, ",")}
+}
@@ -1,11 +1,13 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
@@ -13,8 +15,10 @@ import (
 )
 
 type TriggerJobCommand struct {
-	Job   flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to trigger"`
-	Watch bool                `short:"w" long:"watch" description:"Start watching the build output"`
+	Job          flaghelpers.JobFlag            `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to trigger"`
+	Watch        bool                           `short:"w" long:"watch" description:"Start watching the build output"`
+	Inputs       []flaghelpers.InputVersionFlag `short:"i" long:"input" value-name:"NAME:VERSION" description:"Version to use for an input of the build, as JSON, e.g. 'repo:{\"ref\":\"abcd\"}'. Can be specified multiple times."`
+	IgnorePassed bool                           `long:"ignore-passed" description:"Use the given input versions even if they have not passed the input's upstream jobs"`
 }
 
 func (command *TriggerJobCommand) Execute(args []string) error {
@@ -30,7 +34,25 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 		return err
 	}
 
-	build, err := target.Team().CreateJobBuild(pipelineName, jobName)
+	if command.IgnorePassed && len(command.Inputs) == 0 {
+		return errors.New("--ignore-passed can only be used with --input")
+	}
+
+	var build atc.Build
+	if len(command.Inputs) == 0 {
+		build, err = target.Team().CreateJobBuild(pipelineName, jobName)
+	} else {
+		overrides := atc.InputOverrides{
+			Inputs:       map[string]atc.Version{},
+			IgnorePassed: command.IgnorePassed,
+		}
+
+		for _, input := range command.Inputs {
+			overrides.Inputs[input.Name] = input.Version
+		}
+
+		build, err = target.Team().CreateJobBuildWithInputOverrides(pipelineName, jobName, overrides)
+	}
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,34 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type UncordonWorkerCommand struct {
+	Worker string `short:"w" long:"worker" required:"true" description:"Worker to uncordon"`
+}
+
+func (command *UncordonWorkerCommand) Execute(args []string) error {
+	workerName := command.Worker
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = target.Client().UncordonWorker(workerName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("uncordoned '%s'\n", workerName)
+
+	return nil
+}
@@ -141,7 +141,7 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			{Contents: w.Platform},
 			stringOrDefault(strings.Join(w.Tags, ", ")),
 			stringOrDefault(w.Team),
-			{Contents: w.State},
+			w.StateCell(),
 			w.VersionCell(),
 		}
 
@@ -174,6 +174,14 @@ type worker struct {
 	outdated bool
 }
 
+func (w *worker) StateCell() ui.TableCell {
+	if w.Cordoned {
+		return ui.TableCell{Contents: w.State + " (cordoned)"}
+	}
+
+	return ui.TableCell{Contents: w.State}
+}
+
 func (w *worker) VersionCell() ui.TableCell {
 	var column ui.TableCell
 	if w.Version != "" {
@@ -0,0 +1,181 @@
+package integration_test
+
+import (
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("check-history", func() {
+		var (
+			flyCmd *exec.Cmd
+
+			startTime time.Time
+			endTime   time.Time
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "check-history", "-r", "pipeline/foo")
+
+			startTime = time.Date(2019, time.April, 1, 12, 0, 0, 0, time.UTC)
+			endTime = startTime.Add(10 * time.Second)
+		})
+
+		Context("when checks are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/checks"),
+						ghttp.RespondWithJSONEncoded(200, []atc.ResourceCheck{
+							{
+								ID:         3,
+								StartTime:  startTime.Unix(),
+								EndTime:    endTime.Unix(),
+								WorkerName: "some-worker",
+								Versions:   []atc.Version{},
+								Error:      "resource script '/opt/resource/check []' failed: exit status 1",
+								Stderr:     "some-stderr",
+							},
+							{
+								ID:        2,
+								StartTime: startTime.Unix(),
+								EndTime:   endTime.Unix(),
+								Versions:  []atc.Version{},
+								Error:     "no workers",
+							},
+							{
+								ID:         1,
+								StartTime:  startTime.Unix(),
+								EndTime:    endTime.Unix(),
+								WorkerName: "some-worker",
+								Versions:   []atc.Version{{"ref": "v1"}, {"ref": "v2"}},
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists the checks", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "start", Color: color.New(color.Bold)},
+						{Contents: "end", Color: color.New(color.Bold)},
+						{Contents: "duration", Color: color.New(color.Bold)},
+						{Contents: "worker", Color: color.New(color.Bold)},
+						{Contents: "versions", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "error", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "3"},
+							{Contents: startTime.Local().Format(timeDateLayout)},
+							{Contents: endTime.Local().Format(timeDateLayout)},
+							{Contents: "10s"},
+							{Contents: "some-worker"},
+							{Contents: "0"},
+							{Contents: "errored"},
+							{Contents: "resource script '/opt/resource/check []' failed: exit status 1"},
+						},
+						{
+							{Contents: "2"},
+							{Contents: startTime.Local().Format(timeDateLayout)},
+							{Contents: endTime.Local().Format(timeDateLayout)},
+							{Contents: "10s"},
+							{Contents: "none"},
+							{Contents: "0"},
+							{Contents: "errored"},
+							{Contents: "no workers"},
+						},
+						{
+							{Contents: "1"},
+							{Contents: startTime.Local().Format(timeDateLayout)},
+							{Contents: endTime.Local().Format(timeDateLayout)},
+							{Contents: "10s"},
+							{Contents: "some-worker"},
+							{Contents: "2"},
+							{Contents: "succeeded"},
+							{Contents: ""},
+						},
+					},
+				}))
+			})
+
+			Context("when --count is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--count", "1", "--json")
+				})
+
+				It("prints only that many checks", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"id": 3,
+							"start_time": 1554120000,
+							"end_time": 1554120010,
+							"worker_name": "some-worker",
+							"versions": [],
+							"error": "resource script '/opt/resource/check []' failed: exit status 1",
+							"stderr": "some-stderr"
+						}
+					]`))
+				})
+			})
+		})
+
+		Context("when the resource is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/checks"),
+						ghttp.RespondWith(404, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("pipeline 'pipeline' or resource 'foo' not found"))
+			})
+		})
+
+		Context("and the api returns an internal server error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/checks"),
+						ghttp.RespondWith(500, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
+			})
+		})
+	})
+})
@@ -1,9 +1,15 @@
 package integration_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"os/exec"
 
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/vito/go-sse/sse"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -17,6 +23,42 @@ var _ = Describe("CheckResource", func() {
 		flyCmd *exec.Cmd
 	)
 
+	checkBuild := atc.Build{ID: 123, Name: "1", Status: "started"}
+
+	streamEvents := func(events ...atc.Event) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/v1/builds/123/events"),
+			func(w http.ResponseWriter, r *http.Request) {
+				flusher := w.(http.Flusher)
+
+				w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+				w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
+				w.Header().Add("Connection", "keep-alive")
+
+				w.WriteHeader(http.StatusOK)
+
+				for id, e := range events {
+					payload, err := json.Marshal(event.Message{Event: e})
+					Expect(err).NotTo(HaveOccurred())
+
+					err = sse.Event{
+						ID:   fmt.Sprintf("%d", id),
+						Name: "event",
+						Data: payload,
+					}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+
+					flusher.Flush()
+				}
+
+				err := sse.Event{
+					Name: "end",
+				}.Write(w)
+				Expect(err).NotTo(HaveOccurred())
+			},
+		)
+	}
+
 	Context("when ATC request succeeds", func() {
 		BeforeEach(func() {
 			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/check"
@@ -145,4 +187,59 @@ var _ = Describe("CheckResource", func() {
 
 		})
 	})
+
+	Context("when -w option is provided", func() {
+		BeforeEach(func() {
+			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/check"
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.VerifyJSON(`{"from":null,"watch":true}`),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, checkBuild),
+				),
+				streamEvents(
+					event.Log{Payload: "sup from check\n"},
+					event.Status{Status: atc.StatusSucceeded},
+				),
+			)
+		})
+
+		It("streams the output of the check", func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/myresource", "-w")
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(gbytes.Say("checking 'myresource' in build 123"))
+			Expect(sess.Out).To(gbytes.Say("sup from check"))
+			Expect(sess.Out).To(gbytes.Say("succeeded"))
+		})
+	})
+
+	Context("when -w option is provided and the check fails", func() {
+		BeforeEach(func() {
+			expectedURL := "/api/v1/teams/main/pipelines/mypipeline/resources/myresource/check"
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, checkBuild),
+				),
+				streamEvents(
+					event.Log{Payload: "bad version\n"},
+					event.Status{Status: atc.StatusFailed},
+				),
+			)
+		})
+
+		It("exits with failure", func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "check-resource", "-r", "mypipeline/myresource", "-w")
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Out).To(gbytes.Say("bad version"))
+		})
+	})
 })
@@ -0,0 +1,141 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("land-worker", func() {
+		var flyCmd *exec.Cmd
+
+		Context("when the worker is landed", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "land-worker", "-w", "some-worker")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land"),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("lands the worker", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("landed 'some-worker'"))
+			})
+		})
+
+		Context("when a deadline is given", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "land-worker", "-w", "some-worker", "--deadline", "30m", "--abort-after")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land", "abort_builds=true&deadline=30m0s"),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("lands the worker with the deadline", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("landed 'some-worker'"))
+			})
+		})
+
+		Context("when asked to abort builds without a deadline", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "land-worker", "-w", "some-worker", "--abort-after")
+			})
+
+			It("errors without landing the worker", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--abort-after requires --deadline"))
+			})
+		})
+
+		Context("when waiting for the worker to land", func() {
+			Context("when the worker lands", func() {
+				BeforeEach(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "land-worker", "-w", "some-worker", "--wait")
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land"),
+							ghttp.RespondWith(http.StatusOK, nil),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/workers"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Worker{
+								{Name: "some-worker", State: "landed"},
+							}),
+						),
+					)
+				})
+
+				It("waits for the worker to land", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say("landed 'some-worker'"))
+				})
+			})
+
+			Context("when builds keep the worker from landing past the deadline", func() {
+				BeforeEach(func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "land-worker", "-w", "some-worker", "--wait", "--deadline", "1ms")
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land", "abort_builds=false&deadline=1ms"),
+							ghttp.RespondWith(http.StatusOK, nil),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/workers"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Worker{
+								{Name: "some-worker", State: "landing"},
+							}),
+						),
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/blocking-builds"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Build{
+								{ID: 42, Name: "3", JobName: "some-job", PipelineName: "some-pipeline", Status: "started"},
+								{ID: 43, Status: "pending"},
+							}),
+						),
+					)
+				})
+
+				It("lists the builds and errors", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Out).To(gbytes.Say("waiting for 2 build\\(s\\) to finish:"))
+					Expect(sess.Out).To(gbytes.Say("some-pipeline/some-job #3 \\(build 42\\) started"))
+					Expect(sess.Out).To(gbytes.Say("one-off build 43 pending"))
+					Expect(sess.Err).To(gbytes.Say("worker 'some-worker' did not land before the deadline"))
+				})
+			})
+		})
+	})
+})
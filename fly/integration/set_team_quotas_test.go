@@ -0,0 +1,88 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("SetTeamQuotas", func() {
+	BeforeEach(func() {
+		atcServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/teams/a-team/quotas"),
+				ghttp.VerifyJSON(`{"max_running_builds":2,"max_containers":10}`),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+					ID:     1,
+					Name:   "a-team",
+					Quotas: &atc.TeamQuotas{MaxRunningBuilds: 2, MaxContainers: 10},
+				}),
+			),
+		)
+	})
+
+	Context("when not specifying a team name", func() {
+		It("fails and says you should provide a team name", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-team-quotas", "--max-containers", "10")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("n", "team-name") + "' was not specified"))
+		})
+	})
+
+	Context("when all the inputs are provided", func() {
+		var flyCmd *exec.Cmd
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "set-team-quotas", "-n", "a-team", "--max-running-builds", "2", "--max-containers", "10")
+		})
+
+		It("sets the team's quotas", func() {
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(4))
+			Expect(sess.Out).To(gbytes.Say("Quotas for team 'a-team' updated"))
+		})
+
+		Context("when the team is not found", func() {
+			BeforeEach(func() {
+				atcServer.SetHandler(3, ghttp.RespondWith(http.StatusNotFound, ""))
+			})
+
+			It("returns an error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("Team 'a-team' not found"))
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				atcServer.SetHandler(3, ghttp.RespondWith(http.StatusForbidden, ""))
+			})
+
+			It("returns an error", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("forbidden"))
+			})
+		})
+	})
+})
@@ -49,6 +49,19 @@ var _ = Describe("Fly CLI", func() {
 					})
 				})
 
+				Context("auth config has an unknown role", func() {
+					BeforeEach(func() {
+						cmdParams = []string{"-c", "fixtures/team_config_unknown_role.yml"}
+					})
+
+					It("returns an error", func() {
+						sess, err := gexec.Start(flyCmd, nil, nil)
+						Expect(err).ToNot(HaveOccurred())
+						Eventually(sess.Err).Should(gbytes.Say("unknown role: admin, expected one of: owner, member, operator, viewer"))
+						Eventually(sess).Should(gexec.Exit(1))
+					})
+				})
+
 				Context("auth config contains empty user", func() {
 					BeforeEach(func() {
 						cmdParams = []string{"-c", "fixtures/team_config_empty_users.yml"}
@@ -98,6 +111,31 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("Setting auth for the operator role", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"-c", "fixtures/team_config_with_operator.yml"}
+				})
+
+				It("shows the users and groups configured for the operator role", func() {
+					sess, err := gexec.Start(flyCmd, nil, nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("setting team: venture"))
+
+					Eventually(sess.Out).Should(gbytes.Say("role operator:"))
+					Eventually(sess.Out).Should(gbytes.Say("users:"))
+					Eventually(sess.Out).Should(gbytes.Say("- local:some-operator"))
+					Eventually(sess.Out).Should(gbytes.Say("groups:"))
+					Eventually(sess.Out).Should(gbytes.Say("- github:some-org:on-call"))
+
+					Eventually(sess.Out).Should(gbytes.Say("role owner:"))
+					Eventually(sess.Out).Should(gbytes.Say("users:"))
+					Eventually(sess.Out).Should(gbytes.Say("- local:some-owner"))
+
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+
 			Context("Setting github auth", func() {
 				BeforeEach(func() {
 					cmdParams = []string{"-c", "fixtures/team_config_with_github_auth.yml"}
@@ -675,6 +713,54 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("sending credential managers", func() {
+			BeforeEach(func() {
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--credential-manager", "vault",
+					"--credential-manager", "credhub",
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": [
+										"local:brock-obama"
+									],
+									"groups": []
+								}
+							},
+							"credential_managers": ["vault", "credhub"]
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows and sends the credential managers", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, nil, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("credential managers:"))
+				Eventually(sess.Out).Should(gbytes.Say("- vault"))
+				Eventually(sess.Out).Should(gbytes.Say("- credhub"))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"--local-user", "brock-obama"}
@@ -60,8 +60,9 @@ var _ = Describe("Fly CLI", func() {
 								},
 							},
 							{
-								ID:   4,
-								Name: "c-team",
+								ID:     4,
+								Name:   "c-team",
+								Quotas: &atc.TeamQuotas{MaxRunningBuilds: 2, MaxContainers: 10},
 								Auth: atc.TeamAuth{
 									"owner": map[string][]string{
 										"users":  []string{"github:github-user"},
@@ -139,6 +140,7 @@ var _ = Describe("Fly CLI", func() {
               {
 								"id": 4,
 								"name": "c-team",
+								"quotas": {"max_running_builds": 2, "max_containers": 10},
 								"auth": {
 									"owner": {
 										"groups":["github:github-org"],
@@ -170,12 +172,12 @@ var _ = Describe("Fly CLI", func() {
 					Eventually(sess).Should(gexec.Exit(0))
 					Expect(sess.Out).To(PrintTable(ui.Table{
 						Data: []ui.TableRow{
-							{{Contents: "a-team/owner"}, {Contents: "none"}, {Contents: "github:github-org"}},
-							{{Contents: "b-team/member"}, {Contents: "github:github-user"}, {Contents: "none"}},
-							{{Contents: "c-team/member"}, {Contents: "github:github-user"}, {Contents: "github:github-org"}},
-							{{Contents: "c-team/owner"}, {Contents: "github:github-user"}, {Contents: "github:github-org"}},
-							{{Contents: "c-team/viewer"}, {Contents: "github:github-user"}, {Contents: "github:github-org"}},
-							{{Contents: "main/owner"}, {Contents: "all"}, {Contents: "none"}},
+							{{Contents: "a-team/owner"}, {Contents: "none"}, {Contents: "github:github-org"}, {Contents: "none"}},
+							{{Contents: "b-team/member"}, {Contents: "github:github-user"}, {Contents: "none"}, {Contents: "none"}},
+							{{Contents: "c-team/member"}, {Contents: "github:github-user"}, {Contents: "github:github-org"}, {Contents: "builds=2,containers=10"}},
+							{{Contents: "c-team/owner"}, {Contents: "github:github-user"}, {Contents: "github:github-org"}, {Contents: "builds=2,containers=10"}},
+							{{Contents: "c-team/viewer"}, {Contents: "github:github-user"}, {Contents: "github:github-org"}, {Contents: "builds=2,containers=10"}},
+							{{Contents: "main/owner"}, {Contents: "all"}, {Contents: "none"}, {Contents: "none"}},
 						},
 					}))
 				})
@@ -130,6 +130,44 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("when input versions are given", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("POST", path),
+							ghttp.VerifyJSON(`{"inputs":{"some-input":{"ref":"abc"}},"ignore_passed":true}`),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 57, Name: "42"}),
+						),
+					)
+				})
+
+				It("starts the build with the given input versions", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "trigger-job", "-j", "awesome-pipeline/awesome-job", "-i", `some-input:{"ref":"abc"}`, "--ignore-passed")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`started awesome-pipeline/awesome-job #42`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				})
+			})
+
+			Context("when --ignore-passed is given without input versions", func() {
+				It("errors", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "trigger-job", "-j", "awesome-pipeline/awesome-job", "--ignore-passed")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say(`--ignore-passed can only be used with --input`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+
 			Context("when the pipeline/job doesn't exist", func() {
 				BeforeEach(func() {
 					atcServer.AppendHandlers(
@@ -61,3 +61,44 @@ func (team *team) CheckResource(pipelineName string, resourceName string, versio
 		return false, err
 	}
 }
+
+// CheckResourceInBuild starts a build which checks the resource, returning the
+// build so that the output of the check can be followed through its events.
+func (team *team) CheckResourceInBuild(pipelineName string, resourceName string, version atc.Version) (atc.Build, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"resource_name": resourceName,
+		"team_name":     team.name,
+	}
+
+	var build atc.Build
+
+	jsonBytes, err := json.Marshal(atc.CheckRequestBody{From: version, Watch: true})
+	if err != nil {
+		return build, false, err
+	}
+
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.CheckResource,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &build,
+	})
+
+	switch e := err.(type) {
+	case nil:
+		return build, true, nil
+	case internal.ResourceNotFoundError:
+		return build, false, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusInternalServerError {
+			return build, false, GenericError{e.Body}
+		}
+
+		return build, false, err
+	default:
+		return build, false, err
+	}
+}
@@ -104,3 +104,86 @@ var _ = Describe("CheckResource", func() {
 		})
 	})
 })
+
+var _ = Describe("CheckResourceInBuild", func() {
+	Context("when ATC request succeeds", func() {
+		var expectedBuild atc.Build
+
+		BeforeEach(func() {
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/check"
+
+			expectedBuild = atc.Build{
+				ID:           123,
+				Name:         "1",
+				Status:       "started",
+				TeamName:     "some-team",
+				PipelineName: "mypipeline",
+				APIURL:       "/api/v1/builds/123",
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.VerifyJSON(`{"from":{"ref":"fake-ref"},"watch":true}`),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedBuild),
+				),
+			)
+		})
+
+		It("asks the ATC to check the resource in a build", func() {
+			_, found, err := team.CheckResourceInBuild("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("returns the build running the check", func() {
+			build, _, err := team.CheckResourceInBuild("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build).To(Equal(expectedBuild))
+		})
+	})
+
+	Context("when pipeline or resource does not exist", func() {
+		BeforeEach(func() {
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/check"
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.VerifyJSON(`{"from":{"ref":"fake-ref"},"watch":true}`),
+					ghttp.RespondWithJSONEncoded(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("returns a ResourceNotFoundError", func() {
+			_, found, err := team.CheckResourceInBuild("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when ATC responds with an internal server error", func() {
+		BeforeEach(func() {
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/resources/myresource/check"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.VerifyJSON(`{"from":{"ref":"fake-ref"},"watch":true}`),
+					ghttp.RespondWith(http.StatusInternalServerError, "unknown server error"),
+				),
+			)
+		})
+
+		It("returns an error with body", func() {
+			_, _, err := team.CheckResourceInBuild("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			Expect(err).To(HaveOccurred())
+
+			cre, ok := err.(concourse.GenericError)
+			Expect(ok).To(BeTrue())
+			Expect(cre.Error()).To(Equal("unknown server error"))
+		})
+	})
+})
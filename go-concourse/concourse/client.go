@@ -25,6 +25,11 @@ type Client interface {
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
 	LandWorker(workerName string) error
+	LandWorkerWithDeadline(workerName string, deadline time.Duration, abortBuilds bool) error
+	ListBlockingBuilds(workerName string) ([]atc.Build, error)
+	CordonWorker(workerName string) error
+	UncordonWorker(workerName string) error
+	ScheduleWorkerMaintenance(workerName string, window atc.MaintenanceWindow) error
 	GetInfo() (atc.Info, error)
 	GetCLIReader(arch, platform string) (io.ReadCloser, http.Header, error)
 	ListPipelines() ([]atc.Pipeline, error)
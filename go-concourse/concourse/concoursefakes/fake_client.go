@@ -96,6 +96,17 @@ type FakeClient struct {
 		result2 concourse.Pagination
 		result3 error
 	}
+	CordonWorkerStub        func(string) error
+	cordonWorkerMutex       sync.RWMutex
+	cordonWorkerArgsForCall []struct {
+		arg1 string
+	}
+	cordonWorkerReturns struct {
+		result1 error
+	}
+	cordonWorkerReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetCLIReaderStub        func(string, string) (io.ReadCloser, http.Header, error)
 	getCLIReaderMutex       sync.RWMutex
 	getCLIReaderArgsForCall []struct {
@@ -145,6 +156,32 @@ type FakeClient struct {
 	landWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	LandWorkerWithDeadlineStub        func(string, time.Duration, bool) error
+	landWorkerWithDeadlineMutex       sync.RWMutex
+	landWorkerWithDeadlineArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+		arg3 bool
+	}
+	landWorkerWithDeadlineReturns struct {
+		result1 error
+	}
+	landWorkerWithDeadlineReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListBlockingBuildsStub        func(string) ([]atc.Build, error)
+	listBlockingBuildsMutex       sync.RWMutex
+	listBlockingBuildsArgsForCall []struct {
+		arg1 string
+	}
+	listBlockingBuildsReturns struct {
+		result1 []atc.Build
+		result2 error
+	}
+	listBlockingBuildsReturnsOnCall map[int]struct {
+		result1 []atc.Build
+		result2 error
+	}
 	ListBuildArtifactsStub        func(string) ([]atc.WorkerArtifact, error)
 	listBuildArtifactsMutex       sync.RWMutex
 	listBuildArtifactsArgsForCall []struct {
@@ -219,6 +256,18 @@ type FakeClient struct {
 		result1 *atc.Worker
 		result2 error
 	}
+	ScheduleWorkerMaintenanceStub        func(string, atc.MaintenanceWindow) error
+	scheduleWorkerMaintenanceMutex       sync.RWMutex
+	scheduleWorkerMaintenanceArgsForCall []struct {
+		arg1 string
+		arg2 atc.MaintenanceWindow
+	}
+	scheduleWorkerMaintenanceReturns struct {
+		result1 error
+	}
+	scheduleWorkerMaintenanceReturnsOnCall map[int]struct {
+		result1 error
+	}
 	TeamStub        func(string) concourse.Team
 	teamMutex       sync.RWMutex
 	teamArgsForCall []struct {
@@ -240,6 +289,17 @@ type FakeClient struct {
 	uRLReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UncordonWorkerStub        func(string) error
+	uncordonWorkerMutex       sync.RWMutex
+	uncordonWorkerArgsForCall []struct {
+		arg1 string
+	}
+	uncordonWorkerReturns struct {
+		result1 error
+	}
+	uncordonWorkerReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UserInfoStub        func() (map[string]interface{}, error)
 	userInfoMutex       sync.RWMutex
 	userInfoArgsForCall []struct {
@@ -643,6 +703,66 @@ func (fake *FakeClient) BuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) CordonWorker(arg1 string) error {
+	fake.cordonWorkerMutex.Lock()
+	ret, specificReturn := fake.cordonWorkerReturnsOnCall[len(fake.cordonWorkerArgsForCall)]
+	fake.cordonWorkerArgsForCall = append(fake.cordonWorkerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("CordonWorker", []interface{}{arg1})
+	fake.cordonWorkerMutex.Unlock()
+	if fake.CordonWorkerStub != nil {
+		return fake.CordonWorkerStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cordonWorkerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) CordonWorkerCallCount() int {
+	fake.cordonWorkerMutex.RLock()
+	defer fake.cordonWorkerMutex.RUnlock()
+	return len(fake.cordonWorkerArgsForCall)
+}
+
+func (fake *FakeClient) CordonWorkerCalls(stub func(string) error) {
+	fake.cordonWorkerMutex.Lock()
+	defer fake.cordonWorkerMutex.Unlock()
+	fake.CordonWorkerStub = stub
+}
+
+func (fake *FakeClient) CordonWorkerArgsForCall(i int) string {
+	fake.cordonWorkerMutex.RLock()
+	defer fake.cordonWorkerMutex.RUnlock()
+	argsForCall := fake.cordonWorkerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CordonWorkerReturns(result1 error) {
+	fake.cordonWorkerMutex.Lock()
+	defer fake.cordonWorkerMutex.Unlock()
+	fake.CordonWorkerStub = nil
+	fake.cordonWorkerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CordonWorkerReturnsOnCall(i int, result1 error) {
+	fake.cordonWorkerMutex.Lock()
+	defer fake.cordonWorkerMutex.Unlock()
+	fake.CordonWorkerStub = nil
+	if fake.cordonWorkerReturnsOnCall == nil {
+		fake.cordonWorkerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonWorkerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) GetCLIReader(arg1 string, arg2 string) (io.ReadCloser, http.Header, error) {
 	fake.getCLIReaderMutex.Lock()
 	ret, specificReturn := fake.getCLIReaderReturnsOnCall[len(fake.getCLIReaderArgsForCall)]
@@ -877,6 +997,131 @@ func (fake *FakeClient) LandWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) LandWorkerWithDeadline(arg1 string, arg2 time.Duration, arg3 bool) error {
+	fake.landWorkerWithDeadlineMutex.Lock()
+	ret, specificReturn := fake.landWorkerWithDeadlineReturnsOnCall[len(fake.landWorkerWithDeadlineArgsForCall)]
+	fake.landWorkerWithDeadlineArgsForCall = append(fake.landWorkerWithDeadlineArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+		arg3 bool
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("LandWorkerWithDeadline", []interface{}{arg1, arg2, arg3})
+	fake.landWorkerWithDeadlineMutex.Unlock()
+	if fake.LandWorkerWithDeadlineStub != nil {
+		return fake.LandWorkerWithDeadlineStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.landWorkerWithDeadlineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) LandWorkerWithDeadlineCallCount() int {
+	fake.landWorkerWithDeadlineMutex.RLock()
+	defer fake.landWorkerWithDeadlineMutex.RUnlock()
+	return len(fake.landWorkerWithDeadlineArgsForCall)
+}
+
+func (fake *FakeClient) LandWorkerWithDeadlineCalls(stub func(string, time.Duration, bool) error) {
+	fake.landWorkerWithDeadlineMutex.Lock()
+	defer fake.landWorkerWithDeadlineMutex.Unlock()
+	fake.LandWorkerWithDeadlineStub = stub
+}
+
+func (fake *FakeClient) LandWorkerWithDeadlineArgsForCall(i int) (string, time.Duration, bool) {
+	fake.landWorkerWithDeadlineMutex.RLock()
+	defer fake.landWorkerWithDeadlineMutex.RUnlock()
+	argsForCall := fake.landWorkerWithDeadlineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) LandWorkerWithDeadlineReturns(result1 error) {
+	fake.landWorkerWithDeadlineMutex.Lock()
+	defer fake.landWorkerWithDeadlineMutex.Unlock()
+	fake.LandWorkerWithDeadlineStub = nil
+	fake.landWorkerWithDeadlineReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) LandWorkerWithDeadlineReturnsOnCall(i int, result1 error) {
+	fake.landWorkerWithDeadlineMutex.Lock()
+	defer fake.landWorkerWithDeadlineMutex.Unlock()
+	fake.LandWorkerWithDeadlineStub = nil
+	if fake.landWorkerWithDeadlineReturnsOnCall == nil {
+		fake.landWorkerWithDeadlineReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.landWorkerWithDeadlineReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ListBlockingBuilds(arg1 string) ([]atc.Build, error) {
+	fake.listBlockingBuildsMutex.Lock()
+	ret, specificReturn := fake.listBlockingBuildsReturnsOnCall[len(fake.listBlockingBuildsArgsForCall)]
+	fake.listBlockingBuildsArgsForCall = append(fake.listBlockingBuildsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ListBlockingBuilds", []interface{}{arg1})
+	fake.listBlockingBuildsMutex.Unlock()
+	if fake.ListBlockingBuildsStub != nil {
+		return fake.ListBlockingBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listBlockingBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListBlockingBuildsCallCount() int {
+	fake.listBlockingBuildsMutex.RLock()
+	defer fake.listBlockingBuildsMutex.RUnlock()
+	return len(fake.listBlockingBuildsArgsForCall)
+}
+
+func (fake *FakeClient) ListBlockingBuildsCalls(stub func(string) ([]atc.Build, error)) {
+	fake.listBlockingBuildsMutex.Lock()
+	defer fake.listBlockingBuildsMutex.Unlock()
+	fake.ListBlockingBuildsStub = stub
+}
+
+func (fake *FakeClient) ListBlockingBuildsArgsForCall(i int) string {
+	fake.listBlockingBuildsMutex.RLock()
+	defer fake.listBlockingBuildsMutex.RUnlock()
+	argsForCall := fake.listBlockingBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ListBlockingBuildsReturns(result1 []atc.Build, result2 error) {
+	fake.listBlockingBuildsMutex.Lock()
+	defer fake.listBlockingBuildsMutex.Unlock()
+	fake.ListBlockingBuildsStub = nil
+	fake.listBlockingBuildsReturns = struct {
+		result1 []atc.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListBlockingBuildsReturnsOnCall(i int, result1 []atc.Build, result2 error) {
+	fake.listBlockingBuildsMutex.Lock()
+	defer fake.listBlockingBuildsMutex.Unlock()
+	fake.ListBlockingBuildsStub = nil
+	if fake.listBlockingBuildsReturnsOnCall == nil {
+		fake.listBlockingBuildsReturnsOnCall = make(map[int]struct {
+			result1 []atc.Build
+			result2 error
+		})
+	}
+	fake.listBlockingBuildsReturnsOnCall[i] = struct {
+		result1 []atc.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListBuildArtifacts(arg1 string) ([]atc.WorkerArtifact, error) {
 	fake.listBuildArtifactsMutex.Lock()
 	ret, specificReturn := fake.listBuildArtifactsReturnsOnCall[len(fake.listBuildArtifactsArgsForCall)]
@@ -1229,6 +1474,67 @@ func (fake *FakeClient) SaveWorkerReturnsOnCall(i int, result1 *atc.Worker, resu
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ScheduleWorkerMaintenance(arg1 string, arg2 atc.MaintenanceWindow) error {
+	fake.scheduleWorkerMaintenanceMutex.Lock()
+	ret, specificReturn := fake.scheduleWorkerMaintenanceReturnsOnCall[len(fake.scheduleWorkerMaintenanceArgsForCall)]
+	fake.scheduleWorkerMaintenanceArgsForCall = append(fake.scheduleWorkerMaintenanceArgsForCall, struct {
+		arg1 string
+		arg2 atc.MaintenanceWindow
+	}{arg1, arg2})
+	fake.recordInvocation("ScheduleWorkerMaintenance", []interface{}{arg1, arg2})
+	fake.scheduleWorkerMaintenanceMutex.Unlock()
+	if fake.ScheduleWorkerMaintenanceStub != nil {
+		return fake.ScheduleWorkerMaintenanceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.scheduleWorkerMaintenanceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ScheduleWorkerMaintenanceCallCount() int {
+	fake.scheduleWorkerMaintenanceMutex.RLock()
+	defer fake.scheduleWorkerMaintenanceMutex.RUnlock()
+	return len(fake.scheduleWorkerMaintenanceArgsForCall)
+}
+
+func (fake *FakeClient) ScheduleWorkerMaintenanceCalls(stub func(string, atc.MaintenanceWindow) error) {
+	fake.scheduleWorkerMaintenanceMutex.Lock()
+	defer fake.scheduleWorkerMaintenanceMutex.Unlock()
+	fake.ScheduleWorkerMaintenanceStub = stub
+}
+
+func (fake *FakeClient) ScheduleWorkerMaintenanceArgsForCall(i int) (string, atc.MaintenanceWindow) {
+	fake.scheduleWorkerMaintenanceMutex.RLock()
+	defer fake.scheduleWorkerMaintenanceMutex.RUnlock()
+	argsForCall := fake.scheduleWorkerMaintenanceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ScheduleWorkerMaintenanceReturns(result1 error) {
+	fake.scheduleWorkerMaintenanceMutex.Lock()
+	defer fake.scheduleWorkerMaintenanceMutex.Unlock()
+	fake.ScheduleWorkerMaintenanceStub = nil
+	fake.scheduleWorkerMaintenanceReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ScheduleWorkerMaintenanceReturnsOnCall(i int, result1 error) {
+	fake.scheduleWorkerMaintenanceMutex.Lock()
+	defer fake.scheduleWorkerMaintenanceMutex.Unlock()
+	fake.ScheduleWorkerMaintenanceStub = nil
+	if fake.scheduleWorkerMaintenanceReturnsOnCall == nil {
+		fake.scheduleWorkerMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.scheduleWorkerMaintenanceReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) Team(arg1 string) concourse.Team {
 	fake.teamMutex.Lock()
 	ret, specificReturn := fake.teamReturnsOnCall[len(fake.teamArgsForCall)]
@@ -1341,6 +1647,66 @@ func (fake *FakeClient) URLReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeClient) UncordonWorker(arg1 string) error {
+	fake.uncordonWorkerMutex.Lock()
+	ret, specificReturn := fake.uncordonWorkerReturnsOnCall[len(fake.uncordonWorkerArgsForCall)]
+	fake.uncordonWorkerArgsForCall = append(fake.uncordonWorkerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UncordonWorker", []interface{}{arg1})
+	fake.uncordonWorkerMutex.Unlock()
+	if fake.UncordonWorkerStub != nil {
+		return fake.UncordonWorkerStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.uncordonWorkerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UncordonWorkerCallCount() int {
+	fake.uncordonWorkerMutex.RLock()
+	defer fake.uncordonWorkerMutex.RUnlock()
+	return len(fake.uncordonWorkerArgsForCall)
+}
+
+func (fake *FakeClient) UncordonWorkerCalls(stub func(string) error) {
+	fake.uncordonWorkerMutex.Lock()
+	defer fake.uncordonWorkerMutex.Unlock()
+	fake.UncordonWorkerStub = stub
+}
+
+func (fake *FakeClient) UncordonWorkerArgsForCall(i int) string {
+	fake.uncordonWorkerMutex.RLock()
+	defer fake.uncordonWorkerMutex.RUnlock()
+	argsForCall := fake.uncordonWorkerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) UncordonWorkerReturns(result1 error) {
+	fake.uncordonWorkerMutex.Lock()
+	defer fake.uncordonWorkerMutex.Unlock()
+	fake.UncordonWorkerStub = nil
+	fake.uncordonWorkerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UncordonWorkerReturnsOnCall(i int, result1 error) {
+	fake.uncordonWorkerMutex.Lock()
+	defer fake.uncordonWorkerMutex.Unlock()
+	fake.UncordonWorkerStub = nil
+	if fake.uncordonWorkerReturnsOnCall == nil {
+		fake.uncordonWorkerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonWorkerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) UserInfo() (map[string]interface{}, error) {
 	fake.userInfoMutex.Lock()
 	ret, specificReturn := fake.userInfoReturnsOnCall[len(fake.userInfoArgsForCall)]
@@ -1411,6 +1777,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
+	fake.cordonWorkerMutex.RLock()
+	defer fake.cordonWorkerMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
 	defer fake.getCLIReaderMutex.RUnlock()
 	fake.getInfoMutex.RLock()
@@ -1419,6 +1787,10 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.hTTPClientMutex.RUnlock()
 	fake.landWorkerMutex.RLock()
 	defer fake.landWorkerMutex.RUnlock()
+	fake.landWorkerWithDeadlineMutex.RLock()
+	defer fake.landWorkerWithDeadlineMutex.RUnlock()
+	fake.listBlockingBuildsMutex.RLock()
+	defer fake.listBlockingBuildsMutex.RUnlock()
 	fake.listBuildArtifactsMutex.RLock()
 	defer fake.listBuildArtifactsMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
@@ -1431,10 +1803,14 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.pruneWorkerMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.scheduleWorkerMaintenanceMutex.RLock()
+	defer fake.scheduleWorkerMaintenanceMutex.RUnlock()
 	fake.teamMutex.RLock()
 	defer fake.teamMutex.RUnlock()
 	fake.uRLMutex.RLock()
 	defer fake.uRLMutex.RUnlock()
+	fake.uncordonWorkerMutex.RLock()
+	defer fake.uncordonWorkerMutex.RUnlock()
 	fake.userInfoMutex.RLock()
 	defer fake.userInfoMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -90,6 +90,23 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	CheckResourceInBuildStub        func(string, string, atc.Version) (atc.Build, bool, error)
+	checkResourceInBuildMutex       sync.RWMutex
+	checkResourceInBuildArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 atc.Version
+	}
+	checkResourceInBuildReturns struct {
+		result1 atc.Build
+		result2 bool
+		result3 error
+	}
+	checkResourceInBuildReturnsOnCall map[int]struct {
+		result1 atc.Build
+		result2 bool
+		result3 error
+	}
 	CheckResourceTypeStub        func(string, string, atc.Version) (bool, error)
 	checkResourceTypeMutex       sync.RWMutex
 	checkResourceTypeArgsForCall []struct {
@@ -161,6 +178,21 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
+	CreateJobBuildWithInputOverridesStub        func(string, string, atc.InputOverrides) (atc.Build, error)
+	createJobBuildWithInputOverridesMutex       sync.RWMutex
+	createJobBuildWithInputOverridesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 atc.InputOverrides
+	}
+	createJobBuildWithInputOverridesReturns struct {
+		result1 atc.Build
+		result2 error
+	}
+	createJobBuildWithInputOverridesReturnsOnCall map[int]struct {
+		result1 atc.Build
+		result2 error
+	}
 	CreateOrUpdateStub        func(atc.Team) (atc.Team, bool, bool, error)
 	createOrUpdateMutex       sync.RWMutex
 	createOrUpdateArgsForCall []struct {
@@ -575,6 +607,22 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	ResourceCheckHistoryStub        func(string, string) ([]atc.ResourceCheck, bool, error)
+	resourceCheckHistoryMutex       sync.RWMutex
+	resourceCheckHistoryArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	resourceCheckHistoryReturns struct {
+		result1 []atc.ResourceCheck
+		result2 bool
+		result3 error
+	}
+	resourceCheckHistoryReturnsOnCall map[int]struct {
+		result1 []atc.ResourceCheck
+		result2 bool
+		result3 error
+	}
 	ResourceVersionsStub        func(string, string, concourse.Page) ([]atc.ResourceVersion, concourse.Pagination, bool, error)
 	resourceVersionsMutex       sync.RWMutex
 	resourceVersionsArgsForCall []struct {
@@ -594,6 +642,22 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	SetTeamQuotasStub        func(string, atc.TeamQuotas) (atc.Team, bool, error)
+	setTeamQuotasMutex       sync.RWMutex
+	setTeamQuotasArgsForCall []struct {
+		arg1 string
+		arg2 atc.TeamQuotas
+	}
+	setTeamQuotasReturns struct {
+		result1 atc.Team
+		result2 bool
+		result3 error
+	}
+	setTeamQuotasReturnsOnCall map[int]struct {
+		result1 atc.Team
+		result2 bool
+		result3 error
+	}
 	UnpauseJobStub        func(string, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
@@ -974,6 +1038,74 @@ func (fake *FakeTeam) CheckResourceReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CheckResourceInBuild(arg1 string, arg2 string, arg3 atc.Version) (atc.Build, bool, error) {
+	fake.checkResourceInBuildMutex.Lock()
+	ret, specificReturn := fake.checkResourceInBuildReturnsOnCall[len(fake.checkResourceInBuildArgsForCall)]
+	fake.checkResourceInBuildArgsForCall = append(fake.checkResourceInBuildArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 atc.Version
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CheckResourceInBuild", []interface{}{arg1, arg2, arg3})
+	fake.checkResourceInBuildMutex.Unlock()
+	if fake.CheckResourceInBuildStub != nil {
+		return fake.CheckResourceInBuildStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.checkResourceInBuildReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) CheckResourceInBuildCallCount() int {
+	fake.checkResourceInBuildMutex.RLock()
+	defer fake.checkResourceInBuildMutex.RUnlock()
+	return len(fake.checkResourceInBuildArgsForCall)
+}
+
+func (fake *FakeTeam) CheckResourceInBuildCalls(stub func(string, string, atc.Version) (atc.Build, bool, error)) {
+	fake.checkResourceInBuildMutex.Lock()
+	defer fake.checkResourceInBuildMutex.Unlock()
+	fake.CheckResourceInBuildStub = stub
+}
+
+func (fake *FakeTeam) CheckResourceInBuildArgsForCall(i int) (string, string, atc.Version) {
+	fake.checkResourceInBuildMutex.RLock()
+	defer fake.checkResourceInBuildMutex.RUnlock()
+	argsForCall := fake.checkResourceInBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) CheckResourceInBuildReturns(result1 atc.Build, result2 bool, result3 error) {
+	fake.checkResourceInBuildMutex.Lock()
+	defer fake.checkResourceInBuildMutex.Unlock()
+	fake.CheckResourceInBuildStub = nil
+	fake.checkResourceInBuildReturns = struct {
+		result1 atc.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) CheckResourceInBuildReturnsOnCall(i int, result1 atc.Build, result2 bool, result3 error) {
+	fake.checkResourceInBuildMutex.Lock()
+	defer fake.checkResourceInBuildMutex.Unlock()
+	fake.CheckResourceInBuildStub = nil
+	if fake.checkResourceInBuildReturnsOnCall == nil {
+		fake.checkResourceInBuildReturnsOnCall = make(map[int]struct {
+			result1 atc.Build
+			result2 bool
+			result3 error
+		})
+	}
+	fake.checkResourceInBuildReturnsOnCall[i] = struct {
+		result1 atc.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) CheckResourceType(arg1 string, arg2 string, arg3 atc.Version) (bool, error) {
 	fake.checkResourceTypeMutex.Lock()
 	ret, specificReturn := fake.checkResourceTypeReturnsOnCall[len(fake.checkResourceTypeArgsForCall)]
@@ -1295,6 +1427,71 @@ func (fake *FakeTeam) CreateJobBuildReturnsOnCall(i int, result1 atc.Build, resu
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateJobBuildWithInputOverrides(arg1 string, arg2 string, arg3 atc.InputOverrides) (atc.Build, error) {
+	fake.createJobBuildWithInputOverridesMutex.Lock()
+	ret, specificReturn := fake.createJobBuildWithInputOverridesReturnsOnCall[len(fake.createJobBuildWithInputOverridesArgsForCall)]
+	fake.createJobBuildWithInputOverridesArgsForCall = append(fake.createJobBuildWithInputOverridesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 atc.InputOverrides
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateJobBuildWithInputOverrides", []interface{}{arg1, arg2, arg3})
+	fake.createJobBuildWithInputOverridesMutex.Unlock()
+	if fake.CreateJobBuildWithInputOverridesStub != nil {
+		return fake.CreateJobBuildWithInputOverridesStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createJobBuildWithInputOverridesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateJobBuildWithInputOverridesCallCount() int {
+	fake.createJobBuildWithInputOverridesMutex.RLock()
+	defer fake.createJobBuildWithInputOverridesMutex.RUnlock()
+	return len(fake.createJobBuildWithInputOverridesArgsForCall)
+}
+
+func (fake *FakeTeam) CreateJobBuildWithInputOverridesCalls(stub func(string, string, atc.InputOverrides) (atc.Build, error)) {
+	fake.createJobBuildWithInputOverridesMutex.Lock()
+	defer fake.createJobBuildWithInputOverridesMutex.Unlock()
+	fake.CreateJobBuildWithInputOverridesStub = stub
+}
+
+func (fake *FakeTeam) CreateJobBuildWithInputOverridesArgsForCall(i int) (string, string, atc.InputOverrides) {
+	fake.createJobBuildWithInputOverridesMutex.RLock()
+	defer fake.createJobBuildWithInputOverridesMutex.RUnlock()
+	argsForCall := fake.createJobBuildWithInputOverridesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) CreateJobBuildWithInputOverridesReturns(result1 atc.Build, result2 error) {
+	fake.createJobBuildWithInputOverridesMutex.Lock()
+	defer fake.createJobBuildWithInputOverridesMutex.Unlock()
+	fake.CreateJobBuildWithInputOverridesStub = nil
+	fake.createJobBuildWithInputOverridesReturns = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateJobBuildWithInputOverridesReturnsOnCall(i int, result1 atc.Build, result2 error) {
+	fake.createJobBuildWithInputOverridesMutex.Lock()
+	defer fake.createJobBuildWithInputOverridesMutex.Unlock()
+	fake.CreateJobBuildWithInputOverridesStub = nil
+	if fake.createJobBuildWithInputOverridesReturnsOnCall == nil {
+		fake.createJobBuildWithInputOverridesReturnsOnCall = make(map[int]struct {
+			result1 atc.Build
+			result2 error
+		})
+	}
+	fake.createJobBuildWithInputOverridesReturnsOnCall[i] = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CreateOrUpdate(arg1 atc.Team) (atc.Team, bool, bool, error) {
 	fake.createOrUpdateMutex.Lock()
 	ret, specificReturn := fake.createOrUpdateReturnsOnCall[len(fake.createOrUpdateArgsForCall)]
@@ -3159,6 +3356,73 @@ func (fake *FakeTeam) ResourceReturnsOnCall(i int, result1 atc.Resource, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) ResourceCheckHistory(arg1 string, arg2 string) ([]atc.ResourceCheck, bool, error) {
+	fake.resourceCheckHistoryMutex.Lock()
+	ret, specificReturn := fake.resourceCheckHistoryReturnsOnCall[len(fake.resourceCheckHistoryArgsForCall)]
+	fake.resourceCheckHistoryArgsForCall = append(fake.resourceCheckHistoryArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ResourceCheckHistory", []interface{}{arg1, arg2})
+	fake.resourceCheckHistoryMutex.Unlock()
+	if fake.ResourceCheckHistoryStub != nil {
+		return fake.ResourceCheckHistoryStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.resourceCheckHistoryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) ResourceCheckHistoryCallCount() int {
+	fake.resourceCheckHistoryMutex.RLock()
+	defer fake.resourceCheckHistoryMutex.RUnlock()
+	return len(fake.resourceCheckHistoryArgsForCall)
+}
+
+func (fake *FakeTeam) ResourceCheckHistoryCalls(stub func(string, string) ([]atc.ResourceCheck, bool, error)) {
+	fake.resourceCheckHistoryMutex.Lock()
+	defer fake.resourceCheckHistoryMutex.Unlock()
+	fake.ResourceCheckHistoryStub = stub
+}
+
+func (fake *FakeTeam) ResourceCheckHistoryArgsForCall(i int) (string, string) {
+	fake.resourceCheckHistoryMutex.RLock()
+	defer fake.resourceCheckHistoryMutex.RUnlock()
+	argsForCall := fake.resourceCheckHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) ResourceCheckHistoryReturns(result1 []atc.ResourceCheck, result2 bool, result3 error) {
+	fake.resourceCheckHistoryMutex.Lock()
+	defer fake.resourceCheckHistoryMutex.Unlock()
+	fake.ResourceCheckHistoryStub = nil
+	fake.resourceCheckHistoryReturns = struct {
+		result1 []atc.ResourceCheck
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ResourceCheckHistoryReturnsOnCall(i int, result1 []atc.ResourceCheck, result2 bool, result3 error) {
+	fake.resourceCheckHistoryMutex.Lock()
+	defer fake.resourceCheckHistoryMutex.Unlock()
+	fake.ResourceCheckHistoryStub = nil
+	if fake.resourceCheckHistoryReturnsOnCall == nil {
+		fake.resourceCheckHistoryReturnsOnCall = make(map[int]struct {
+			result1 []atc.ResourceCheck
+			result2 bool
+			result3 error
+		})
+	}
+	fake.resourceCheckHistoryReturnsOnCall[i] = struct {
+		result1 []atc.ResourceCheck
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) ResourceVersions(arg1 string, arg2 string, arg3 concourse.Page) ([]atc.ResourceVersion, concourse.Pagination, bool, error) {
 	fake.resourceVersionsMutex.Lock()
 	ret, specificReturn := fake.resourceVersionsReturnsOnCall[len(fake.resourceVersionsArgsForCall)]
@@ -3230,6 +3494,73 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) SetTeamQuotas(arg1 string, arg2 atc.TeamQuotas) (atc.Team, bool, error) {
+	fake.setTeamQuotasMutex.Lock()
+	ret, specificReturn := fake.setTeamQuotasReturnsOnCall[len(fake.setTeamQuotasArgsForCall)]
+	fake.setTeamQuotasArgsForCall = append(fake.setTeamQuotasArgsForCall, struct {
+		arg1 string
+		arg2 atc.TeamQuotas
+	}{arg1, arg2})
+	fake.recordInvocation("SetTeamQuotas", []interface{}{arg1, arg2})
+	fake.setTeamQuotasMutex.Unlock()
+	if fake.SetTeamQuotasStub != nil {
+		return fake.SetTeamQuotasStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.setTeamQuotasReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) SetTeamQuotasCallCount() int {
+	fake.setTeamQuotasMutex.RLock()
+	defer fake.setTeamQuotasMutex.RUnlock()
+	return len(fake.setTeamQuotasArgsForCall)
+}
+
+func (fake *FakeTeam) SetTeamQuotasCalls(stub func(string, atc.TeamQuotas) (atc.Team, bool, error)) {
+	fake.setTeamQuotasMutex.Lock()
+	defer fake.setTeamQuotasMutex.Unlock()
+	fake.SetTeamQuotasStub = stub
+}
+
+func (fake *FakeTeam) SetTeamQuotasArgsForCall(i int) (string, atc.TeamQuotas) {
+	fake.setTeamQuotasMutex.RLock()
+	defer fake.setTeamQuotasMutex.RUnlock()
+	argsForCall := fake.setTeamQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) SetTeamQuotasReturns(result1 atc.Team, result2 bool, result3 error) {
+	fake.setTeamQuotasMutex.Lock()
+	defer fake.setTeamQuotasMutex.Unlock()
+	fake.SetTeamQuotasStub = nil
+	fake.setTeamQuotasReturns = struct {
+		result1 atc.Team
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) SetTeamQuotasReturnsOnCall(i int, result1 atc.Team, result2 bool, result3 error) {
+	fake.setTeamQuotasMutex.Lock()
+	defer fake.setTeamQuotasMutex.Unlock()
+	fake.SetTeamQuotasStub = nil
+	if fake.setTeamQuotasReturnsOnCall == nil {
+		fake.setTeamQuotasReturnsOnCall = make(map[int]struct {
+			result1 atc.Team
+			result2 bool
+			result3 error
+		})
+	}
+	fake.setTeamQuotasReturnsOnCall[i] = struct {
+		result1 atc.Team
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) UnpauseJob(arg1 string, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
@@ -3436,6 +3767,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.buildsWithVersionAsOutputMutex.RUnlock()
 	fake.checkResourceMutex.RLock()
 	defer fake.checkResourceMutex.RUnlock()
+	fake.checkResourceInBuildMutex.RLock()
+	defer fake.checkResourceInBuildMutex.RUnlock()
 	fake.checkResourceTypeMutex.RLock()
 	defer fake.checkResourceTypeMutex.RUnlock()
 	fake.clearTaskCacheMutex.RLock()
@@ -3446,6 +3779,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createBuildMutex.RUnlock()
 	fake.createJobBuildMutex.RLock()
 	defer fake.createJobBuildMutex.RUnlock()
+	fake.createJobBuildWithInputOverridesMutex.RLock()
+	defer fake.createJobBuildWithInputOverridesMutex.RUnlock()
 	fake.createOrUpdateMutex.RLock()
 	defer fake.createOrUpdateMutex.RUnlock()
 	fake.createOrUpdatePipelineConfigMutex.RLock()
@@ -3504,8 +3839,12 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.renameTeamMutex.RUnlock()
 	fake.resourceMutex.RLock()
 	defer fake.resourceMutex.RUnlock()
+	fake.resourceCheckHistoryMutex.RLock()
+	defer fake.resourceCheckHistoryMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.setTeamQuotasMutex.RLock()
+	defer fake.setTeamQuotasMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	fake.unpausePipelineMutex.RLock()
@@ -46,3 +46,27 @@ func (team *team) ListResources(pipelineName string) ([]atc.Resource, error) {
 
 	return resources, err
 }
+
+func (team *team) ResourceCheckHistory(pipelineName string, resourceName string) ([]atc.ResourceCheck, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineName,
+		"resource_name": resourceName,
+		"team_name":     team.name,
+	}
+
+	var checks []atc.ResourceCheck
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListResourceChecks,
+		Params:      params,
+	}, &internal.Response{
+		Result: &checks,
+	})
+	switch err.(type) {
+	case nil:
+		return checks, true, nil
+	case internal.ResourceNotFoundError:
+		return checks, false, nil
+	default:
+		return checks, false, err
+	}
+}
@@ -108,4 +108,70 @@ var _ = Describe("ATC Handler Resource", func() {
 			})
 		})
 	})
+
+	Describe("ResourceCheckHistory", func() {
+		var expectedChecks []atc.ResourceCheck
+
+		var checks []atc.ResourceCheck
+		var found bool
+		var clientErr error
+
+		BeforeEach(func() {
+			expectedChecks = []atc.ResourceCheck{
+				{
+					ID:         2,
+					StartTime:  1000,
+					EndTime:    1010,
+					WorkerName: "some-worker",
+					Versions:   []atc.Version{},
+					Error:      "some-error",
+					Stderr:     "some-stderr",
+				},
+				{
+					ID:         1,
+					StartTime:  900,
+					EndTime:    905,
+					WorkerName: "some-worker",
+					Versions:   []atc.Version{{"ref": "v1"}},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			checks, found, clientErr = team.ResourceCheckHistory("some-pipeline", "myresource")
+		})
+
+		Context("when the server returns the checks", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/some-pipeline/resources/myresource/checks"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedChecks),
+					),
+				)
+			})
+
+			It("returns the checks", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(checks).To(Equal(expectedChecks))
+			})
+		})
+
+		Context("when the server returns a 404", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/some-pipeline/resources/myresource/checks"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false for found and a nil error", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
 })
@@ -15,6 +15,7 @@ type Team interface {
 	CreateOrUpdate(team atc.Team) (atc.Team, bool, bool, error)
 	RenameTeam(teamName, name string) (bool, error)
 	DestroyTeam(teamName string) error
+	SetTeamQuotas(teamName string, quotas atc.TeamQuotas) (atc.Team, bool, error)
 
 	Pipeline(name string) (atc.Pipeline, bool, error)
 	PipelineBuilds(pipelineName string, page Page) ([]atc.Build, Pagination, bool, error)
@@ -36,6 +37,7 @@ type Team interface {
 	JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error)
 	JobBuilds(pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, bool, error)
 	CreateJobBuild(pipelineName string, jobName string) (atc.Build, error)
+	CreateJobBuildWithInputOverrides(pipelineName string, jobName string, overrides atc.InputOverrides) (atc.Build, error)
 	ListJobs(pipelineName string) ([]atc.Job, error)
 
 	PauseJob(pipelineName string, jobName string) (bool, error)
@@ -45,9 +47,11 @@ type Team interface {
 
 	Resource(pipelineName string, resourceName string) (atc.Resource, bool, error)
 	ListResources(pipelineName string) ([]atc.Resource, error)
+	ResourceCheckHistory(pipelineName string, resourceName string) ([]atc.ResourceCheck, bool, error)
 	VersionedResourceTypes(pipelineName string) (atc.VersionedResourceTypes, bool, error)
 	ResourceVersions(pipelineName string, resourceName string, page Page) ([]atc.ResourceVersion, Pagination, bool, error)
 	CheckResource(pipelineName string, resourceName string, version atc.Version) (bool, error)
+	CheckResourceInBuild(pipelineName string, resourceName string, version atc.Version) (atc.Build, bool, error)
 	CheckResourceType(pipelineName string, resourceTypeName string, version atc.Version) (bool, error)
 	DisableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
 	EnableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
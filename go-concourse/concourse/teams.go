@@ -96,6 +96,37 @@ func (team *team) RenameTeam(teamName, name string) (bool, error) {
 	}
 }
 
+// SetTeamQuotas limits the builds and containers the team with the name given
+// as argument can have at once. A zero quota means there is no limit.
+func (team *team) SetTeamQuotas(teamName string, quotas atc.TeamQuotas) (atc.Team, bool, error) {
+	params := rata.Params{
+		"team_name": teamName,
+	}
+
+	jsonBytes, err := json.Marshal(quotas)
+	if err != nil {
+		return atc.Team{}, false, err
+	}
+
+	var savedTeam atc.Team
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.SetTeamQuotas,
+		Params:      params,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &savedTeam,
+	})
+	switch err.(type) {
+	case nil:
+		return savedTeam, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.Team{}, false, nil
+	default:
+		return atc.Team{}, false, err
+	}
+}
+
 func (client *client) ListTeams() ([]atc.Team, error) {
 	var teams []atc.Team
 	err := client.connection.Send(internal.Request{
@@ -147,6 +147,79 @@ var _ = Describe("ATC Handler Teams", func() {
 		})
 	})
 
+	Describe("SetTeamQuotas", func() {
+		var (
+			expectedURL string
+			quotas      atc.TeamQuotas
+		)
+
+		BeforeEach(func() {
+			expectedURL = "/api/v1/teams/enron/quotas"
+			quotas = atc.TeamQuotas{MaxRunningBuilds: 2, MaxContainers: 10}
+			team = client.Team("not-super-important")
+		})
+
+		Context("when the server updates the quotas", func() {
+			var expectedTeam atc.Team
+
+			BeforeEach(func() {
+				expectedTeam = atc.Team{
+					ID:     1,
+					Name:   "enron",
+					Quotas: &quotas,
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.VerifyJSONRepresenting(quotas),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedTeam),
+					),
+				)
+			})
+
+			It("returns back the team", func() {
+				savedTeam, found, err := team.SetTeamQuotas("enron", quotas)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(savedTeam).To(Equal(expectedTeam))
+			})
+		})
+
+		Context("when the team does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.SetTeamQuotas("enron", quotas)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the server blows up", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, _, err := team.SetTeamQuotas("enron", quotas)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("ListTeams", func() {
 		var expectedTeams []atc.Team
 
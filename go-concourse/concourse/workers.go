@@ -5,6 +5,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -81,10 +84,25 @@ func (client *client) PruneWorker(workerName string) error {
 }
 
 func (client *client) LandWorker(workerName string) error {
+	return client.landWorker(workerName, url.Values{})
+}
+
+// LandWorkerWithDeadline lands the worker, giving the builds preventing it
+// from landing until the deadline to finish. If abortBuilds is true, they are
+// aborted once the deadline has passed.
+func (client *client) LandWorkerWithDeadline(workerName string, deadline time.Duration, abortBuilds bool) error {
+	return client.landWorker(workerName, url.Values{
+		"deadline":     {deadline.String()},
+		"abort_builds": {strconv.FormatBool(abortBuilds)},
+	})
+}
+
+func (client *client) landWorker(workerName string, queryParams url.Values) error {
 	params := rata.Params{"worker_name": workerName}
 	err := client.connection.Send(internal.Request{
 		RequestName: atc.LandWorker,
 		Params:      params,
+		Query:       queryParams,
 		Header: http.Header{
 			"Content-Type": {"application/json"},
 		},
@@ -92,3 +110,58 @@ func (client *client) LandWorker(workerName string) error {
 
 	return err
 }
+
+// CordonWorker stops new containers from being placed on the worker, leaving
+// its existing containers be. Cordoning a worker which is not running fails
+// with a GenericError explaining why.
+func (client *client) CordonWorker(workerName string) error {
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.CordonWorker,
+		Params:      rata.Params{"worker_name": workerName},
+	}, nil)
+
+	if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+		if unexpectedResponseError.StatusCode == http.StatusConflict {
+			return GenericError{strings.TrimSpace(unexpectedResponseError.Body)}
+		}
+	}
+
+	return err
+}
+
+func (client *client) UncordonWorker(workerName string) error {
+	return client.connection.Send(internal.Request{
+		RequestName: atc.UncordonWorker,
+		Params:      rata.Params{"worker_name": workerName},
+	}, nil)
+}
+
+// ScheduleWorkerMaintenance cordons the worker for the duration of the
+// maintenance window.
+func (client *client) ScheduleWorkerMaintenance(workerName string, window atc.MaintenanceWindow) error {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(window)
+	if err != nil {
+		return fmt.Errorf("Unable to marshal maintenance window: %s", err)
+	}
+
+	return client.connection.Send(internal.Request{
+		RequestName: atc.ScheduleWorkerMaintenance,
+		Params:      rata.Params{"worker_name": workerName},
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+}
+
+func (client *client) ListBlockingBuilds(workerName string) ([]atc.Build, error) {
+	var builds []atc.Build
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListBlockingBuilds,
+		Params:      rata.Params{"worker_name": workerName},
+	}, &internal.Response{
+		Result: &builds,
+	})
+	return builds, err
+}
@@ -2,6 +2,7 @@ package concourse_test
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -160,4 +161,122 @@ var _ = Describe("ATC Handler Workers", func() {
 			})
 		})
 	})
+
+	Describe("LandWorkerWithDeadline", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land", "abort_builds=true&deadline=30m0s"),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+		})
+
+		It("lands the worker with the deadline", func() {
+			err := client.LandWorkerWithDeadline("some-worker", 30*time.Minute, true)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("ListBlockingBuilds", func() {
+		var expectedBuilds []atc.Build
+
+		BeforeEach(func() {
+			expectedBuilds = []atc.Build{
+				{
+					ID:           42,
+					Name:         "1",
+					JobName:      "some-job",
+					PipelineName: "some-pipeline",
+					TeamName:     "some-team",
+					Status:       "started",
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/workers/some-worker/blocking-builds"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuilds),
+				),
+			)
+		})
+
+		It("returns the builds blocking the worker", func() {
+			builds, err := client.ListBlockingBuilds("some-worker")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(Equal(expectedBuilds))
+		})
+	})
+
+	Describe("CordonWorker", func() {
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/cordon"),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("cordons the worker", func() {
+				err := client.CordonWorker("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the worker is not running", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/cordon"),
+						ghttp.RespondWith(http.StatusConflict, "cannot cordon worker 'some-worker' as it is not running\n"),
+					),
+				)
+			})
+
+			It("returns the explanation", func() {
+				err := client.CordonWorker("some-worker")
+				Expect(err).To(Equal(concourse.GenericError{
+					Message: "cannot cordon worker 'some-worker' as it is not running",
+				}))
+			})
+		})
+	})
+
+	Describe("UncordonWorker", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/uncordon"),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+		})
+
+		It("uncordons the worker", func() {
+			err := client.UncordonWorker("some-worker")
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("ScheduleWorkerMaintenance", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/maintenance"),
+					ghttp.VerifyJSONRepresenting(atc.MaintenanceWindow{Start: 1500000000, End: 1500003600}),
+					ghttp.RespondWith(http.StatusOK, nil),
+				),
+			)
+		})
+
+		It("schedules maintenance on the worker", func() {
+			err := client.ScheduleWorkerMaintenance("some-worker", atc.MaintenanceWindow{
+				Start: 1500000000,
+				End:   1500003600,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
 })
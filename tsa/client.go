@@ -23,7 +23,7 @@ import (
 )
 
 // ErrAllGatewaysUnreachable is returned when all hosts reject the connection.
-var ErrAllGatewaysUnreachable = errors.New("all worker SSH gateways unreachable")
+var ErrAllGatewaysUnreachable = errors.New("all worker gateways unreachable")
 
 // ErrConnectionDrainTimeout is returned when the connection underlying a
 // registration has been idle for the configured ConnectionDrainTimeout.
@@ -325,7 +325,7 @@ func (client *Client) dial(ctx context.Context, idleTimeout time.Duration) (*ssh
 	logger := lagerctx.WithSession(ctx, "dial")
 
 	var err error
-	tcpConn, tsaAddr, err := client.tryDialAll(ctx)
+	tcpConn, tsaAddr, err := tryDialAll(ctx, client.Hosts)
 	if err != nil {
 		logger.Error("failed-to-connect-to-any-tsa", err)
 		return nil, nil, err
@@ -365,7 +365,7 @@ func (client *Client) dial(ctx context.Context, idleTimeout time.Duration) (*ssh
 	return ssh.NewClient(clientConn, chans, reqs), tcpConn.(*net.TCPConn), nil
 }
 
-func (client *Client) tryDialAll(ctx context.Context) (net.Conn, string, error) {
+func tryDialAll(ctx context.Context, hosts []string) (net.Conn, string, error) {
 	logger := lagerctx.FromContext(ctx)
 
 	dialer := &net.Dialer{
@@ -373,8 +373,8 @@ func (client *Client) tryDialAll(ctx context.Context) (net.Conn, string, error)
 		KeepAlive: 15 * time.Second,
 	}
 
-	shuffled := make([]string, len(client.Hosts))
-	copy(shuffled, client.Hosts)
+	shuffled := make([]string, len(hosts))
+	copy(shuffled, hosts)
 	shuffle(sort.StringSlice(shuffled))
 
 	for _, host := range shuffled {
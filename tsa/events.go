@@ -10,10 +10,17 @@ type EventType string
 const (
 	EventTypeRegistered  EventType = "registered"
 	EventTypeHeartbeated EventType = "heartbeated"
+
+	// EventTypeForwardedConnection is only sent by the HTTP/2 gateway, asking
+	// the worker to open a stream for a connection made to one of its forwards.
+	EventTypeForwardedConnection EventType = "forwarded-connection"
 )
 
 type Event struct {
 	Type EventType `json:"event"`
+
+	Forward    string `json:"forward,omitempty"`
+	Connection string `json:"connection,omitempty"`
 }
 
 type EventWriter struct {
@@ -34,6 +41,14 @@ func (w EventWriter) Heartbeated() error {
 	return w.enc.Encode(Event{Type: EventTypeHeartbeated})
 }
 
+func (w EventWriter) ForwardedConnection(forward string, connection string) error {
+	return w.enc.Encode(Event{
+		Type:       EventTypeForwardedConnection,
+		Forward:    forward,
+		Connection: connection,
+	})
+}
+
 type EventReader struct {
 	dec *json.Decoder
 }
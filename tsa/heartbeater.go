@@ -36,6 +36,9 @@ type Heartbeater struct {
 
 	registration atc.Worker
 	eventWriter  EventWriter
+
+	cpuUsage   map[string]uint64
+	measuredAt time.Time
 }
 
 func NewHeartbeater(
@@ -298,9 +301,75 @@ func (heartbeater *Heartbeater) pingWorker(logger lager.Logger) (atc.Worker, boo
 	registration.ActiveContainers = len(containers)
 	registration.ActiveVolumes = len(volumes)
 
+	if registration.Resources != nil {
+		resources, err := heartbeater.measureResources(*registration.Resources, containers)
+		if err != nil {
+			logger.Error("failed-to-measure-resources", err)
+			registration.Resources = nil
+		} else {
+			registration.Resources = &resources
+		}
+	}
+
 	return registration, true
 }
 
+// measureResources determines how much of the worker's capacity is used by its
+// containers. CPU usage is measured since the previous measurement, so it is
+// only known from the second measurement onwards, and a container only counts
+// towards it from the measurement after the one it first appears in.
+func (heartbeater *Heartbeater) measureResources(resources atc.WorkerResources, containers []garden.Container) (atc.WorkerResources, error) {
+	capacity, err := heartbeater.gardenClient.Capacity()
+	if err != nil {
+		return atc.WorkerResources{}, err
+	}
+
+	resources.MemoryInBytes = capacity.MemoryInBytes
+	resources.DiskInBytes = capacity.DiskInBytes
+
+	handles := make([]string, len(containers))
+	for i, container := range containers {
+		handles[i] = container.Handle()
+	}
+
+	metrics := map[string]garden.ContainerMetricsEntry{}
+	if len(handles) != 0 {
+		metrics, err = heartbeater.gardenClient.BulkMetrics(handles)
+		if err != nil {
+			return atc.WorkerResources{}, err
+		}
+	}
+
+	now := heartbeater.clock.Now()
+
+	cpuUsage := map[string]uint64{}
+	var cpuTime uint64
+	for handle, entry := range metrics {
+		if entry.Err != nil {
+			continue
+		}
+
+		resources.MemoryUsedInBytes += entry.Metrics.MemoryStat.TotalUsageTowardLimit
+		resources.DiskUsedInBytes += entry.Metrics.DiskStat.ExclusiveBytesUsed
+
+		usage := entry.Metrics.CPUStat.Usage
+		if previous, found := heartbeater.cpuUsage[handle]; found && usage >= previous {
+			cpuTime += usage - previous
+		}
+
+		cpuUsage[handle] = usage
+	}
+
+	if !heartbeater.measuredAt.IsZero() && now.After(heartbeater.measuredAt) {
+		resources.CPUUsage = float64(cpuTime) / float64(now.Sub(heartbeater.measuredAt))
+	}
+
+	heartbeater.cpuUsage = cpuUsage
+	heartbeater.measuredAt = now
+
+	return resources, nil
+}
+
 func (heartbeater *Heartbeater) ttl() time.Duration {
 	return heartbeater.interval * 2
 }
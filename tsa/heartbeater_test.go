@@ -3,6 +3,7 @@ package tsa_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"time"
 
@@ -356,4 +357,111 @@ var _ = Describe("Heartbeater", func() {
 			})
 		})
 	})
+
+	Context("when the worker reports its resources", func() {
+		BeforeEach(func() {
+			worker.Resources = &atc.WorkerResources{CPUs: 2}
+
+			container1 := new(gardenfakes.FakeContainer)
+			container1.HandleReturns("container-1")
+
+			container2 := new(gardenfakes.FakeContainer)
+			container2.HandleReturns("container-2")
+
+			fakeGardenClient.ContainersReturns([]garden.Container{container1, container2}, nil)
+
+			fakeGardenClient.CapacityReturns(garden.Capacity{
+				MemoryInBytes: 1000,
+				DiskInBytes:   2000,
+			}, nil)
+
+			fakeGardenClient.BulkMetricsStub = func([]string) (map[string]garden.ContainerMetricsEntry, error) {
+				cpuUsage := uint64(fakeGardenClient.BulkMetricsCallCount()) * uint64(time.Second)
+
+				metrics := garden.ContainerMetrics{
+					MemoryStat: garden.ContainerMemoryStat{TotalUsageTowardLimit: 100},
+					DiskStat:   garden.ContainerDiskStat{ExclusiveBytesUsed: 300},
+					CPUStat:    garden.ContainerCPUStat{Usage: cpuUsage},
+				}
+
+				return map[string]garden.ContainerMetricsEntry{
+					"container-1": {Metrics: metrics},
+					"container-2": {Metrics: metrics},
+				}, nil
+			}
+
+			fakeATC1.AppendHandlers(verifyRegister)
+			fakeATC2.AppendHandlers(verifyHeartbeat)
+		})
+
+		It("registers with the resources used by its containers", func() {
+			var registered registration
+			Eventually(registrations).Should(Receive(&registered))
+
+			Expect(registered.worker.Resources).To(Equal(&atc.WorkerResources{
+				CPUs:              2,
+				MemoryInBytes:     1000,
+				MemoryUsedInBytes: 200,
+				DiskInBytes:       2000,
+				DiskUsedInBytes:   600,
+			}))
+		})
+
+		It("heartbeats with the CPU used since the previous measurement", func() {
+			Eventually(registrations).Should(Receive())
+
+			fakeClock.WaitForWatcherAndIncrement(interval)
+
+			var heartbeated registration
+			Eventually(heartbeats).Should(Receive(&heartbeated))
+
+			Expect(heartbeated.worker.Resources.CPUUsage).To(Equal(2.0))
+		})
+
+		Context("when a container appears after the previous measurement", func() {
+			BeforeEach(func() {
+				fakeGardenClient.BulkMetricsStub = func(handles []string) (map[string]garden.ContainerMetricsEntry, error) {
+					cpuUsage := uint64(fakeGardenClient.BulkMetricsCallCount()) * uint64(time.Second)
+
+					metrics := map[string]garden.ContainerMetricsEntry{
+						"container-1": {Metrics: garden.ContainerMetrics{
+							CPUStat: garden.ContainerCPUStat{Usage: cpuUsage},
+						}},
+					}
+
+					if fakeGardenClient.BulkMetricsCallCount() > 1 {
+						metrics["container-2"] = garden.ContainerMetricsEntry{Metrics: garden.ContainerMetrics{
+							CPUStat: garden.ContainerCPUStat{Usage: uint64(time.Hour)},
+						}}
+					}
+
+					return metrics, nil
+				}
+			})
+
+			It("does not count the CPU it used before then", func() {
+				Eventually(registrations).Should(Receive())
+
+				fakeClock.WaitForWatcherAndIncrement(interval)
+
+				var heartbeated registration
+				Eventually(heartbeats).Should(Receive(&heartbeated))
+
+				Expect(heartbeated.worker.Resources.CPUUsage).To(Equal(1.0))
+			})
+		})
+
+		Context("when the worker's capacity cannot be determined", func() {
+			BeforeEach(func() {
+				fakeGardenClient.CapacityReturns(garden.Capacity{}, errors.New("nope"))
+			})
+
+			It("registers without its resources", func() {
+				var registered registration
+				Eventually(registrations).Should(Receive(&registered))
+
+				Expect(registered.worker.Resources).To(BeNil())
+			})
+		})
+	})
 })
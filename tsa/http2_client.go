@@ -0,0 +1,464 @@
+package tsa
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"golang.org/x/net/http2"
+)
+
+// Paths served by the HTTP/2 gateway. Commands are run by posting the worker
+// to the path of the command, e.g. /workers/land-worker. Connections made to
+// a forwarded worker are streamed by posting to the path of the connection.
+const (
+	HTTP2WorkersPath     = "/workers/"
+	HTTP2ConnectionsPath = "/connections/"
+)
+
+// HTTP2ExitStatusTrailer is the trailer with which the HTTP/2 gateway reports
+// the exit status of a command once its output has been streamed.
+const HTTP2ExitStatusTrailer = "Exit-Status"
+
+// ErrExitStatusMissing is returned when the stream of a command ended without
+// the HTTP/2 gateway reporting its exit status, e.g. because the connection
+// broke.
+var ErrExitStatusMissing = errors.New("gateway did not report the exit status of the command")
+
+// ExitError is returned when a command run through the HTTP/2 gateway exits
+// with a non-zero status.
+type ExitError struct {
+	Command    string
+	ExitStatus int
+}
+
+func (err *ExitError) Error() string {
+	return fmt.Sprintf("%s exited with status %d", err.Command, err.ExitStatus)
+}
+
+// HTTP2Client is used to communicate with a pool of remote HTTP/2 gateways,
+// authenticating with a client certificate. Each command runs on a stream of
+// its own, and connections made to the forwarded Garden and Baggageclaim are
+// multiplexed as streams over the registration's connection.
+type HTTP2Client struct {
+	Hosts []string
+
+	TLSConfig *tls.Config
+
+	Worker atc.Worker
+}
+
+// Register invokes the 'forward-worker' command, like Client.Register. The
+// HTTP/2 gateway sends an event for each connection made to the forwarded
+// Garden or Baggageclaim, for which a stream is opened to proxy the
+// connection to the configured address.
+//
+// If the context is canceled, the request body is closed, which the gateway
+// takes as a signal to stop heartbeating and drain connections.
+func (client *HTTP2Client) Register(ctx context.Context, opts RegisterOptions) error {
+	logger := lagerctx.FromContext(ctx)
+
+	conn, err := client.dial(ctx, opts.ConnectionDrainTimeout)
+	if err != nil {
+		logger.Error("failed-to-dial", err)
+		return err
+	}
+
+	defer conn.Close()
+
+	forwards := map[string]forwardedAddr{
+		gardenForwardAddr: {
+			network: opts.LocalGardenNetwork,
+			addr:    opts.LocalGardenAddr,
+		},
+		baggageclaimForwardAddr: {
+			network: opts.LocalBaggageclaimNetwork,
+			addr:    opts.LocalBaggageclaimAddr,
+		},
+	}
+
+	eventsR, eventsW := io.Pipe()
+	defer eventsW.Close()
+
+	events := NewEventReader(eventsR)
+	go func() {
+		for {
+			ev, err := events.Next()
+			if err != nil {
+				if err != io.EOF {
+					logger.Error("failed-to-read-event", err)
+				}
+
+				return
+			}
+
+			switch ev.Type {
+			case EventTypeRegistered:
+				if opts.RegisteredFunc != nil {
+					opts.RegisteredFunc()
+				}
+
+			case EventTypeHeartbeated:
+				if opts.HeartbeatedFunc != nil {
+					opts.HeartbeatedFunc()
+				}
+
+			case EventTypeForwardedConnection:
+				forward, found := forwards[ev.Forward]
+				if !found {
+					logger.Info("unknown-forward", lager.Data{
+						"forward": ev.Forward,
+					})
+
+					continue
+				}
+
+				// forwarded connections are drained, so they outlive the context
+				go conn.forwardConnection(logger, ev.Connection, forward)
+			}
+		}
+	}()
+
+	err = client.run(
+		ctx,
+		conn,
+		ForwardWorker,
+		url.Values{
+			"garden":       {gardenForwardAddr},
+			"baggageclaim": {baggageclaimForwardAddr},
+		},
+		eventsW,
+	)
+	if err != nil {
+		if ctx.Err() != nil && opts.ConnectionDrainTimeout != 0 && err == ErrExitStatusMissing {
+			return ErrConnectionDrainTimeout
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// Land invokes the 'land-worker' command.
+func (client *HTTP2Client) Land(ctx context.Context) error {
+	return client.runCommand(ctx, LandWorker, nil, os.Stdout)
+}
+
+// Retire invokes the 'retire-worker' command.
+func (client *HTTP2Client) Retire(ctx context.Context) error {
+	return client.runCommand(ctx, RetireWorker, nil, os.Stdout)
+}
+
+// Delete invokes the 'delete-worker' command.
+func (client *HTTP2Client) Delete(ctx context.Context) error {
+	return client.runCommand(ctx, DeleteWorker, nil, os.Stdout)
+}
+
+// ContainersToDestroy invokes the 'sweep-containers' command, returning a list
+// of handles to be destroyed.
+func (client *HTTP2Client) ContainersToDestroy(ctx context.Context) ([]string, error) {
+	return client.sweep(ctx, SweepContainers)
+}
+
+// ReportContainers invokes the 'report-containers' command, sending a list of
+// the worker's container handles to Concourse.
+func (client *HTTP2Client) ReportContainers(ctx context.Context, handles []string) error {
+	return client.runCommand(ctx, ReportContainers, url.Values{"handle": handles}, os.Stdout)
+}
+
+// VolumesToDestroy invokes the 'sweep-volumes' command, returning a list of
+// handles to be destroyed.
+func (client *HTTP2Client) VolumesToDestroy(ctx context.Context) ([]string, error) {
+	return client.sweep(ctx, SweepVolumes)
+}
+
+// ReportVolumes invokes the 'report-volumes' command, sending a list of the
+// worker's volume handles to Concourse.
+func (client *HTTP2Client) ReportVolumes(ctx context.Context, handles []string) error {
+	return client.runCommand(ctx, ReportVolumes, url.Values{"handle": handles}, os.Stdout)
+}
+
+func (client *HTTP2Client) sweep(ctx context.Context, command string) ([]string, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	out := new(bytes.Buffer)
+	err := client.runCommand(ctx, command, nil, out)
+	if err != nil {
+		return nil, err
+	}
+
+	var handles []string
+	err = json.Unmarshal(out.Bytes(), &handles)
+	if err != nil {
+		logger.Error("failed-to-unmarshal-handles", err)
+		return nil, err
+	}
+
+	return handles, nil
+}
+
+func (client *HTTP2Client) runCommand(ctx context.Context, command string, query url.Values, stdout io.Writer) error {
+	logger := lagerctx.FromContext(ctx)
+
+	conn, err := client.dial(ctx, 0)
+	if err != nil {
+		logger.Error("failed-to-dial", err)
+		return err
+	}
+
+	defer conn.Close()
+
+	return client.run(ctx, conn, command, query, stdout)
+}
+
+func (client *HTTP2Client) dial(ctx context.Context, idleTimeout time.Duration) (*http2Conn, error) {
+	logger := lagerctx.WithSession(ctx, "dial")
+
+	if client.TLSConfig == nil || len(client.TLSConfig.Certificates) == 0 {
+		return nil, fmt.Errorf("client certificate not provided")
+	}
+
+	tcpConn, gatewayAddr, err := tryDialAll(ctx, client.Hosts)
+	if err != nil {
+		logger.Error("failed-to-connect-to-any-tsa", err)
+		return nil, err
+	}
+
+	gatewayConn := tcpConn
+	if idleTimeout != 0 {
+		gatewayConn = &timeoutConn{
+			Conn:        tcpConn,
+			IdleTimeout: idleTimeout,
+		}
+	}
+
+	tlsConfig := client.TLSConfig.Clone()
+	tlsConfig.NextProtos = []string{http2.NextProtoTLS}
+
+	if tlsConfig.ServerName == "" {
+		host, _, err := net.SplitHostPort(gatewayAddr)
+		if err != nil {
+			tcpConn.Close()
+			return nil, err
+		}
+
+		tlsConfig.ServerName = host
+	}
+
+	tlsConn := tls.Client(gatewayConn, tlsConfig)
+
+	err = tlsConn.Handshake()
+	if err != nil {
+		tcpConn.Close()
+		return nil, fmt.Errorf("failed to establish TLS connection with gateway: %s", err)
+	}
+
+	if tlsConn.ConnectionState().NegotiatedProtocol != http2.NextProtoTLS {
+		tlsConn.Close()
+		return nil, fmt.Errorf("gateway %s does not support HTTP/2", gatewayAddr)
+	}
+
+	clientConn, err := (&http2.Transport{}).NewClientConn(tlsConn)
+	if err != nil {
+		tlsConn.Close()
+		return nil, err
+	}
+
+	return &http2Conn{
+		clientConn: clientConn,
+
+		conn: tlsConn,
+		host: gatewayAddr,
+	}, nil
+}
+
+func (client *HTTP2Client) run(ctx context.Context, conn *http2Conn, command string, query url.Values, stdout io.Writer) error {
+	logger := lagerctx.WithSession(ctx, "run", lager.Data{
+		"command": command,
+	})
+
+	workerPayload, err := json.Marshal(client.Worker)
+	if err != nil {
+		return err
+	}
+
+	// the request body is held open until the context is canceled, at which
+	// point closing it interrupts the command, like a signal would over SSH
+	bodyR, bodyW := io.Pipe()
+	defer bodyW.Close()
+
+	exited := make(chan struct{})
+	defer close(exited)
+
+	go func() {
+		_, err := bodyW.Write(workerPayload)
+		if err != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("context-done", lager.Data{
+				"context-error": ctx.Err(),
+			})
+		case <-exited:
+		}
+
+		bodyW.Close()
+	}()
+
+	req, err := http.NewRequest("POST", conn.url(HTTP2WorkersPath+command, query), bodyR)
+	if err != nil {
+		return err
+	}
+
+	resp, err := conn.RoundTrip(req)
+	if err != nil {
+		logger.Error("failed-to-start-command", err)
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := ioutil.ReadAll(resp.Body)
+
+		err := fmt.Errorf("gateway refused command: %s: %s", resp.Status, strings.TrimSpace(string(message)))
+		logger.Error("failed-to-start-command", err)
+		return err
+	}
+
+	_, err = io.Copy(stdout, resp.Body)
+	if err != nil {
+		logger.Error("failed-to-read-output", err)
+		return ErrExitStatusMissing
+	}
+
+	status := resp.Trailer.Get(HTTP2ExitStatusTrailer)
+	if status == "" {
+		logger.Info("exit-status-missing")
+		return ErrExitStatusMissing
+	}
+
+	exitStatus, err := strconv.Atoi(status)
+	if err != nil {
+		logger.Error("malformed-exit-status", err)
+		return err
+	}
+
+	if exitStatus != 0 {
+		err := &ExitError{
+			Command:    command,
+			ExitStatus: exitStatus,
+		}
+
+		logger.Error("command-failed", err)
+		return err
+	}
+
+	logger.Debug("command-exited")
+
+	return nil
+}
+
+type forwardedAddr struct {
+	network string
+	addr    string
+}
+
+type http2Conn struct {
+	clientConn *http2.ClientConn
+
+	conn net.Conn
+	host string
+}
+
+func (conn *http2Conn) RoundTrip(req *http.Request) (*http.Response, error) {
+	return conn.clientConn.RoundTrip(req)
+}
+
+func (conn *http2Conn) Close() error {
+	return conn.conn.Close()
+}
+
+func (conn *http2Conn) url(path string, query url.Values) string {
+	u := url.URL{
+		Scheme:   "https",
+		Host:     conn.host,
+		Path:     path,
+		RawQuery: query.Encode(),
+	}
+
+	return u.String()
+}
+
+func (conn *http2Conn) forwardConnection(logger lager.Logger, connection string, forward forwardedAddr) {
+	logger = logger.Session("forward-conn", lager.Data{
+		"network":    forward.network,
+		"addr":       forward.addr,
+		"connection": connection,
+	})
+
+	var localConn net.Conn
+	for {
+		var err error
+		localConn, err = net.Dial(forward.network, forward.addr)
+		if err != nil {
+			logger.Error("failed-to-dial", err)
+			time.Sleep(time.Second)
+			logger.Info("retrying")
+			continue
+		}
+
+		break
+	}
+
+	defer localConn.Close()
+
+	// the request body streams what the local connection reads, and the
+	// response body streams what it is to write
+	req, err := http.NewRequest("POST", conn.url(HTTP2ConnectionsPath+connection, nil), localConn)
+	if err != nil {
+		logger.Error("failed-to-create-request", err)
+		return
+	}
+
+	resp, err := conn.RoundTrip(req)
+	if err != nil {
+		logger.Error("failed-to-open-stream", err)
+		return
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		logger.Info("gateway-refused-connection", lager.Data{
+			"status": resp.Status,
+		})
+
+		return
+	}
+
+	_, err = io.Copy(localConn, resp.Body)
+	if err != nil {
+		logger.Debug("stream-interrupted", lager.Data{
+			"error": err.Error(),
+		})
+	}
+}
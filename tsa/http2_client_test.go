@@ -0,0 +1,205 @@
+package tsa_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/tsa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/http2"
+)
+
+var _ = Describe("HTTP2Client", func() {
+	type receivedRequest struct {
+		protoMajor int
+		path       string
+		query      string
+		worker     atc.Worker
+		clientCert bool
+	}
+
+	var (
+		ctx context.Context
+
+		fakeGateway *httptest.Server
+		requests    chan receivedRequest
+		output      string
+		exitStatus  string
+
+		client *tsa.HTTP2Client
+	)
+
+	BeforeEach(func() {
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+
+		requests = make(chan receivedRequest, 1)
+		output = ""
+		exitStatus = "0"
+
+		fakeGateway = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			var worker atc.Worker
+			err := json.NewDecoder(r.Body).Decode(&worker)
+			Expect(err).NotTo(HaveOccurred())
+
+			requests <- receivedRequest{
+				protoMajor: r.ProtoMajor,
+				path:       r.URL.Path,
+				query:      r.URL.RawQuery,
+				worker:     worker,
+				clientCert: len(r.TLS.PeerCertificates) > 0,
+			}
+
+			w.Header().Set("Trailer", tsa.HTTP2ExitStatusTrailer)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(output))
+
+			if exitStatus != "" {
+				w.Header().Set(tsa.HTTP2ExitStatusTrailer, exitStatus)
+			}
+		}))
+
+		err := http2.ConfigureServer(fakeGateway.Config, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeGateway.TLS = fakeGateway.Config.TLSConfig
+		fakeGateway.TLS.ClientAuth = tls.RequireAnyClientCert
+		fakeGateway.StartTLS()
+
+		rootCAs := x509.NewCertPool()
+		rootCAs.AddCert(fakeGateway.Certificate())
+
+		client = &tsa.HTTP2Client{
+			Hosts: []string{fakeGateway.Listener.Addr().String()},
+			TLSConfig: &tls.Config{
+				Certificates: []tls.Certificate{generateClientCertificate()},
+				RootCAs:      rootCAs,
+			},
+			Worker: atc.Worker{
+				Name: "some-worker",
+				Team: "some-team",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		fakeGateway.Close()
+	})
+
+	Describe("Land", func() {
+		It("posts the worker to the command over HTTP/2 with its client certificate", func() {
+			err := client.Land(ctx)
+			Expect(err).NotTo(HaveOccurred())
+
+			var request receivedRequest
+			Expect(requests).To(Receive(&request))
+			Expect(request.protoMajor).To(Equal(2))
+			Expect(request.path).To(Equal("/workers/land-worker"))
+			Expect(request.worker.Name).To(Equal("some-worker"))
+			Expect(request.worker.Team).To(Equal("some-team"))
+			Expect(request.clientCert).To(BeTrue())
+		})
+
+		Context("when the command exits with a non-zero status", func() {
+			BeforeEach(func() {
+				exitStatus = "1"
+			})
+
+			It("returns an ExitError", func() {
+				err := client.Land(ctx)
+				Expect(err).To(Equal(&tsa.ExitError{
+					Command:    "land-worker",
+					ExitStatus: 1,
+				}))
+			})
+		})
+
+		Context("when the gateway does not report the exit status", func() {
+			BeforeEach(func() {
+				exitStatus = ""
+			})
+
+			It("returns ErrExitStatusMissing", func() {
+				err := client.Land(ctx)
+				Expect(err).To(Equal(tsa.ErrExitStatusMissing))
+			})
+		})
+
+		Context("when no client certificate is configured", func() {
+			BeforeEach(func() {
+				client.TLSConfig.Certificates = nil
+			})
+
+			It("errors without contacting the gateway", func() {
+				err := client.Land(ctx)
+				Expect(err).To(HaveOccurred())
+				Expect(requests).NotTo(Receive())
+			})
+		})
+	})
+
+	Describe("ReportContainers", func() {
+		It("sends the handles with the command", func() {
+			err := client.ReportContainers(ctx, []string{"handle-1", "handle-2"})
+			Expect(err).NotTo(HaveOccurred())
+
+			var request receivedRequest
+			Expect(requests).To(Receive(&request))
+			Expect(request.path).To(Equal("/workers/report-containers"))
+			Expect(request.query).To(Equal("handle=handle-1&handle=handle-2"))
+		})
+	})
+
+	Describe("ContainersToDestroy", func() {
+		BeforeEach(func() {
+			output = `["handle-1","handle-2"]`
+		})
+
+		It("returns the handles output by the command", func() {
+			handles, err := client.ContainersToDestroy(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(handles).To(Equal([]string{"handle-1", "handle-2"}))
+
+			var request receivedRequest
+			Expect(requests).To(Receive(&request))
+			Expect(request.path).To(Equal("/workers/sweep-containers"))
+		})
+	})
+})
+
+func generateClientCertificate() tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "some-worker"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+}
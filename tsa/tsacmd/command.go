@@ -2,7 +2,11 @@ package tsacmd
 
 import (
 	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"sync"
@@ -11,6 +15,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/tsa"
 	"github.com/concourse/flag"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/tedsuo/ifrit"
 	"github.com/tedsuo/ifrit/grouper"
 	"github.com/tedsuo/ifrit/http_server"
@@ -38,6 +43,14 @@ type TSACommand struct {
 	SessionSigningKey *flag.PrivateKey `long:"session-signing-key" required:"true" description:"Path to private key to use when signing tokens in reqests to the ATC during registration."`
 
 	HeartbeatInterval time.Duration `long:"heartbeat-interval" default:"30s" description:"interval on which to heartbeat workers to the ATC"`
+
+	EnableHTTP2Gateway bool `long:"enable-http2-gateway" description:"Also let workers register over mutually-authenticated HTTP/2 streams, alongside SSH."`
+
+	HTTP2BindPort          uint16               `long:"http2-bind-port"           default:"2223" description:"Port on which to listen for HTTP/2."`
+	HTTP2TLSCert           flag.File            `long:"http2-tls-cert"            description:"File containing the certificate presented to workers connecting over HTTP/2."`
+	HTTP2TLSKey            flag.File            `long:"http2-tls-key"             description:"File containing the private key of the HTTP/2 certificate."`
+	HTTP2ClientCACert      flag.File            `long:"http2-client-ca-cert"      description:"File containing the CA certificate which signs the client certificates of workers of any team."`
+	HTTP2TeamClientCACerts map[string]flag.File `long:"http2-team-client-ca-cert" value-name:"NAME:PATH" description:"File containing the CA certificate which signs the client certificates of a team's workers."`
 }
 
 type TeamAuthKeys struct {
@@ -116,7 +129,107 @@ func (cmd *TSACommand) Runner(args []string) (ifrit.Runner, error) {
 		sessionTeam:       sessionAuthTeam,
 	}
 
-	return serverRunner{logger, server, listenAddr}, nil
+	sshRunner := serverRunner{logger, server, listenAddr}
+
+	if !cmd.EnableHTTP2Gateway {
+		return sshRunner, nil
+	}
+
+	gatewayRunner, err := cmd.http2GatewayRunner(logger, server)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure HTTP/2 gateway: %s", err)
+	}
+
+	members := []grouper.Member{
+		{
+			Name:   "ssh-gateway",
+			Runner: sshRunner,
+		},
+		{
+			Name:   "http2-gateway",
+			Runner: gatewayRunner,
+		},
+	}
+
+	return grouper.NewParallel(os.Interrupt, members), nil
+}
+
+func (cmd *TSACommand) http2GatewayRunner(logger lager.Logger, server *server) (ifrit.Runner, error) {
+	if cmd.HTTP2TLSCert == "" || cmd.HTTP2TLSKey == "" {
+		return nil, errors.New("the HTTP/2 gateway requires a TLS certificate and key")
+	}
+
+	cert, err := tls.LoadX509KeyPair(string(cmd.HTTP2TLSCert), string(cmd.HTTP2TLSKey))
+	if err != nil {
+		return nil, err
+	}
+
+	cas, err := cmd.loadHTTP2ClientCAs()
+	if err != nil {
+		return nil, err
+	}
+
+	if cas.global == nil && len(cas.teams) == 0 {
+		return nil, errors.New("the HTTP/2 gateway requires a client CA certificate")
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates:          []tls.Certificate{cert},
+		ClientAuth:            tls.RequireAnyClientCert,
+		VerifyPeerCertificate: cas.verifyPeerCertificate,
+		MinVersion:            tls.VersionTLS12,
+	}
+
+	gateway := newHTTP2Gateway(
+		logger.Session("http2-gateway"),
+		server,
+		cas,
+		newGatewayMetrics(prometheus.DefaultRegisterer),
+	)
+
+	listenAddr := fmt.Sprintf("%s:%d", cmd.BindIP, cmd.HTTP2BindPort)
+
+	return http2GatewayRunner{logger.Session("http2-gateway"), gateway, tlsConfig, listenAddr}, nil
+}
+
+func (cmd *TSACommand) loadHTTP2ClientCAs() (clientCAs, error) {
+	cas := clientCAs{
+		teams: map[string]*x509.CertPool{},
+	}
+
+	if cmd.HTTP2ClientCACert != "" {
+		pool, err := loadCertPool(cmd.HTTP2ClientCACert)
+		if err != nil {
+			return clientCAs{}, err
+		}
+
+		cas.global = pool
+	}
+
+	for team, caCert := range cmd.HTTP2TeamClientCACerts {
+		pool, err := loadCertPool(caCert)
+		if err != nil {
+			return clientCAs{}, err
+		}
+
+		cas.teams[team] = pool
+	}
+
+	return cas, nil
+}
+
+func loadCertPool(caCert flag.File) (*x509.CertPool, error) {
+	pem, err := ioutil.ReadFile(string(caCert))
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificates found in %s", caCert)
+	}
+
+	return pool, nil
 }
 
 func (cmd *TSACommand) constructLogger() (lager.Logger, *lager.ReconfigurableSink) {
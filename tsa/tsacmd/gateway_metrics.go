@@ -0,0 +1,115 @@
+package tsacmd
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// gatewayMetrics are the metrics of the streams of the HTTP/2 gateway, by
+// kind of stream: the command run on it, or the forward whose connection it
+// carries.
+type gatewayMetrics struct {
+	streamsActive  *prometheus.GaugeVec
+	streamsTotal   *prometheus.CounterVec
+	streamDuration *prometheus.HistogramVec
+	streamBytes    *prometheus.CounterVec
+}
+
+func newGatewayMetrics(registerer prometheus.Registerer) *gatewayMetrics {
+	metrics := &gatewayMetrics{
+		streamsActive: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "tsa_http2",
+			Name:      "streams_active",
+			Help:      "Number of open HTTP/2 gateway streams.",
+		}, []string{"stream"}),
+
+		streamsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "tsa_http2",
+			Name:      "streams_total",
+			Help:      "Total number of HTTP/2 gateway streams opened.",
+		}, []string{"stream"}),
+
+		streamDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "tsa_http2",
+			Name:      "stream_duration_seconds",
+			Help:      "How long HTTP/2 gateway streams stayed open.",
+			Buckets:   []float64{0.1, 1, 10, 60, 600, 3600, 14400},
+		}, []string{"stream"}),
+
+		streamBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "tsa_http2",
+			Name:      "stream_bytes_total",
+			Help:      "Total number of bytes received from and sent to workers over HTTP/2 gateway streams.",
+		}, []string{"stream", "direction"}),
+	}
+
+	registerer.MustRegister(
+		metrics.streamsActive,
+		metrics.streamsTotal,
+		metrics.streamDuration,
+		metrics.streamBytes,
+	)
+
+	return metrics
+}
+
+// stream opens a metered stream of the given kind, which is counted as active
+// until it is closed.
+func (metrics *gatewayMetrics) stream(kind string, body io.Reader, w http.ResponseWriter) *gatewayStream {
+	metrics.streamsTotal.WithLabelValues(kind).Inc()
+	metrics.streamsActive.WithLabelValues(kind).Inc()
+
+	flusher, _ := w.(http.Flusher)
+
+	return &gatewayStream{
+		kind:    kind,
+		started: time.Now(),
+		metrics: metrics,
+
+		reader: &meteredReader{
+			Reader:  body,
+			counter: metrics.streamBytes.WithLabelValues(kind, "received"),
+		},
+
+		writer: &meteredWriter{
+			Writer:  w,
+			counter: metrics.streamBytes.WithLabelValues(kind, "sent"),
+		},
+		flusher: flusher,
+	}
+}
+
+type meteredReader struct {
+	io.Reader
+
+	counter prometheus.Counter
+	n       int64
+}
+
+func (reader *meteredReader) Read(p []byte) (int, error) {
+	n, err := reader.Reader.Read(p)
+	reader.n += int64(n)
+	reader.counter.Add(float64(n))
+	return n, err
+}
+
+type meteredWriter struct {
+	io.Writer
+
+	counter prometheus.Counter
+	n       int64
+}
+
+func (writer *meteredWriter) Write(p []byte) (int, error) {
+	n, err := writer.Writer.Write(p)
+	writer.n += int64(n)
+	writer.counter.Add(float64(n))
+	return n, err
+}
@@ -0,0 +1,568 @@
+package tsacmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/tsa"
+)
+
+// connectionStreamTimeout is how long the worker is given to open the stream
+// of a connection made to one of its forwards.
+const connectionStreamTimeout = 10 * time.Second
+
+// http2Gateway runs the same commands as the SSH server, each on a stream of
+// its own. Workers authenticate with a client certificate, whose CA determines
+// the team they are authorized for.
+type http2Gateway struct {
+	logger  lager.Logger
+	server  *server
+	cas     clientCAs
+	metrics *gatewayMetrics
+
+	connectionsLock sync.Mutex
+	connections     map[string]*pendingConnection
+}
+
+// pendingConnection is a connection made to a forward, waiting for the
+// worker to open its stream.
+type pendingConnection struct {
+	team    string
+	forward string
+	conn    net.Conn
+	done    chan struct{}
+}
+
+func newHTTP2Gateway(logger lager.Logger, server *server, cas clientCAs, metrics *gatewayMetrics) *http2Gateway {
+	return &http2Gateway{
+		logger:      logger,
+		server:      server,
+		cas:         cas,
+		metrics:     metrics,
+		connections: map[string]*pendingConnection{},
+	}
+}
+
+func (gateway *http2Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := gateway.logger.Session("stream", lager.Data{
+		"remote": r.RemoteAddr,
+		"path":   r.URL.Path,
+	})
+
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if r.TLS == nil {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	team, err := gateway.cas.authorizedTeam(r.TLS.PeerCertificates)
+	if err != nil {
+		logger.Info("unauthorized", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	switch {
+	case strings.HasPrefix(r.URL.Path, tsa.HTTP2WorkersPath):
+		command := strings.TrimPrefix(r.URL.Path, tsa.HTTP2WorkersPath)
+		gateway.runCommand(logger, w, r, team, command)
+
+	case strings.HasPrefix(r.URL.Path, tsa.HTTP2ConnectionsPath):
+		id := strings.TrimPrefix(r.URL.Path, tsa.HTTP2ConnectionsPath)
+		gateway.streamConnection(logger, w, r, team, id)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (gateway *http2Gateway) runCommand(logger lager.Logger, w http.ResponseWriter, r *http.Request, team string, command string) {
+	logger = logger.Session("command", lager.Data{
+		"command": command,
+	})
+
+	workerRequest, err := gateway.server.newRequest(command, commandArgs(command, r.URL.Query()))
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, "invalid command: %s", err)
+		return
+	}
+
+	var payload json.RawMessage
+	err = json.NewDecoder(r.Body).Decode(&payload)
+	if err != nil {
+		logger.Info("malformed-worker", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// forwarded connections outlive the command, which is interrupted once the
+	// worker closes the request body
+	connCtx := lagerctx.NewContext(r.Context(), logger)
+
+	ctx, cancel := context.WithCancel(connCtx)
+	defer cancel()
+
+	go func() {
+		io.Copy(ioutil.Discard, r.Body)
+		logger.Debug("interrupted")
+		cancel()
+	}()
+
+	w.Header().Set("Trailer", tsa.HTTP2ExitStatusTrailer)
+	w.WriteHeader(http.StatusOK)
+
+	stream := gateway.metrics.stream(command, bytes.NewReader(payload), w)
+	defer stream.close(logger)
+
+	forwardedTCPIPs := make(chan ForwardedTCPIP, maxForwards)
+	if command == tsa.ForwardWorker {
+		for _, name := range []string{"garden", "baggageclaim"} {
+			bindAddr := r.URL.Query().Get(name)
+			if bindAddr == "" {
+				continue
+			}
+
+			listener, forwarded, err := gateway.forward(connCtx, stream, team, name, bindAddr)
+			if err != nil {
+				logger.Error("failed-to-forward", err)
+				w.Header().Set(tsa.HTTP2ExitStatusTrailer, "1")
+				return
+			}
+
+			defer listener.Close()
+
+			forwardedTCPIPs <- forwarded
+		}
+	}
+
+	state := ConnState{
+		Team: team,
+
+		ForwardedTCPIPs: forwardedTCPIPs,
+	}
+
+	exitStatus := 0
+
+	err = workerRequest.Handle(ctx, state, stream)
+	if err != nil {
+		logger.Error("exited-with-error", err)
+		exitStatus = 1
+	} else {
+		logger.Debug("exited-successfully")
+	}
+
+	// connections may still be forwarded while draining; stop them from
+	// writing to the stream before reporting the exit status
+	stream.finish()
+
+	w.Header().Set(tsa.HTTP2ExitStatusTrailer, strconv.Itoa(exitStatus))
+}
+
+// commandArgs translates the query of a command's request into the
+// arguments the command would be given over SSH.
+func commandArgs(command string, query url.Values) []string {
+	switch command {
+	case tsa.ForwardWorker:
+		return []string{
+			"--garden", query.Get("garden"),
+			"--baggageclaim", query.Get("baggageclaim"),
+		}
+	case tsa.ReportContainers, tsa.ReportVolumes:
+		return query["handle"]
+	default:
+		return nil
+	}
+}
+
+// forward listens for connections to the worker's forward, asking the worker
+// over the stream to open a stream for each of them.
+func (gateway *http2Gateway) forward(
+	ctx context.Context,
+	stream *gatewayStream,
+	team string,
+	name string,
+	bindAddr string,
+) (net.Listener, ForwardedTCPIP, error) {
+	logger := lagerctx.WithSession(ctx, "forward", lager.Data{
+		"forward":        name,
+		"requested-addr": bindAddr,
+	})
+
+	listener, err := net.Listen("tcp", "0.0.0.0:0")
+	if err != nil {
+		return nil, ForwardedTCPIP{}, err
+	}
+
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	if err != nil {
+		listener.Close()
+		return nil, ForwardedTCPIP{}, err
+	}
+
+	boundPort, err := strconv.ParseUint(port, 10, 32)
+	if err != nil {
+		listener.Close()
+		return nil, ForwardedTCPIP{}, err
+	}
+
+	logger = logger.WithData(lager.Data{
+		"addr": listener.Addr().String(),
+	})
+
+	logger.Debug("listening")
+
+	drain := make(chan struct{})
+	wait := new(sync.WaitGroup)
+
+	wait.Add(1)
+	go gateway.acceptConnections(lagerctx.NewContext(ctx, logger), drain, wait, listener, stream, team, name, bindAddr)
+
+	return listener, ForwardedTCPIP{
+		Logger: logger,
+
+		BindAddr:  bindAddr,
+		BoundPort: uint32(boundPort),
+
+		Drain: drain,
+
+		wg: wait,
+	}, nil
+}
+
+func (gateway *http2Gateway) acceptConnections(
+	ctx context.Context,
+	drain <-chan struct{},
+	connsWg *sync.WaitGroup,
+	listener net.Listener,
+	stream *gatewayStream,
+	team string,
+	name string,
+	bindAddr string,
+) {
+	defer connsWg.Done()
+
+	logger := lagerctx.FromContext(ctx)
+
+	done := make(chan struct{})
+	defer close(done)
+
+	interrupted := false
+	go func() {
+		select {
+		case <-drain:
+			logger.Debug("draining")
+			interrupted = true
+			listener.Close()
+		case <-done:
+			logger.Debug("done")
+		}
+	}()
+
+	for {
+		localConn, err := listener.Accept()
+		if err != nil {
+			if !interrupted {
+				logger.Error("failed-to-accept", err)
+			}
+
+			break
+		}
+
+		connsWg.Add(1)
+
+		go func() {
+			defer connsWg.Done()
+
+			gateway.awaitConnectionStream(
+				lagerctx.WithSession(ctx, "forward-conn"),
+				stream,
+				&pendingConnection{
+					team:    team,
+					forward: name,
+					conn:    localConn,
+					done:    make(chan struct{}),
+				},
+				bindAddr,
+			)
+		}()
+	}
+}
+
+func (gateway *http2Gateway) awaitConnectionStream(
+	ctx context.Context,
+	stream *gatewayStream,
+	pending *pendingConnection,
+	bindAddr string,
+) {
+	logger := lagerctx.FromContext(ctx)
+
+	defer pending.conn.Close()
+
+	id, err := newConnectionID()
+	if err != nil {
+		logger.Error("failed-to-generate-connection-id", err)
+		return
+	}
+
+	gateway.connectionsLock.Lock()
+	gateway.connections[id] = pending
+	gateway.connectionsLock.Unlock()
+
+	err = tsa.NewEventWriter(stream).ForwardedConnection(bindAddr, id)
+	if err != nil {
+		logger.Error("failed-to-request-connection-stream", err)
+		gateway.takeConnection(id)
+		return
+	}
+
+	timer := time.NewTimer(connectionStreamTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-pending.done:
+	case <-timer.C:
+		if gateway.takeConnection(id) != nil {
+			logger.Info("connection-stream-never-opened")
+			return
+		}
+
+		<-pending.done
+	case <-ctx.Done():
+		if gateway.takeConnection(id) != nil {
+			return
+		}
+
+		<-pending.done
+	}
+}
+
+// takeConnection removes the pending connection, returning nil if its stream
+// has already been opened or it has been given up on.
+func (gateway *http2Gateway) takeConnection(id string) *pendingConnection {
+	gateway.connectionsLock.Lock()
+	defer gateway.connectionsLock.Unlock()
+
+	pending, found := gateway.connections[id]
+	if !found {
+		return nil
+	}
+
+	delete(gateway.connections, id)
+
+	return pending
+}
+
+func (gateway *http2Gateway) streamConnection(logger lager.Logger, w http.ResponseWriter, r *http.Request, team string, id string) {
+	pending := gateway.takeConnection(id)
+	if pending == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	defer close(pending.done)
+
+	if pending.team != team {
+		logger.Info("connection-belongs-to-other-team", lager.Data{
+			"team": team,
+		})
+
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	logger = logger.Session("connection", lager.Data{
+		"forward": pending.forward,
+	})
+
+	w.WriteHeader(http.StatusOK)
+
+	stream := gateway.metrics.stream(pending.forward, r.Body, w)
+	defer stream.close(logger)
+
+	numPipes := 2
+	wait := make(chan struct{}, numPipes)
+
+	pipe := func(to io.Writer, from io.Reader) {
+		// if either end breaks, close both ends to ensure they're both unblocked,
+		// otherwise io.Copy can block forever if e.g. reading after write end has
+		// gone away
+		defer r.Body.Close()
+		defer pending.conn.Close()
+		defer func() {
+			wait <- struct{}{}
+		}()
+
+		io.Copy(to, from)
+	}
+
+	go pipe(pending.conn, stream)
+	go pipe(stream, pending.conn)
+
+	for i := 0; i < numPipes; i++ {
+		<-wait
+	}
+}
+
+func newConnectionID() (string, error) {
+	id := make([]byte, 16)
+
+	_, err := rand.Read(id)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(id), nil
+}
+
+// gatewayStream is a stream's request body and response, which flushes each
+// write so that it reaches the worker right away. Writes are serialized so
+// that events and command output can be written to it concurrently.
+type gatewayStream struct {
+	kind    string
+	started time.Time
+	metrics *gatewayMetrics
+
+	reader *meteredReader
+
+	writeLock sync.Mutex
+	writer    *meteredWriter
+	flusher   http.Flusher
+	finished  bool
+}
+
+var errStreamFinished = errors.New("stream finished")
+
+func (stream *gatewayStream) Read(p []byte) (int, error) {
+	return stream.reader.Read(p)
+}
+
+func (stream *gatewayStream) Write(p []byte) (int, error) {
+	stream.writeLock.Lock()
+	defer stream.writeLock.Unlock()
+
+	if stream.finished {
+		return 0, errStreamFinished
+	}
+
+	n, err := stream.writer.Write(p)
+	if err != nil {
+		return n, err
+	}
+
+	if stream.flusher != nil {
+		stream.flusher.Flush()
+	}
+
+	return n, nil
+}
+
+// finish stops anything else from being written to the stream.
+func (stream *gatewayStream) finish() {
+	stream.writeLock.Lock()
+	stream.finished = true
+	stream.writeLock.Unlock()
+}
+
+func (stream *gatewayStream) close(logger lager.Logger) {
+	stream.finish()
+
+	duration := time.Since(stream.started)
+
+	stream.metrics.streamsActive.WithLabelValues(stream.kind).Dec()
+	stream.metrics.streamDuration.WithLabelValues(stream.kind).Observe(duration.Seconds())
+
+	logger.Debug("stream-closed", lager.Data{
+		"duration":       duration.String(),
+		"bytes-received": stream.reader.n,
+		"bytes-sent":     stream.writer.n,
+	})
+}
+
+// clientCAs are the CAs which sign the client certificates of workers. The
+// global CAs sign those of workers of any team.
+type clientCAs struct {
+	global *x509.CertPool
+	teams  map[string]*x509.CertPool
+}
+
+// verifyPeerCertificate refuses handshakes with clients whose certificate is
+// not signed by any of the CAs.
+func (cas clientCAs) verifyPeerCertificate(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return err
+		}
+
+		certs[i] = cert
+	}
+
+	_, err := cas.authorizedTeam(certs)
+	return err
+}
+
+// authorizedTeam returns the team the client certificate is authorized for,
+// or "" if it is signed by a global CA.
+func (cas clientCAs) authorizedTeam(certs []*x509.Certificate) (string, error) {
+	if len(certs) == 0 {
+		return "", errors.New("no client certificate presented")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	verifies := func(roots *x509.CertPool) bool {
+		_, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+
+		return err == nil
+	}
+
+	if cas.global != nil && verifies(cas.global) {
+		return "", nil
+	}
+
+	teams := make([]string, 0, len(cas.teams))
+	for team := range cas.teams {
+		teams = append(teams, team)
+	}
+
+	sort.Strings(teams)
+
+	for _, team := range teams {
+		if verifies(cas.teams[team]) {
+			return team, nil
+		}
+	}
+
+	return "", errors.New("client certificate is not signed by an authorized CA")
+}
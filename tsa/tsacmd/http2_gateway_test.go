@@ -0,0 +1,561 @@
+package tsacmd
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/tsa"
+	"github.com/concourse/concourse/tsa/tsafakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/tedsuo/rata"
+	"golang.org/x/net/http2"
+)
+
+var _ = Describe("HTTP2Gateway", func() {
+	var (
+		ctx context.Context
+
+		globalCA    *testCA
+		someTeamCA  *testCA
+		otherTeamCA *testCA
+		rogueCA     *testCA
+
+		fakeATC *ghttp.Server
+
+		gateway       *http2Gateway
+		gatewayServer *httptest.Server
+	)
+
+	BeforeEach(func() {
+		logger := lagertest.NewTestLogger("test")
+		ctx = lagerctx.NewContext(context.Background(), logger)
+
+		globalCA = newTestCA("global-ca")
+		someTeamCA = newTestCA("some-team-ca")
+		otherTeamCA = newTestCA("other-team-ca")
+		rogueCA = newTestCA("rogue-ca")
+
+		fakeATC = ghttp.NewServer()
+
+		fakeEndpointPicker := new(tsafakes.FakeEndpointPicker)
+		fakeEndpointPicker.PickReturns(rata.NewRequestGenerator(fakeATC.URL(), atc.Routes))
+
+		fakeTokenGenerator := new(tsafakes.FakeTokenGenerator)
+		fakeTokenGenerator.GenerateSystemTokenReturns("yo", nil)
+		fakeTokenGenerator.GenerateTeamTokenReturns("yo-team", nil)
+
+		gateway = newHTTP2Gateway(
+			logger,
+			&server{
+				logger:            logger,
+				atcEndpointPicker: fakeEndpointPicker,
+				tokenGenerator:    fakeTokenGenerator,
+				heartbeatInterval: 100 * time.Millisecond,
+				cprInterval:       100 * time.Millisecond,
+				forwardHost:       "127.0.0.1",
+			},
+			clientCAs{
+				global: globalCA.pool(),
+				teams: map[string]*x509.CertPool{
+					"some-team":  someTeamCA.pool(),
+					"other-team": otherTeamCA.pool(),
+				},
+			},
+			newGatewayMetrics(prometheus.NewRegistry()),
+		)
+
+		gatewayServer = httptest.NewUnstartedServer(gateway)
+
+		err := http2.ConfigureServer(gatewayServer.Config, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		gatewayServer.TLS = gatewayServer.Config.TLSConfig
+		gatewayServer.TLS.ClientAuth = tls.RequireAnyClientCert
+		gatewayServer.TLS.VerifyPeerCertificate = gateway.cas.verifyPeerCertificate
+		gatewayServer.StartTLS()
+	})
+
+	AfterEach(func() {
+		gatewayServer.Close()
+		fakeATC.Close()
+	})
+
+	gatewayTLSConfig := func(ca *testCA) *tls.Config {
+		rootCAs := x509.NewCertPool()
+		rootCAs.AddCert(gatewayServer.Certificate())
+
+		return &tls.Config{
+			Certificates: []tls.Certificate{ca.clientCertificate()},
+			RootCAs:      rootCAs,
+		}
+	}
+
+	workerClient := func(ca *testCA, worker atc.Worker) *tsa.HTTP2Client {
+		return &tsa.HTTP2Client{
+			Hosts:     []string{gatewayServer.Listener.Addr().String()},
+			TLSConfig: gatewayTLSConfig(ca),
+			Worker:    worker,
+		}
+	}
+
+	gatewayClient := func(ca *testCA) *http.Client {
+		return &http.Client{
+			Transport: &http2.Transport{
+				TLSClientConfig: gatewayTLSConfig(ca),
+			},
+		}
+	}
+
+	Describe("authorizing client certificates", func() {
+		It("authorizes certificates signed by the global CA for any team", func() {
+			team, err := gateway.cas.authorizedTeam([]*x509.Certificate{leaf(globalCA.clientCertificate())})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(team).To(BeEmpty())
+		})
+
+		It("authorizes certificates signed by a team's CA for the team", func() {
+			team, err := gateway.cas.authorizedTeam([]*x509.Certificate{leaf(someTeamCA.clientCertificate())})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(team).To(Equal("some-team"))
+
+			team, err = gateway.cas.authorizedTeam([]*x509.Certificate{leaf(otherTeamCA.clientCertificate())})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(team).To(Equal("other-team"))
+		})
+
+		It("rejects certificates signed by any other CA", func() {
+			_, err := gateway.cas.authorizedTeam([]*x509.Certificate{leaf(rogueCA.clientCertificate())})
+			Expect(err).To(HaveOccurred())
+
+			err = gateway.cas.verifyPeerCertificate([][]byte{rogueCA.clientCertificate().Certificate[0]}, nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rejects clients which present no certificate", func() {
+			_, err := gateway.cas.authorizedTeam(nil)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when a worker connects with a certificate signed by another CA", func() {
+			It("refuses the connection without running the command", func() {
+				err := workerClient(rogueCA, atc.Worker{Name: "some-worker"}).Land(ctx)
+				Expect(err).To(HaveOccurred())
+
+				Expect(fakeATC.ReceivedRequests()).To(BeEmpty())
+			})
+
+			It("refuses requests which get past the handshake", func() {
+				request := httptest.NewRequest("POST", tsa.HTTP2WorkersPath+tsa.LandWorker, strings.NewReader(`{"name":"some-worker"}`))
+				request.TLS = &tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{leaf(rogueCA.clientCertificate())},
+				}
+
+				recorder := httptest.NewRecorder()
+				gateway.ServeHTTP(recorder, request)
+				Expect(recorder.Code).To(Equal(http.StatusForbidden))
+
+				Expect(fakeATC.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+
+		It("refuses requests not made over TLS", func() {
+			request := httptest.NewRequest("POST", tsa.HTTP2WorkersPath+tsa.LandWorker, strings.NewReader(`{"name":"some-worker"}`))
+
+			recorder := httptest.NewRecorder()
+			gateway.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusForbidden))
+		})
+	})
+
+	Describe("land-worker", func() {
+		var client *tsa.HTTP2Client
+
+		BeforeEach(func() {
+			client = workerClient(someTeamCA, atc.Worker{
+				Name: "some-worker",
+				Team: "some-team",
+			})
+		})
+
+		Context("when the ATC lands the worker", func() {
+			BeforeEach(func() {
+				fakeATC.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land"),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer yo-team"),
+					ghttp.RespondWith(http.StatusOK, nil),
+				))
+			})
+
+			It("reports an exit status of 0", func() {
+				err := client.Land(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the ATC fails to land the worker", func() {
+			BeforeEach(func() {
+				fakeATC.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, nil))
+			})
+
+			It("reports an exit status of 1", func() {
+				err := client.Land(ctx)
+				Expect(err).To(Equal(&tsa.ExitError{
+					Command:    tsa.LandWorker,
+					ExitStatus: 1,
+				}))
+			})
+		})
+
+		Context("when the worker belongs to a team the certificate is not authorized for", func() {
+			BeforeEach(func() {
+				client.Worker.Team = "other-team"
+			})
+
+			It("reports an exit status of 1 without landing the worker", func() {
+				err := client.Land(ctx)
+				Expect(err).To(Equal(&tsa.ExitError{
+					Command:    tsa.LandWorker,
+					ExitStatus: 1,
+				}))
+
+				Expect(fakeATC.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("retire-worker", func() {
+		var client *tsa.HTTP2Client
+
+		BeforeEach(func() {
+			client = workerClient(someTeamCA, atc.Worker{
+				Name: "some-worker",
+				Team: "some-team",
+			})
+		})
+
+		Context("when the ATC retires the worker", func() {
+			BeforeEach(func() {
+				fakeATC.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/retire"),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer yo-team"),
+					ghttp.RespondWith(http.StatusOK, nil),
+				))
+			})
+
+			It("reports an exit status of 0", func() {
+				err := client.Retire(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the ATC fails to retire the worker", func() {
+			BeforeEach(func() {
+				fakeATC.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, nil))
+			})
+
+			It("reports an exit status of 1", func() {
+				err := client.Retire(ctx)
+				Expect(err).To(Equal(&tsa.ExitError{
+					Command:    tsa.RetireWorker,
+					ExitStatus: 1,
+				}))
+			})
+		})
+
+		Context("when the worker belongs to a team the certificate is not authorized for", func() {
+			BeforeEach(func() {
+				client.Worker.Team = "other-team"
+			})
+
+			It("reports an exit status of 1 without retiring the worker", func() {
+				err := client.Retire(ctx)
+				Expect(err).To(Equal(&tsa.ExitError{
+					Command:    tsa.RetireWorker,
+					ExitStatus: 1,
+				}))
+
+				Expect(fakeATC.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("forward-worker", func() {
+		var (
+			client *tsa.HTTP2Client
+			opts   tsa.RegisterOptions
+
+			fakeGarden       *ghttp.Server
+			fakeBaggageclaim *ghttp.Server
+
+			registered chan atc.Worker
+		)
+
+		BeforeEach(func() {
+			client = workerClient(someTeamCA, atc.Worker{
+				Name: "some-worker",
+				Team: "some-team",
+			})
+
+			fakeGarden = ghttp.NewServer()
+			fakeGarden.RouteToHandler("GET", "/containers", ghttp.RespondWithJSONEncoded(http.StatusOK, map[string][]string{
+				"Handles": {},
+			}))
+
+			fakeBaggageclaim = ghttp.NewServer()
+			fakeBaggageclaim.RouteToHandler("GET", "/volumes", ghttp.RespondWithJSONEncoded(http.StatusOK, []string{}))
+
+			registered = make(chan atc.Worker, 1)
+
+			fakeATC.RouteToHandler("POST", "/api/v1/workers", func(w http.ResponseWriter, r *http.Request) {
+				var worker atc.Worker
+				err := json.NewDecoder(r.Body).Decode(&worker)
+				Expect(err).NotTo(HaveOccurred())
+
+				select {
+				case registered <- worker:
+				default:
+				}
+			})
+
+			// the worker lands on its first heartbeat, so that the command exits
+			fakeATC.RouteToHandler("PUT", "/api/v1/workers/some-worker/heartbeat", ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Worker{
+				Name:  "some-worker",
+				Team:  "some-team",
+				State: "landed",
+			}))
+
+			opts = tsa.RegisterOptions{
+				LocalGardenNetwork: "tcp",
+				LocalGardenAddr:    fakeGarden.Addr(),
+
+				LocalBaggageclaimNetwork: "tcp",
+				LocalBaggageclaimAddr:    fakeBaggageclaim.Addr(),
+			}
+		})
+
+		AfterEach(func() {
+			fakeGarden.Close()
+			fakeBaggageclaim.Close()
+		})
+
+		It("forwards connections to the worker over streams and reports an exit status of 0 once it exits", func() {
+			errs := make(chan error, 1)
+			go func() {
+				errs <- client.Register(ctx, opts)
+			}()
+
+			Eventually(errs, 10*time.Second).Should(Receive(BeNil()))
+
+			var worker atc.Worker
+			Expect(registered).To(Receive(&worker))
+
+			host, _, err := net.SplitHostPort(worker.GardenAddr)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(host).To(Equal("127.0.0.1"))
+			Expect(worker.GardenAddr).NotTo(Equal(fakeGarden.Addr()))
+
+			Expect(fakeGarden.ReceivedRequests()).NotTo(BeEmpty())
+			Expect(fakeBaggageclaim.ReceivedRequests()).NotTo(BeEmpty())
+		})
+
+		Context("when the worker belongs to a team the certificate is not authorized for", func() {
+			BeforeEach(func() {
+				client.Worker.Team = "other-team"
+			})
+
+			It("reports an exit status of 1 without registering the worker", func() {
+				err := client.Register(ctx, opts)
+				Expect(err).To(Equal(&tsa.ExitError{
+					Command:    tsa.ForwardWorker,
+					ExitStatus: 1,
+				}))
+
+				Expect(registered).NotTo(Receive())
+			})
+		})
+	})
+
+	Describe("connection streams", func() {
+		var (
+			localConn  net.Conn
+			remoteConn net.Conn
+			done       chan struct{}
+		)
+
+		BeforeEach(func() {
+			localConn, remoteConn = net.Pipe()
+			done = make(chan struct{})
+
+			gateway.connections["some-connection"] = &pendingConnection{
+				team:    "some-team",
+				forward: "garden",
+				conn:    localConn,
+				done:    done,
+			}
+		})
+
+		AfterEach(func() {
+			remoteConn.Close()
+		})
+
+		connectionURL := func(id string) string {
+			return gatewayServer.URL + tsa.HTTP2ConnectionsPath + id
+		}
+
+		It("pipes the connection through the stream opened by a worker of its team", func() {
+			bodyR, bodyW := io.Pipe()
+			defer bodyW.Close()
+
+			responses := make(chan *http.Response, 1)
+			go func() {
+				defer GinkgoRecover()
+
+				response, err := gatewayClient(someTeamCA).Post(connectionURL("some-connection"), "", bodyR)
+				Expect(err).NotTo(HaveOccurred())
+
+				responses <- response
+			}()
+
+			go bodyW.Write([]byte("ping"))
+
+			received := make([]byte, 4)
+			_, err := io.ReadFull(remoteConn, received)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(received)).To(Equal("ping"))
+
+			_, err = remoteConn.Write([]byte("pong"))
+			Expect(err).NotTo(HaveOccurred())
+
+			var response *http.Response
+			Eventually(responses).Should(Receive(&response))
+			defer response.Body.Close()
+
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			sent := make([]byte, 4)
+			_, err = io.ReadFull(response.Body, sent)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(sent)).To(Equal("pong"))
+
+			bodyW.Close()
+
+			Eventually(done).Should(BeClosed())
+		})
+
+		Context("when a worker of another team opens the stream", func() {
+			It("refuses the stream and gives up on the connection", func() {
+				response, err := gatewayClient(otherTeamCA).Post(connectionURL("some-connection"), "", strings.NewReader("ping"))
+				Expect(err).NotTo(HaveOccurred())
+				response.Body.Close()
+
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Eventually(done).Should(BeClosed())
+
+				response, err = gatewayClient(someTeamCA).Post(connectionURL("some-connection"), "", strings.NewReader("ping"))
+				Expect(err).NotTo(HaveOccurred())
+				response.Body.Close()
+
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the connection is not pending", func() {
+			It("refuses the stream", func() {
+				response, err := gatewayClient(someTeamCA).Post(connectionURL("bogus-connection"), "", strings.NewReader("ping"))
+				Expect(err).NotTo(HaveOccurred())
+				response.Body.Close()
+
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				Consistently(done).ShouldNot(BeClosed())
+			})
+		})
+	})
+})
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+	}
+}
+
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return pool
+}
+
+func (ca *testCA) clientCertificate() tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "some-worker"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return tls.Certificate{
+		Certificate: [][]byte{der},
+		PrivateKey:  key,
+	}
+}
+
+func leaf(cert tls.Certificate) *x509.Certificate {
+	parsed, err := x509.ParseCertificate(cert.Certificate[0])
+	Expect(err).NotTo(HaveOccurred())
+	return parsed
+}
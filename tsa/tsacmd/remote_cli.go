@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"time"
@@ -16,11 +17,10 @@ import (
 	bclient "github.com/concourse/baggageclaim/client"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
-	"golang.org/x/crypto/ssh"
 )
 
 type request interface {
-	Handle(context.Context, ConnState, ssh.Channel) error
+	Handle(context.Context, ConnState, io.ReadWriter) error
 }
 
 type forwardWorkerRequest struct {
@@ -30,7 +30,7 @@ type forwardWorkerRequest struct {
 	baggageclaimAddr string
 }
 
-func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, channel io.ReadWriter) error {
 	logger := lagerctx.FromContext(ctx)
 
 	var worker atc.Worker
@@ -142,7 +142,7 @@ type registerWorkerRequest struct {
 	server *server
 }
 
-func (req registerWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+func (req registerWorkerRequest) Handle(ctx context.Context, state ConnState, channel io.ReadWriter) error {
 	var worker atc.Worker
 	err := json.NewDecoder(channel).Decode(&worker)
 	if err != nil {
@@ -199,7 +199,7 @@ func checkTeam(state ConnState, worker atc.Worker) error {
 	return nil
 }
 
-func (req landWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+func (req landWorkerRequest) Handle(ctx context.Context, state ConnState, channel io.ReadWriter) error {
 	var worker atc.Worker
 	err := json.NewDecoder(channel).Decode(&worker)
 	if err != nil {
@@ -220,7 +220,7 @@ type retireWorkerRequest struct {
 	server *server
 }
 
-func (req retireWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+func (req retireWorkerRequest) Handle(ctx context.Context, state ConnState, channel io.ReadWriter) error {
 	var worker atc.Worker
 	err := json.NewDecoder(channel).Decode(&worker)
 	if err != nil {
@@ -241,7 +241,7 @@ type deleteWorkerRequest struct {
 	server *server
 }
 
-func (req deleteWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+func (req deleteWorkerRequest) Handle(ctx context.Context, state ConnState, channel io.ReadWriter) error {
 	var worker atc.Worker
 	err := json.NewDecoder(channel).Decode(&worker)
 	if err != nil {
@@ -262,7 +262,7 @@ type sweepContainersRequest struct {
 	server *server
 }
 
-func (req sweepContainersRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+func (req sweepContainersRequest) Handle(ctx context.Context, state ConnState, channel io.ReadWriter) error {
 	var worker atc.Worker
 	err := json.NewDecoder(channel).Decode(&worker)
 	if err != nil {
@@ -296,7 +296,7 @@ type reportContainersRequest struct {
 	containerHandles []string
 }
 
-func (req reportContainersRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+func (req reportContainersRequest) Handle(ctx context.Context, state ConnState, channel io.ReadWriter) error {
 	var worker atc.Worker
 	err := json.NewDecoder(channel).Decode(&worker)
 	if err != nil {
@@ -318,7 +318,7 @@ type sweepVolumesRequest struct {
 	server *server
 }
 
-func (req sweepVolumesRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+func (req sweepVolumesRequest) Handle(ctx context.Context, state ConnState, channel io.ReadWriter) error {
 	var worker atc.Worker
 	err := json.NewDecoder(channel).Decode(&worker)
 	if err != nil {
@@ -352,7 +352,7 @@ type reportVolumesRequest struct {
 	volumeHandles []string
 }
 
-func (req reportVolumesRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+func (req reportVolumesRequest) Handle(ctx context.Context, state ConnState, channel io.ReadWriter) error {
 	var worker atc.Worker
 	err := json.NewDecoder(channel).Decode(&worker)
 	if err != nil {
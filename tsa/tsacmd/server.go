@@ -488,6 +488,15 @@ func (server *server) parseRequest(cli string) (request, string, error) {
 	command := argv[0]
 	args := argv[1:]
 
+	req, err := server.newRequest(command, args)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return req, command, nil
+}
+
+func (server *server) newRequest(command string, args []string) (request, error) {
 	var req request
 	switch command {
 	case tsa.RegisterWorker:
@@ -502,7 +511,7 @@ func (server *server) parseRequest(cli string) (request, string, error) {
 
 		err := fs.Parse(args)
 		if err != nil {
-			return nil, "", err
+			return nil, err
 		}
 
 		req = forwardWorkerRequest{
@@ -542,8 +551,8 @@ func (server *server) parseRequest(cli string) (request, string, error) {
 			volumeHandles: args,
 		}
 	default:
-		return nil, "", fmt.Errorf("unknown command: %s", command)
+		return nil, fmt.Errorf("unknown command: %s", command)
 	}
 
-	return req, command, nil
+	return req, nil
 }
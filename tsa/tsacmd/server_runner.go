@@ -1,11 +1,14 @@
 package tsacmd
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 
 	"code.cloudfoundry.org/lager"
+	"golang.org/x/net/http2"
 )
 
 type serverRunner struct {
@@ -42,3 +45,50 @@ func (runner serverRunner) Run(signals <-chan os.Signal, ready chan<- struct{})
 		}
 	}
 }
+
+type http2GatewayRunner struct {
+	logger lager.Logger
+
+	gateway *http2Gateway
+
+	tlsConfig  *tls.Config
+	listenAddr string
+}
+
+func (runner http2GatewayRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	listener, err := net.Listen("tcp", runner.listenAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %s", runner.listenAddr, err)
+	}
+
+	server := &http.Server{
+		Handler:   runner.gateway,
+		TLSConfig: runner.tlsConfig,
+	}
+
+	err = http2.ConfigureServer(server, nil)
+	if err != nil {
+		listener.Close()
+		return fmt.Errorf("failed to configure HTTP/2: %s", err)
+	}
+
+	runner.logger.Info("listening")
+
+	close(ready)
+
+	exited := make(chan struct{})
+
+	go func() {
+		defer close(exited)
+		server.ServeTLS(listener, "", "")
+	}()
+
+	for {
+		select {
+		case <-exited:
+			return nil
+		case <-signals:
+			listener.Close()
+		}
+	}
+}
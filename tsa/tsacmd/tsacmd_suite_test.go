@@ -0,0 +1,13 @@
+package tsacmd
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestTSACmd(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "TSACmd Suite")
+}
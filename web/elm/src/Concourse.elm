@@ -366,10 +366,15 @@ decodeBuildPlan_ =
             (Json.Decode.oneOf
                 -- buckle up
                 [ Json.Decode.field "task" <| lazy (\_ -> decodeBuildStepTask)
+                , Json.Decode.field "set_pipeline" <| lazy (\_ -> decodeBuildStepTask)
+                , Json.Decode.field "check" <| lazy (\_ -> decodeBuildStepTask)
+                , Json.Decode.field "load_var" <| lazy (\_ -> decodeBuildStepTask)
                 , Json.Decode.field "get" <| lazy (\_ -> decodeBuildStepGet)
                 , Json.Decode.field "put" <| lazy (\_ -> decodeBuildStepPut)
                 , Json.Decode.field "dependent_get" <| lazy (\_ -> decodeBuildStepGet)
                 , Json.Decode.field "aggregate" <| lazy (\_ -> decodeBuildStepAggregate)
+                , Json.Decode.field "in_parallel" <| lazy (\_ -> decodeBuildStepInParallel)
+                , Json.Decode.field "across" <| lazy (\_ -> decodeBuildStepAcross)
                 , Json.Decode.field "do" <| lazy (\_ -> decodeBuildStepDo)
                 , Json.Decode.field "on_success" <| lazy (\_ -> decodeBuildStepOnSuccess)
                 , Json.Decode.field "on_failure" <| lazy (\_ -> decodeBuildStepOnFailure)
@@ -407,6 +412,18 @@ decodeBuildStepAggregate =
         |> andMap (Json.Decode.array (lazy (\_ -> decodeBuildPlan_)))
 
 
+decodeBuildStepInParallel : Json.Decode.Decoder BuildStep
+decodeBuildStepInParallel =
+    Json.Decode.succeed BuildStepAggregate
+        |> andMap (Json.Decode.field "steps" <| Json.Decode.array (lazy (\_ -> decodeBuildPlan_)))
+
+
+decodeBuildStepAcross : Json.Decode.Decoder BuildStep
+decodeBuildStepAcross =
+    Json.Decode.succeed BuildStepAggregate
+        |> andMap (Json.Decode.field "steps" <| Json.Decode.array (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_)))
+
+
 decodeBuildStepDo : Json.Decode.Decoder BuildStep
 decodeBuildStepDo =
     Json.Decode.succeed BuildStepDo
@@ -106,6 +106,15 @@ decodeBuildEvent =
                                 (Json.Decode.field "exit_status" Json.Decode.int)
                             )
 
+                    "finish-load-var" ->
+                        Json.Decode.field "data" decodeFinishSucceeded
+
+                    "finish-set-pipeline" ->
+                        Json.Decode.field "data" decodeFinishSucceeded
+
+                    "finish-check" ->
+                        Json.Decode.field "data" decodeFinishSucceeded
+
                     "finish-get" ->
                         Json.Decode.field "data" (decodeFinishResource FinishGet)
 
@@ -122,6 +131,23 @@ dateFromSeconds =
     Time.millisToPosix << (*) 1000
 
 
+decodeFinishSucceeded : Json.Decode.Decoder BuildEvent
+decodeFinishSucceeded =
+    -- steps which only succeed or fail are shown as finished tasks
+    Json.Decode.map2 FinishTask
+        (Json.Decode.field "origin" decodeOrigin)
+        (Json.Decode.field "succeeded" Json.Decode.bool
+            |> Json.Decode.map
+                (\succeeded ->
+                    if succeeded then
+                        0
+
+                    else
+                        1
+                )
+        )
+
+
 decodeFinishResource :
     (Origin
      -> Int
@@ -14,7 +14,7 @@ import (
 
 func NewBeaconRunner(
 	logger lager.Logger,
-	tsaClient *tsa.Client,
+	tsaClient TSAClient,
 	rebalanceInterval time.Duration,
 	connectionDrainTimeout time.Duration,
 	gardenAddr string,
@@ -67,6 +67,13 @@ func NewBeaconRunner(
 				return nil
 			}
 
+			if _, ok := prevErr.(*tsa.ExitError); ok {
+				logger.Info("exiting", lager.Data{
+					"reason": "registration process exited via HTTP/2 gateway",
+				})
+				return nil
+			}
+
 			logger.Error("failed", prevErr)
 
 			time.Sleep(5 * time.Second)
@@ -20,9 +20,12 @@ func (cmd *RetireWorkerCommand) Execute(args []string) error {
 	logger := lager.NewLogger("retire-worker")
 	logger.RegisterSink(lager.NewPrettySink(os.Stdout, lager.DEBUG))
 
-	client := cmd.TSA.Client(atc.Worker{
+	client, err := cmd.TSA.Client(atc.Worker{
 		Name: cmd.WorkerName,
 	})
+	if err != nil {
+		return err
+	}
 
 	return client.Retire(lagerctx.NewContext(context.Background(), logger))
 }
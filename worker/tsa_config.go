@@ -1,22 +1,84 @@
 package worker
 
 import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 	"github.com/concourse/flag"
 )
 
 type TSAConfig struct {
+	Gateway string `long:"gateway" default:"ssh" choice:"ssh" choice:"http2" description:"Gateway to register the worker through. The HTTP/2 gateway must be enabled on the web nodes."`
+
 	Hosts            []string            `long:"host" default:"127.0.0.1:2222" description:"TSA host to forward the worker through. Can be specified multiple times."`
 	PublicKey        flag.AuthorizedKeys `long:"public-key" description:"File containing a public key to expect from the TSA."`
-	WorkerPrivateKey *flag.PrivateKey    `long:"worker-private-key" required:"true" description:"File containing the private key to use when authenticating to the TSA."`
+	WorkerPrivateKey *flag.PrivateKey    `long:"worker-private-key" description:"File containing the private key to use when authenticating to the TSA."`
+
+	HTTP2Hosts  []string  `long:"http2-host"    default:"127.0.0.1:2223" description:"TSA HTTP/2 gateway host to register the worker through. Can be specified multiple times."`
+	HTTP2CACert flag.File `long:"http2-ca-cert" description:"File containing the CA certificate to verify the TSA's HTTP/2 certificate with. Defaults to the system's CAs."`
+	HTTP2Cert   flag.File `long:"http2-cert"    description:"File containing the client certificate to use when authenticating to the TSA over HTTP/2."`
+	HTTP2Key    flag.File `long:"http2-key"     description:"File containing the private key of the client certificate."`
 }
 
-func (config TSAConfig) Client(worker atc.Worker) *tsa.Client {
+func (config TSAConfig) Client(worker atc.Worker) (TSAClient, error) {
+	if config.Gateway == "http2" {
+		tlsConfig, err := config.http2TLSConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		return &tsa.HTTP2Client{
+			Hosts:     config.HTTP2Hosts,
+			TLSConfig: tlsConfig,
+			Worker:    worker,
+		}, nil
+	}
+
+	if config.WorkerPrivateKey == nil {
+		return nil, errors.New("a worker private key is required to register through the SSH gateway")
+	}
+
 	return &tsa.Client{
 		Hosts:      config.Hosts,
 		HostKeys:   config.PublicKey.Keys,
 		PrivateKey: config.WorkerPrivateKey.PrivateKey,
 		Worker:     worker,
+	}, nil
+}
+
+func (config TSAConfig) http2TLSConfig() (*tls.Config, error) {
+	if config.HTTP2Cert == "" || config.HTTP2Key == "" {
+		return nil, errors.New("a client certificate and key are required to register through the HTTP/2 gateway")
 	}
+
+	cert, err := tls.LoadX509KeyPair(string(config.HTTP2Cert), string(config.HTTP2Key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %s", err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if config.HTTP2CACert != "" {
+		caCert, err := ioutil.ReadFile(string(config.HTTP2CACert))
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates found in %s", config.HTTP2CACert)
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
 }